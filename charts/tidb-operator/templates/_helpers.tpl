@@ -59,3 +59,65 @@ By default, we extract the v<major>.<minor>.<patch> part from the KubeVersion, e
 {{- define "kube-scheduler.image_tag" -}}
 {{- default (regexFind "^v\\d+\\.\\d+\\.\\d+" .Capabilities.KubeVersion.GitVersion) .Values.scheduler.kubeSchedulerImageTag -}}
 {{- end -}}
+
+{{/*
+The permissions of the controller manager in the namespaces it manages if clusterScoped is false.
+*/}}
+{{- define "controller-manager.namespaced-rules" }}
+- apiGroups: [""]
+  resources:
+    - services
+    - events
+  verbs: ["*"]
+- apiGroups: [""]
+  resources: ["endpoints","configmaps"]
+  verbs: ["create", "get", "list", "watch", "update", "delete"]
+- apiGroups: [""]
+  resources: ["serviceaccounts"]
+  verbs: ["create","get","update","delete"]
+- apiGroups: ["batch"]
+  resources: ["jobs"]
+  verbs: ["get", "list", "watch", "create", "update", "delete"]
+- apiGroups: [""]
+  resources: ["secrets"]
+  verbs: ["create", "update", "get", "list", "watch", "delete"]
+- apiGroups: [""]
+  resources: ["persistentvolumeclaims"]
+  verbs: ["get", "list", "watch", "create", "update", "delete", "patch"]
+- apiGroups: [""]
+  resources: ["pods"]
+  verbs: ["get", "list", "watch", "create", "update", "delete"]
+# resize the pods in place by the resize subresource since Kubernetes v1.33
+- apiGroups: [""]
+  resources: ["pods/resize"]
+  verbs: ["patch"]
+- apiGroups: ["apps"]
+  resources: ["statefulsets","deployments", "daemonsets", "controllerrevisions"]
+  verbs: ["*"]
+- apiGroups: ["apps.pingcap.com"]
+  resources: ["statefulsets", "statefulsets/status"]
+  verbs: ["*"]
+- apiGroups: ["extensions"]
+  resources: ["ingresses"]
+  verbs: ["*"]
+- apiGroups: ["networking.k8s.io"]
+  resources: ["ingresses"]
+  verbs: ["*"]
+- apiGroups: ["pingcap.com"]
+  resources: ["*"]
+  verbs: ["*"]
+- apiGroups: ["rbac.authorization.k8s.io"]
+  resources: ["roles"]
+  verbs: ["escalate","create","get","update", "delete"]
+- apiGroups: ["rbac.authorization.k8s.io"]
+  resources: ["rolebindings"]
+  verbs: ["create","get","update", "delete"]
+{{- if .Values.features | has "AdvancedStatefulSet=true" }}
+- apiGroups:
+  - apps.pingcap.com
+  resources:
+  - statefulsets
+  verbs:
+  - '*'
+{{- end }}
+{{- end }}
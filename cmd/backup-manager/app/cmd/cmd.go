@@ -35,6 +35,7 @@ func NewBackupMgrCommand() *cobra.Command {
 	cmds.AddCommand(NewImportCommand())
 	cmds.AddCommand(NewCleanCommand())
 	cmds.AddCommand(NewCompactCommand())
+	cmds.AddCommand(NewDiagnoseCommand())
 	return cmds
 }
 
@@ -0,0 +1,71 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/constants"
+	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/diagnose"
+	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
+	informers "github.com/pingcap/tidb-operator/pkg/client/informers/externalversions"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+// NewDiagnoseCommand implements the diagnose command
+func NewDiagnoseCommand() *cobra.Command {
+	opts := diagnose.Options{}
+
+	cmd := &cobra.Command{
+		Use:   "diagnose",
+		Short: "Collect the diagnostics bundle of a tidb cluster.",
+		Run: func(cmd *cobra.Command, args []string) {
+			util.ValidCmdFlags(cmd.CommandPath(), cmd.LocalFlags())
+			cmdutil.CheckErr(runDiagnose(opts, kubecfg))
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Namespace, "namespace", "", "TidbClusterDiagnostics CR's namespace")
+	cmd.Flags().StringVar(&opts.ResourceName, "resourceName", "", "TidbClusterDiagnostics CRD object name")
+	return cmd
+}
+
+func runDiagnose(opts diagnose.Options, kubecfg string) error {
+	kubeCli, cli, err := util.NewKubeAndCRCli(kubecfg)
+	if err != nil {
+		return err
+	}
+	options := []informers.SharedInformerOption{
+		informers.WithNamespace(opts.Namespace),
+	}
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(cli, constants.ResyncDuration, options...)
+	recorder := util.NewEventRecorder(kubeCli, "diagnostics-manager")
+	diagInformer := informerFactory.Pingcap().V1alpha1().TidbClusterDiagnostics()
+	statusUpdater := controller.NewRealDiagnosticsStatusUpdater(recorder, diagInformer.Lister(), cli)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go informerFactory.Start(ctx.Done())
+
+	// waiting for the shared informer's store has synced.
+	cache.WaitForCacheSync(ctx.Done(), diagInformer.Informer().HasSynced)
+
+	klog.Infof("start to collect diagnostics %s", opts.String())
+	dm := diagnose.NewManager(kubeCli, cli, diagInformer.Lister(), statusUpdater, opts)
+	return dm.ProcessDiagnostics()
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// bundle is a tar.gz file written to a temporary file before it's uploaded
+type bundle struct {
+	file *os.File
+	gz   *gzip.Writer
+	tw   *tar.Writer
+	root string
+	// errors are the items failed to be collected
+	errors []string
+}
+
+func newBundle(root string) (*bundle, error) {
+	f, err := os.CreateTemp("", root+"-*.tar.gz")
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(f)
+	return &bundle{
+		file: f,
+		gz:   gz,
+		tw:   tar.NewWriter(gz),
+		root: root,
+	}, nil
+}
+
+// add adds a file to the bundle, the data must have been redacted
+func (b *bundle) add(name string, data []byte) error {
+	hdr := &tar.Header{
+		Name:    path.Join(b.root, name),
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err := b.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := b.tw.Write(data)
+	return err
+}
+
+// addObject adds obj as a redacted json file to the bundle
+func (b *bundle) addObject(name string, obj interface{}) {
+	data, err := redactObject(obj)
+	if err != nil {
+		b.fail(name, err)
+		return
+	}
+	if err := b.add(name, data); err != nil {
+		b.fail(name, err)
+	}
+}
+
+// addText adds the redacted text to the bundle
+func (b *bundle) addText(name string, text []byte) {
+	if err := b.add(name, []byte(redactText(string(text)))); err != nil {
+		b.fail(name, err)
+	}
+}
+
+// fail records an item failed to be collected, the collection continues with the other items
+func (b *bundle) fail(item string, err error) {
+	klog.Warningf("failed to collect %s: %v", item, err)
+	b.errors = append(b.errors, fmt.Sprintf("%s: %v", item, err))
+}
+
+// close flushes the bundle and returns its size
+func (b *bundle) close() (int64, error) {
+	if err := b.tw.Close(); err != nil {
+		return 0, err
+	}
+	if err := b.gz.Close(); err != nil {
+		return 0, err
+	}
+	info, err := b.file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), b.file.Close()
+}
+
+// remove removes the temporary file
+func (b *bundle) remove() {
+	b.file.Close()
+	os.Remove(b.file.Name())
+}
@@ -0,0 +1,316 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/crypto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const statusAPITimeout = 10 * time.Second
+
+// statusAPIs are the paths of the status APIs collected from each pod of the components
+var statusAPIs = map[v1alpha1.MemberType][]string{
+	v1alpha1.TiKVMemberType: {"/status", "/config"},
+	v1alpha1.TiDBMemberType: {"/status", "/config", "/info/all"},
+}
+
+// collector collects the diagnostics of a TidbCluster into a bundle, the failures of the items are recorded
+// in the bundle and never stop the collection.
+type collector struct {
+	kubeCli kubernetes.Interface
+	cli     versioned.Interface
+	diag    *v1alpha1.TidbClusterDiagnostics
+	bundle  *bundle
+
+	ns string
+	tc *v1alpha1.TidbCluster
+	// tlsConfig is set if the TLS is enabled between the components
+	tlsConfig *tls.Config
+}
+
+func (c *collector) collect(ctx context.Context) {
+	c.ns = c.diag.GetClusterNamespace()
+	tcName := c.diag.Spec.Cluster.Name
+
+	tc, err := c.cli.PingcapV1alpha1().TidbClusters(c.ns).Get(ctx, tcName, metav1.GetOptions{})
+	if err != nil {
+		c.bundle.fail("tidbcluster", err)
+	} else {
+		c.tc = tc
+		c.bundle.addObject("tidbcluster.json", tc)
+	}
+
+	c.collectKubeObjects(ctx, tcName)
+	c.collectEvents(ctx, tcName)
+	c.collectLogs(ctx, tcName)
+
+	// the APIs of the components can't be found without the TidbCluster
+	if c.tc == nil {
+		return
+	}
+	if c.tc.IsTLSClusterEnabled() {
+		secretName := util.ClusterClientTLSSecretName(tcName)
+		secret, err := c.kubeCli.CoreV1().Secrets(c.ns).Get(ctx, secretName, metav1.GetOptions{})
+		if err != nil {
+			c.bundle.fail("tls", fmt.Errorf("unable to load certificates from secret %s/%s: %v", c.ns, secretName, err))
+			return
+		}
+		if c.tlsConfig, err = crypto.LoadTlsConfigFromSecret(secret); err != nil {
+			c.bundle.fail("tls", err)
+			return
+		}
+	}
+	if c.collectComponent(v1alpha1.PDMemberType) && c.tc.Spec.PD != nil {
+		c.collectPD()
+	}
+	c.collectStatusAPIs(ctx, tcName)
+}
+
+func (c *collector) collectComponent(memberType v1alpha1.MemberType) bool {
+	for _, m := range c.diag.GetComponents() {
+		if m == memberType {
+			return true
+		}
+	}
+	return false
+}
+
+// collectKubeObjects collects the Kubernetes objects of the cluster, secrets are never collected
+func (c *collector) collectKubeObjects(ctx context.Context, tcName string) {
+	opts := metav1.ListOptions{LabelSelector: label.New().Instance(tcName).String()}
+
+	items := []struct {
+		name string
+		list func() (interface{}, error)
+	}{
+		{"statefulsets", func() (interface{}, error) {
+			return c.kubeCli.AppsV1().StatefulSets(c.ns).List(ctx, opts)
+		}},
+		{"pods", func() (interface{}, error) { return c.kubeCli.CoreV1().Pods(c.ns).List(ctx, opts) }},
+		{"services", func() (interface{}, error) { return c.kubeCli.CoreV1().Services(c.ns).List(ctx, opts) }},
+		{"configmaps", func() (interface{}, error) { return c.kubeCli.CoreV1().ConfigMaps(c.ns).List(ctx, opts) }},
+		{"persistentvolumeclaims", func() (interface{}, error) {
+			return c.kubeCli.CoreV1().PersistentVolumeClaims(c.ns).List(ctx, opts)
+		}},
+	}
+	for _, item := range items {
+		list, err := item.list()
+		if err != nil {
+			c.bundle.fail(item.name, err)
+			continue
+		}
+		c.bundle.addObject(path.Join("kubernetes", item.name+".json"), list)
+	}
+}
+
+// collectEvents collects the most recent events of the TidbCluster and the objects named after it
+func (c *collector) collectEvents(ctx context.Context, tcName string) {
+	events, err := c.kubeCli.CoreV1().Events(c.ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		c.bundle.fail("events", err)
+		return
+	}
+	var items []corev1.Event
+	for _, e := range events.Items {
+		if e.InvolvedObject.Name == tcName || strings.HasPrefix(e.InvolvedObject.Name, tcName+"-") {
+			items = append(items, e)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return eventTime(&items[i]).After(eventTime(&items[j]))
+	})
+	if limit := c.diag.GetEventLimit(); len(items) > limit {
+		items = items[:limit]
+	}
+	events.Items = items
+	c.bundle.addObject(path.Join("kubernetes", "events.json"), events)
+}
+
+func eventTime(e *corev1.Event) time.Time {
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp.Time
+	}
+	if !e.EventTime.IsZero() {
+		return e.EventTime.Time
+	}
+	return e.CreationTimestamp.Time
+}
+
+// collectLogs collects the logs of the containers of the components, including the ones of the previous
+// containers if they have restarted
+func (c *collector) collectLogs(ctx context.Context, tcName string) {
+	for _, memberType := range c.diag.GetComponents() {
+		opts := metav1.ListOptions{LabelSelector: label.New().Instance(tcName).Component(memberType.String()).String()}
+		pods, err := c.kubeCli.CoreV1().Pods(c.ns).List(ctx, opts)
+		if err != nil {
+			c.bundle.fail(fmt.Sprintf("%s pods", memberType), err)
+			continue
+		}
+		for _, pod := range pods.Items {
+			for _, status := range pod.Status.ContainerStatuses {
+				c.collectLog(ctx, pod.Name, status.Name, false)
+				if status.RestartCount > 0 {
+					c.collectLog(ctx, pod.Name, status.Name, true)
+				}
+			}
+		}
+	}
+}
+
+func (c *collector) collectLog(ctx context.Context, podName, container string, previous bool) {
+	name := path.Join("logs", podName, container+".log")
+	if previous {
+		name = path.Join("logs", podName, container+".previous.log")
+	}
+	sinceSeconds := c.diag.GetLogSinceSeconds()
+	limitBytes := c.diag.GetLogLimitBytes()
+	data, err := c.kubeCli.CoreV1().Pods(c.ns).GetLogs(podName, &corev1.PodLogOptions{
+		Container:    container,
+		Previous:     previous,
+		SinceSeconds: &sinceSeconds,
+		LimitBytes:   &limitBytes,
+	}).DoRaw(ctx)
+	if err != nil {
+		c.bundle.fail(name, err)
+		return
+	}
+	c.bundle.addText(name, data)
+}
+
+// collectPD collects the snapshots of the members, stores and regions from PD
+func (c *collector) collectPD() {
+	url := fmt.Sprintf("%s://%s.%s:%d", c.tc.Scheme(), controller.PDMemberName(c.tc.Name), c.ns, v1alpha1.DefaultPDClientPort)
+	pdClient := pdapi.NewPDClient(url, pdapi.DefaultTimeout, c.tlsConfig)
+
+	items := []struct {
+		name string
+		get  func() (interface{}, error)
+	}{
+		{"members", func() (interface{}, error) { return pdClient.GetMembers() }},
+		{"health", func() (interface{}, error) { return pdClient.GetHealth() }},
+		{"config", func() (interface{}, error) { return pdClient.GetConfig() }},
+		{"cluster", func() (interface{}, error) { return pdClient.GetCluster() }},
+		{"stores", func() (interface{}, error) { return pdClient.GetStores() }},
+		{"tombstone-stores", func() (interface{}, error) { return pdClient.GetTombStoneStores() }},
+		{"region-checks", func() (interface{}, error) {
+			counts := map[pdapi.RegionCheckType]int{}
+			for _, check := range []pdapi.RegionCheckType{
+				pdapi.RegionCheckMissPeer,
+				pdapi.RegionCheckDownPeer,
+				pdapi.RegionCheckPendingPeer,
+				pdapi.RegionCheckLearnerPeer,
+			} {
+				count, err := pdClient.GetRegionCountByCheck(check)
+				if err != nil {
+					return nil, err
+				}
+				counts[check] = count
+			}
+			return counts, nil
+		}},
+	}
+	for _, item := range items {
+		name := path.Join("pd", item.name+".json")
+		obj, err := item.get()
+		if err != nil {
+			c.bundle.fail(name, err)
+			continue
+		}
+		c.bundle.addObject(name, obj)
+	}
+}
+
+// collectStatusAPIs collects the outputs of the status APIs from each pod of TiKV and TiDB
+func (c *collector) collectStatusAPIs(ctx context.Context, tcName string) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = c.tlsConfig
+	httpClient := &http.Client{Timeout: statusAPITimeout, Transport: transport}
+
+	for _, memberType := range c.diag.GetComponents() {
+		paths, ok := statusAPIs[memberType]
+		if !ok {
+			continue
+		}
+		var peerService string
+		var port int32
+		switch memberType {
+		case v1alpha1.TiKVMemberType:
+			peerService, port = controller.TiKVPeerMemberName(tcName), v1alpha1.DefaultTiKVStatusPort
+		case v1alpha1.TiDBMemberType:
+			peerService, port = controller.TiDBPeerMemberName(tcName), v1alpha1.DefaultTiDBStatusPort
+		}
+
+		opts := metav1.ListOptions{LabelSelector: label.New().Instance(tcName).Component(memberType.String()).String()}
+		pods, err := c.kubeCli.CoreV1().Pods(c.ns).List(ctx, opts)
+		if err != nil {
+			c.bundle.fail(fmt.Sprintf("%s pods", memberType), err)
+			continue
+		}
+		for _, pod := range pods.Items {
+			host := fmt.Sprintf("%s.%s.%s", pod.Name, peerService, c.ns)
+			if c.tc.Spec.ClusterDomain != "" {
+				host = fmt.Sprintf("%s.svc.%s", host, c.tc.Spec.ClusterDomain)
+			}
+			for _, p := range paths {
+				name := path.Join("status", pod.Name, strings.ReplaceAll(strings.TrimPrefix(p, "/"), "/", "-")+".json")
+				url := fmt.Sprintf("%s://%s:%d%s", c.tc.Scheme(), host, port, p)
+				data, err := getStatusAPI(ctx, httpClient, url)
+				if err != nil {
+					c.bundle.fail(name, err)
+					continue
+				}
+				c.bundle.addText(name, data)
+			}
+		}
+	}
+}
+
+func getStatusAPI(ctx context.Context, httpClient *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s returns %d: %s", url, res.StatusCode, string(data))
+	}
+	return data, nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pingcap/errors"
+	backuputil "github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	pkgutil "github.com/pingcap/tidb-operator/pkg/backup/util"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	listers "github.com/pingcap/tidb-operator/pkg/client/listers/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// Options contains the input arguments to the diagnose command
+type Options struct {
+	Namespace    string
+	ResourceName string
+}
+
+func (o *Options) String() string {
+	return fmt.Sprintf("%s/%s", o.Namespace, o.ResourceName)
+}
+
+// Manager collects the diagnostics bundle of a TidbClusterDiagnostics and uploads it
+type Manager struct {
+	kubeCli       kubernetes.Interface
+	cli           versioned.Interface
+	lister        listers.TidbClusterDiagnosticsLister
+	statusUpdater controller.DiagnosticsStatusUpdaterInterface
+	options       Options
+}
+
+// NewManager returns a Manager
+func NewManager(
+	kubeCli kubernetes.Interface,
+	cli versioned.Interface,
+	lister listers.TidbClusterDiagnosticsLister,
+	statusUpdater controller.DiagnosticsStatusUpdaterInterface,
+	options Options) *Manager {
+	return &Manager{
+		kubeCli:       kubeCli,
+		cli:           cli,
+		lister:        lister,
+		statusUpdater: statusUpdater,
+		options:       options,
+	}
+}
+
+// ProcessDiagnostics collects and uploads the diagnostics bundle, then updates the status
+func (m *Manager) ProcessDiagnostics() error {
+	ctx, cancel := backuputil.GetContextForTerminationSignals(m.options.ResourceName)
+	defer cancel()
+
+	diag, err := m.lister.TidbClusterDiagnostics(m.options.Namespace).Get(m.options.ResourceName)
+	if err != nil {
+		return errors.Annotatef(err, "can't find TidbClusterDiagnostics %s", m.options.String())
+	}
+	diag = diag.DeepCopy()
+	if diag.IsFinished() {
+		klog.Infof("TidbClusterDiagnostics %s is already %s, skip", m.options.String(), diag.Status.Phase)
+		return nil
+	}
+
+	startTime := metav1.Now()
+	if err := m.statusUpdater.Update(diag, &v1alpha1.TidbClusterDiagnosticsStatus{
+		Phase:     v1alpha1.DiagnosticsRunning,
+		StartTime: &startTime,
+	}); err != nil {
+		return err
+	}
+
+	location, size, collectErrors, err := m.collectAndUpload(ctx, diag, startTime)
+	if err != nil {
+		// the job is retried on failures, the controller marks the diagnostics failed after the retries
+		klog.Errorf("failed to collect diagnostics %s: %v", m.options.String(), err)
+		if uerr := m.statusUpdater.Update(diag, &v1alpha1.TidbClusterDiagnosticsStatus{
+			Message: err.Error(),
+		}); uerr != nil {
+			klog.Errorf("failed to update the status of diagnostics %s: %v", m.options.String(), uerr)
+		}
+		return err
+	}
+
+	completionTime := metav1.Now()
+	status := &v1alpha1.TidbClusterDiagnosticsStatus{
+		Phase:          v1alpha1.DiagnosticsComplete,
+		Location:       location,
+		Size:           size,
+		Errors:         collectErrors,
+		CompletionTime: &completionTime,
+	}
+	if len(collectErrors) > 0 {
+		status.Message = fmt.Sprintf("%d items failed to be collected", len(collectErrors))
+	}
+	return m.statusUpdater.Update(diag, status)
+}
+
+// collectAndUpload returns the location and size of the uploaded bundle, and the items failed to be collected
+func (m *Manager) collectAndUpload(ctx context.Context, diag *v1alpha1.TidbClusterDiagnostics, startTime metav1.Time) (string, int64, []string, error) {
+	root := fmt.Sprintf("%s-%s", diag.Name, startTime.UTC().Format("20060102-150405"))
+	b, err := newBundle(root)
+	if err != nil {
+		return "", 0, nil, errors.Annotate(err, "failed to create the bundle")
+	}
+	defer b.remove()
+
+	c := &collector{
+		kubeCli: m.kubeCli,
+		cli:     m.cli,
+		diag:    diag,
+		bundle:  b,
+	}
+	c.collect(ctx)
+
+	size, err := b.close()
+	if err != nil {
+		return "", 0, nil, errors.Annotate(err, "failed to write the bundle")
+	}
+
+	key := root + ".tar.gz"
+	if err := upload(ctx, diag.Spec.StorageProvider, key, b.file.Name()); err != nil {
+		return "", 0, nil, errors.Annotate(err, "failed to upload the bundle")
+	}
+	storagePath, err := backuputil.GetStoragePath(&diag.Spec.StorageProvider)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	klog.Infof("diagnostics %s is uploaded to %s/%s, %d bytes", m.options.String(), storagePath, key, size)
+	return strings.TrimSuffix(storagePath, "/") + "/" + key, size, b.errors, nil
+}
+
+func upload(ctx context.Context, provider v1alpha1.StorageProvider, key, file string) error {
+	backend, err := pkgutil.NewStorageBackend(provider, &pkgutil.StorageCredential{})
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w, err := backend.NewWriter(ctx, key, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, f); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
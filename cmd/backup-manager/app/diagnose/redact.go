@@ -0,0 +1,125 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+const redacted = "<redacted>"
+
+var (
+	// sensitiveWords are matched against the keys normalized by normalizeKey
+	sensitiveWords = []string{"password", "passwd", "secret", "token", "accesskey", "privatekey", "credential"}
+	// keys ending with these suffixes refer to the sensitive data instead of containing it, e.g. secretName
+	referenceSuffixes = []string{"name", "names", "ref", "path", "file"}
+
+	// keyValuePattern matches `key = value` and `key: value` in the config files, json and logs
+	keyValuePattern = regexp.MustCompile(`((?:^|[\s{,\['"])"?([\w.-]+)"?\s*[:=]\s*)("(?:[^"\\\n]|\\.)*"|'[^'\n]*'|[^\s,#{}\[\]"']+)`)
+)
+
+func normalizeKey(key string) string {
+	return strings.NewReplacer("-", "", "_", "", ".", "").Replace(strings.ToLower(key))
+}
+
+// isSensitiveKey returns whether the value of the key should be redacted
+func isSensitiveKey(key string) bool {
+	k := normalizeKey(key)
+	for _, suffix := range referenceSuffixes {
+		if strings.HasSuffix(k, suffix) {
+			return false
+		}
+	}
+	for _, word := range sensitiveWords {
+		if strings.Contains(k, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactText redacts the values of the sensitive keys in the text, the result is still a valid json, toml or
+// yaml if the text is.
+func redactText(text string) string {
+	return keyValuePattern.ReplaceAllStringFunc(text, func(match string) string {
+		sub := keyValuePattern.FindStringSubmatch(match)
+		if sub == nil || !isSensitiveKey(sub[2]) {
+			return match
+		}
+		return sub[1] + `"` + redacted + `"`
+	})
+}
+
+// redactValue walks the value decoded from json, redacts the string values of the sensitive keys, the
+// sensitive environment variables and the sensitive settings in the embedded configs.
+func redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		// environment variables like {"name": "PASSWORD", "value": "..."}
+		if name, ok := val["name"].(string); ok && isSensitiveKey(name) {
+			if _, ok := val["value"].(string); ok {
+				val["value"] = redacted
+			}
+		}
+		for k, item := range val {
+			if s, ok := item.(string); ok && isSensitiveKey(k) && s != "" {
+				val[k] = redacted
+				continue
+			}
+			val[k] = redactValue(item)
+		}
+		return val
+	case []interface{}:
+		for i, item := range val {
+			val[i] = redactValue(item)
+		}
+		return val
+	case string:
+		return redactText(val)
+	default:
+		return v
+	}
+}
+
+// redactObject converts obj to a redacted json, the managedFields of the Kubernetes objects are removed
+func redactObject(obj interface{}) ([]byte, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	removeManagedFields(v)
+	return json.MarshalIndent(redactValue(v), "", "  ")
+}
+
+func removeManagedFields(v interface{}) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if meta, ok := val["metadata"].(map[string]interface{}); ok {
+			delete(meta, "managedFields")
+		}
+		for _, item := range val {
+			removeManagedFields(item)
+		}
+	case []interface{}:
+		for _, item := range val {
+			removeManagedFields(item)
+		}
+	}
+}
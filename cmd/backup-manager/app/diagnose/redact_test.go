@@ -0,0 +1,125 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRedactText(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cases := map[string]struct {
+		text   string
+		expect string
+	}{
+		"toml": {
+			text:   "[security]\nssl-ca = \"/var/lib/tidb-tls/ca.crt\"\npassword = \"abc\"\n",
+			expect: "[security]\nssl-ca = \"/var/lib/tidb-tls/ca.crt\"\npassword = \"<redacted>\"\n",
+		},
+		"yaml": {
+			text:   "user: root\naccess-key: AKIA123\n",
+			expect: "user: root\naccess-key: \"<redacted>\"\n",
+		},
+		"json": {
+			text:   `{"user":"root","security":{"secret_key":"abc","token":123},"port":4000}`,
+			expect: `{"user":"root","security":{"secret_key":"<redacted>","token":"<redacted>"},"port":4000}`,
+		},
+		"args": {
+			text:   "--user=root --password=abc",
+			expect: `--user=root --password="<redacted>"`,
+		},
+		"references are kept": {
+			text:   "secretName: tls-secret\ntoken-file: /var/run/token\n",
+			expect: "secretName: tls-secret\ntoken-file: /var/run/token\n",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			g.Expect(redactText(c.text)).To(Equal(c.expect))
+		})
+	}
+
+	var v interface{}
+	g.Expect(json.Unmarshal([]byte(redactText(cases["json"].text)), &v)).To(Succeed())
+}
+
+func TestRedactObject(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "basic-tidb-0",
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "tidb-controller-manager"},
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "tidb",
+					Env: []corev1.EnvVar{
+						{Name: "TZ", Value: "UTC"},
+						{Name: "MYSQL_PASSWORD", Value: "abc"},
+						{Name: "TIDB_TOKEN", ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "tidb-secret"},
+								Key:                  "token",
+							},
+						}},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "tls",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{SecretName: "basic-tidb-server-secret"},
+					},
+				},
+			},
+		},
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "basic-tidb"},
+		Data: map[string]string{
+			"config-file": "[security]\nsecret-key = \"abc\"\n",
+		},
+	}
+
+	data, err := redactObject(pod)
+	g.Expect(err).NotTo(HaveOccurred())
+	out := &corev1.Pod{}
+	g.Expect(json.Unmarshal(data, out)).To(Succeed())
+	g.Expect(out.ManagedFields).To(BeEmpty())
+	env := out.Spec.Containers[0].Env
+	g.Expect(env[0].Value).To(Equal("UTC"))
+	g.Expect(env[1].Value).To(Equal(redacted))
+	g.Expect(env[2].ValueFrom.SecretKeyRef.Name).To(Equal("tidb-secret"))
+	g.Expect(out.Spec.Volumes[0].Secret.SecretName).To(Equal("basic-tidb-server-secret"))
+	g.Expect(strings.Contains(string(data), "abc")).To(BeFalse())
+
+	data, err = redactObject(cm)
+	g.Expect(err).NotTo(HaveOccurred())
+	outCM := &corev1.ConfigMap{}
+	g.Expect(json.Unmarshal(data, outCM)).To(Succeed())
+	g.Expect(outCM.Data["config-file"]).To(Equal("[security]\nsecret-key = \"<redacted>\"\n"))
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/controller/dmcluster"
 	"github.com/pingcap/tidb-operator/pkg/controller/restore"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbcluster"
+	"github.com/pingcap/tidb-operator/pkg/controller/tidbclusterdiagnostics"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbdashboard"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbinitializer"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbmonitor"
@@ -42,6 +43,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/metrics"
 	"github.com/pingcap/tidb-operator/pkg/scheme"
 	"github.com/pingcap/tidb-operator/pkg/upgrader"
+	"github.com/pingcap/tidb-operator/pkg/util/tracing"
 	"github.com/pingcap/tidb-operator/pkg/version"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -145,6 +147,11 @@ func main() {
 		kubeCli = helper.NewHijackClient(kubeCli, asCli)
 	}
 
+	shutdownTracing, err := tracing.Setup(context.Background(), "tidb-controller-manager", cliCfg.TracingConfig())
+	if err != nil {
+		klog.Fatalf("failed to set up tracing: %v", err)
+	}
+
 	deps, err := controller.NewDependencies(ns, cliCfg, cli, kubeCli, genericCli)
 	if err != nil {
 		klog.Fatalf("failed to create Dependencies: %s", err)
@@ -189,6 +196,7 @@ func main() {
 			tidbmonitor.NewController(deps),
 			tidbngmonitoring.NewController(deps),
 			tidbdashboard.NewController(deps),
+			tidbclusterdiagnostics.NewController(deps),
 		}
 
 		// Start informer factories after all controllers are initialized.
@@ -196,6 +204,7 @@ func main() {
 			deps.InformerFactory,
 			deps.KubeInformerFactory,
 			deps.LabelFilterKubeInformerFactory,
+			deps.ClusterKubeInformerFactory,
 		}
 		for _, f := range informerFactories {
 			f.Start(ctx.Done())
@@ -261,6 +270,9 @@ func main() {
 	go func() {
 		sig := <-sc
 		klog.Infof("got signal %s to exit", sig)
+		if err2 := shutdownTracing(context.Background()); err2 != nil {
+			klog.Errorf("failed to flush the spans: %v", err2)
+		}
 		if err2 := srv.Shutdown(context.Background()); err2 != nil {
 			klog.Fatal("fail to shutdown the HTTP server", err2)
 		}
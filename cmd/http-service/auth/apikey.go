@@ -0,0 +1,99 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// apiKeyAuthenticator authenticates the clients by the API keys in a Secret, the Secret is reloaded
+// periodically so that the keys can be rotated without restarting
+type apiKeyAuthenticator struct {
+	cfg     *APIKeysConfig
+	kubeCli kubernetes.Interface
+
+	mu   sync.RWMutex
+	keys map[[sha256.Size]byte]string // sha256 of the API key --> user name
+}
+
+func newAPIKeyAuthenticator(ctx context.Context, cfg *APIKeysConfig, kubeCli kubernetes.Interface) (*apiKeyAuthenticator, error) {
+	if kubeCli == nil {
+		return nil, fmt.Errorf("the kube client of %q for the API keys is not found", cfg.KubernetesID)
+	}
+	a := &apiKeyAuthenticator{cfg: cfg, kubeCli: kubeCli}
+	if err := a.loadKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		ticker := time.NewTicker(cfg.RefreshInterval.Duration)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := a.loadKeys(ctx); err != nil {
+					log.Warn("Failed to reload API keys", zap.Error(err))
+				}
+			}
+		}
+	}()
+	return a, nil
+}
+
+func (a *apiKeyAuthenticator) loadKeys(ctx context.Context) error {
+	secret, err := a.kubeCli.CoreV1().Secrets(a.cfg.SecretNamespace).Get(ctx, a.cfg.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get secret %s/%s: %w", a.cfg.SecretNamespace, a.cfg.SecretName, err)
+	}
+	keys := make(map[[sha256.Size]byte]string, len(secret.Data))
+	for name, key := range secret.Data {
+		if len(key) == 0 {
+			continue
+		}
+		keys[sha256.Sum256(key)] = name
+	}
+	a.mu.Lock()
+	a.keys = keys
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *apiKeyAuthenticator) Authenticate(_ context.Context, creds *Credentials) (*Identity, error) {
+	if creds.APIKey == "" {
+		return nil, ErrNoCredentials
+	}
+	// compare the digests in constant time so that the keys can't be guessed by timing
+	digest := sha256.Sum256([]byte(creds.APIKey))
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for d, name := range a.keys {
+		if subtle.ConstantTimeCompare(d[:], digest[:]) == 1 {
+			return &Identity{Name: name, Method: MethodAPIKey}, nil
+		}
+	}
+	return nil, errors.New("invalid API key")
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestAPIKeyAuthenticator(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cli := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "auth", Name: "api-keys"},
+		Data: map[string][]byte{
+			"alice": []byte("alice-key"),
+			"bob":   []byte("bob-key"),
+			"empty": {},
+		},
+	})
+	cfg := &APIKeysConfig{SecretNamespace: "auth", SecretName: "api-keys", RefreshInterval: Duration{time.Hour}}
+	a, err := newAPIKeyAuthenticator(ctx, cfg, cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		key     string
+		want    *Identity
+		wantErr error
+		invalid bool
+	}{
+		{name: "alice", key: "alice-key", want: &Identity{Name: "alice", Method: MethodAPIKey}},
+		{name: "bob", key: "bob-key", want: &Identity{Name: "bob", Method: MethodAPIKey}},
+		{name: "wrong key", key: "carol-key", invalid: true},
+		{name: "no key", wantErr: ErrNoCredentials},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.Authenticate(ctx, &Credentials{APIKey: tt.key})
+			switch {
+			case tt.wantErr != nil:
+				if err != tt.wantErr {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+			case tt.invalid:
+				if err == nil || err == ErrNoCredentials {
+					t.Fatalf("expected the key to be invalid, got %v", err)
+				}
+			default:
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Fatalf("expected %+v, got %+v", tt.want, got)
+				}
+			}
+		})
+	}
+	if len(a.keys) != 2 {
+		t.Fatalf("expected the empty key to be skipped, got %d keys", len(a.keys))
+	}
+
+	t.Run("rotated", func(t *testing.T) {
+		secret, err := cli.CoreV1().Secrets("auth").Get(ctx, "api-keys", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		secret.Data["alice"] = []byte("alice-new-key")
+		if _, err := cli.CoreV1().Secrets("auth").Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if err := a.loadKeys(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := a.Authenticate(ctx, &Credentials{APIKey: "alice-key"}); err == nil {
+			t.Fatal("expected the old key to be rejected")
+		}
+		if _, err := a.Authenticate(ctx, &Credentials{APIKey: "alice-new-key"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("secret not found", func(t *testing.T) {
+		cfg := &APIKeysConfig{SecretNamespace: "auth", SecretName: "missing", RefreshInterval: Duration{time.Hour}}
+		if _, err := newAPIKeyAuthenticator(ctx, cfg, cli); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/pingcap/tidb-operator/http-service/kube"
+	"github.com/pingcap/tidb-operator/http-service/server"
+)
+
+// Auth authenticates the clients and authorizes their calls.
+// The HTTP gateway authenticates the HTTP requests and forwards the identities to the gRPC server, the gRPC
+// server authenticates the direct gRPC calls, authorizes all calls by the policy and audits the mutating calls.
+// The internal gRPC server doesn't serve TLS, so the client certificates are only authenticated by the gateway.
+type Auth struct {
+	authenticator Authenticator
+	// grpcAuthenticator authenticates the direct gRPC calls without the client certificates
+	grpcAuthenticator Authenticator
+	policy            policy
+	forwarder         *forwarder
+	auditLogger       *zap.Logger
+}
+
+// New creates the authenticators configured, the key sets and the API keys are reloaded until the context is done
+func New(ctx context.Context, cfg *Config, kubeClient *kube.KubeClient) (*Auth, error) {
+	var authenticators chain
+	if cfg.OIDC != nil {
+		a, err := newJWTAuthenticator(ctx, cfg.OIDC)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, a)
+	}
+	if cfg.APIKeys != nil {
+		a, err := newAPIKeyAuthenticator(ctx, cfg.APIKeys, kubeClient.GetKubeClient(cfg.APIKeys.KubernetesID))
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, a)
+	}
+
+	f, err := newForwarder()
+	if err != nil {
+		return nil, err
+	}
+	a := &Auth{
+		authenticator:     authenticators,
+		grpcAuthenticator: authenticators,
+		policy:            cfg.Policy,
+		forwarder:         f,
+		auditLogger:       log.L().Named("audit"),
+	}
+	if cfg.MTLS != nil && cfg.MTLS.Enabled {
+		a.authenticator = append(chain{certAuthenticator{}}, authenticators...)
+	}
+	return a, nil
+}
+
+// Authenticate authenticates the credentials by the configured methods
+func (a *Auth) Authenticate(ctx context.Context, creds *Credentials) (*Identity, error) {
+	return a.authenticator.Authenticate(ctx, creds)
+}
+
+// Forward returns the value of the HeaderKeyIdentity header which carries the identity to the gRPC server
+func (a *Auth) Forward(id *Identity) (string, error) {
+	return a.forwarder.sign(id, time.Now())
+}
+
+// UnaryServerInterceptor authenticates, authorizes and audits the unary calls
+func (a *Auth) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := handler(ctx, req)
+		a.audit(ctx, info.FullMethod, req, resp, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor authenticates and authorizes the streaming calls
+func (a *Auth) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod, nil)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream replaces the context of a stream with the one carrying the identity
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorize authenticates the call and checks the policy, returns the context carrying the identity
+func (a *Auth) authorize(ctx context.Context, fullMethod string, req interface{}) (context.Context, error) {
+	id, err := a.authenticateCall(ctx)
+	if err != nil {
+		err = status.Errorf(codes.Unauthenticated, "unauthenticated: %v", err)
+		a.audit(ctx, fullMethod, req, nil, err)
+		return nil, err
+	}
+	ctx = NewContext(ctx, id)
+
+	method := path.Base(fullMethod)
+	k8sID := firstValue(ctx, server.HeaderKeyKubernetesID)
+	if !a.policy.allows(id, method, k8sID) {
+		a.audit(ctx, fullMethod, req, nil, status.Error(codes.PermissionDenied, "denied by the policy"))
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s on kubernetes %q", id.Name, method, k8sID)
+	}
+	return ctx, nil
+}
+
+// authenticateCall trusts the identity forwarded by the HTTP gateway, or authenticates the credentials of the
+// gRPC client
+func (a *Auth) authenticateCall(ctx context.Context) (*Identity, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if forwarded := md.Get(HeaderKeyIdentity); len(forwarded) > 0 {
+		if len(forwarded) != 1 {
+			return nil, fmt.Errorf("multiple %s headers", HeaderKeyIdentity)
+		}
+		return a.forwarder.verify(forwarded[0], time.Now())
+	}
+
+	creds := &Credentials{
+		Authorization: firstValue(ctx, HeaderKeyAuthorization),
+		APIKey:        firstValue(ctx, HeaderKeyAPIKey),
+	}
+	return a.grpcAuthenticator.Authenticate(ctx, creds)
+}
+
+// audit logs the mutating calls, including the unauthenticated and denied ones
+func (a *Auth) audit(ctx context.Context, fullMethod string, req, resp interface{}, err error) {
+	method := path.Base(fullMethod)
+	if !isMutating(method) {
+		return
+	}
+	code := status.Code(err)
+
+	fields := []zap.Field{
+		zap.String("method", method),
+		zap.String("k8sID", firstValue(ctx, server.HeaderKeyKubernetesID)),
+		zap.String("code", code.String()),
+	}
+	if id := FromContext(ctx); id != nil {
+		fields = append(fields, zap.String("user", id.Name), zap.Strings("groups", id.Groups), zap.String("authMethod", id.Method))
+	}
+	if r, ok := req.(interface{ GetClusterId() string }); ok {
+		fields = append(fields, zap.String("clusterID", r.GetClusterId()))
+	}
+	// the handlers report the failures in the responses instead of the errors
+	if r, ok := resp.(interface{ GetSuccess() bool }); ok {
+		fields = append(fields, zap.Bool("success", r.GetSuccess()))
+	}
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	}
+	a.auditLogger.Info("Audit", fields...)
+}
+
+func firstValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(key)) == 0 {
+		return ""
+	}
+	return md.Get(key)[0]
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/pingcap/tidb-operator/http-service/server"
+)
+
+func newTestAuth(t *testing.T, mtls bool) *Auth {
+	cfg := &Config{
+		MTLS:   &MTLSConfig{Enabled: mtls},
+		Policy: []PolicyRule{{Subjects: []string{"group:dev"}, Methods: []string{"Get*"}, KubernetesIDs: []string{"default"}}},
+	}
+	a, err := New(context.Background(), cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.auditLogger = zap.NewNop()
+	return a
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	a := newTestAuth(t, true)
+	alice := &Identity{Name: "alice", Groups: []string{"dev"}, Method: MethodMTLS}
+	signed, err := a.Forward(alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, err := a.forwarder.sign(alice, time.Now().Add(-2*forwardedIdentityTTL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		method   string
+		wantCode codes.Code
+	}{
+		{name: "forwarded identity", md: metadata.Pairs(HeaderKeyIdentity, signed), method: "GetClusterInfo", wantCode: codes.OK},
+		{
+			name:     "forwarded identity on other kubernetes",
+			md:       metadata.Pairs(HeaderKeyIdentity, signed, server.HeaderKeyKubernetesID, "prod"),
+			method:   "GetClusterInfo",
+			wantCode: codes.PermissionDenied,
+		},
+		{name: "denied by the policy", md: metadata.Pairs(HeaderKeyIdentity, signed), method: "DeleteCluster", wantCode: codes.PermissionDenied},
+		{name: "expired identity", md: metadata.Pairs(HeaderKeyIdentity, expired), method: "GetClusterInfo", wantCode: codes.Unauthenticated},
+		{name: "forged identity", md: metadata.Pairs(HeaderKeyIdentity, signed+"x"), method: "GetClusterInfo", wantCode: codes.Unauthenticated},
+		{
+			name:     "multiple identities",
+			md:       metadata.Pairs(HeaderKeyIdentity, signed, HeaderKeyIdentity, signed),
+			method:   "GetClusterInfo",
+			wantCode: codes.Unauthenticated,
+		},
+		// the gRPC server doesn't serve TLS, so the calls without forwarded identities have no credentials
+		{name: "no credentials", md: metadata.MD{}, method: "GetClusterInfo", wantCode: codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			info := &grpc.UnaryServerInfo{FullMethod: "/tidb.v1.ClusterService/" + tt.method}
+			var got *Identity
+			_, err := a.UnaryServerInterceptor()(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+				got = FromContext(ctx)
+				return nil, nil
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("expected %v, got %v", tt.wantCode, err)
+			}
+			if tt.wantCode == codes.OK && !reflect.DeepEqual(got, alice) {
+				t.Fatalf("expected %+v in the context, got %+v", alice, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"strings"
+)
+
+const (
+	// HeaderKeyAuthorization is the header of the bearer tokens
+	HeaderKeyAuthorization = "authorization"
+	// HeaderKeyAPIKey is the header of the API keys
+	HeaderKeyAPIKey = "x-api-key"
+
+	bearerPrefix = "Bearer "
+
+	MethodMTLS   = "mtls"
+	MethodOIDC   = "oidc"
+	MethodAPIKey = "api-key"
+)
+
+var (
+	// ErrNoCredentials means the request doesn't carry any credentials accepted by the authenticator
+	ErrNoCredentials = errors.New("no credentials")
+)
+
+// Identity is an authenticated client
+type Identity struct {
+	// Name is the user name
+	Name string `json:"name"`
+	// Groups are the groups the user belongs to
+	Groups []string `json:"groups,omitempty"`
+	// Method is the authentication method
+	Method string `json:"method"`
+}
+
+// Subjects returns the subjects of the identity matched by the policy rules
+func (id *Identity) Subjects() []string {
+	subjects := make([]string, 0, len(id.Groups)+1)
+	subjects = append(subjects, "user:"+id.Name)
+	for _, g := range id.Groups {
+		subjects = append(subjects, "group:"+g)
+	}
+	return subjects
+}
+
+type identityKey struct{}
+
+// NewContext returns a context carrying the identity
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity in the context, or nil if the request is not authenticated
+func FromContext(ctx context.Context) *Identity {
+	id, _ := ctx.Value(identityKey{}).(*Identity)
+	return id
+}
+
+// Credentials are what the client presents to authenticate itself, they're extracted from the gRPC metadata
+// and peer or from the HTTP request
+type Credentials struct {
+	// Authorization is the value of the authorization header
+	Authorization string
+	// APIKey is the value of the x-api-key header
+	APIKey string
+	// PeerCertificates are the verified client certificates, the first one is the leaf
+	PeerCertificates []*x509.Certificate
+}
+
+// BearerToken returns the bearer token in the authorization header
+func (c *Credentials) BearerToken() string {
+	if len(c.Authorization) > len(bearerPrefix) && strings.EqualFold(c.Authorization[:len(bearerPrefix)], bearerPrefix) {
+		return strings.TrimSpace(c.Authorization[len(bearerPrefix):])
+	}
+	return ""
+}
+
+// Authenticator verifies the credentials and returns the identity. ErrNoCredentials is returned if the
+// credentials it accepts are absent, other errors mean the credentials are invalid.
+type Authenticator interface {
+	Authenticate(ctx context.Context, creds *Credentials) (*Identity, error)
+}
+
+// chain tries the authenticators in order until one of them finds its credentials
+type chain []Authenticator
+
+func (c chain) Authenticate(ctx context.Context, creds *Credentials) (*Identity, error) {
+	for _, a := range c {
+		id, err := a.Authenticate(ctx, creds)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		return id, err
+	}
+	return nil, ErrNoCredentials
+}
+
+// certAuthenticator authenticates the client by the certificate verified in the TLS handshake
+type certAuthenticator struct{}
+
+func (certAuthenticator) Authenticate(_ context.Context, creds *Credentials) (*Identity, error) {
+	if len(creds.PeerCertificates) == 0 {
+		return nil, ErrNoCredentials
+	}
+	cert := creds.PeerCertificates[0]
+	if cert.Subject.CommonName == "" {
+		return nil, errors.New("the common name of the client certificate is empty")
+	}
+	return &Identity{Name: cert.Subject.CommonName, Groups: cert.Subject.Organization, Method: MethodMTLS}, nil
+}
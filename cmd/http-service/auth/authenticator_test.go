@@ -0,0 +1,111 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBearerToken(t *testing.T) {
+	tests := map[string]string{
+		"Bearer abc":   "abc",
+		"bearer abc":   "abc",
+		"BEARER  abc ": "abc",
+		"Bearer ":      "",
+		"Basic abc":    "",
+		"":             "",
+	}
+	for authorization, want := range tests {
+		creds := &Credentials{Authorization: authorization}
+		if got := creds.BearerToken(); got != want {
+			t.Errorf("BearerToken of %q: expected %q, got %q", authorization, want, got)
+		}
+	}
+}
+
+type fakeAuthenticator struct {
+	id  *Identity
+	err error
+}
+
+func (f fakeAuthenticator) Authenticate(context.Context, *Credentials) (*Identity, error) {
+	return f.id, f.err
+}
+
+func TestChain(t *testing.T) {
+	alice := &Identity{Name: "alice"}
+	invalid := errors.New("invalid")
+
+	tests := []struct {
+		name    string
+		chain   chain
+		want    *Identity
+		wantErr error
+	}{
+		{name: "empty", wantErr: ErrNoCredentials},
+		{
+			name:    "no credentials",
+			chain:   chain{fakeAuthenticator{err: ErrNoCredentials}, fakeAuthenticator{err: ErrNoCredentials}},
+			wantErr: ErrNoCredentials,
+		},
+		{
+			name:  "skip the authenticators without credentials",
+			chain: chain{fakeAuthenticator{err: ErrNoCredentials}, fakeAuthenticator{id: alice}},
+			want:  alice,
+		},
+		{
+			name:    "stop at invalid credentials",
+			chain:   chain{fakeAuthenticator{err: invalid}, fakeAuthenticator{id: alice}},
+			wantErr: invalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.chain.Authenticate(context.Background(), &Credentials{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCertAuthenticator(t *testing.T) {
+	ctx := context.Background()
+	if _, err := (certAuthenticator{}).Authenticate(ctx, &Credentials{}); err != ErrNoCredentials {
+		t.Fatalf("expected %v, got %v", ErrNoCredentials, err)
+	}
+
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "alice", Organization: []string{"dev"}}}
+	id, err := (certAuthenticator{}).Authenticate(ctx, &Credentials{PeerCertificates: []*x509.Certificate{cert}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Identity{Name: "alice", Groups: []string{"dev"}, Method: MethodMTLS}
+	if !reflect.DeepEqual(id, want) {
+		t.Fatalf("expected %+v, got %+v", want, id)
+	}
+
+	cert = &x509.Certificate{Subject: pkix.Name{Organization: []string{"dev"}}}
+	if _, err := (certAuthenticator{}).Authenticate(ctx, &Credentials{PeerCertificates: []*x509.Certificate{cert}}); err == nil || err == ErrNoCredentials {
+		t.Fatalf("expected the certificate without common name to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultUsernameClaim   = "sub"
+	defaultGroupsClaim     = "groups"
+	defaultRefreshInterval = time.Minute
+)
+
+// Config is the configuration of the authentication and authorization, it's loaded from a YAML file.
+// Authentication methods are tried in the order of mTLS, bearer tokens and API keys, the first one whose
+// credentials are present in the request is used.
+type Config struct {
+	// MTLS authenticates the clients by their certificates verified by the TLS server of the HTTP gateway,
+	// the direct calls to the internal gRPC server are not authenticated by certificates
+	MTLS *MTLSConfig `json:"mtls,omitempty"`
+	// OIDC authenticates the clients by JWT bearer tokens
+	OIDC *OIDCConfig `json:"oidc,omitempty"`
+	// APIKeys authenticates the clients by static API keys stored in a Secret
+	APIKeys *APIKeysConfig `json:"apiKeys,omitempty"`
+
+	// Policy lists the rules allowing identities to call RPCs, everything not allowed is denied
+	Policy []PolicyRule `json:"policy,omitempty"`
+}
+
+// MTLSConfig configures the mTLS authentication
+type MTLSConfig struct {
+	// the identity is `user:<common name>` with the groups `group:<organization>` of the client certificate
+	Enabled bool `json:"enabled"`
+}
+
+// OIDCConfig configures the authentication by JWT bearer tokens
+type OIDCConfig struct {
+	// Issuer is the required `iss` claim
+	Issuer string `json:"issuer"`
+	// Audience is the required `aud` claim
+	Audience string `json:"audience"`
+	// JWKSFile is the path of the JSON Web Key Set to verify the tokens
+	JWKSFile string `json:"jwksFile,omitempty"`
+	// JWKSURL is the URL of the JSON Web Key Set to verify the tokens, either JWKSFile or JWKSURL must be set
+	JWKSURL string `json:"jwksURL,omitempty"`
+	// UsernameClaim is the claim used as the user name, defaults to `sub`
+	UsernameClaim string `json:"usernameClaim,omitempty"`
+	// GroupsClaim is the claim used as the groups, defaults to `groups`
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	// RefreshInterval is the interval to reload the key set, defaults to 1m
+	RefreshInterval Duration `json:"refreshInterval,omitempty"`
+}
+
+// APIKeysConfig configures the authentication by static API keys.
+// Each key of the Secret data is a user name and the value is its API key.
+type APIKeysConfig struct {
+	// KubernetesID is the kubeconfig context of the Kubernetes cluster where the Secret is, empty means the
+	// cluster where http-service is running
+	KubernetesID    string   `json:"kubernetesID,omitempty"`
+	SecretNamespace string   `json:"secretNamespace"`
+	SecretName      string   `json:"secretName"`
+	RefreshInterval Duration `json:"refreshInterval,omitempty"`
+}
+
+// PolicyRule allows the subjects to call the methods against the Kubernetes clusters
+type PolicyRule struct {
+	// Subjects are `user:<name>` or `group:<name>`, `*` matches any authenticated identity
+	Subjects []string `json:"subjects"`
+	// Methods are the RPC names such as `CreateCluster`, `*` matches any RPC and `Get*` matches the RPCs with
+	// the prefix `Get`
+	Methods []string `json:"methods"`
+	// KubernetesIDs are the values of the `kubernetes-id` header, `*` or empty matches any cluster
+	KubernetesIDs []string `json:"kubernetesIDs,omitempty"`
+}
+
+// Duration is a time.Duration in the format of `1m30s` in the config file
+type Duration struct {
+	time.Duration
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = v
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", d.Duration.String())), nil
+}
+
+// LoadConfig loads the config from the YAML file and validates it
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &Config{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse auth config %s: %w", path, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid auth config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+func (c *Config) validate() error {
+	if (c.MTLS == nil || !c.MTLS.Enabled) && c.OIDC == nil && c.APIKeys == nil {
+		return errors.New("no authentication method is configured")
+	}
+	if c.OIDC != nil {
+		if c.OIDC.Issuer == "" || c.OIDC.Audience == "" {
+			return errors.New("oidc.issuer and oidc.audience are required")
+		}
+		if (c.OIDC.JWKSFile == "") == (c.OIDC.JWKSURL == "") {
+			return errors.New("exactly one of oidc.jwksFile and oidc.jwksURL must be set")
+		}
+		if c.OIDC.UsernameClaim == "" {
+			c.OIDC.UsernameClaim = defaultUsernameClaim
+		}
+		if c.OIDC.GroupsClaim == "" {
+			c.OIDC.GroupsClaim = defaultGroupsClaim
+		}
+		if c.OIDC.RefreshInterval.Duration <= 0 {
+			c.OIDC.RefreshInterval.Duration = defaultRefreshInterval
+		}
+	}
+	if c.APIKeys != nil {
+		if c.APIKeys.SecretNamespace == "" || c.APIKeys.SecretName == "" {
+			return errors.New("apiKeys.secretNamespace and apiKeys.secretName are required")
+		}
+		if c.APIKeys.RefreshInterval.Duration <= 0 {
+			c.APIKeys.RefreshInterval.Duration = defaultRefreshInterval
+		}
+	}
+	for i, rule := range c.Policy {
+		if len(rule.Subjects) == 0 || len(rule.Methods) == 0 {
+			return fmt.Errorf("policy[%d]: subjects and methods are required", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// HeaderKeyIdentity carries the identity authenticated by the HTTP gateway to the gRPC server, it's signed
+	// by a key generated when the process starts so it can't be forged by the clients
+	HeaderKeyIdentity = "x-http-service-identity"
+
+	// forwardedIdentityTTL limits the replay of a forwarded identity
+	forwardedIdentityTTL = time.Minute
+)
+
+type forwardedIdentity struct {
+	Identity
+	IssuedAt int64 `json:"iat"`
+}
+
+// forwarder signs and verifies the identities forwarded from the HTTP gateway
+type forwarder struct {
+	key []byte
+}
+
+func newForwarder() (*forwarder, error) {
+	key := make([]byte, sha256.Size)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return &forwarder{key: key}, nil
+}
+
+func (f *forwarder) sign(id *Identity, now time.Time) (string, error) {
+	data, err := json.Marshal(forwardedIdentity{Identity: *id, IssuedAt: now.Unix()})
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(f.mac(payload)), nil
+}
+
+func (f *forwarder) verify(value string, now time.Time) (*Identity, error) {
+	payload, sig, ok := strings.Cut(value, ".")
+	if !ok {
+		return nil, errors.New("malformed forwarded identity")
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, f.mac(payload)) {
+		return nil, errors.New("invalid signature of the forwarded identity")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, err
+	}
+	var id forwardedIdentity
+	if err := json.Unmarshal(data, &id); err != nil {
+		return nil, err
+	}
+	if now.Sub(time.Unix(id.IssuedAt, 0)) > forwardedIdentityTTL {
+		return nil, errors.New("the forwarded identity is expired")
+	}
+	return &id.Identity, nil
+}
+
+func (f *forwarder) mac(payload string) []byte {
+	h := hmac.New(sha256.New, f.key)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestForwarder(t *testing.T) {
+	f, err := newForwarder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := newForwarder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().Truncate(time.Second)
+	id := &Identity{Name: "alice", Groups: []string{"dev"}, Method: MethodOIDC}
+	signed, err := f.sign(id, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, sig, _ := strings.Cut(signed, ".")
+
+	tests := []struct {
+		name    string
+		value   string
+		now     time.Time
+		wantErr string
+	}{
+		{name: "valid", value: signed, now: now},
+		{name: "valid before expiring", value: signed, now: now.Add(forwardedIdentityTTL)},
+		{name: "expired", value: signed, now: now.Add(forwardedIdentityTTL + time.Second), wantErr: "expired"},
+		{name: "malformed", value: payload, now: now, wantErr: "malformed"},
+		{
+			name:    "tampered",
+			value:   base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"name":"admin","method":"oidc","iat":%d}`, now.Unix()))) + "." + sig,
+			now:     now,
+			wantErr: "invalid signature",
+		},
+		{name: "invalid signature encoding", value: payload + ".!!!", now: now, wantErr: "invalid signature"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := f.verify(tt.value, tt.now)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, id) {
+				t.Fatalf("expected %+v, got %+v", id, got)
+			}
+		})
+	}
+
+	t.Run("forged", func(t *testing.T) {
+		forged, err := other.sign(&Identity{Name: "admin", Method: MethodOIDC}, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.verify(forged, now); err == nil || !strings.Contains(err.Error(), "invalid signature") {
+			t.Fatalf("expected the forged identity to be rejected, got %v", err)
+		}
+	})
+}
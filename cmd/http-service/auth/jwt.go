@@ -0,0 +1,286 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// clockSkew is the tolerated clock difference when checking exp and nbf
+	clockSkew = time.Minute
+
+	jwksFetchTimeout = 10 * time.Second
+	maxJWKSSize      = 1 << 20
+)
+
+// jwtAuthenticator authenticates the clients by JWT bearer tokens signed by the keys in a JWKS
+type jwtAuthenticator struct {
+	cfg    *OIDCConfig
+	client *http.Client
+
+	mu   sync.RWMutex
+	keys map[string]crypto.PublicKey // kid --> key
+}
+
+// newJWTAuthenticator loads the key set and reloads it periodically until the context is done
+func newJWTAuthenticator(ctx context.Context, cfg *OIDCConfig) (*jwtAuthenticator, error) {
+	a := &jwtAuthenticator{
+		cfg:    cfg,
+		client: &http.Client{Timeout: jwksFetchTimeout},
+	}
+	if err := a.loadKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		ticker := time.NewTicker(cfg.RefreshInterval.Duration)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// keep the old keys if the key set is temporarily unavailable
+				if err := a.loadKeys(ctx); err != nil {
+					log.Warn("Failed to reload JWKS", zap.Error(err))
+				}
+			}
+		}
+	}()
+	return a, nil
+}
+
+func (a *jwtAuthenticator) loadKeys(ctx context.Context) error {
+	var data []byte
+	var err error
+	if a.cfg.JWKSFile != "" {
+		data, err = os.ReadFile(a.cfg.JWKSFile)
+	} else {
+		data, err = a.fetchKeys(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load JWKS: %w", err)
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return err
+	}
+	a.mu.Lock()
+	a.keys = keys
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *jwtAuthenticator) fetchKeys(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.cfg.JWKSURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", a.cfg.JWKSURL, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+}
+
+func (a *jwtAuthenticator) key(kid string) (crypto.PublicKey, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if kid == "" && len(a.keys) == 1 {
+		for _, k := range a.keys {
+			return k, true
+		}
+	}
+	k, ok := a.keys[kid]
+	return k, ok
+}
+
+func (a *jwtAuthenticator) Authenticate(_ context.Context, creds *Credentials) (*Identity, error) {
+	token := creds.BearerToken()
+	if token == "" {
+		return nil, ErrNoCredentials
+	}
+	claims, err := a.verify(token, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("invalid bearer token: %w", err)
+	}
+
+	name, _ := claims[a.cfg.UsernameClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("invalid bearer token: claim %s is missing", a.cfg.UsernameClaim)
+	}
+	id := &Identity{Name: name, Method: MethodOIDC}
+	switch groups := claims[a.cfg.GroupsClaim].(type) {
+	case string:
+		id.Groups = []string{groups}
+	case []interface{}:
+		for _, g := range groups {
+			if s, ok := g.(string); ok {
+				id.Groups = append(id.Groups, s)
+			}
+		}
+	}
+	return id, nil
+}
+
+// signingMethods are the asymmetric algorithms accepted to sign the tokens
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// verify verifies the signature and the registered claims of the token and returns its claims
+func (a *jwtAuthenticator) verify(token string, now time.Time) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, a.keyFunc,
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(a.cfg.Issuer),
+		jwt.WithAudience(a.cfg.Audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(clockSkew),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// keyFunc returns the key to verify the token by its kid, ES256, ES384 and ES512 are only
+// accepted with P-256, P-384 and P-521 respectively
+func (a *jwtAuthenticator) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := a.key(kid)
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+	if m, ok := token.Method.(*jwt.SigningMethodECDSA); ok {
+		if k, ok := key.(*ecdsa.PublicKey); ok && k.Curve.Params().BitSize != m.CurveBits {
+			return nil, fmt.Errorf("algorithm %q doesn't match the key", m.Alg())
+		}
+	}
+	return key, nil
+}
+
+// jsonWebKey is a key of a JWKS, only the RSA and EC public keys for signatures are supported
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("malformed JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q in JWKS: %w", k.Kid, err)
+		}
+		if key != nil {
+			keys[k.Kid] = key
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no signing key is found in JWKS")
+	}
+	return keys, nil
+}
+
+// publicKey returns the public key, or nil if the type of the key is not supported
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("the point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,426 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testIssuer   = "https://issuer.example.com"
+	testAudience = "http-service"
+)
+
+type testSigner struct {
+	kid string
+	key crypto.Signer
+}
+
+func newTestSigners(t *testing.T) map[string]*testSigner {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signers := map[string]*testSigner{"rsa": {kid: "rsa", key: rsaKey}}
+	for name, curve := range map[string]elliptic.Curve{"p256": elliptic.P256(), "p384": elliptic.P384(), "p521": elliptic.P521()} {
+		k, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signers[name] = &testSigner{kid: name, key: k}
+	}
+	return signers
+}
+
+func encodeBigInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+// jwks returns the JWKS of the public keys of the signers
+func jwks(t *testing.T, signers map[string]*testSigner) []byte {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	for _, s := range signers {
+		switch k := s.key.Public().(type) {
+		case *rsa.PublicKey:
+			set.Keys = append(set.Keys, jsonWebKey{Kty: "RSA", Kid: s.kid, Use: "sig",
+				N: encodeBigInt(k.N), E: encodeBigInt(big.NewInt(int64(k.E)))})
+		case *ecdsa.PublicKey:
+			set.Keys = append(set.Keys, jsonWebKey{Kty: "EC", Kid: s.kid, Crv: k.Curve.Params().Name,
+				X: encodeBigInt(k.X), Y: encodeBigInt(k.Y)})
+		}
+	}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// sign returns a token of the claims signed by the signer with the algorithm
+func (s *testSigner) sign(t *testing.T, alg string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": s.kid, "typ": "JWT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	hash := map[string]crypto.Hash{"256": crypto.SHA256, "384": crypto.SHA384, "512": crypto.SHA512}[alg[2:]]
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	var sig []byte
+	switch k := s.key.(type) {
+	case *rsa.PrivateKey:
+		if strings.HasPrefix(alg, "PS") {
+			sig, err = rsa.SignPSS(rand.Reader, k, hash, digest, nil)
+		} else {
+			sig, err = rsa.SignPKCS1v15(rand.Reader, k, hash, digest)
+		}
+	case *ecdsa.PrivateKey:
+		var r, ss *big.Int
+		r, ss, err = ecdsa.Sign(rand.Reader, k, digest)
+		size := (k.Curve.Params().BitSize + 7) / 8
+		sig = make([]byte, 2*size)
+		r.FillBytes(sig[:size])
+		ss.FillBytes(sig[size:])
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func validClaims(now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"iss":    testIssuer,
+		"aud":    testAudience,
+		"sub":    "alice",
+		"groups": []string{"dba", "ops"},
+		"exp":    now.Add(time.Hour).Unix(),
+	}
+}
+
+func newTestJWTAuthenticator(t *testing.T, signers map[string]*testSigner) *jwtAuthenticator {
+	keys, err := parseJWKS(jwks(t, signers))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &jwtAuthenticator{
+		cfg:  &OIDCConfig{Issuer: testIssuer, Audience: testAudience, UsernameClaim: "sub", GroupsClaim: "groups"},
+		keys: keys,
+	}
+}
+
+func TestJWTVerify(t *testing.T) {
+	signers := newTestSigners(t)
+	a := newTestJWTAuthenticator(t, signers)
+	now := time.Now()
+
+	with := func(key string, value interface{}) map[string]interface{} {
+		claims := validClaims(now)
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name    string
+		token   func() string
+		wantErr string
+	}{
+		{
+			name:  "RS256",
+			token: func() string { return signers["rsa"].sign(t, "RS256", validClaims(now)) },
+		},
+		{
+			name:  "RS512",
+			token: func() string { return signers["rsa"].sign(t, "RS512", validClaims(now)) },
+		},
+		{
+			name:  "PS384",
+			token: func() string { return signers["rsa"].sign(t, "PS384", validClaims(now)) },
+		},
+		{
+			name:  "ES256 with P-256",
+			token: func() string { return signers["p256"].sign(t, "ES256", validClaims(now)) },
+		},
+		{
+			name:  "ES384 with P-384",
+			token: func() string { return signers["p384"].sign(t, "ES384", validClaims(now)) },
+		},
+		{
+			name:  "ES512 with P-521",
+			token: func() string { return signers["p521"].sign(t, "ES512", validClaims(now)) },
+		},
+		{
+			name:    "ES256 with P-384",
+			token:   func() string { return signers["p384"].sign(t, "ES256", validClaims(now)) },
+			wantErr: "doesn't match the key",
+		},
+		{
+			name:    "ES512 with P-256",
+			token:   func() string { return signers["p256"].sign(t, "ES512", validClaims(now)) },
+			wantErr: "doesn't match the key",
+		},
+		{
+			name: "RS256 with an EC key",
+			token: func() string {
+				s := &testSigner{kid: "p256", key: signers["rsa"].key}
+				return s.sign(t, "RS256", validClaims(now))
+			},
+			wantErr: "key is of invalid type",
+		},
+		{
+			name: "alg none",
+			token: func() string {
+				token := signers["rsa"].sign(t, "RS256", validClaims(now))
+				parts := strings.Split(token, ".")
+				header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","kid":"rsa"}`))
+				return header + "." + parts[1] + "."
+			},
+			wantErr: "signing method none is invalid",
+		},
+		{
+			name: "HS256",
+			token: func() string {
+				token := signers["rsa"].sign(t, "RS256", validClaims(now))
+				parts := strings.Split(token, ".")
+				header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","kid":"rsa"}`))
+				return header + "." + parts[1] + "." + parts[2]
+			},
+			wantErr: "signing method HS256 is invalid",
+		},
+		{
+			name: "unknown kid",
+			token: func() string {
+				s := &testSigner{kid: "unknown", key: signers["rsa"].key}
+				return s.sign(t, "RS256", validClaims(now))
+			},
+			wantErr: `unknown key "unknown"`,
+		},
+		{
+			name: "signed by another key with a known kid",
+			token: func() string {
+				s := &testSigner{kid: "p256", key: signers["p384"].key}
+				return s.sign(t, "ES256", validClaims(now))
+			},
+			wantErr: "verification error",
+		},
+		{
+			name: "tampered claims",
+			token: func() string {
+				token := signers["rsa"].sign(t, "RS256", validClaims(now))
+				parts := strings.Split(token, ".")
+				claims := validClaims(now)
+				claims["sub"] = "admin"
+				payload, _ := json.Marshal(claims)
+				return parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload) + "." + parts[2]
+			},
+			wantErr: "verification error",
+		},
+		{
+			name:    "wrong issuer",
+			token:   func() string { return signers["rsa"].sign(t, "RS256", with("iss", "https://evil.example.com")) },
+			wantErr: "invalid issuer",
+		},
+		{
+			name:    "missing issuer",
+			token:   func() string { return signers["rsa"].sign(t, "RS256", with("iss", nil)) },
+			wantErr: "iss claim is required",
+		},
+		{
+			name:  "audience in a list",
+			token: func() string { return signers["rsa"].sign(t, "RS256", with("aud", []string{"other", testAudience})) },
+		},
+		{
+			name:    "wrong audience",
+			token:   func() string { return signers["rsa"].sign(t, "RS256", with("aud", []string{"other"})) },
+			wantErr: "audience",
+		},
+		{
+			name:    "missing exp",
+			token:   func() string { return signers["rsa"].sign(t, "RS256", with("exp", nil)) },
+			wantErr: "exp claim is required",
+		},
+		{
+			name:    "expired",
+			token:   func() string { return signers["rsa"].sign(t, "RS256", with("exp", now.Add(-2*clockSkew).Unix())) },
+			wantErr: "token is expired",
+		},
+		{
+			name:  "expired within the clock skew",
+			token: func() string { return signers["rsa"].sign(t, "RS256", with("exp", now.Add(-clockSkew/2).Unix())) },
+		},
+		{
+			name:    "not valid yet",
+			token:   func() string { return signers["rsa"].sign(t, "RS256", with("nbf", now.Add(2*clockSkew).Unix())) },
+			wantErr: "token is not valid yet",
+		},
+		{
+			name:  "valid since now",
+			token: func() string { return signers["rsa"].sign(t, "RS256", with("nbf", now.Unix())) },
+		},
+		{
+			name:    "malformed",
+			token:   func() string { return "a.b" },
+			wantErr: "token is malformed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := a.verify(tt.token(), now)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestJWTAuthenticate(t *testing.T) {
+	signers := map[string]*testSigner{}
+	for name, s := range newTestSigners(t) {
+		if name == "rsa" {
+			signers[name] = s
+		}
+	}
+	dir := t.TempDir()
+	file := filepath.Join(dir, "jwks.json")
+	if err := os.WriteFile(file, jwks(t, signers), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	a, err := newJWTAuthenticator(ctx, &OIDCConfig{
+		Issuer:          testIssuer,
+		Audience:        testAudience,
+		JWKSFile:        file,
+		UsernameClaim:   "sub",
+		GroupsClaim:     "groups",
+		RefreshInterval: Duration{Duration: time.Hour},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the kid can be omitted if there is only one key
+	s := &testSigner{key: signers["rsa"].key}
+	token := s.sign(t, "RS256", validClaims(time.Now()))
+	id, err := a.Authenticate(ctx, &Credentials{Authorization: "bearer " + token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Identity{Name: "alice", Groups: []string{"dba", "ops"}, Method: MethodOIDC}
+	if !reflect.DeepEqual(id, want) {
+		t.Fatalf("expected %+v, got %+v", want, id)
+	}
+
+	if _, err := a.Authenticate(ctx, &Credentials{APIKey: "key"}); err != ErrNoCredentials {
+		t.Fatalf("expected ErrNoCredentials, got %v", err)
+	}
+
+	claims := validClaims(time.Now())
+	delete(claims, "sub")
+	if _, err := a.Authenticate(ctx, &Credentials{Authorization: "Bearer " + s.sign(t, "RS256", claims)}); err == nil {
+		t.Fatal("expected an error for the token without the username claim")
+	}
+}
+
+func TestParseJWKS(t *testing.T) {
+	tests := []struct {
+		name     string
+		jwks     string
+		wantKids []string
+		wantErr  bool
+	}{
+		{
+			name:    "malformed",
+			jwks:    `{"keys":`,
+			wantErr: true,
+		},
+		{
+			name:    "no signing key",
+			jwks:    `{"keys":[{"kty":"RSA","kid":"enc","use":"enc","n":"AQAB","e":"AQAB"},{"kty":"oct","kid":"hmac","k":"c2VjcmV0"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported curve",
+			jwks:    `{"keys":[{"kty":"EC","kid":"ec","crv":"P-224","x":"AQ","y":"AQ"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "point not on the curve",
+			jwks:    `{"keys":[{"kty":"EC","kid":"ec","crv":"P-256","x":"AQ","y":"AQ"}]}`,
+			wantErr: true,
+		},
+		{
+			name:     "the keys for encryption and unsupported types are skipped",
+			jwks:     `{"keys":[{"kty":"RSA","kid":"enc","use":"enc","n":"AQAB","e":"AQAB"},{"kty":"oct","kid":"hmac","k":"c2VjcmV0"},{"kty":"RSA","kid":"sig","use":"sig","n":"AQAB","e":"AQAB"}]}`,
+			wantKids: []string{"sig"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := parseJWKS([]byte(tt.jwks))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(keys) != len(tt.wantKids) {
+				t.Fatalf("expected keys %v, got %d keys", tt.wantKids, len(keys))
+			}
+			for _, kid := range tt.wantKids {
+				if _, ok := keys[kid]; !ok {
+					t.Fatalf("key %q is not found", kid)
+				}
+			}
+		})
+	}
+}
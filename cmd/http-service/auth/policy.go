@@ -0,0 +1,68 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"strings"
+)
+
+const (
+	wildcard = "*"
+	// defaultKubernetesID is the kubernetes ID matched by the policy when the header is absent, which means
+	// the cluster where http-service is running
+	defaultKubernetesID = "default"
+)
+
+// readOnlyPrefixes are the prefixes of the RPCs which don't change anything, the others are audited
+var readOnlyPrefixes = []string{"Get", "List", "Watch"}
+
+func isMutating(method string) bool {
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// policy is a list of rules, a call is allowed if any rule allows it
+type policy []PolicyRule
+
+func (p policy) allows(id *Identity, method, kubernetesID string) bool {
+	if kubernetesID == "" {
+		kubernetesID = defaultKubernetesID
+	}
+	subjects := id.Subjects()
+	for _, rule := range p {
+		if matchAny(rule.Subjects, subjects) && matchAny(rule.Methods, []string{method}) &&
+			(len(rule.KubernetesIDs) == 0 || matchAny(rule.KubernetesIDs, []string{kubernetesID})) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAny returns whether any value matches any pattern, a pattern is either `*`, a prefix ending with `*`
+// or an exact value
+func matchAny(patterns, values []string) bool {
+	for _, pattern := range patterns {
+		for _, v := range values {
+			if pattern == wildcard || pattern == v ||
+				(strings.HasSuffix(pattern, wildcard) && strings.HasPrefix(v, strings.TrimSuffix(pattern, wildcard))) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+)
+
+func TestPolicyAllows(t *testing.T) {
+	p := policy{
+		{Subjects: []string{"user:admin"}, Methods: []string{"*"}},
+		{Subjects: []string{"group:dev"}, Methods: []string{"Get*", "List*"}, KubernetesIDs: []string{"default", "dev-*"}},
+		{Subjects: []string{"*"}, Methods: []string{"GetClusterInfo"}, KubernetesIDs: []string{"*"}},
+	}
+	alice := &Identity{Name: "alice", Groups: []string{"dev"}}
+	bob := &Identity{Name: "bob"}
+	admin := &Identity{Name: "admin"}
+
+	tests := []struct {
+		name   string
+		id     *Identity
+		method string
+		k8sID  string
+		want   bool
+	}{
+		{name: "user with wildcard methods", id: admin, method: "DeleteCluster", k8sID: "prod", want: true},
+		{name: "group with method prefix", id: alice, method: "GetBackupInfo", k8sID: "default", want: true},
+		{name: "empty kubernetes ID is default", id: alice, method: "ListClusters", want: true},
+		{name: "kubernetes ID prefix", id: alice, method: "ListClusters", k8sID: "dev-1", want: true},
+		{name: "kubernetes ID not matched", id: alice, method: "ListClusters", k8sID: "prod"},
+		{name: "method not matched", id: alice, method: "DeleteCluster", k8sID: "default"},
+		{name: "prefix matches itself", id: alice, method: "Get", k8sID: "default", want: true},
+		{name: "wildcard subject", id: bob, method: "GetClusterInfo", k8sID: "prod", want: true},
+		{name: "wildcard subject with other method", id: bob, method: "GetBackupInfo"},
+		{name: "user name is not a group", id: &Identity{Name: "dev"}, method: "ListClusters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.allows(tt.id, tt.method, tt.k8sID); got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if (policy{}).allows(admin, "GetClusterInfo", "") {
+		t.Fatal("expected the empty policy to deny everything")
+	}
+}
+
+func TestIsMutating(t *testing.T) {
+	tests := map[string]bool{
+		"GetClusterInfo": false,
+		"ListClusters":   false,
+		"WatchClusters":  false,
+		"CreateCluster":  true,
+		"DeleteCluster":  true,
+		"ScaleTiKV":      true,
+	}
+	for method, want := range tests {
+		if got := isMutating(method); got != want {
+			t.Errorf("isMutating(%q): expected %v, got %v", method, want, got)
+		}
+	}
+}
@@ -45,6 +45,16 @@ type Config struct {
 
 	// Kubeconfig is the path to Kubeconfig.
 	Kubeconfig string `toml:"kubeconfig" json:"kubeconfig"`
+
+	// AuthConfig is the path of the authentication and authorization config, the clients are not
+	// authenticated if it's empty.
+	AuthConfig string `toml:"auth-config" json:"auth-config"`
+
+	// TLSCertFile and TLSKeyFile are the certificate and key to serve HTTPS.
+	TLSCertFile string `toml:"tls-cert-file" json:"tls-cert-file"`
+	TLSKeyFile  string `toml:"tls-key-file" json:"tls-key-file"`
+	// TLSClientCAFile is the CA to verify the client certificates for mTLS.
+	TLSClientCAFile string `toml:"tls-client-ca-file" json:"tls-client-ca-file"`
 }
 
 func NewConfig() *Config {
@@ -61,6 +71,10 @@ func NewConfig() *Config {
 	cfg.flagSet.StringVar(&cfg.Addr, "addr", cfg.Addr, "address to listen on")
 	cfg.flagSet.StringVar(&cfg.InternalGRPCAddr, "internal-grpc-addr", cfg.InternalGRPCAddr, "address of internal grpc server")
 	cfg.flagSet.StringVar(&cfg.Kubeconfig, "kubeconfig", cfg.Kubeconfig, "path to kubeconfig")
+	cfg.flagSet.StringVar(&cfg.AuthConfig, "auth-config", cfg.AuthConfig, "path to the authentication and authorization config")
+	cfg.flagSet.StringVar(&cfg.TLSCertFile, "tls-cert-file", cfg.TLSCertFile, "path to the certificate to serve HTTPS")
+	cfg.flagSet.StringVar(&cfg.TLSKeyFile, "tls-key-file", cfg.TLSKeyFile, "path to the key to serve HTTPS")
+	cfg.flagSet.StringVar(&cfg.TLSClientCAFile, "tls-client-ca-file", cfg.TLSClientCAFile, "path to the CA to verify the client certificates")
 
 	return cfg
 }
@@ -75,5 +89,12 @@ func (c *Config) Parse(args []string) error {
 		fmt.Println(version.GetRawInfo())
 		return flag.ErrHelp
 	}
+
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return fmt.Errorf("--tls-cert-file and --tls-key-file must be specified together")
+	}
+	if c.TLSClientCAFile != "" && c.TLSCertFile == "" {
+		return fmt.Errorf("--tls-client-ca-file requires --tls-cert-file and --tls-key-file")
+	}
 	return nil
 }
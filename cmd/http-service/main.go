@@ -15,6 +15,8 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"flag"
 	"fmt"
@@ -36,6 +38,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/pingcap/tidb-operator/http-service/auth"
 	"github.com/pingcap/tidb-operator/http-service/kube"
 	"github.com/pingcap/tidb-operator/http-service/middlewares"
 	"github.com/pingcap/tidb-operator/http-service/pbgen/api"
@@ -57,6 +60,7 @@ func main() {
 	case flag.ErrHelp:
 		os.Exit(0)
 	default:
+		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
 
@@ -79,18 +83,44 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	kubeClient, err := kube.InitKubeClients(cfg.Kubeconfig)
+	if err != nil {
+		log.Fatal("Failed to init kube client", zap.Error(err))
+	}
+
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		log.Fatal("Failed to load TLS config", zap.Error(err))
+	}
+
+	var authn *auth.Auth
+	if cfg.AuthConfig != "" {
+		authCfg, err := auth.LoadConfig(cfg.AuthConfig)
+		if err != nil {
+			log.Fatal("Failed to load auth config", zap.Error(err))
+		}
+		if authCfg.MTLS != nil && authCfg.MTLS.Enabled && cfg.TLSClientCAFile == "" {
+			log.Fatal("mTLS authentication requires --tls-client-ca-file")
+		}
+		if authn, err = auth.New(ctx, authCfg, kubeClient); err != nil {
+			log.Fatal("Failed to init auth", zap.Error(err))
+		}
+	} else {
+		log.Warn("No auth config is specified, the clients are not authenticated")
+	}
+
 	var wg sync.WaitGroup
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		setupAndRunGRPCServer(ctx, log.L(), cfg.InternalGRPCAddr, cfg.Kubeconfig)
+		setupAndRunGRPCServer(ctx, log.L(), cfg.InternalGRPCAddr, kubeClient, authn)
 	}()
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		setupAndRunGRPCGateway(ctx, log.L(), cfg.Addr, cfg.InternalGRPCAddr)
+		setupAndRunGRPCGateway(ctx, log.L(), cfg.Addr, cfg.InternalGRPCAddr, tlsConfig, authn)
 	}()
 
 	wg.Wait()
@@ -99,17 +129,45 @@ func main() {
 	log.Info("HTTP service exiting")
 }
 
-func setupAndRunGRPCServer(ctx context.Context, logger *zap.Logger, addr, kubeconfigPath string) {
-	kubeClient, err := kube.InitKubeClients(kubeconfigPath)
+// newTLSConfig returns the TLS config to serve HTTPS, or nil if no certificate is specified
+func newTLSConfig(cfg *Config) (*tls.Config, error) {
+	if cfg.TLSCertFile == "" {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
 	if err != nil {
-		log.Fatal("Failed to init kube client", zap.Error(err))
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.TLSClientCAFile != "" {
+		data, err := os.ReadFile(cfg.TLSClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificate is found in %s", cfg.TLSClientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+		// the clients without certificates may be authenticated by tokens or API keys
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
 	}
+	return tlsConfig, nil
+}
 
+func setupAndRunGRPCServer(ctx context.Context, logger *zap.Logger, addr string, kubeClient *kube.KubeClient, authn *auth.Auth) {
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
 		log.Fatal("Failed to listen for gRPC server", zap.String("addr", addr), zap.Error(err))
 	}
-	s := grpc.NewServer()
+	var opts []grpc.ServerOption
+	if authn != nil {
+		opts = append(opts, grpc.UnaryInterceptor(authn.UnaryServerInterceptor()), grpc.StreamInterceptor(authn.StreamServerInterceptor()))
+	}
+	s := grpc.NewServer(opts...)
 	api.RegisterClusterServer(s, &server.ClusterServer{KubeClient: kubeClient})
 	log.Info("Starting internal gRPC server", zap.String("addr", addr))
 	go func() {
@@ -125,10 +183,11 @@ func setupAndRunGRPCServer(ctx context.Context, logger *zap.Logger, addr, kubeco
 	log.Info("Internal gRPC server stopped", zap.String("addr", addr))
 }
 
-func setupAndRunGRPCGateway(ctx context.Context, logger *zap.Logger, addr, grpcAddr string) {
+func setupAndRunGRPCGateway(ctx context.Context, logger *zap.Logger, addr, grpcAddr string, tlsConfig *tls.Config, authn *auth.Auth) {
 	mux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(customHeaderMatcher),
 		runtime.WithForwardResponseOption(httpResponseModifier),
+		runtime.WithMarshalerOption(mimeEventStream, newSSEMarshaler()),
 	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	if err := api.RegisterClusterHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
@@ -142,13 +201,19 @@ func setupAndRunGRPCGateway(ctx context.Context, logger *zap.Logger, addr, grpcA
 
 	router := gin.New()
 	router.Use(middlewares.LoggingMiddleware(), gin.Recovery()) // log with custom format
+	if authn != nil {
+		router.Use(middlewares.AuthMiddleware(authn))
+	}
 	router.Group("/v1beta/*{grpc_gateway}").Any("", gin.WrapH(mux))
 
 	srv := &http.Server{
 		Addr:    addr,
 		Handler: router,
 	}
-	log.Info("Starting gRPC gateway", zap.String("addr", addr))
+	if tlsConfig != nil {
+		lis = tls.NewListener(lis, tlsConfig)
+	}
+	log.Info("Starting gRPC gateway", zap.String("addr", addr), zap.Bool("tls", tlsConfig != nil))
 	go func() {
 		if err2 := srv.Serve(lis); err2 != nil && !errors.Is(err2, http.ErrServerClosed) {
 			log.Info("The gRPC gateway returned with error", zap.String("addr", addr), zap.Error(err2))
@@ -171,7 +236,9 @@ func setupAndRunGRPCGateway(ctx context.Context, logger *zap.Logger, addr, grpcA
 
 func customHeaderMatcher(key string) (string, bool) {
 	switch key {
-	case textproto.CanonicalMIMEHeaderKey(server.HeaderKeyKubernetesID):
+	case textproto.CanonicalMIMEHeaderKey(server.HeaderKeyKubernetesID),
+		textproto.CanonicalMIMEHeaderKey(auth.HeaderKeyIdentity),
+		textproto.CanonicalMIMEHeaderKey(server.HeaderKeyLastEventID):
 		return key, true
 	default:
 		return runtime.DefaultHeaderMatcher(key)
@@ -0,0 +1,67 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+
+	"github.com/pingcap/tidb-operator/http-service/auth"
+)
+
+// ContextKeyUser is the key of the authenticated user name in the gin context
+const ContextKeyUser = "user"
+
+// AuthMiddleware authenticates the HTTP requests and forwards the identities to the gRPC server,
+// the calls are authorized by the gRPC server.
+func AuthMiddleware(a *auth.Auth) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := ctx.Request
+		// never trust the identities sent by the clients
+		req.Header.Del(auth.HeaderKeyIdentity)
+		req.Header.Del(runtime.MetadataHeaderPrefix + auth.HeaderKeyIdentity)
+
+		creds := &auth.Credentials{
+			Authorization: req.Header.Get(auth.HeaderKeyAuthorization),
+			APIKey:        req.Header.Get(auth.HeaderKeyAPIKey),
+		}
+		if req.TLS != nil && len(req.TLS.VerifiedChains) > 0 {
+			creds.PeerCertificates = req.TLS.VerifiedChains[0]
+		}
+		id, err := a.Authenticate(req.Context(), creds)
+		if err != nil {
+			_ = ctx.Error(err)
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"code":    codes.Unauthenticated,
+				"message": fmt.Sprintf("unauthenticated: %v", err),
+			})
+			return
+		}
+
+		forwarded, err := a.Forward(id)
+		if err != nil {
+			_ = ctx.Error(err)
+			ctx.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		req.Header.Set(auth.HeaderKeyIdentity, forwarded)
+		ctx.Set(ContextKeyUser, id.Name)
+
+		ctx.Next()
+	}
+}
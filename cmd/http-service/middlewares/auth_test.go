@@ -0,0 +1,110 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+
+	"github.com/pingcap/tidb-operator/http-service/auth"
+)
+
+func newTestAuth(t *testing.T) *auth.Auth {
+	path := filepath.Join(t.TempDir(), "auth.yaml")
+	data := []byte("mtls:\n  enabled: true\npolicy:\n- subjects: [\"*\"]\n  methods: [\"*\"]\n")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := auth.LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	a, err := auth.New(ctx, cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	a := newTestAuth(t)
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "alice"}}
+
+	tests := []struct {
+		name       string
+		tls        *tls.ConnectionState
+		wantStatus int
+		wantUser   string
+	}{
+		{name: "no credentials", wantStatus: http.StatusUnauthorized},
+		{name: "unverified certificates", tls: &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}, wantStatus: http.StatusUnauthorized},
+		{
+			name:       "verified certificate",
+			tls:        &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+			wantStatus: http.StatusOK,
+			wantUser:   "alice",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var user, forwarded string
+			var forwardedWithPrefix []string
+			r := gin.New()
+			r.Use(AuthMiddleware(a))
+			r.GET("/", func(ctx *gin.Context) {
+				user = ctx.GetString(ContextKeyUser)
+				forwarded = ctx.Request.Header.Get(auth.HeaderKeyIdentity)
+				forwardedWithPrefix = ctx.Request.Header.Values(runtime.MetadataHeaderPrefix + auth.HeaderKeyIdentity)
+				ctx.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.TLS = tt.tls
+			// the identities sent by the clients are never trusted
+			req.Header.Set(auth.HeaderKeyIdentity, "forged")
+			req.Header.Set(runtime.MetadataHeaderPrefix+auth.HeaderKeyIdentity, "forged")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if user != tt.wantUser {
+				t.Fatalf("expected user %q, got %q", tt.wantUser, user)
+			}
+			if forwarded == "" || forwarded == "forged" {
+				t.Fatalf("expected the identity to be replaced, got %q", forwarded)
+			}
+			if len(forwardedWithPrefix) != 0 {
+				t.Fatalf("expected the prefixed identity to be removed, got %v", forwardedWithPrefix)
+			}
+		})
+	}
+}
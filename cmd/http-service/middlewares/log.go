@@ -64,6 +64,9 @@ func LoggingMiddleware() gin.HandlerFunc {
 			zap.String("client", clientIP),
 			zap.Any("params", ctx.Request.URL.Query()),
 		)
+		if user := ctx.GetString(ContextKeyUser); user != "" {
+			logger = logger.With(zap.String("user", user))
+		}
 		if errs := ctx.Errors.ByType(gin.ErrorTypePrivate); len(errs) > 0 {
 			logger = logger.With(zap.String("error", errs.String()))
 		}
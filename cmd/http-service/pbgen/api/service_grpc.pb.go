@@ -23,6 +23,8 @@ const (
 	Cluster_CreateCluster_FullMethodName  = "/api.Cluster/CreateCluster"
 	Cluster_UpdateCluster_FullMethodName  = "/api.Cluster/UpdateCluster"
 	Cluster_GetCluster_FullMethodName     = "/api.Cluster/GetCluster"
+	Cluster_ListClusters_FullMethodName   = "/api.Cluster/ListClusters"
+	Cluster_WatchCluster_FullMethodName   = "/api.Cluster/WatchCluster"
 	Cluster_DeleteCluster_FullMethodName  = "/api.Cluster/DeleteCluster"
 	Cluster_RestartCluster_FullMethodName = "/api.Cluster/RestartCluster"
 	Cluster_PauseCluster_FullMethodName   = "/api.Cluster/PauseCluster"
@@ -31,6 +33,10 @@ const (
 	Cluster_CreateRestore_FullMethodName  = "/api.Cluster/CreateRestore"
 	Cluster_GetBackup_FullMethodName      = "/api.Cluster/GetBackup"
 	Cluster_GetRestore_FullMethodName     = "/api.Cluster/GetRestore"
+	Cluster_ListBackups_FullMethodName    = "/api.Cluster/ListBackups"
+	Cluster_ListRestores_FullMethodName   = "/api.Cluster/ListRestores"
+	Cluster_WatchBackup_FullMethodName    = "/api.Cluster/WatchBackup"
+	Cluster_WatchRestore_FullMethodName   = "/api.Cluster/WatchRestore"
 	Cluster_StopBackup_FullMethodName     = "/api.Cluster/StopBackup"
 	Cluster_StopRestore_FullMethodName    = "/api.Cluster/StopRestore"
 	Cluster_DeleteBackup_FullMethodName   = "/api.Cluster/DeleteBackup"
@@ -43,6 +49,8 @@ type ClusterClient interface {
 	CreateCluster(ctx context.Context, in *CreateClusterReq, opts ...grpc.CallOption) (*CreateClusterResp, error)
 	UpdateCluster(ctx context.Context, in *UpdateClusterReq, opts ...grpc.CallOption) (*UpdateClusterResp, error)
 	GetCluster(ctx context.Context, in *GetClusterReq, opts ...grpc.CallOption) (*GetClusterResp, error)
+	ListClusters(ctx context.Context, in *ListClustersReq, opts ...grpc.CallOption) (*ListClustersResp, error)
+	WatchCluster(ctx context.Context, in *WatchClusterReq, opts ...grpc.CallOption) (Cluster_WatchClusterClient, error)
 	DeleteCluster(ctx context.Context, in *DeleteClusterReq, opts ...grpc.CallOption) (*DeleteClusterResp, error)
 	RestartCluster(ctx context.Context, in *RestartClusterReq, opts ...grpc.CallOption) (*RestartClusterResp, error)
 	PauseCluster(ctx context.Context, in *PauseClusterReq, opts ...grpc.CallOption) (*PauseClusterResp, error)
@@ -51,6 +59,10 @@ type ClusterClient interface {
 	CreateRestore(ctx context.Context, in *CreateRestoreReq, opts ...grpc.CallOption) (*CreateRestoreResp, error)
 	GetBackup(ctx context.Context, in *GetBackupReq, opts ...grpc.CallOption) (*GetBackupResp, error)
 	GetRestore(ctx context.Context, in *GetRestoreReq, opts ...grpc.CallOption) (*GetRestoreResp, error)
+	ListBackups(ctx context.Context, in *ListBackupsReq, opts ...grpc.CallOption) (*ListBackupsResp, error)
+	ListRestores(ctx context.Context, in *ListRestoresReq, opts ...grpc.CallOption) (*ListRestoresResp, error)
+	WatchBackup(ctx context.Context, in *WatchBackupReq, opts ...grpc.CallOption) (Cluster_WatchBackupClient, error)
+	WatchRestore(ctx context.Context, in *WatchRestoreReq, opts ...grpc.CallOption) (Cluster_WatchRestoreClient, error)
 	StopBackup(ctx context.Context, in *StopBackupReq, opts ...grpc.CallOption) (*StopBackupResp, error)
 	StopRestore(ctx context.Context, in *StopRestoreReq, opts ...grpc.CallOption) (*StopRestoreResp, error)
 	DeleteBackup(ctx context.Context, in *DeleteBackupReq, opts ...grpc.CallOption) (*DeleteBackupResp, error)
@@ -91,6 +103,47 @@ func (c *clusterClient) GetCluster(ctx context.Context, in *GetClusterReq, opts
 	return out, nil
 }
 
+func (c *clusterClient) ListClusters(ctx context.Context, in *ListClustersReq, opts ...grpc.CallOption) (*ListClustersResp, error) {
+	out := new(ListClustersResp)
+	err := c.cc.Invoke(ctx, Cluster_ListClusters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) WatchCluster(ctx context.Context, in *WatchClusterReq, opts ...grpc.CallOption) (Cluster_WatchClusterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Cluster_ServiceDesc.Streams[0], Cluster_WatchCluster_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clusterWatchClusterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Cluster_WatchClusterClient interface {
+	Recv() (*WatchClusterResp, error)
+	grpc.ClientStream
+}
+
+type clusterWatchClusterClient struct {
+	grpc.ClientStream
+}
+
+func (x *clusterWatchClusterClient) Recv() (*WatchClusterResp, error) {
+	m := new(WatchClusterResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *clusterClient) DeleteCluster(ctx context.Context, in *DeleteClusterReq, opts ...grpc.CallOption) (*DeleteClusterResp, error) {
 	out := new(DeleteClusterResp)
 	err := c.cc.Invoke(ctx, Cluster_DeleteCluster_FullMethodName, in, out, opts...)
@@ -163,6 +216,88 @@ func (c *clusterClient) GetRestore(ctx context.Context, in *GetRestoreReq, opts
 	return out, nil
 }
 
+func (c *clusterClient) ListBackups(ctx context.Context, in *ListBackupsReq, opts ...grpc.CallOption) (*ListBackupsResp, error) {
+	out := new(ListBackupsResp)
+	err := c.cc.Invoke(ctx, Cluster_ListBackups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) ListRestores(ctx context.Context, in *ListRestoresReq, opts ...grpc.CallOption) (*ListRestoresResp, error) {
+	out := new(ListRestoresResp)
+	err := c.cc.Invoke(ctx, Cluster_ListRestores_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) WatchBackup(ctx context.Context, in *WatchBackupReq, opts ...grpc.CallOption) (Cluster_WatchBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Cluster_ServiceDesc.Streams[1], Cluster_WatchBackup_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clusterWatchBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Cluster_WatchBackupClient interface {
+	Recv() (*WatchBackupResp, error)
+	grpc.ClientStream
+}
+
+type clusterWatchBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *clusterWatchBackupClient) Recv() (*WatchBackupResp, error) {
+	m := new(WatchBackupResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *clusterClient) WatchRestore(ctx context.Context, in *WatchRestoreReq, opts ...grpc.CallOption) (Cluster_WatchRestoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Cluster_ServiceDesc.Streams[2], Cluster_WatchRestore_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clusterWatchRestoreClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Cluster_WatchRestoreClient interface {
+	Recv() (*WatchRestoreResp, error)
+	grpc.ClientStream
+}
+
+type clusterWatchRestoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *clusterWatchRestoreClient) Recv() (*WatchRestoreResp, error) {
+	m := new(WatchRestoreResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *clusterClient) StopBackup(ctx context.Context, in *StopBackupReq, opts ...grpc.CallOption) (*StopBackupResp, error) {
 	out := new(StopBackupResp)
 	err := c.cc.Invoke(ctx, Cluster_StopBackup_FullMethodName, in, out, opts...)
@@ -197,6 +332,8 @@ type ClusterServer interface {
 	CreateCluster(context.Context, *CreateClusterReq) (*CreateClusterResp, error)
 	UpdateCluster(context.Context, *UpdateClusterReq) (*UpdateClusterResp, error)
 	GetCluster(context.Context, *GetClusterReq) (*GetClusterResp, error)
+	ListClusters(context.Context, *ListClustersReq) (*ListClustersResp, error)
+	WatchCluster(*WatchClusterReq, Cluster_WatchClusterServer) error
 	DeleteCluster(context.Context, *DeleteClusterReq) (*DeleteClusterResp, error)
 	RestartCluster(context.Context, *RestartClusterReq) (*RestartClusterResp, error)
 	PauseCluster(context.Context, *PauseClusterReq) (*PauseClusterResp, error)
@@ -205,6 +342,10 @@ type ClusterServer interface {
 	CreateRestore(context.Context, *CreateRestoreReq) (*CreateRestoreResp, error)
 	GetBackup(context.Context, *GetBackupReq) (*GetBackupResp, error)
 	GetRestore(context.Context, *GetRestoreReq) (*GetRestoreResp, error)
+	ListBackups(context.Context, *ListBackupsReq) (*ListBackupsResp, error)
+	ListRestores(context.Context, *ListRestoresReq) (*ListRestoresResp, error)
+	WatchBackup(*WatchBackupReq, Cluster_WatchBackupServer) error
+	WatchRestore(*WatchRestoreReq, Cluster_WatchRestoreServer) error
 	StopBackup(context.Context, *StopBackupReq) (*StopBackupResp, error)
 	StopRestore(context.Context, *StopRestoreReq) (*StopRestoreResp, error)
 	DeleteBackup(context.Context, *DeleteBackupReq) (*DeleteBackupResp, error)
@@ -224,6 +365,12 @@ func (UnimplementedClusterServer) UpdateCluster(context.Context, *UpdateClusterR
 func (UnimplementedClusterServer) GetCluster(context.Context, *GetClusterReq) (*GetClusterResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCluster not implemented")
 }
+func (UnimplementedClusterServer) ListClusters(context.Context, *ListClustersReq) (*ListClustersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
+}
+func (UnimplementedClusterServer) WatchCluster(*WatchClusterReq, Cluster_WatchClusterServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCluster not implemented")
+}
 func (UnimplementedClusterServer) DeleteCluster(context.Context, *DeleteClusterReq) (*DeleteClusterResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCluster not implemented")
 }
@@ -248,6 +395,18 @@ func (UnimplementedClusterServer) GetBackup(context.Context, *GetBackupReq) (*Ge
 func (UnimplementedClusterServer) GetRestore(context.Context, *GetRestoreReq) (*GetRestoreResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRestore not implemented")
 }
+func (UnimplementedClusterServer) ListBackups(context.Context, *ListBackupsReq) (*ListBackupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackups not implemented")
+}
+func (UnimplementedClusterServer) ListRestores(context.Context, *ListRestoresReq) (*ListRestoresResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRestores not implemented")
+}
+func (UnimplementedClusterServer) WatchBackup(*WatchBackupReq, Cluster_WatchBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBackup not implemented")
+}
+func (UnimplementedClusterServer) WatchRestore(*WatchRestoreReq, Cluster_WatchRestoreServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRestore not implemented")
+}
 func (UnimplementedClusterServer) StopBackup(context.Context, *StopBackupReq) (*StopBackupResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopBackup not implemented")
 }
@@ -324,6 +483,45 @@ func _Cluster_GetCluster_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_ListClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClustersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ListClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_ListClusters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ListClusters(ctx, req.(*ListClustersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_WatchCluster_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchClusterReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServer).WatchCluster(m, &clusterWatchClusterServer{stream})
+}
+
+type Cluster_WatchClusterServer interface {
+	Send(*WatchClusterResp) error
+	grpc.ServerStream
+}
+
+type clusterWatchClusterServer struct {
+	grpc.ServerStream
+}
+
+func (x *clusterWatchClusterServer) Send(m *WatchClusterResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Cluster_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteClusterReq)
 	if err := dec(in); err != nil {
@@ -468,6 +666,84 @@ func _Cluster_GetRestore_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_ListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackupsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ListBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_ListBackups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ListBackups(ctx, req.(*ListBackupsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_ListRestores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRestoresReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ListRestores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_ListRestores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ListRestores(ctx, req.(*ListRestoresReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_WatchBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBackupReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServer).WatchBackup(m, &clusterWatchBackupServer{stream})
+}
+
+type Cluster_WatchBackupServer interface {
+	Send(*WatchBackupResp) error
+	grpc.ServerStream
+}
+
+type clusterWatchBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *clusterWatchBackupServer) Send(m *WatchBackupResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Cluster_WatchRestore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRestoreReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServer).WatchRestore(m, &clusterWatchRestoreServer{stream})
+}
+
+type Cluster_WatchRestoreServer interface {
+	Send(*WatchRestoreResp) error
+	grpc.ServerStream
+}
+
+type clusterWatchRestoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *clusterWatchRestoreServer) Send(m *WatchRestoreResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Cluster_StopBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopBackupReq)
 	if err := dec(in); err != nil {
@@ -541,6 +817,10 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCluster",
 			Handler:    _Cluster_GetCluster_Handler,
 		},
+		{
+			MethodName: "ListClusters",
+			Handler:    _Cluster_ListClusters_Handler,
+		},
 		{
 			MethodName: "DeleteCluster",
 			Handler:    _Cluster_DeleteCluster_Handler,
@@ -573,6 +853,14 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRestore",
 			Handler:    _Cluster_GetRestore_Handler,
 		},
+		{
+			MethodName: "ListBackups",
+			Handler:    _Cluster_ListBackups_Handler,
+		},
+		{
+			MethodName: "ListRestores",
+			Handler:    _Cluster_ListRestores_Handler,
+		},
 		{
 			MethodName: "StopBackup",
 			Handler:    _Cluster_StopBackup_Handler,
@@ -586,6 +874,22 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Cluster_DeleteBackup_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCluster",
+			Handler:       _Cluster_WatchCluster_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchBackup",
+			Handler:       _Cluster_WatchBackup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchRestore",
+			Handler:       _Cluster_WatchRestore_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/service.proto",
 }
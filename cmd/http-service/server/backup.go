@@ -385,6 +385,7 @@ func convertToBackupInfo(backup *v1alpha1.Backup) *api.BackupInfo {
 		Size:       backup.Status.BackupSizeReadable,
 		BackupPath: backup.Status.BackupPath,
 		CommitTs:   backup.Status.CommitTs,
+		Progresses: convertProgresses(backup.Status.Progresses),
 	}
 
 	if !backup.Status.TimeStarted.IsZero() {
@@ -444,10 +445,11 @@ func (s *ClusterServer) GetRestore(ctx context.Context, req *api.GetRestoreReq)
 
 func convertToRestoreInfo(restore *v1alpha1.Restore) *api.RestoreInfo {
 	info := &api.RestoreInfo{
-		ClusterId: restore.Namespace,
-		RestoreId: restore.Name,
-		Status:    string(restore.Status.Phase),
-		CommitTs:  restore.Status.CommitTs,
+		ClusterId:  restore.Namespace,
+		RestoreId:  restore.Name,
+		Status:     string(restore.Status.Phase),
+		CommitTs:   restore.Status.CommitTs,
+		Progresses: convertProgresses(restore.Status.Progresses),
 	}
 
 	if !restore.Status.TimeStarted.IsZero() {
@@ -460,6 +462,18 @@ func convertToRestoreInfo(restore *v1alpha1.Restore) *api.RestoreInfo {
 	return info
 }
 
+func convertProgresses(progresses []v1alpha1.Progress) []*api.Progress {
+	res := make([]*api.Progress, 0, len(progresses))
+	for _, p := range progresses {
+		progress := &api.Progress{Step: p.Step, Progress: p.Progress}
+		if !p.LastTransitionTime.IsZero() {
+			progress.UpdatedAt = p.LastTransitionTime.Format(time.RFC3339)
+		}
+		res = append(res, progress)
+	}
+	return res
+}
+
 func (s *ClusterServer) StopBackup(ctx context.Context, req *api.StopBackupReq) (*api.StopBackupResp, error) {
 	k8sID := getKubernetesID(ctx)
 	opCli := s.KubeClient.GetOperatorClient(k8sID)
@@ -0,0 +1,424 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/pingcap/tidb-operator/http-service/pbgen/api"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+	// maxSortedObjects bounds the objects kept in memory to locate a page not ordered by the namespaces and names,
+	// the objects beyond it can only be listed in the order of the namespaces and names
+	maxSortedObjects = 10000
+
+	orderByCreateTime = "create_time"
+	orderDesc         = "desc"
+
+	// allClusters is the cluster ID to list the backups and restores of all clusters
+	allClusters = "-"
+)
+
+// pageToken is encoded as the opaque next_page_token, it carries the query so that a token can't be used
+// to get the next page of another query.
+type pageToken struct {
+	Query string `json:"q"`
+	// Continue is the continue token of the Kubernetes list, for the objects ordered by the namespaces and names
+	Continue string `json:"c,omitempty"`
+	// Offset is the index of the first object of the page in the sorted objects, for the other orders
+	Offset int `json:"o,omitempty"`
+}
+
+// listQuery is a parsed list request
+type listQuery struct {
+	key           string
+	labelSelector string
+	byCreateTime  bool
+	desc          bool
+	pageSize      int
+	token         pageToken
+}
+
+// parseListQuery validates a list request, `idField` is the name of the default order field,
+// `scope` distinguishes the queries of different clusters.
+func parseListQuery(scope, labelSelector, status, orderBy, idField string, pageSize uint32, token string) (*listQuery, error) {
+	if _, err := labels.Parse(labelSelector); err != nil {
+		return nil, fmt.Errorf("invalid label selector: %w", err)
+	}
+
+	q := &listQuery{labelSelector: labelSelector, pageSize: defaultPageSize}
+	if pageSize > maxPageSize {
+		return nil, fmt.Errorf("page size %d is larger than %d", pageSize, maxPageSize)
+	} else if pageSize > 0 {
+		q.pageSize = int(pageSize)
+	}
+
+	order := strings.Fields(orderBy)
+	switch {
+	case len(order) == 0:
+		order = []string{idField}
+	case len(order) > 2 || (len(order) == 2 && order[1] != orderDesc) || (order[0] != idField && order[0] != orderByCreateTime):
+		return nil, fmt.Errorf("invalid order %q, should be %q or %q followed by an optional \" %s\"",
+			orderBy, idField, orderByCreateTime, orderDesc)
+	}
+	q.byCreateTime = order[0] == orderByCreateTime
+	q.desc = len(order) == 2
+	q.key = strings.Join([]string{scope, labelSelector, status, strings.Join(order, " ")}, "|")
+
+	if token != "" {
+		data, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, errors.New("malformed page token")
+		}
+		if err := json.Unmarshal(data, &q.token); err != nil {
+			return nil, errors.New("malformed page token")
+		}
+		if q.token.Query != q.key {
+			return nil, errors.New("the page token doesn't belong to this query")
+		}
+		if q.token.Offset < 0 || q.token.Offset+q.pageSize > maxSortedObjects {
+			return nil, fmt.Errorf("the page is beyond the first %d objects, order by %q to list more", maxSortedObjects, idField)
+		}
+	}
+	return q, nil
+}
+
+func (q *listQuery) nextPageToken(token pageToken) string {
+	token.Query = q.key
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// listFunc lists a chunk of the objects by Kubernetes
+type listFunc[T metav1.Object] func(ctx context.Context, opts metav1.ListOptions) (items []T, continueToken string, err error)
+
+// compare orders the objects by the creation time if required, the namespaces and names break the ties
+func (q *listQuery) compare(a, b metav1.Object) int {
+	c := 0
+	if q.byCreateTime {
+		c = a.GetCreationTimestamp().Time.Compare(b.GetCreationTimestamp().Time)
+	}
+	if c == 0 {
+		c = cmp.Or(cmp.Compare(a.GetNamespace(), b.GetNamespace()), cmp.Compare(a.GetName(), b.GetName()))
+	}
+	if q.desc {
+		return -c
+	}
+	return c
+}
+
+// listPage returns a page of the objects matching the query and the token of the next page.
+// Kubernetes lists the objects ordered by the namespaces, then the names within a namespace, which is
+// the ascending order of the IDs, so these are listed chunk by chunk with the continue tokens of Kubernetes,
+// the objects in the other orders are listed entirely while only the first ones up to the end of the page
+// are kept and sorted, then the page is located by the offset.
+func listPage[T metav1.Object](ctx context.Context, q *listQuery, list listFunc[T], match func(T) bool) ([]T, string, error) {
+	opts := metav1.ListOptions{LabelSelector: q.labelSelector, Continue: q.token.Continue}
+	if !q.byCreateTime && !q.desc {
+		page := make([]T, 0, q.pageSize)
+		for {
+			// the objects are filtered after listing, so continue until the page is full
+			opts.Limit = int64(q.pageSize - len(page))
+			items, cont, err := list(ctx, opts)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, item := range items {
+				if match(item) {
+					page = append(page, item)
+				}
+			}
+			opts.Continue = cont
+			if cont == "" || len(page) >= q.pageSize {
+				break
+			}
+		}
+		if opts.Continue == "" {
+			return page, "", nil
+		}
+		return page, q.nextPageToken(pageToken{Continue: opts.Continue}), nil
+	}
+
+	// the objects up to the end of the page in the order, the others are dropped after each chunk
+	limit := q.token.Offset + q.pageSize
+	top := make([]T, 0, limit)
+	total := 0
+	compare := func(a, b T) int { return q.compare(a, b) }
+	opts.Continue = ""
+	opts.Limit = maxPageSize
+	for {
+		items, cont, err := list(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, item := range items {
+			if match(item) {
+				top = append(top, item)
+				total++
+			}
+		}
+		if len(top) > limit {
+			slices.SortFunc(top, compare)
+			top = slices.Delete(top, limit, len(top))
+		}
+		if cont == "" {
+			break
+		}
+		opts.Continue = cont
+	}
+	slices.SortFunc(top, compare)
+
+	page := top[min(q.token.Offset, len(top)):]
+	if total <= limit {
+		return page, "", nil
+	}
+	return page, q.nextPageToken(pageToken{Offset: limit}), nil
+}
+
+// listErrorStatus returns the HTTP status code for a failed list, the continue token in an outdated page token
+// is expired by Kubernetes
+func listErrorStatus(err error) int {
+	if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) || apierrors.IsBadRequest(err) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+func isClusterStatus(status string) bool {
+	switch ClusterStatus(status) {
+	case ClusterStatusCreating, ClusterStatusRunning, ClusterStatusDeleting,
+		ClusterStatusScaling, ClusterStatusUpgrading, ClusterStatusUnavailable:
+		return true
+	}
+	return false
+}
+
+func (s *ClusterServer) ListClusters(ctx context.Context, req *api.ListClustersReq) (*api.ListClustersResp, error) {
+	k8sID := getKubernetesID(ctx)
+	opCli := s.KubeClient.GetOperatorClient(k8sID)
+	kubeCli := s.KubeClient.GetKubeClient(k8sID)
+	logger := log.L().With(zap.String("request", "ListClusters"), zap.String("k8sID", k8sID))
+	if opCli == nil || kubeCli == nil {
+		logger.Error("K8s client not found")
+		message := fmt.Sprintf("no %s is specified in the request header or the kubeconfig context not exists", HeaderKeyKubernetesID)
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ListClustersResp{Success: false, Message: &message}, nil
+	}
+
+	if req.Status != "" && !isClusterStatus(req.Status) {
+		message := fmt.Sprintf("invalid status %q", req.Status)
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ListClustersResp{Success: false, Message: &message}, nil
+	}
+	q, err := parseListQuery("", req.LabelSelector, req.Status, req.OrderBy, "cluster_id", req.PageSize, req.PageToken)
+	if err != nil {
+		logger.Warn("Invalid list request", zap.Error(err))
+		message := fmt.Sprintf("invalid list request: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ListClustersResp{Success: false, Message: &message}, nil
+	}
+
+	// all TidbClusters are named `tidbClusterName`, the namespaces are the cluster IDs
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", tidbClusterName).String()
+	tcs, next, err := listPage(ctx, q, func(ctx context.Context, opts metav1.ListOptions) ([]*v1alpha1.TidbCluster, string, error) {
+		opts.FieldSelector = fieldSelector
+		list, err := opCli.PingcapV1alpha1().TidbClusters(metav1.NamespaceAll).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		items := make([]*v1alpha1.TidbCluster, 0, len(list.Items))
+		for i := range list.Items {
+			items = append(items, &list.Items[i])
+		}
+		return items, list.Continue, nil
+	}, func(tc *v1alpha1.TidbCluster) bool {
+		return req.Status == "" || string(convertClusterStatus(tc)) == req.Status
+	})
+	if err != nil {
+		logger.Error("List TidbClusters failed", zap.Error(err))
+		message := fmt.Sprintf("list TidbClusters failed: %s", err.Error())
+		setResponseStatusCodes(ctx, listErrorStatus(err))
+		return &api.ListClustersResp{Success: false, Message: &message}, nil
+	}
+
+	tms := listTidbMonitors(ctx, logger, opCli)
+	infos := make([]*api.ClusterInfo, 0, len(tcs))
+	for _, tc := range tcs {
+		infos = append(infos, convertToClusterInfo(logger, kubeCli, tc, tms[tc.Namespace]))
+	}
+	return &api.ListClustersResp{Success: true, Data: infos, NextPageToken: next}, nil
+}
+
+// getTidbMonitor returns the TidbMonitor of a cluster, or nil if it's not found or failed to get
+func getTidbMonitor(ctx context.Context, logger *zap.Logger, opCli versioned.Interface, namespace string) *v1alpha1.TidbMonitor {
+	tm, err := opCli.PingcapV1alpha1().TidbMonitors(namespace).Get(ctx, tidbMonitorName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Error("Get TidbMonitor failed", zap.String("clusterID", namespace), zap.Error(err))
+		}
+		return nil
+	}
+	return tm
+}
+
+// listTidbMonitors returns the TidbMonitors of all clusters by their cluster IDs, or nil if failed to list
+func listTidbMonitors(ctx context.Context, logger *zap.Logger, opCli versioned.Interface) map[string]*v1alpha1.TidbMonitor {
+	list, err := opCli.PingcapV1alpha1().TidbMonitors(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", tidbMonitorName).String(),
+	})
+	if err != nil {
+		logger.Error("List TidbMonitors failed", zap.Error(err))
+		return nil
+	}
+	tms := make(map[string]*v1alpha1.TidbMonitor, len(list.Items))
+	for i := range list.Items {
+		tms[list.Items[i].Namespace] = &list.Items[i]
+	}
+	return tms
+}
+
+func (s *ClusterServer) ListBackups(ctx context.Context, req *api.ListBackupsReq) (*api.ListBackupsResp, error) {
+	k8sID := getKubernetesID(ctx)
+	opCli := s.KubeClient.GetOperatorClient(k8sID)
+	logger := log.L().With(zap.String("request", "ListBackups"), zap.String("k8sID", k8sID), zap.String("clusterID", req.ClusterId))
+	if opCli == nil {
+		logger.Error("K8s client not found")
+		message := fmt.Sprintf("no %s is specified in the request header or the kubeconfig context not exists", HeaderKeyKubernetesID)
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ListBackupsResp{Success: false, Message: &message}, nil
+	}
+
+	namespace, err := listNamespace(req.ClusterId)
+	if err != nil {
+		message := err.Error()
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ListBackupsResp{Success: false, Message: &message}, nil
+	}
+	q, err := parseListQuery(req.ClusterId, req.LabelSelector, req.Status, req.OrderBy, "backup_id", req.PageSize, req.PageToken)
+	if err != nil {
+		logger.Warn("Invalid list request", zap.Error(err))
+		message := fmt.Sprintf("invalid list request: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ListBackupsResp{Success: false, Message: &message}, nil
+	}
+
+	backups, next, err := listPage(ctx, q, func(ctx context.Context, opts metav1.ListOptions) ([]*v1alpha1.Backup, string, error) {
+		list, err := opCli.PingcapV1alpha1().Backups(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		items := make([]*v1alpha1.Backup, 0, len(list.Items))
+		for i := range list.Items {
+			items = append(items, &list.Items[i])
+		}
+		return items, list.Continue, nil
+	}, func(backup *v1alpha1.Backup) bool {
+		return req.Status == "" || strings.EqualFold(string(backup.Status.Phase), req.Status)
+	})
+	if err != nil {
+		logger.Error("List backups failed", zap.Error(err))
+		message := fmt.Sprintf("list backups failed: %s", err.Error())
+		setResponseStatusCodes(ctx, listErrorStatus(err))
+		return &api.ListBackupsResp{Success: false, Message: &message}, nil
+	}
+
+	infos := make([]*api.BackupInfo, 0, len(backups))
+	for _, backup := range backups {
+		infos = append(infos, convertToBackupInfo(backup))
+	}
+	return &api.ListBackupsResp{Success: true, Data: infos, NextPageToken: next}, nil
+}
+
+func (s *ClusterServer) ListRestores(ctx context.Context, req *api.ListRestoresReq) (*api.ListRestoresResp, error) {
+	k8sID := getKubernetesID(ctx)
+	opCli := s.KubeClient.GetOperatorClient(k8sID)
+	logger := log.L().With(zap.String("request", "ListRestores"), zap.String("k8sID", k8sID), zap.String("clusterID", req.ClusterId))
+	if opCli == nil {
+		logger.Error("K8s client not found")
+		message := fmt.Sprintf("no %s is specified in the request header or the kubeconfig context not exists", HeaderKeyKubernetesID)
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ListRestoresResp{Success: false, Message: &message}, nil
+	}
+
+	namespace, err := listNamespace(req.ClusterId)
+	if err != nil {
+		message := err.Error()
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ListRestoresResp{Success: false, Message: &message}, nil
+	}
+	q, err := parseListQuery(req.ClusterId, req.LabelSelector, req.Status, req.OrderBy, "restore_id", req.PageSize, req.PageToken)
+	if err != nil {
+		logger.Warn("Invalid list request", zap.Error(err))
+		message := fmt.Sprintf("invalid list request: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ListRestoresResp{Success: false, Message: &message}, nil
+	}
+
+	restores, next, err := listPage(ctx, q, func(ctx context.Context, opts metav1.ListOptions) ([]*v1alpha1.Restore, string, error) {
+		list, err := opCli.PingcapV1alpha1().Restores(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		items := make([]*v1alpha1.Restore, 0, len(list.Items))
+		for i := range list.Items {
+			items = append(items, &list.Items[i])
+		}
+		return items, list.Continue, nil
+	}, func(restore *v1alpha1.Restore) bool {
+		return req.Status == "" || strings.EqualFold(string(restore.Status.Phase), req.Status)
+	})
+	if err != nil {
+		logger.Error("List restores failed", zap.Error(err))
+		message := fmt.Sprintf("list restores failed: %s", err.Error())
+		setResponseStatusCodes(ctx, listErrorStatus(err))
+		return &api.ListRestoresResp{Success: false, Message: &message}, nil
+	}
+
+	infos := make([]*api.RestoreInfo, 0, len(restores))
+	for _, restore := range restores {
+		infos = append(infos, convertToRestoreInfo(restore))
+	}
+	return &api.ListRestoresResp{Success: true, Data: infos, NextPageToken: next}, nil
+}
+
+// listNamespace returns the namespace to list the backups or restores of a cluster
+func listNamespace(clusterID string) (string, error) {
+	switch clusterID {
+	case "":
+		return "", errors.New("cluster_id is required")
+	case allClusters:
+		return metav1.NamespaceAll, nil
+	}
+	return clusterID, nil
+}
@@ -0,0 +1,331 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned/fake"
+)
+
+// fakeLister lists the objects chunk by chunk like Kubernetes, the continue token is the index of the next object
+type fakeLister struct {
+	objects []*metav1.ObjectMeta
+	calls   int
+}
+
+func (l *fakeLister) list(_ context.Context, opts metav1.ListOptions) ([]*metav1.ObjectMeta, string, error) {
+	l.calls++
+	start := 0
+	if opts.Continue != "" {
+		var err error
+		if start, err = strconv.Atoi(opts.Continue); err != nil {
+			return nil, "", fmt.Errorf("invalid continue token %q", opts.Continue)
+		}
+	}
+	end := len(l.objects)
+	if opts.Limit > 0 {
+		end = min(start+int(opts.Limit), end)
+	}
+	cont := ""
+	if end < len(l.objects) {
+		cont = strconv.Itoa(end)
+	}
+	return l.objects[start:end], cont, nil
+}
+
+// newTestObjects returns the objects ordered by the names, the creation time of obj-i is i minutes
+// after the base time but the odd ones are created before the even ones
+func newTestObjects(n int) []*metav1.ObjectMeta {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	objects := make([]*metav1.ObjectMeta, 0, n)
+	for i := 0; i < n; i++ {
+		created := base.Add(time.Duration(i) * time.Minute)
+		if i%2 == 1 {
+			created = created.Add(-time.Hour)
+		}
+		objects = append(objects, &metav1.ObjectMeta{
+			Namespace:         "ns",
+			Name:              fmt.Sprintf("obj-%04d", i),
+			CreationTimestamp: metav1.NewTime(created),
+			Labels:            map[string]string{"parity": strconv.Itoa(i % 2)},
+		})
+	}
+	return objects
+}
+
+func names(objects []*metav1.ObjectMeta) []string {
+	ret := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		ret = append(ret, obj.Name)
+	}
+	return ret
+}
+
+// listAll follows the page tokens until the last page
+func listAll(t *testing.T, l *fakeLister, orderBy string, pageSize uint32, match func(*metav1.ObjectMeta) bool) [][]string {
+	var pages [][]string
+	token := ""
+	for {
+		q, err := parseListQuery("", "", "", orderBy, "id", pageSize, token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		page, next, err := listPage(context.Background(), q, l.list, match)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages = append(pages, names(page))
+		if next == "" {
+			return pages
+		}
+		if len(pages) > 100 {
+			t.Fatal("too many pages")
+		}
+		token = next
+	}
+}
+
+func TestListPage(t *testing.T) {
+	objects := newTestObjects(7)
+	all := func(*metav1.ObjectMeta) bool { return true }
+	even := func(obj *metav1.ObjectMeta) bool { return obj.Labels["parity"] == "0" }
+
+	tests := []struct {
+		name     string
+		orderBy  string
+		pageSize uint32
+		match    func(*metav1.ObjectMeta) bool
+		want     [][]string
+	}{
+		{
+			name:    "default page size",
+			orderBy: "",
+			match:   all,
+			want:    [][]string{{"obj-0000", "obj-0001", "obj-0002", "obj-0003", "obj-0004", "obj-0005", "obj-0006"}},
+		},
+		{
+			name:     "by names",
+			orderBy:  "id",
+			pageSize: 3,
+			match:    all,
+			want:     [][]string{{"obj-0000", "obj-0001", "obj-0002"}, {"obj-0003", "obj-0004", "obj-0005"}, {"obj-0006"}},
+		},
+		{
+			name:     "by names with filter",
+			pageSize: 2,
+			match:    even,
+			want:     [][]string{{"obj-0000", "obj-0002"}, {"obj-0004", "obj-0006"}},
+		},
+		{
+			name:     "by names desc",
+			orderBy:  "id desc",
+			pageSize: 3,
+			match:    all,
+			want:     [][]string{{"obj-0006", "obj-0005", "obj-0004"}, {"obj-0003", "obj-0002", "obj-0001"}, {"obj-0000"}},
+		},
+		{
+			name:     "by create time",
+			orderBy:  "create_time",
+			pageSize: 3,
+			match:    all,
+			want:     [][]string{{"obj-0001", "obj-0003", "obj-0005"}, {"obj-0000", "obj-0002", "obj-0004"}, {"obj-0006"}},
+		},
+		{
+			name:     "by create time desc with filter",
+			orderBy:  "create_time desc",
+			pageSize: 2,
+			match:    even,
+			want:     [][]string{{"obj-0006", "obj-0004"}, {"obj-0002", "obj-0000"}},
+		},
+		{
+			name:     "no matches",
+			orderBy:  "create_time",
+			pageSize: 2,
+			match:    func(*metav1.ObjectMeta) bool { return false },
+			want:     [][]string{{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := listAll(t, &fakeLister{objects: objects}, tt.orderBy, tt.pageSize, tt.match)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestListPageAcrossNamespaces checks that the objects sorted in memory follow the order in which
+// Kubernetes lists them, i.e. by the namespaces and then by the names
+func TestListPageAcrossNamespaces(t *testing.T) {
+	var objects []*metav1.ObjectMeta
+	for _, ns := range []string{"ns-a", "ns-b"} {
+		for _, name := range []string{"a", "b"} {
+			objects = append(objects, &metav1.ObjectMeta{Namespace: ns, Name: name})
+		}
+	}
+	all := func(*metav1.ObjectMeta) bool { return true }
+	key := func(pages [][]string) []string {
+		var ret []string
+		for _, page := range pages {
+			ret = append(ret, page...)
+		}
+		return ret
+	}
+
+	l := &fakeLister{objects: objects}
+	asc := key(listAll(t, l, "id", 3, all))
+	desc := key(listAll(t, l, "id desc", 3, all))
+	slices.Reverse(desc)
+	if !slices.Equal(asc, desc) {
+		t.Errorf("the descending order %v is not the reverse of the ascending order %v", desc, asc)
+	}
+
+	q, err := parseListQuery("", "", "", "id desc", "id", 4, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, _, err := listPage(context.Background(), q, l.list, all)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, obj := range page {
+		got = append(got, obj.Namespace+"/"+obj.Name)
+	}
+	if want := []string{"ns-b/b", "ns-b/a", "ns-a/b", "ns-a/a"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+func TestListPageChunks(t *testing.T) {
+	objects := newTestObjects(2*maxPageSize + 10)
+	all := func(*metav1.ObjectMeta) bool { return true }
+
+	// the objects ordered by the names are listed up to the page size
+	l := &fakeLister{objects: objects}
+	q, err := parseListQuery("", "", "", "", "id", 10, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := listPage(context.Background(), q, l.list, all); err != nil {
+		t.Fatal(err)
+	}
+	if l.calls != 1 {
+		t.Fatalf("expected 1 list call, got %d", l.calls)
+	}
+
+	// the objects in the other orders are listed entirely by chunks
+	l = &fakeLister{objects: objects}
+	q, err = parseListQuery("", "", "", "id desc", "id", 10, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, next, err := listPage(context.Background(), q, l.list, all)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.calls != 3 {
+		t.Fatalf("expected 3 list calls, got %d", l.calls)
+	}
+	if len(page) != 10 || page[0].Name != objects[len(objects)-1].Name || next == "" {
+		t.Fatalf("unexpected page %v, next %q", names(page), next)
+	}
+}
+
+func TestParseListQuery(t *testing.T) {
+	q, err := parseListQuery("c1", "app=tidb", "", "", "id", 10, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validToken := q.nextPageToken(pageToken{Offset: 10})
+
+	tests := []struct {
+		name          string
+		scope         string
+		labelSelector string
+		orderBy       string
+		pageSize      uint32
+		token         string
+		wantErr       string
+	}{
+		{name: "valid", scope: "c1", labelSelector: "app=tidb", pageSize: 10},
+		{name: "valid token", scope: "c1", labelSelector: "app=tidb", pageSize: 10, token: validToken},
+		{name: "max page size", pageSize: maxPageSize},
+		{name: "page size too large", pageSize: maxPageSize + 1, wantErr: "page size"},
+		{name: "invalid label selector", labelSelector: "a in (", wantErr: "invalid label selector"},
+		{name: "invalid order field", orderBy: "name", wantErr: "invalid order"},
+		{name: "invalid order direction", orderBy: "id asc", wantErr: "invalid order"},
+		{name: "too many order fields", orderBy: "id desc create_time", wantErr: "invalid order"},
+		{name: "malformed token", token: "!!!", wantErr: "malformed page token"},
+		{name: "malformed token json", token: "bm90LWpzb24", wantErr: "malformed page token"},
+		{name: "token of other scope", scope: "c2", labelSelector: "app=tidb", token: validToken, wantErr: "doesn't belong"},
+		{name: "token of other selector", scope: "c1", token: validToken, wantErr: "doesn't belong"},
+		{name: "token of other order", scope: "c1", labelSelector: "app=tidb", orderBy: "id desc", token: validToken, wantErr: "doesn't belong"},
+		{
+			name:          "negative offset",
+			scope:         "c1",
+			labelSelector: "app=tidb",
+			token:         q.nextPageToken(pageToken{Offset: -1}),
+			wantErr:       "beyond",
+		},
+		{
+			name:          "offset too large",
+			scope:         "c1",
+			labelSelector: "app=tidb",
+			pageSize:      10,
+			token:         q.nextPageToken(pageToken{Offset: maxSortedObjects}),
+			wantErr:       "beyond",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseListQuery(tt.scope, tt.labelSelector, "", tt.orderBy, "id", tt.pageSize, tt.token)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestListTidbMonitors(t *testing.T) {
+	opCli := fake.NewSimpleClientset(
+		&v1alpha1.TidbMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: "c1", Name: tidbMonitorName}},
+		&v1alpha1.TidbMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: "c2", Name: tidbMonitorName}},
+	)
+	tms := listTidbMonitors(context.Background(), zap.NewNop(), opCli)
+	if len(tms) != 2 || tms["c1"].Namespace != "c1" || tms["c2"].Namespace != "c2" || tms["c3"] != nil {
+		t.Fatalf("unexpected TidbMonitors %v", tms)
+	}
+	if actions := opCli.Actions(); len(actions) != 1 || actions[0].GetVerb() != "list" {
+		t.Fatalf("expected a single list call, got %v", actions)
+	}
+}
@@ -0,0 +1,392 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/pingcap/tidb-operator/http-service/pbgen/api"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+)
+
+const (
+	// HeaderKeyLastEventID is sent by the clients of the server-sent events when reconnecting,
+	// it's used as the resource version if the request doesn't carry one.
+	HeaderKeyLastEventID = "last-event-id"
+
+	// the types of the watch responses
+	watchTypeStatus  = "status"
+	watchTypeEvent   = "event"
+	watchTypeDeleted = "deleted"
+)
+
+// watchPosition is the resumable position of a watch. The object and its events are watched separately,
+// so the position consists of both resource versions.
+type watchPosition struct {
+	object string
+	events string
+}
+
+func parseWatchPosition(resourceVersion string) (watchPosition, error) {
+	if resourceVersion == "" {
+		return watchPosition{}, nil
+	}
+	object, events, ok := strings.Cut(resourceVersion, ".")
+	if !ok || object == "" || events == "" {
+		return watchPosition{}, fmt.Errorf("invalid resource version %q", resourceVersion)
+	}
+	return watchPosition{object: object, events: events}, nil
+}
+
+func (p watchPosition) String() string {
+	return p.object + "." + p.events
+}
+
+// watchSendFunc sends a response of the type, the object is set for the status and the event for the event
+type watchSendFunc func(typ string, obj runtime.Object, event *corev1.Event, pos watchPosition) error
+
+// objectWatcher streams the changes of an object and its Kubernetes events until the object is deleted
+// or the client disconnects.
+type objectWatcher struct {
+	kubeCli   kubernetes.Interface
+	kind      string
+	namespace string
+	name      string
+
+	get   func(ctx context.Context) (runtime.Object, error)
+	watch func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	send  watchSendFunc
+}
+
+func (w *objectWatcher) run(ctx context.Context, resourceVersion string) error {
+	pos, err := parseWatchPosition(resourceVersion)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if pos.object == "" {
+		if pos, err = w.sendCurrent(ctx); err != nil {
+			return err
+		}
+	}
+
+	for {
+		// the watches are closed by Kubernetes after a timeout, restart them from the last position
+		done, err := w.watchOnce(ctx, &pos)
+		if done || err != nil {
+			return err
+		}
+	}
+}
+
+// sendCurrent sends the current status and the recent events, returns the position to watch from
+func (w *objectWatcher) sendCurrent(ctx context.Context) (watchPosition, error) {
+	obj, err := w.get(ctx)
+	if err != nil {
+		return watchPosition{}, w.watchError(err)
+	}
+	events, err := w.kubeCli.CoreV1().Events(w.namespace).List(ctx, metav1.ListOptions{FieldSelector: w.eventSelector()})
+	if err != nil {
+		return watchPosition{}, w.watchError(err)
+	}
+	pos := watchPosition{object: resourceVersionOf(obj), events: events.ResourceVersion}
+
+	if err := w.send(watchTypeStatus, obj, nil, pos); err != nil {
+		return watchPosition{}, err
+	}
+	sort.SliceStable(events.Items, func(i, j int) bool {
+		return eventTime(&events.Items[i]).Before(eventTime(&events.Items[j]))
+	})
+	for i := range events.Items {
+		if err := w.send(watchTypeEvent, nil, &events.Items[i], pos); err != nil {
+			return watchPosition{}, err
+		}
+	}
+	return pos, nil
+}
+
+// watchOnce watches the object and its events from the position, returns true if the watch is finished
+func (w *objectWatcher) watchOnce(ctx context.Context, pos *watchPosition) (bool, error) {
+	objWatch, err := w.watch(ctx, metav1.ListOptions{
+		FieldSelector:       fields.OneTermEqualSelector("metadata.name", w.name).String(),
+		ResourceVersion:     pos.object,
+		AllowWatchBookmarks: true,
+	})
+	if err != nil {
+		return true, w.watchError(err)
+	}
+	defer objWatch.Stop()
+	eventWatch, err := w.kubeCli.CoreV1().Events(w.namespace).Watch(ctx, metav1.ListOptions{
+		FieldSelector:       w.eventSelector(),
+		ResourceVersion:     pos.events,
+		AllowWatchBookmarks: true,
+	})
+	if err != nil {
+		return true, w.watchError(err)
+	}
+	defer eventWatch.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return true, nil
+		case e, ok := <-objWatch.ResultChan():
+			if !ok {
+				return false, nil
+			}
+			if e.Type == watch.Error {
+				return true, w.watchError(apierrors.FromObject(e.Object))
+			}
+			pos.object = resourceVersionOf(e.Object)
+			switch e.Type {
+			case watch.Added, watch.Modified:
+				if err := w.send(watchTypeStatus, e.Object, nil, *pos); err != nil {
+					return true, err
+				}
+			case watch.Deleted:
+				return true, w.send(watchTypeDeleted, nil, nil, *pos)
+			}
+		case e, ok := <-eventWatch.ResultChan():
+			if !ok {
+				return false, nil
+			}
+			if e.Type == watch.Error {
+				return true, w.watchError(apierrors.FromObject(e.Object))
+			}
+			pos.events = resourceVersionOf(e.Object)
+			// the deletions of the events are their expirations
+			if e.Type == watch.Added || e.Type == watch.Modified {
+				if err := w.send(watchTypeEvent, nil, e.Object.(*corev1.Event), *pos); err != nil {
+					return true, err
+				}
+			}
+		}
+	}
+}
+
+func (w *objectWatcher) eventSelector() string {
+	return fields.Set{
+		"involvedObject.kind": w.kind,
+		"involvedObject.name": w.name,
+	}.AsSelector().String()
+}
+
+// watchError converts the errors of Kubernetes to the gRPC errors
+func (w *objectWatcher) watchError(err error) error {
+	switch {
+	case apierrors.IsNotFound(err):
+		return status.Errorf(codes.NotFound, "%s %s/%s not found", w.kind, w.namespace, w.name)
+	case apierrors.IsResourceExpired(err) || apierrors.IsGone(err):
+		return status.Error(codes.OutOfRange, "the resource version is too old, watch again without it")
+	case apierrors.IsBadRequest(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Errorf(codes.Internal, "watch %s failed: %v", w.kind, err)
+}
+
+func resourceVersionOf(obj runtime.Object) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return accessor.GetResourceVersion()
+}
+
+// watchResourceVersion returns the resource version to resume the watch from
+func watchResourceVersion(ctx context.Context, resourceVersion string) string {
+	if resourceVersion != "" {
+		return resourceVersion
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(HeaderKeyLastEventID)) == 0 {
+		return ""
+	}
+	return md.Get(HeaderKeyLastEventID)[0]
+}
+
+func eventTime(event *corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	return event.EventTime.Time
+}
+
+func convertToKubernetesEvent(event *corev1.Event) *api.KubernetesEvent {
+	info := &api.KubernetesEvent{
+		Type:    event.Type,
+		Reason:  event.Reason,
+		Message: event.Message,
+		Count:   event.Count,
+	}
+	if !event.FirstTimestamp.IsZero() {
+		info.FirstSeen = event.FirstTimestamp.Format(time.RFC3339)
+	} else if !event.EventTime.IsZero() {
+		info.FirstSeen = event.EventTime.Format(time.RFC3339)
+	}
+	if t := eventTime(event); !t.IsZero() {
+		info.LastSeen = t.Format(time.RFC3339)
+	}
+	return info
+}
+
+func (s *ClusterServer) WatchCluster(req *api.WatchClusterReq, stream api.Cluster_WatchClusterServer) error {
+	ctx := stream.Context()
+	k8sID := getKubernetesID(ctx)
+	opCli := s.KubeClient.GetOperatorClient(k8sID)
+	kubeCli := s.KubeClient.GetKubeClient(k8sID)
+	logger := log.L().With(zap.String("request", "WatchCluster"), zap.String("k8sID", k8sID), zap.String("clusterID", req.ClusterId))
+	if opCli == nil || kubeCli == nil {
+		logger.Error("K8s client not found")
+		return status.Errorf(codes.InvalidArgument, "no %s is specified in the request header or the kubeconfig context not exists", HeaderKeyKubernetesID)
+	}
+
+	// only send the changes of the cluster information, the status of the TidbCluster is updated frequently
+	var last *api.ClusterInfo
+	w := &objectWatcher{
+		kubeCli:   kubeCli,
+		kind:      v1alpha1.TiDBClusterKind,
+		namespace: req.ClusterId,
+		name:      tidbClusterName,
+		get: func(ctx context.Context) (runtime.Object, error) {
+			return opCli.PingcapV1alpha1().TidbClusters(req.ClusterId).Get(ctx, tidbClusterName, metav1.GetOptions{})
+		},
+		watch: opCli.PingcapV1alpha1().TidbClusters(req.ClusterId).Watch,
+		send: func(typ string, obj runtime.Object, event *corev1.Event, pos watchPosition) error {
+			resp := &api.WatchClusterResp{Type: typ, ResourceVersion: pos.String()}
+			switch typ {
+			case watchTypeStatus:
+				tc := obj.(*v1alpha1.TidbCluster)
+				info := convertToClusterInfo(logger, kubeCli, tc, getTidbMonitor(ctx, logger, opCli, tc.Namespace))
+				if proto.Equal(info, last) {
+					return nil
+				}
+				last, resp.Data = info, info
+			case watchTypeEvent:
+				resp.Event = convertToKubernetesEvent(event)
+			}
+			return stream.Send(resp)
+		},
+	}
+	if err := w.run(ctx, watchResourceVersion(ctx, req.ResourceVersion)); err != nil && ctx.Err() == nil {
+		logger.Error("Watch TidbCluster failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *ClusterServer) WatchBackup(req *api.WatchBackupReq, stream api.Cluster_WatchBackupServer) error {
+	ctx := stream.Context()
+	k8sID := getKubernetesID(ctx)
+	opCli := s.KubeClient.GetOperatorClient(k8sID)
+	kubeCli := s.KubeClient.GetKubeClient(k8sID)
+	logger := log.L().With(zap.String("request", "WatchBackup"), zap.String("k8sID", k8sID),
+		zap.String("clusterID", req.ClusterId), zap.String("backupID", req.BackupId))
+	if opCli == nil || kubeCli == nil {
+		logger.Error("K8s client not found")
+		return status.Errorf(codes.InvalidArgument, "no %s is specified in the request header or the kubeconfig context not exists", HeaderKeyKubernetesID)
+	}
+
+	var last *api.BackupInfo
+	w := &objectWatcher{
+		kubeCli:   kubeCli,
+		kind:      v1alpha1.BackupKind,
+		namespace: req.ClusterId,
+		name:      req.BackupId,
+		get: func(ctx context.Context) (runtime.Object, error) {
+			return opCli.PingcapV1alpha1().Backups(req.ClusterId).Get(ctx, req.BackupId, metav1.GetOptions{})
+		},
+		watch: opCli.PingcapV1alpha1().Backups(req.ClusterId).Watch,
+		send: func(typ string, obj runtime.Object, event *corev1.Event, pos watchPosition) error {
+			resp := &api.WatchBackupResp{Type: typ, ResourceVersion: pos.String()}
+			switch typ {
+			case watchTypeStatus:
+				info := convertToBackupInfo(obj.(*v1alpha1.Backup))
+				if proto.Equal(info, last) {
+					return nil
+				}
+				last, resp.Data = info, info
+			case watchTypeEvent:
+				resp.Event = convertToKubernetesEvent(event)
+			}
+			return stream.Send(resp)
+		},
+	}
+	if err := w.run(ctx, watchResourceVersion(ctx, req.ResourceVersion)); err != nil && ctx.Err() == nil {
+		logger.Error("Watch backup failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *ClusterServer) WatchRestore(req *api.WatchRestoreReq, stream api.Cluster_WatchRestoreServer) error {
+	ctx := stream.Context()
+	k8sID := getKubernetesID(ctx)
+	opCli := s.KubeClient.GetOperatorClient(k8sID)
+	kubeCli := s.KubeClient.GetKubeClient(k8sID)
+	logger := log.L().With(zap.String("request", "WatchRestore"), zap.String("k8sID", k8sID),
+		zap.String("clusterID", req.ClusterId), zap.String("restoreID", req.RestoreId))
+	if opCli == nil || kubeCli == nil {
+		logger.Error("K8s client not found")
+		return status.Errorf(codes.InvalidArgument, "no %s is specified in the request header or the kubeconfig context not exists", HeaderKeyKubernetesID)
+	}
+
+	var last *api.RestoreInfo
+	w := &objectWatcher{
+		kubeCli:   kubeCli,
+		kind:      v1alpha1.RestoreKind,
+		namespace: req.ClusterId,
+		name:      req.RestoreId,
+		get: func(ctx context.Context) (runtime.Object, error) {
+			return opCli.PingcapV1alpha1().Restores(req.ClusterId).Get(ctx, req.RestoreId, metav1.GetOptions{})
+		},
+		watch: opCli.PingcapV1alpha1().Restores(req.ClusterId).Watch,
+		send: func(typ string, obj runtime.Object, event *corev1.Event, pos watchPosition) error {
+			resp := &api.WatchRestoreResp{Type: typ, ResourceVersion: pos.String()}
+			switch typ {
+			case watchTypeStatus:
+				info := convertToRestoreInfo(obj.(*v1alpha1.Restore))
+				if proto.Equal(info, last) {
+					return nil
+				}
+				last, resp.Data = info, info
+			case watchTypeEvent:
+				resp.Event = convertToKubernetesEvent(event)
+			}
+			return stream.Send(resp)
+		},
+	}
+	if err := w.run(ctx, watchResourceVersion(ctx, req.ResourceVersion)); err != nil && ctx.Err() == nil {
+		logger.Error("Watch restore failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+)
+
+const testWatchTimeout = 5 * time.Second
+
+// fakeWatch is a watch started by the objectWatcher
+type fakeWatch struct {
+	opts    metav1.ListOptions
+	watcher *watch.FakeWatcher
+}
+
+// watchHarness runs an objectWatcher of a Backup against the fake watches, it records the responses sent
+type watchHarness struct {
+	w       *objectWatcher
+	objects chan fakeWatch
+	events  chan fakeWatch
+	sent    chan string
+}
+
+func newWatchHarness(get func(ctx context.Context) (runtime.Object, error), events ...corev1.Event) *watchHarness {
+	h := &watchHarness{
+		objects: make(chan fakeWatch, 10),
+		events:  make(chan fakeWatch, 10),
+		sent:    make(chan string, 100),
+	}
+	kubeCli := kubefake.NewSimpleClientset()
+	kubeCli.PrependReactor("list", "events", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.EventList{ListMeta: metav1.ListMeta{ResourceVersion: "7"}, Items: events}, nil
+	})
+	kubeCli.PrependWatchReactor("events", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		w := watch.NewFakeWithChanSize(10, false)
+		restrictions := action.(k8stesting.WatchActionImpl).WatchRestrictions
+		h.events <- fakeWatch{opts: metav1.ListOptions{ResourceVersion: restrictions.ResourceVersion}, watcher: w}
+		return true, w, nil
+	})
+	h.w = &objectWatcher{
+		kubeCli:   kubeCli,
+		kind:      v1alpha1.BackupKind,
+		namespace: "c1",
+		name:      "b1",
+		get:       get,
+		watch: func(_ context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			w := watch.NewFakeWithChanSize(10, false)
+			h.objects <- fakeWatch{opts: opts, watcher: w}
+			return w, nil
+		},
+		send: func(typ string, obj runtime.Object, event *corev1.Event, pos watchPosition) error {
+			name := ""
+			if obj != nil {
+				name = obj.(*v1alpha1.Backup).Name
+			} else if event != nil {
+				name = event.Name
+			}
+			h.sent <- typ + " " + name + " " + pos.String()
+			return nil
+		},
+	}
+	return h
+}
+
+// start runs the watcher and returns the channel of its result
+func (h *watchHarness) start(ctx context.Context, resourceVersion string) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- h.w.run(ctx, resourceVersion)
+	}()
+	return done
+}
+
+func (h *watchHarness) expectSent(t *testing.T, want ...string) {
+	t.Helper()
+	for _, w := range want {
+		select {
+		case got := <-h.sent:
+			if got != w {
+				t.Fatalf("expected %q to be sent, got %q", w, got)
+			}
+		case <-time.After(testWatchTimeout):
+			t.Fatalf("expected %q to be sent, got nothing", w)
+		}
+	}
+}
+
+func receiveWatch(t *testing.T, ch <-chan fakeWatch, resourceVersion string) *watch.FakeWatcher {
+	t.Helper()
+	select {
+	case w := <-ch:
+		if w.opts.ResourceVersion != resourceVersion {
+			t.Fatalf("expected to watch from %q, got %q", resourceVersion, w.opts.ResourceVersion)
+		}
+		return w.watcher
+	case <-time.After(testWatchTimeout):
+		t.Fatal("expected a watch, got nothing")
+	}
+	return nil
+}
+
+func receiveResult(t *testing.T, done <-chan error) error {
+	t.Helper()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(testWatchTimeout):
+		t.Fatal("expected the watch to be finished")
+	}
+	return nil
+}
+
+func newTestBackup(resourceVersion string) *v1alpha1.Backup {
+	return &v1alpha1.Backup{ObjectMeta: metav1.ObjectMeta{Namespace: "c1", Name: "b1", ResourceVersion: resourceVersion}}
+}
+
+func newTestEvent(name, resourceVersion string, lastSeen time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:    metav1.ObjectMeta{Namespace: "c1", Name: name, ResourceVersion: resourceVersion},
+		LastTimestamp: metav1.NewTime(lastSeen),
+	}
+}
+
+func TestObjectWatcher(t *testing.T) {
+	now := time.Now()
+	h := newWatchHarness(func(context.Context) (runtime.Object, error) {
+		return newTestBackup("5"), nil
+	}, *newTestEvent("e-new", "3", now), *newTestEvent("e-old", "2", now.Add(-time.Minute)))
+	done := h.start(context.Background(), "")
+
+	// the current status and the recent events in the order of time
+	h.expectSent(t, "status b1 5.7", "event e-old 5.7", "event e-new 5.7")
+	objWatch := receiveWatch(t, h.objects, "5")
+	eventWatch := receiveWatch(t, h.events, "7")
+
+	objWatch.Modify(newTestBackup("6"))
+	h.expectSent(t, "status b1 6.7")
+	eventWatch.Add(newTestEvent("e1", "8", now))
+	h.expectSent(t, "event e1 6.8")
+	// the expired events are not sent, but the position moves on
+	eventWatch.Delete(newTestEvent("e-old", "9", now))
+
+	// the watches closed by Kubernetes are restarted from the last position
+	objWatch.Stop()
+	objWatch = receiveWatch(t, h.objects, "6")
+	receiveWatch(t, h.events, "9")
+	if !eventWatch.IsStopped() {
+		t.Fatal("expected the previous event watch to be stopped")
+	}
+
+	objWatch.Delete(newTestBackup("10"))
+	h.expectSent(t, "deleted  10.9")
+	if err := receiveResult(t, done); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestObjectWatcherResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h := newWatchHarness(func(context.Context) (runtime.Object, error) {
+		return nil, errors.New("the object should not be got when resuming")
+	})
+	done := h.start(ctx, "5.7")
+
+	objWatch := receiveWatch(t, h.objects, "5")
+	eventWatch := receiveWatch(t, h.events, "7")
+	objWatch.Modify(newTestBackup("6"))
+	h.expectSent(t, "status b1 6.7")
+
+	// the client disconnects
+	cancel()
+	if err := receiveResult(t, done); err != nil {
+		t.Fatal(err)
+	}
+	if !objWatch.IsStopped() || !eventWatch.IsStopped() {
+		t.Fatal("expected the watches to be stopped after the client disconnects")
+	}
+	if len(h.sent) != 0 {
+		t.Fatalf("expected nothing else to be sent, got %q", <-h.sent)
+	}
+}
+
+func TestObjectWatcherErrors(t *testing.T) {
+	gr := schema.GroupResource{Group: v1alpha1.GroupName, Resource: "backups"}
+	found := func(context.Context) (runtime.Object, error) { return newTestBackup("5"), nil }
+
+	t.Run("invalid resource version", func(t *testing.T) {
+		for _, rv := range []string{"5", "5.", ".7"} {
+			h := newWatchHarness(found)
+			if err := h.w.run(context.Background(), rv); status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("expected %v for %q, got %v", codes.InvalidArgument, rv, err)
+			}
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		h := newWatchHarness(func(context.Context) (runtime.Object, error) {
+			return nil, apierrors.NewNotFound(gr, "b1")
+		})
+		if err := h.w.run(context.Background(), ""); status.Code(err) != codes.NotFound {
+			t.Fatalf("expected %v, got %v", codes.NotFound, err)
+		}
+	})
+
+	t.Run("resource version too old", func(t *testing.T) {
+		h := newWatchHarness(found)
+		done := h.start(context.Background(), "1.1")
+		objWatch := receiveWatch(t, h.objects, "1")
+		receiveWatch(t, h.events, "1")
+		objWatch.Error(&apierrors.NewResourceExpired("too old resource version").ErrStatus)
+		if err := receiveResult(t, done); status.Code(err) != codes.OutOfRange {
+			t.Fatalf("expected %v, got %v", codes.OutOfRange, err)
+		}
+	})
+}
+
+func TestWatchResourceVersion(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(HeaderKeyLastEventID, "5.7"))
+	if rv := watchResourceVersion(ctx, "6.8"); rv != "6.8" {
+		t.Fatalf("expected the resource version of the request, got %q", rv)
+	}
+	if rv := watchResourceVersion(ctx, ""); rv != "5.7" {
+		t.Fatalf("expected the last event ID, got %q", rv)
+	}
+	if rv := watchResourceVersion(context.Background(), ""); rv != "" {
+		t.Fatalf("expected no resource version, got %q", rv)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// mimeEventStream is the `Accept` header of the clients which want the streaming responses as server-sent events
+const mimeEventStream = "text/event-stream"
+
+// sseMarshaler writes every chunk of the streaming responses as a server-sent event,
+// the resource version of the response is used as the event ID so that the clients can resume the watch
+// by the `Last-Event-ID` header after reconnecting.
+type sseMarshaler struct {
+	runtime.JSONPb
+}
+
+func newSSEMarshaler() *sseMarshaler {
+	// same as the default marshaler of the gateway
+	return &sseMarshaler{
+		JSONPb: runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		},
+	}
+}
+
+func (m *sseMarshaler) ContentType(_ interface{}) string {
+	return mimeEventStream
+}
+
+func (m *sseMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	switch chunk := v.(type) {
+	case map[string]interface{}:
+		if resp, ok := chunk["result"].(interface{ GetResourceVersion() string }); ok && resp.GetResourceVersion() != "" {
+			buf.WriteString("id: ")
+			buf.WriteString(resp.GetResourceVersion())
+			buf.WriteByte('\n')
+		}
+	case map[string]proto.Message:
+		// the error of the stream
+		buf.WriteString("event: error\n")
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// Delimiter ends every event with an empty line
+func (m *sseMarshaler) Delimiter() []byte {
+	return []byte("\n")
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pingcap/tidb-operator/http-service/pbgen/api"
+)
+
+func TestSSEMarshaler(t *testing.T) {
+	m := newSSEMarshaler()
+	if ct := m.ContentType(nil); ct != mimeEventStream {
+		t.Fatalf("expected content type %q, got %q", mimeEventStream, ct)
+	}
+
+	tests := []struct {
+		name       string
+		chunk      interface{}
+		indent     string
+		wantPrefix string
+		wantLines  int
+	}{
+		{
+			name:       "resource version as the event ID",
+			chunk:      map[string]interface{}{"result": &api.WatchBackupResp{Type: "status", ResourceVersion: "5.7"}},
+			wantPrefix: "id: 5.7\ndata: {",
+			wantLines:  2,
+		},
+		{
+			name:       "no resource version",
+			chunk:      map[string]interface{}{"result": &api.WatchBackupResp{Type: "status"}},
+			wantPrefix: "data: {",
+			wantLines:  1,
+		},
+		{
+			name:       "multiple lines",
+			chunk:      map[string]interface{}{"result": &api.WatchBackupResp{Type: "deleted", ResourceVersion: "5.7"}},
+			indent:     "  ",
+			wantPrefix: "id: 5.7\ndata: {\ndata:   ",
+		},
+		{
+			name:       "error",
+			chunk:      map[string]proto.Message{"error": &api.WatchBackupResp{Type: "status"}},
+			wantPrefix: "event: error\ndata: {",
+			wantLines:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newSSEMarshaler()
+			m.MarshalOptions.Indent = tt.indent
+			data, err := m.Marshal(tt.chunk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			event := string(data) + string(m.Delimiter())
+			if !strings.HasPrefix(event, tt.wantPrefix) {
+				t.Fatalf("expected the event to start with %q, got %q", tt.wantPrefix, event)
+			}
+			// every event ends with an empty line and every line of the data is prefixed
+			if !strings.HasSuffix(event, "}\n\n") || strings.Count(event, "\n\n") != 1 {
+				t.Fatalf("expected the event to end with an empty line, got %q", event)
+			}
+			lines := strings.Split(strings.TrimSuffix(event, "\n\n"), "\n")
+			for _, line := range lines {
+				if !strings.HasPrefix(line, "data: ") && !strings.HasPrefix(line, "id: ") && !strings.HasPrefix(line, "event: ") {
+					t.Fatalf("unexpected line %q", line)
+				}
+			}
+			if tt.wantLines > 0 && len(lines) != tt.wantLines {
+				t.Fatalf("expected %d lines, got %q", tt.wantLines, event)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+func newBackupCommand(o *Options) *cobra.Command {
+	var file string
+	var follow bool
+
+	cmd := &cobra.Command{
+		Use:   "backup [NAME]",
+		Short: "Create a Backup and follow its progress",
+		Long: `Create a Backup from a file and follow its progress, or follow an existing Backup by its name.
+A log backup is followed until it's started.`,
+		Example: "  kubectl tidb backup -f backup.yaml\n  kubectl tidb backup demo-backup",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, err := nameOrFile(args, file)
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			if file != "" {
+				backup := &v1alpha1.Backup{}
+				if err := decodeFile(file, backup); err != nil {
+					return err
+				}
+				if backup, err = o.cli.PingcapV1alpha1().Backups(o.namespace).Create(ctx, backup, metav1.CreateOptions{}); err != nil {
+					return err
+				}
+				name = backup.Name
+				fmt.Fprintf(o.Out, "backup %s is created\n", name)
+			}
+			if !follow {
+				return nil
+			}
+			return o.followBackup(ctx, name)
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "The file containing the Backup to create")
+	cmd.Flags().BoolVar(&follow, "follow", true, "Follow the progress until the backup is complete or failed")
+	return cmd
+}
+
+func newRestoreCommand(o *Options) *cobra.Command {
+	var file string
+	var follow bool
+
+	cmd := &cobra.Command{
+		Use:     "restore [NAME]",
+		Short:   "Create a Restore and follow its progress",
+		Long:    "Create a Restore from a file and follow its progress, or follow an existing Restore by its name.",
+		Example: "  kubectl tidb restore -f restore.yaml\n  kubectl tidb restore demo-restore",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, err := nameOrFile(args, file)
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			if file != "" {
+				restore := &v1alpha1.Restore{}
+				if err := decodeFile(file, restore); err != nil {
+					return err
+				}
+				if restore, err = o.cli.PingcapV1alpha1().Restores(o.namespace).Create(ctx, restore, metav1.CreateOptions{}); err != nil {
+					return err
+				}
+				name = restore.Name
+				fmt.Fprintf(o.Out, "restore %s is created\n", name)
+			}
+			if !follow {
+				return nil
+			}
+			return o.followRestore(ctx, name)
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "The file containing the Restore to create")
+	cmd.Flags().BoolVar(&follow, "follow", true, "Follow the progress until the restore is complete or failed")
+	return cmd
+}
+
+func nameOrFile(args []string, file string) (string, error) {
+	if len(args) == 0 && file == "" {
+		return "", fmt.Errorf("either NAME or --filename must be specified")
+	}
+	if len(args) > 0 && file != "" {
+		return "", fmt.Errorf("NAME and --filename can't be specified at the same time")
+	}
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	return "", nil
+}
+
+func decodeFile(file string, obj interface{}) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return yaml.NewYAMLOrJSONDecoder(f, 4096).Decode(obj)
+}
+
+func (o *Options) followBackup(ctx context.Context, name string) error {
+	p := &progressPrinter{out: o.Out}
+	return wait.PollUntilContextCancel(ctx, pollInterval, true, func(ctx context.Context) (bool, error) {
+		backup, err := o.cli.PingcapV1alpha1().Backups(o.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		p.print(string(backup.Status.Phase), backup.Status.Progresses)
+
+		switch {
+		case v1alpha1.IsBackupComplete(backup):
+			fmt.Fprintf(o.Out, "backup %s is complete, path: %s, size: %s, commitTs: %s\n",
+				name, backup.Status.BackupPath, backup.Status.BackupSizeReadable, backup.Status.CommitTs)
+			return true, nil
+		case v1alpha1.IsBackupFailed(backup):
+			_, cond := v1alpha1.GetBackupCondition(&backup.Status, v1alpha1.BackupFailed)
+			if cond != nil {
+				return false, fmt.Errorf("backup %s failed, %s: %s", name, cond.Reason, cond.Message)
+			}
+			return false, fmt.Errorf("backup %s failed", name)
+		case backup.Spec.Mode == v1alpha1.BackupModeLog && backup.Status.Phase == v1alpha1.BackupRunning:
+			fmt.Fprintf(o.Out, "log backup %s is running, checkpoint: %s\n", name, backup.Status.LogCheckpointTs)
+			return true, nil
+		}
+		return false, nil
+	})
+}
+
+func (o *Options) followRestore(ctx context.Context, name string) error {
+	p := &progressPrinter{out: o.Out}
+	return wait.PollUntilContextCancel(ctx, pollInterval, true, func(ctx context.Context) (bool, error) {
+		restore, err := o.cli.PingcapV1alpha1().Restores(o.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		p.print(string(restore.Status.Phase), restore.Status.Progresses)
+
+		switch {
+		case v1alpha1.IsRestoreComplete(restore):
+			fmt.Fprintf(o.Out, "restore %s is complete, commitTs: %s, time taken: %s\n", name, restore.Status.CommitTs, restore.Status.TimeTaken)
+			return true, nil
+		case v1alpha1.IsRestoreFailed(restore):
+			_, cond := v1alpha1.GetRestoreCondition(&restore.Status, v1alpha1.RestoreFailed)
+			if cond != nil {
+				return false, fmt.Errorf("restore %s failed, %s: %s", name, cond.Reason, cond.Message)
+			}
+			return false, fmt.Errorf("restore %s failed", name)
+		}
+		return false, nil
+	})
+}
+
+// progressPrinter prints the phase and the progresses of the steps when they change
+type progressPrinter struct {
+	out        io.Writer
+	phase      string
+	progresses map[string]float64
+}
+
+func (p *progressPrinter) print(phase string, progresses []v1alpha1.Progress) {
+	if phase != "" && phase != p.phase {
+		p.phase = phase
+		fmt.Fprintf(p.out, "phase: %s\n", phase)
+	}
+	if p.progresses == nil {
+		p.progresses = map[string]float64{}
+	}
+	for _, progress := range progresses {
+		if last, ok := p.progresses[progress.Step]; ok && last == progress.Progress {
+			continue
+		}
+		p.progresses[progress.Step] = progress.Progress
+		fmt.Fprintf(p.out, "%s: %.1f%%\n", progress.Step, progress.Progress)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// pollInterval is the interval to check the progress of the operations
+const pollInterval = 2 * time.Second
+
+// Options are the options and clients shared by the subcommands
+type Options struct {
+	genericiooptions.IOStreams
+
+	configFlags *genericclioptions.ConfigFlags
+
+	namespace  string
+	restConfig *rest.Config
+	kubeCli    kubernetes.Interface
+	cli        versioned.Interface
+}
+
+// NewTidbCommand returns the root command of the kubectl plugin
+func NewTidbCommand(streams genericiooptions.IOStreams) *cobra.Command {
+	o := &Options{
+		IOStreams:   streams,
+		configFlags: genericclioptions.NewConfigFlags(true),
+	}
+
+	cmds := &cobra.Command{
+		Use:          "kubectl tidb",
+		Short:        "Day-2 operations of the TiDB clusters managed by tidb-operator",
+		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return o.complete()
+		},
+	}
+	o.configFlags.AddFlags(cmds.PersistentFlags())
+
+	cmds.AddCommand(newTopologyCommand(o))
+	cmds.AddCommand(newRestartCommand(o))
+	cmds.AddCommand(newBackupCommand(o))
+	cmds.AddCommand(newRestoreCommand(o))
+	cmds.AddCommand(newCtlCommand(o))
+	cmds.AddCommand(newUpgradeStatusCommand(o))
+	return cmds
+}
+
+func (o *Options) complete() error {
+	var err error
+	if o.namespace, _, err = o.configFlags.ToRawKubeConfigLoader().Namespace(); err != nil {
+		return err
+	}
+	if o.restConfig, err = o.configFlags.ToRESTConfig(); err != nil {
+		return err
+	}
+	if o.kubeCli, err = kubernetes.NewForConfig(o.restConfig); err != nil {
+		return err
+	}
+	o.cli, err = versioned.NewForConfig(o.restConfig)
+	return err
+}
+
+func (o *Options) getTidbCluster(ctx context.Context, name string) (*v1alpha1.TidbCluster, error) {
+	return o.cli.PingcapV1alpha1().TidbClusters(o.namespace).Get(ctx, name, metav1.GetOptions{})
+}
@@ -0,0 +1,241 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/kubectl/pkg/util/term"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	ctlContainerName = "ctl"
+	// ctlPodLifetime is the max lifetime of the ctl pod in case it's not deleted after the session
+	ctlPodLifetime = int64(4 * 3600)
+	// the file names of the certificates in the cluster client TLS secret
+	tlsCAFile   = "ca.crt"
+	tlsCertFile = "tls.crt"
+	tlsKeyFile  = "tls.key"
+)
+
+func newCtlCommand(o *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ctl",
+		Short: "Open pd-ctl or tikv-ctl sessions against a TidbCluster",
+		Long: `Open pd-ctl or tikv-ctl sessions in a temporary pod running the image of the cluster,
+the cluster client TLS secret is mounted and used if TLS is enabled between the components.`,
+	}
+	cmd.AddCommand(newPDCtlCommand(o))
+	cmd.AddCommand(newTiKVCtlCommand(o))
+	return cmd
+}
+
+func newPDCtlCommand(o *Options) *cobra.Command {
+	var image string
+	cmd := &cobra.Command{
+		Use:   "pd CLUSTER [-- PD_CTL_ARGS]",
+		Short: "Run pd-ctl, it's interactive if no arguments are given",
+		Example: "  kubectl tidb ctl pd basic\n" +
+			"  kubectl tidb ctl pd basic -- store",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			tc, err := o.getTidbCluster(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			if tc.Spec.PD == nil {
+				return fmt.Errorf("PD is not deployed in TidbCluster %s", tc.Name)
+			}
+			if image == "" {
+				image = tc.PDImage()
+			}
+
+			url := fmt.Sprintf("%s://%s.%s:%d", tc.Scheme(), controller.PDMemberName(tc.Name), tc.Namespace, v1alpha1.DefaultPDClientPort)
+			command := []string{"/pd-ctl", "-u", url}
+			if tc.IsTLSClusterEnabled() {
+				command = append(command,
+					"--cacert", path.Join(util.ClusterClientTLSPath, tlsCAFile),
+					"--cert", path.Join(util.ClusterClientTLSPath, tlsCertFile),
+					"--key", path.Join(util.ClusterClientTLSPath, tlsKeyFile))
+			}
+			if len(args) == 1 {
+				command = append(command, "-i")
+			} else {
+				command = append(command, args[1:]...)
+			}
+			return o.runCtl(ctx, tc, image, command)
+		},
+	}
+	cmd.Flags().StringVar(&image, "image", "", "The image containing /pd-ctl, defaults to the image of PD")
+	return cmd
+}
+
+func newTiKVCtlCommand(o *Options) *cobra.Command {
+	var image string
+	cmd := &cobra.Command{
+		Use:     "tikv CLUSTER POD -- TIKV_CTL_ARGS",
+		Short:   "Run tikv-ctl against a TiKV pod",
+		Example: "  kubectl tidb ctl tikv basic basic-tikv-0 -- metrics",
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			tc, err := o.getTidbCluster(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			if tc.Spec.TiKV == nil {
+				return fmt.Errorf("TiKV is not deployed in TidbCluster %s", tc.Name)
+			}
+			if image == "" {
+				image = tc.TiKVImage()
+			}
+
+			host := fmt.Sprintf("%s.%s.%s:%d", args[1], controller.TiKVPeerMemberName(tc.Name), tc.Namespace, v1alpha1.DefaultTiKVServerPort)
+			command := []string{"/tikv-ctl", "--host", host}
+			if tc.IsTLSClusterEnabled() {
+				command = append(command,
+					"--ca-path", path.Join(util.ClusterClientTLSPath, tlsCAFile),
+					"--cert-path", path.Join(util.ClusterClientTLSPath, tlsCertFile),
+					"--key-path", path.Join(util.ClusterClientTLSPath, tlsKeyFile))
+			}
+			command = append(command, args[2:]...)
+			return o.runCtl(ctx, tc, image, command)
+		},
+	}
+	cmd.Flags().StringVar(&image, "image", "", "The image containing /tikv-ctl, defaults to the image of TiKV")
+	return cmd
+}
+
+// runCtl runs the command in a temporary pod which is deleted after the command exits
+func (o *Options) runCtl(ctx context.Context, tc *v1alpha1.TidbCluster, image string, command []string) error {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-ctl-", tc.Name),
+			Namespace:    tc.Namespace,
+			Labels: map[string]string{
+				label.NameLabelKey:     "kubectl-tidb",
+				label.InstanceLabelKey: tc.Name,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: pointer.Int64(ctlPodLifetime),
+			ImagePullSecrets:      tc.Spec.ImagePullSecrets,
+			Containers: []corev1.Container{
+				{
+					Name:    ctlContainerName,
+					Image:   image,
+					Command: []string{"sh", "-c", fmt.Sprintf("sleep %d", ctlPodLifetime)},
+				},
+			},
+		},
+	}
+	if tc.IsTLSClusterEnabled() {
+		pod.Spec.Volumes = []corev1.Volume{{
+			Name: util.ClusterClientVolName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: util.ClusterClientTLSSecretName(tc.Name)},
+			},
+		}}
+		pod.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{
+			Name: util.ClusterClientVolName, ReadOnly: true, MountPath: util.ClusterClientTLSPath,
+		}}
+	}
+
+	pod, err := o.kubeCli.CoreV1().Pods(tc.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// the context may have been canceled
+		if err := o.kubeCli.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{}); err != nil {
+			fmt.Fprintf(o.ErrOut, "failed to delete pod %s/%s: %v\n", pod.Namespace, pod.Name, err)
+		}
+	}()
+
+	err = wait.PollUntilContextTimeout(ctx, time.Second, 5*time.Minute, true, func(ctx context.Context) (bool, error) {
+		p, err := o.kubeCli.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch p.Status.Phase {
+		case corev1.PodRunning:
+			return true, nil
+		case corev1.PodFailed, corev1.PodSucceeded:
+			return false, fmt.Errorf("pod %s/%s exited unexpectedly: %s", p.Namespace, p.Name, p.Status.Message)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to wait for pod %s/%s to run: %v", pod.Namespace, pod.Name, err)
+	}
+	return o.exec(ctx, pod, command)
+}
+
+// exec runs the command in the pod with the standard streams attached, a TTY is allocated if the stdin is a terminal
+func (o *Options) exec(ctx context.Context, pod *corev1.Pod, command []string) error {
+	t := term.TTY{In: o.In, Out: o.Out, Raw: true}
+	tty := t.IsTerminalIn()
+
+	req := o.kubeCli.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: ctlContainerName,
+			Command:   command,
+			Stdin:     true,
+			Stdout:    true,
+			Stderr:    !tty,
+			TTY:       tty,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(o.restConfig, http.MethodPost, req.URL())
+	if err != nil {
+		return err
+	}
+
+	var sizeQueue remotecommand.TerminalSizeQueue
+	if tty {
+		sizeQueue = t.MonitorSize(t.GetSize())
+	}
+	return t.Safe(func() error {
+		opts := remotecommand.StreamOptions{
+			Stdin:             o.In,
+			Stdout:            o.Out,
+			Tty:               tty,
+			TerminalSizeQueue: sizeQueue,
+		}
+		if !tty {
+			opts.Stderr = o.ErrOut
+		}
+		return executor.StreamWithContext(ctx, opts)
+	})
+}
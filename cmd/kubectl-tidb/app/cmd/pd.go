@@ -0,0 +1,113 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/crypto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// newPDClient returns a PD client connected through a port forwarded to a ready PD pod, the PD services are
+// usually not reachable from where kubectl runs. Call stop to close the port forwarding.
+func (o *Options) newPDClient(ctx context.Context, tc *v1alpha1.TidbCluster) (pdClient pdapi.PDClient, stop func(), err error) {
+	pods, err := o.kubeCli.CoreV1().Pods(tc.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: label.New().Instance(tc.Name).PD().String(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var pdPod *corev1.Pod
+	for i := range pods.Items {
+		if k8s.IsPodReady(&pods.Items[i]) {
+			pdPod = &pods.Items[i]
+			break
+		}
+	}
+	if pdPod == nil {
+		return nil, nil, fmt.Errorf("no PD pod of TidbCluster %s/%s is ready", tc.Namespace, tc.Name)
+	}
+
+	var tlsConfig *tls.Config
+	if tc.IsTLSClusterEnabled() {
+		secretName := util.ClusterClientTLSSecretName(tc.Name)
+		secret, err := o.kubeCli.CoreV1().Secrets(tc.Namespace).Get(ctx, secretName, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to load certificates from secret %s/%s: %v", tc.Namespace, secretName, err)
+		}
+		if tlsConfig, err = crypto.LoadTlsConfigFromSecret(secret); err != nil {
+			return nil, nil, err
+		}
+		// the address is 127.0.0.1, verify the certificate by the name of the PD service
+		tlsConfig.ServerName = fmt.Sprintf("%s.%s.svc", controller.PDMemberName(tc.Name), tc.Namespace)
+	}
+
+	localPort, stop, err := o.portForward(pdPod, int(v1alpha1.DefaultPDClientPort))
+	if err != nil {
+		return nil, nil, err
+	}
+	url := fmt.Sprintf("%s://127.0.0.1:%d", tc.Scheme(), localPort)
+	return pdapi.NewPDClient(url, pdapi.DefaultTimeout, tlsConfig), stop, nil
+}
+
+// portForward forwards a random local port to the remote port of the pod, returns the local port
+func (o *Options) portForward(pod *corev1.Pod, remotePort int) (int, func(), error) {
+	transport, upgrader, err := spdy.RoundTripperFor(o.restConfig)
+	if err != nil {
+		return 0, nil, err
+	}
+	req := o.kubeCli.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("portforward")
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+
+	stopCh := make(chan struct{})
+	readyCh := make(chan struct{})
+	fw, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, []string{fmt.Sprintf("0:%d", remotePort)}, stopCh, readyCh, io.Discard, o.ErrOut)
+	if err != nil {
+		return 0, nil, err
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- fw.ForwardPorts()
+	}()
+	select {
+	case <-readyCh:
+	case err := <-errCh:
+		return 0, nil, fmt.Errorf("failed to forward port %d of pod %s/%s: %v", remotePort, pod.Namespace, pod.Name, err)
+	}
+
+	ports, err := fw.GetPorts()
+	if err != nil {
+		close(stopCh)
+		return 0, nil, err
+	}
+	return int(ports[0].Local), func() { close(stopCh) }, nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// gracefulRestartAnnotations are the annotations that make tidb-operator delete the pods after the leaders on
+// them are evicted or transferred, or after they are shutdown gracefully
+var gracefulRestartAnnotations = map[string]struct{ key, value string }{
+	label.TiKVLabelVal: {v1alpha1.EvictLeaderAnnKey, v1alpha1.EvictLeaderValueDeletePod},
+	label.PDLabelVal:   {v1alpha1.PDLeaderTransferAnnKey, v1alpha1.TransferLeaderValueDeletePod},
+	label.TiDBLabelVal: {v1alpha1.TiDBGracefulShutdownAnnKey, v1alpha1.TiDBPodDeletionDeletePod},
+}
+
+func newRestartCommand(o *Options) *cobra.Command {
+	var force bool
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "restart CLUSTER POD",
+		Short: "Restart a pod of a TidbCluster gracefully",
+		Long: `Restart a pod of a TidbCluster and wait until it's recreated and ready.
+
+The pod is annotated to be deleted by tidb-operator after:
+  - TiKV: the region leaders on it are evicted
+  - PD: the PD leader is transferred if it's the leader
+  - TiDB: it's shutdown gracefully
+A TiKV pod is not restarted if there are regions missing or with down replicas.
+The pods of the TiDB groups, TiKV pools and TiFlash compute nodes of the TidbCluster can be restarted too.
+Pods of the other components are deleted directly with --force.`,
+		Example: `  kubectl tidb restart basic basic-tikv-1 -n tidb-cluster
+  # restart a pod of the TiKV pool "hot"
+  kubectl tidb restart basic basic-hot-tikv-0 -n tidb-cluster`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+			defer cancel()
+			return o.restart(ctx, args[0], args[1], force)
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Restart the pod even if it can't be restarted gracefully or the regions are unhealthy")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "The time to wait for the pod to be recreated and ready")
+	return cmd
+}
+
+func (o *Options) restart(ctx context.Context, tcName, podName string, force bool) error {
+	tc, err := o.getTidbCluster(ctx, tcName)
+	if err != nil {
+		return err
+	}
+	pod, err := o.kubeCli.CoreV1().Pods(o.namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	pool, err := podPool(tc, pod)
+	if err != nil {
+		return err
+	}
+	component := pod.Labels[label.ComponentLabelKey]
+
+	ann, graceful := gracefulRestartAnnotations[component]
+	if !graceful && !force {
+		return fmt.Errorf("pods of %s can't be restarted gracefully, use --force to delete pod %s directly", component, podName)
+	}
+	if component == label.TiKVLabelVal && !force {
+		if err := o.checkRegionHealth(ctx, tc); err != nil {
+			return err
+		}
+	}
+
+	if graceful {
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{ann.key: ann.value},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := o.kubeCli.CoreV1().Pods(o.namespace).Patch(ctx, podName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "pod %s is annotated with %s=%s, waiting for tidb-operator to restart it\n", podName, ann.key, ann.value)
+	} else {
+		if err := o.kubeCli.CoreV1().Pods(o.namespace).Delete(ctx, podName, metav1.DeleteOptions{}); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "pod %s is deleted\n", podName)
+	}
+
+	return o.waitForRestart(ctx, tcName, podName, pool, pod.UID, component)
+}
+
+// podPool checks that the pod belongs to the TidbCluster, or to one of its TiDB groups, TiKV pools or TiFlash
+// compute nodes whose pods are labeled with the instance `<cluster>-<name>`. It returns the name of the TiKV
+// pool if the pod is in one.
+func podPool(tc *v1alpha1.TidbCluster, pod *corev1.Pod) (string, error) {
+	instance := pod.Labels[label.InstanceLabelKey]
+	if instance == tc.GetInstanceName() {
+		return "", nil
+	}
+	for _, key := range []string{label.TiDBGroupLabelKey, label.TiKVPoolLabelKey, label.TiFlashRoleLabelKey} {
+		name := pod.Labels[key]
+		if name == "" || instance != fmt.Sprintf("%s-%s", tc.GetInstanceName(), name) {
+			continue
+		}
+		if key == label.TiKVPoolLabelKey {
+			return name, nil
+		}
+		return "", nil
+	}
+	return "", fmt.Errorf("pod %s doesn't belong to TidbCluster %s", pod.Name, tc.Name)
+}
+
+// tikvStores returns the TiKV stores of the TidbCluster, or of its TiKV pool if the pool is not empty
+func tikvStores(tc *v1alpha1.TidbCluster, pool string) map[string]v1alpha1.TiKVStore {
+	if pool == "" {
+		return tc.Status.TiKV.Stores
+	}
+	if status := tc.Status.TiKVPools[pool]; status != nil {
+		return status.Stores
+	}
+	return nil
+}
+
+// checkRegionHealth refuses to restart a TiKV pod if there are regions whose replicas are missing or down, the
+// restart may make them unavailable
+func (o *Options) checkRegionHealth(ctx context.Context, tc *v1alpha1.TidbCluster) error {
+	pdClient, stop, err := o.newPDClient(ctx, tc)
+	if err != nil {
+		return fmt.Errorf("failed to check the health of the regions: %v, use --force to skip the check", err)
+	}
+	defer stop()
+
+	for _, check := range []pdapi.RegionCheckType{pdapi.RegionCheckMissPeer, pdapi.RegionCheckDownPeer} {
+		count, err := pdClient.GetRegionCountByCheck(check)
+		if err != nil {
+			return fmt.Errorf("failed to get the %s regions: %v, use --force to skip the check", check, err)
+		}
+		if count > 0 {
+			return fmt.Errorf("%d regions are %s, restarting a TiKV pod may make them unavailable, use --force to restart anyway", count, check)
+		}
+	}
+	return nil
+}
+
+// waitForRestart waits until the pod is recreated and ready, the leader count of the store is printed while
+// the leaders are being evicted
+func (o *Options) waitForRestart(ctx context.Context, tcName, podName, pool string, oldUID types.UID, component string) error {
+	lastLeaderCount := int32(-1)
+	recreated := false
+	return wait.PollUntilContextCancel(ctx, pollInterval, true, func(ctx context.Context) (bool, error) {
+		pod, err := o.kubeCli.CoreV1().Pods(o.namespace).Get(ctx, podName, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+		if err == nil && pod.UID != oldUID {
+			if !recreated {
+				recreated = true
+				fmt.Fprintf(o.Out, "pod %s is recreated\n", podName)
+			}
+			if k8s.IsPodReady(pod) {
+				fmt.Fprintf(o.Out, "pod %s is ready\n", podName)
+				return true, nil
+			}
+			return false, nil
+		}
+
+		if component == label.TiKVLabelVal && err == nil {
+			tc, err := o.getTidbCluster(ctx, tcName)
+			if err != nil {
+				return false, err
+			}
+			for _, store := range tikvStores(tc, pool) {
+				if store.PodName == podName && store.LeaderCount != lastLeaderCount {
+					lastLeaderCount = store.LeaderCount
+					fmt.Fprintf(o.Out, "evicting leaders of store %s, %d leaders left\n", store.ID, store.LeaderCount)
+				}
+			}
+		}
+		return false, nil
+	})
+}
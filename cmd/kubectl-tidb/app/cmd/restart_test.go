@@ -0,0 +1,92 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodPool(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := &v1alpha1.TidbCluster{ObjectMeta: metav1.ObjectMeta{Name: "basic"}}
+	tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{"1": {ID: "1", PodName: "basic-tikv-0"}}
+	tc.Status.TiKVPools = map[string]*v1alpha1.TiKVStatus{
+		"hot": {Stores: map[string]v1alpha1.TiKVStore{"2": {ID: "2", PodName: "basic-hot-tikv-0"}}},
+	}
+
+	tests := []struct {
+		name      string
+		labels    map[string]string
+		expectErr bool
+		pool      string
+		stores    []string
+	}{
+		{
+			name:   "cluster",
+			labels: map[string]string{label.InstanceLabelKey: "basic"},
+			stores: []string{"1"},
+		},
+		{
+			name:   "tikv pool",
+			labels: map[string]string{label.InstanceLabelKey: "basic-hot", label.TiKVPoolLabelKey: "hot"},
+			pool:   "hot",
+			stores: []string{"2"},
+		},
+		{
+			name:   "tidb group",
+			labels: map[string]string{label.InstanceLabelKey: "basic-olap", label.TiDBGroupLabelKey: "olap"},
+			stores: []string{"1"},
+		},
+		{
+			name:   "tiflash compute",
+			labels: map[string]string{label.InstanceLabelKey: "basic-compute", label.TiFlashRoleLabelKey: label.TiFlashComputeRoleLabelVal},
+			stores: []string{"1"},
+		},
+		{
+			name:      "another cluster",
+			labels:    map[string]string{label.InstanceLabelKey: "other"},
+			expectErr: true,
+		},
+		{
+			name:      "pool of another cluster",
+			labels:    map[string]string{label.InstanceLabelKey: "other-hot", label.TiKVPoolLabelKey: "hot"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Labels: tt.labels}}
+			pool, err := podPool(tc, pod)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(pool).To(Equal(tt.pool))
+			var stores []string
+			for id := range tikvStores(tc, pool) {
+				stores = append(stores, id)
+			}
+			g.Expect(stores).To(Equal(tt.stores))
+		})
+	}
+}
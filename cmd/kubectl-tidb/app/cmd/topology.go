@@ -0,0 +1,189 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+// topologyRow is a row of the topology table
+type topologyRow struct {
+	component string
+	name      string
+	id        string
+	address   string
+	state     string
+	leaders   string
+	message   string
+}
+
+func newTopologyCommand(o *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:     "topology CLUSTER",
+		Aliases: []string{"topo"},
+		Short:   "Show the members, stores, leaders and failures of a TidbCluster",
+		Example: "  kubectl tidb topology basic -n tidb-cluster",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tc, err := o.getTidbCluster(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return printTopology(o.Out, tc)
+		},
+	}
+}
+
+func printTopology(out io.Writer, tc *v1alpha1.TidbCluster) error {
+	ready := "Unknown"
+	for _, cond := range tc.Status.Conditions {
+		if cond.Type == v1alpha1.TidbClusterReady {
+			ready = fmt.Sprintf("%s, %s", cond.Status, cond.Message)
+		}
+	}
+	fmt.Fprintf(out, "TidbCluster %s/%s, Ready: %s\n\n", tc.Namespace, tc.Name, ready)
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "COMPONENT\tNAME\tID\tADDRESS\tSTATE\tLEADERS\tMESSAGE")
+	for _, r := range topologyRows(tc) {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.component, r.name, r.id, r.address, r.state, r.leaders, r.message)
+	}
+	return w.Flush()
+}
+
+// topologyRows returns the rows of the members and stores recorded in the status of the TidbCluster
+func topologyRows(tc *v1alpha1.TidbCluster) []topologyRow {
+	var rows []topologyRow
+
+	pd := tc.Status.PD
+	for _, name := range sortedKeys(pd.Members) {
+		m := pd.Members[name]
+		r := topologyRow{component: "pd", name: m.Name, id: m.ID, address: m.ClientURL, state: healthState(m.Health), leaders: "-"}
+		if m.Name == pd.Leader.Name {
+			r.message = "leader"
+		}
+		rows = append(rows, r)
+	}
+	for _, name := range sortedKeys(pd.PeerMembers) {
+		m := pd.PeerMembers[name]
+		rows = append(rows, topologyRow{component: "pd", name: m.Name, id: m.ID, address: m.ClientURL, state: healthState(m.Health), leaders: "-", message: "peer member"})
+	}
+	for _, name := range sortedKeys(pd.FailureMembers) {
+		m := pd.FailureMembers[name]
+		rows = append(rows, topologyRow{component: "pd", name: m.PodName, id: m.MemberID, address: "-", state: "Failed", leaders: "-",
+			message: failureMessage(m.CreatedAt.String(), m.HostDown, m.MemberDeleted, "member")})
+	}
+	for _, name := range sortedKeys(pd.UnjoinedMembers) {
+		m := pd.UnjoinedMembers[name]
+		rows = append(rows, topologyRow{component: "pd", name: m.PodName, id: "-", address: "-", state: "Unjoined", leaders: "-",
+			message: fmt.Sprintf("not joined since %s", m.CreatedAt)})
+	}
+
+	rows = append(rows, storeRows("tikv", tc.Status.TiKV.Stores, tc.Status.TiKV.PeerStores, tc.Status.TiKV.TombstoneStores,
+		tc.Status.TiKV.FailureStores, tc.Status.TiKV.EvictLeader)...)
+	rows = append(rows, storeRows("tiflash", tc.Status.TiFlash.Stores, tc.Status.TiFlash.PeerStores, tc.Status.TiFlash.TombstoneStores,
+		tc.Status.TiFlash.FailureStores, nil)...)
+
+	tidb := tc.Status.TiDB
+	for _, name := range sortedKeys(tidb.Members) {
+		m := tidb.Members[name]
+		r := topologyRow{component: "tidb", name: m.Name, id: "-", address: "-", state: healthState(m.Health), leaders: "-"}
+		if m.NodeName != "" {
+			r.message = fmt.Sprintf("node %s", m.NodeName)
+		}
+		rows = append(rows, r)
+	}
+	for _, name := range sortedKeys(tidb.FailureMembers) {
+		m := tidb.FailureMembers[name]
+		rows = append(rows, topologyRow{component: "tidb", name: m.PodName, id: "-", address: "-", state: "Failed", leaders: "-",
+			message: failureMessage(m.CreatedAt.String(), false, false, "")})
+	}
+	return rows
+}
+
+func storeRows(component string, stores, peerStores, tombstoneStores map[string]v1alpha1.TiKVStore,
+	failureStores map[string]v1alpha1.TiKVFailureStore, evictLeader map[string]*v1alpha1.EvictLeaderStatus) []topologyRow {
+	var rows []topologyRow
+	add := func(stores map[string]v1alpha1.TiKVStore, message string) {
+		for _, id := range sortedStoreIDs(stores) {
+			s := stores[id]
+			r := topologyRow{component: component, name: s.PodName, id: s.ID, address: s.IP, state: s.State, message: message}
+			r.leaders = "-"
+			if component == "tikv" {
+				r.leaders = strconv.Itoa(int(s.LeaderCount))
+			}
+			if _, ok := evictLeader[s.PodName]; ok {
+				r.message = "evicting leaders"
+			}
+			rows = append(rows, r)
+		}
+	}
+	add(stores, "")
+	add(peerStores, "peer store")
+	add(tombstoneStores, "")
+	for _, name := range sortedKeys(failureStores) {
+		s := failureStores[name]
+		rows = append(rows, topologyRow{component: component, name: s.PodName, id: s.StoreID, address: "-", state: "Failed", leaders: "-",
+			message: failureMessage(s.CreatedAt.String(), s.HostDown, s.StoreDeleted, "store")})
+	}
+	return rows
+}
+
+func healthState(health bool) string {
+	if health {
+		return "Healthy"
+	}
+	return "Unhealthy"
+}
+
+func failureMessage(since string, hostDown, deleted bool, kind string) string {
+	msg := fmt.Sprintf("failover since %s", since)
+	if hostDown {
+		msg += ", host down"
+	}
+	if deleted {
+		msg += fmt.Sprintf(", %s deleted", kind)
+	}
+	return msg
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortedStoreIDs sorts the store IDs numerically
+func sortedStoreIDs(stores map[string]v1alpha1.TiKVStore) []string {
+	ids := sortedKeys(stores)
+	sort.SliceStable(ids, func(i, j int) bool {
+		a, errA := strconv.ParseUint(ids[i], 10, 64)
+		b, errB := strconv.ParseUint(ids[j], 10, 64)
+		if errA != nil || errB != nil {
+			return ids[i] < ids[j]
+		}
+		return a < b
+	})
+	return ids
+}
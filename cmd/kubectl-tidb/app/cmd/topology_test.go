@@ -0,0 +1,88 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTopologyRows(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := &v1alpha1.TidbCluster{}
+	tc.Status.PD.Leader = v1alpha1.PDMember{Name: "basic-pd-0"}
+	tc.Status.PD.Members = map[string]v1alpha1.PDMember{
+		"basic-pd-1": {Name: "basic-pd-1", ID: "2", Health: false},
+		"basic-pd-0": {Name: "basic-pd-0", ID: "1", Health: true},
+	}
+	tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{
+		"10": {ID: "10", PodName: "basic-tikv-1", State: v1alpha1.TiKVStateUp, LeaderCount: 3},
+		"9":  {ID: "9", PodName: "basic-tikv-0", State: v1alpha1.TiKVStateUp, LeaderCount: 5},
+	}
+	tc.Status.TiKV.EvictLeader = map[string]*v1alpha1.EvictLeaderStatus{"basic-tikv-1": {}}
+	tc.Status.TiKV.FailureStores = map[string]v1alpha1.TiKVFailureStore{
+		"11": {PodName: "basic-tikv-2", StoreID: "11", HostDown: true, CreatedAt: metav1.Now()},
+	}
+	tc.Status.TiFlash.Stores = map[string]v1alpha1.TiKVStore{
+		"20": {ID: "20", PodName: "basic-tiflash-0", State: v1alpha1.TiKVStateOffline, LeaderCount: 1},
+	}
+	tc.Status.TiDB.Members = map[string]v1alpha1.TiDBMember{
+		"basic-tidb-0": {Name: "basic-tidb-0", Health: true, NodeName: "node-1"},
+	}
+
+	rows := topologyRows(tc)
+	g.Expect(rows).To(HaveLen(7))
+
+	g.Expect(rows[0].name).To(Equal("basic-pd-0"))
+	g.Expect(rows[0].message).To(Equal("leader"))
+	g.Expect(rows[1].name).To(Equal("basic-pd-1"))
+	g.Expect(rows[1].state).To(Equal("Unhealthy"))
+
+	// the stores are sorted numerically
+	g.Expect(rows[2].id).To(Equal("9"))
+	g.Expect(rows[2].leaders).To(Equal("5"))
+	g.Expect(rows[3].id).To(Equal("10"))
+	g.Expect(rows[3].message).To(Equal("evicting leaders"))
+	g.Expect(rows[4].state).To(Equal("Failed"))
+	g.Expect(rows[4].message).To(ContainSubstring("host down"))
+
+	g.Expect(rows[5].component).To(Equal("tiflash"))
+	g.Expect(rows[5].leaders).To(Equal("-"))
+	g.Expect(rows[6].component).To(Equal("tidb"))
+	g.Expect(rows[6].message).To(Equal("node node-1"))
+}
+
+func TestPrintTopology(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := &v1alpha1.TidbCluster{}
+	tc.Namespace, tc.Name = "ns", "basic"
+	tc.Status.Conditions = []v1alpha1.TidbClusterCondition{
+		{Type: v1alpha1.TidbClusterReady, Status: "True", Message: "TiDB cluster is fully up and running"},
+	}
+	tc.Status.PD.Members = map[string]v1alpha1.PDMember{
+		"basic-pd-0": {Name: "basic-pd-0", ID: "1", ClientURL: "http://basic-pd-0.basic-pd-peer.ns.svc:2379", Health: true},
+	}
+
+	out := &bytes.Buffer{}
+	g.Expect(printTopology(out, tc)).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("TidbCluster ns/basic, Ready: True, TiDB cluster is fully up and running"))
+	g.Expect(out.String()).To(ContainSubstring("COMPONENT"))
+	g.Expect(out.String()).To(MatchRegexp(`pd\s+basic-pd-0\s+1\s+http://basic-pd-0\S+\s+Healthy`))
+}
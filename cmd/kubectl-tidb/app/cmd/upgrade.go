@@ -0,0 +1,242 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+	"github.com/spf13/cobra"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// componentUpgrade is the upgrade state of a component
+type componentUpgrade struct {
+	memberType   v1alpha1.MemberType
+	phase        v1alpha1.MemberPhase
+	currentImage string
+	desiredImage string
+	set          *apps.StatefulSet
+}
+
+func (c *componentUpgrade) pending() bool {
+	return c.phase == v1alpha1.UpgradePhase || (c.currentImage != "" && c.currentImage != c.desiredImage)
+}
+
+func newUpgradeStatusCommand(o *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:     "upgrade-status CLUSTER",
+		Short:   "Show the progress of the upgrade of a TidbCluster and why it's blocked",
+		Example: "  kubectl tidb upgrade-status basic -n tidb-cluster",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			tc, err := o.getTidbCluster(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			components, err := o.componentUpgrades(ctx, tc)
+			if err != nil {
+				return err
+			}
+			pods, err := o.kubeCli.CoreV1().Pods(tc.Namespace).List(ctx, metav1.ListOptions{
+				LabelSelector: label.New().Instance(tc.Name).String(),
+			})
+			if err != nil {
+				return err
+			}
+			return printUpgradeStatus(o.Out, components, upgradeBlockers(tc, components, pods.Items))
+		},
+	}
+}
+
+func (o *Options) componentUpgrades(ctx context.Context, tc *v1alpha1.TidbCluster) ([]*componentUpgrade, error) {
+	var components []*componentUpgrade
+	add := func(memberType v1alpha1.MemberType, phase v1alpha1.MemberPhase, currentImage, desiredImage, setName string) error {
+		c := &componentUpgrade{memberType: memberType, phase: phase, currentImage: currentImage, desiredImage: desiredImage}
+		set, err := o.kubeCli.AppsV1().StatefulSets(tc.Namespace).Get(ctx, setName, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if err == nil {
+			c.set = set
+		}
+		components = append(components, c)
+		return nil
+	}
+
+	// in the order the components are upgraded
+	if tc.Spec.PD != nil {
+		if err := add(v1alpha1.PDMemberType, tc.Status.PD.Phase, tc.Status.PD.Image, tc.PDImage(), controller.PDMemberName(tc.Name)); err != nil {
+			return nil, err
+		}
+	}
+	if tc.Spec.TiFlash != nil {
+		if err := add(v1alpha1.TiFlashMemberType, tc.Status.TiFlash.Phase, tc.Status.TiFlash.Image, tc.TiFlashImage(), controller.TiFlashMemberName(tc.Name)); err != nil {
+			return nil, err
+		}
+	}
+	if tc.Spec.TiKV != nil {
+		if err := add(v1alpha1.TiKVMemberType, tc.Status.TiKV.Phase, tc.Status.TiKV.Image, tc.TiKVImage(), controller.TiKVMemberName(tc.Name)); err != nil {
+			return nil, err
+		}
+	}
+	if tc.Spec.TiDB != nil {
+		if err := add(v1alpha1.TiDBMemberType, tc.Status.TiDB.Phase, tc.Status.TiDB.Image, tc.TiDBImage(), controller.TiDBMemberName(tc.Name)); err != nil {
+			return nil, err
+		}
+	}
+	return components, nil
+}
+
+func printUpgradeStatus(out io.Writer, components []*componentUpgrade, blockers []string) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "COMPONENT\tPHASE\tCURRENT IMAGE\tDESIRED IMAGE\tUPDATED")
+	for _, c := range components {
+		updated := "-"
+		if c.set != nil && c.set.Spec.Replicas != nil {
+			updated = fmt.Sprintf("%d/%d", c.set.Status.UpdatedReplicas, *c.set.Spec.Replicas)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", c.memberType, c.phase, c.currentImage, c.desiredImage, updated)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out)
+	if len(blockers) == 0 {
+		fmt.Fprintln(out, "Nothing blocks the upgrade.")
+		return nil
+	}
+	fmt.Fprintln(out, "The upgrade is blocked or slowed down by:")
+	for _, b := range blockers {
+		fmt.Fprintf(out, "  - %s\n", b)
+	}
+	return nil
+}
+
+// upgradeBlockers returns why the pending upgrades can't go on, following the checks of the upgraders of
+// tidb-operator: the order of the components, the health of the members and stores, and the eviction of the
+// leaders.
+func upgradeBlockers(tc *v1alpha1.TidbCluster, components []*componentUpgrade, pods []corev1.Pod) []string {
+	var blockers []string
+	if tc.Spec.Paused {
+		blockers = append(blockers, "the TidbCluster is paused, set spec.paused to false to continue")
+	}
+
+	busy := func(phase v1alpha1.MemberPhase) bool {
+		return phase == v1alpha1.UpgradePhase || phase == v1alpha1.ScalePhase
+	}
+	waitFor := func(c *componentUpgrade, memberType v1alpha1.MemberType, phase v1alpha1.MemberPhase) {
+		if busy(phase) {
+			blockers = append(blockers, fmt.Sprintf("%s: waiting for %s, whose phase is %s", c.memberType, memberType, phase))
+		}
+	}
+
+	status := tc.Status
+	for _, c := range components {
+		if !c.pending() {
+			continue
+		}
+		if c.phase == v1alpha1.ScalePhase {
+			blockers = append(blockers, fmt.Sprintf("%s: it's scaling", c.memberType))
+		}
+		switch c.memberType {
+		case v1alpha1.PDMemberType:
+			if !status.PD.Synced {
+				blockers = append(blockers, "pd: the status of PD is not synced, PD may be unavailable")
+			}
+			for _, name := range sortedKeys(status.PD.Members) {
+				if !status.PD.Members[name].Health {
+					blockers = append(blockers, fmt.Sprintf("pd: member %s is unhealthy", name))
+				}
+			}
+		case v1alpha1.TiFlashMemberType:
+			waitFor(c, v1alpha1.PDMemberType, status.PD.Phase)
+			blockers = append(blockers, storeBlockers(c.memberType, status.TiFlash.Stores, status.TiFlash.Conditions)...)
+		case v1alpha1.TiKVMemberType:
+			waitFor(c, v1alpha1.PDMemberType, status.PD.Phase)
+			waitFor(c, v1alpha1.TiFlashMemberType, status.TiFlash.Phase)
+			blockers = append(blockers, storeBlockers(c.memberType, status.TiKV.Stores, status.TiKV.Conditions)...)
+			for _, podName := range sortedKeys(status.TiKV.EvictLeader) {
+				for _, store := range status.TiKV.Stores {
+					if store.PodName == podName {
+						blockers = append(blockers, fmt.Sprintf("tikv: evicting the leaders of pod %s, %d leaders left", podName, store.LeaderCount))
+					}
+				}
+			}
+		case v1alpha1.TiDBMemberType:
+			waitFor(c, v1alpha1.PDMemberType, status.PD.Phase)
+			waitFor(c, v1alpha1.TiKVMemberType, status.TiKV.Phase)
+			waitFor(c, v1alpha1.TiFlashMemberType, status.TiFlash.Phase)
+			waitFor(c, v1alpha1.PumpMemberType, status.Pump.Phase)
+			for _, name := range sortedKeys(status.TiDB.Members) {
+				if !status.TiDB.Members[name].Health {
+					blockers = append(blockers, fmt.Sprintf("tidb: member %s is unhealthy", name))
+				}
+			}
+		}
+
+		// the upgraders wait for each upgraded pod to be ready before upgrading the next one
+		for i := range pods {
+			pod := &pods[i]
+			if pod.Labels[label.ComponentLabelKey] == c.memberType.String() && !k8s.IsPodReady(pod) {
+				blockers = append(blockers, fmt.Sprintf("%s: pod %s is not ready", c.memberType, pod.Name))
+			}
+		}
+	}
+
+	for _, op := range status.Operations {
+		if op.Result == v1alpha1.OperationRunning {
+			blockers = append(blockers, fmt.Sprintf("%s: operation %s of %s is running since %s", op.Component, op.Type, operationTarget(op), op.StartTime))
+		}
+	}
+	return blockers
+}
+
+func storeBlockers(memberType v1alpha1.MemberType, stores map[string]v1alpha1.TiKVStore, conditions []metav1.Condition) []string {
+	var blockers []string
+	if cond := meta.FindStatusCondition(conditions, v1alpha1.ConditionTypeUpgradeBlocked); cond != nil && cond.Status == metav1.ConditionTrue {
+		blockers = append(blockers, fmt.Sprintf("%s: %s", memberType, cond.Message))
+	}
+	for _, id := range sortedStoreIDs(stores) {
+		store := stores[id]
+		if store.State != v1alpha1.TiKVStateUp {
+			blockers = append(blockers, fmt.Sprintf("%s: store %s of pod %s is %s", memberType, id, store.PodName, store.State))
+		}
+	}
+	return blockers
+}
+
+func operationTarget(op v1alpha1.TidbClusterOperation) string {
+	switch {
+	case op.PodName != "":
+		return "pod " + op.PodName
+	case op.StoreID != "":
+		return "store " + op.StoreID
+	case op.Target != "":
+		return op.Target
+	}
+	return "the cluster"
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpgradeBlockers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	newTC := func() *v1alpha1.TidbCluster {
+		tc := &v1alpha1.TidbCluster{}
+		tc.Status.PD.Synced = true
+		tc.Status.PD.Phase = v1alpha1.NormalPhase
+		tc.Status.TiKV.Phase = v1alpha1.NormalPhase
+		tc.Status.TiDB.Phase = v1alpha1.NormalPhase
+		return tc
+	}
+	tikv := func(phase v1alpha1.MemberPhase, current, desired string) *componentUpgrade {
+		return &componentUpgrade{memberType: v1alpha1.TiKVMemberType, phase: phase, currentImage: current, desiredImage: desired}
+	}
+	tidb := func(phase v1alpha1.MemberPhase, current, desired string) *componentUpgrade {
+		return &componentUpgrade{memberType: v1alpha1.TiDBMemberType, phase: phase, currentImage: current, desiredImage: desired}
+	}
+
+	type testcase struct {
+		name       string
+		update     func(tc *v1alpha1.TidbCluster)
+		components []*componentUpgrade
+		pods       []corev1.Pod
+		expect     []string
+	}
+	tests := []testcase{
+		{
+			name:       "no upgrade",
+			components: []*componentUpgrade{tikv(v1alpha1.NormalPhase, "tikv:v8.1.0", "tikv:v8.1.0")},
+			pods: []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{
+				Name: "basic-tikv-0", Labels: map[string]string{label.ComponentLabelKey: label.TiKVLabelVal}}}},
+		},
+		{
+			name:       "paused",
+			update:     func(tc *v1alpha1.TidbCluster) { tc.Spec.Paused = true },
+			components: []*componentUpgrade{tikv(v1alpha1.NormalPhase, "tikv:v8.1.0", "tikv:v8.1.0")},
+			expect:     []string{"the TidbCluster is paused, set spec.paused to false to continue"},
+		},
+		{
+			name: "tidb waits for tikv",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.TiKV.Phase = v1alpha1.UpgradePhase
+			},
+			components: []*componentUpgrade{
+				tikv(v1alpha1.UpgradePhase, "tikv:v8.1.0", "tikv:v8.5.0"),
+				tidb(v1alpha1.NormalPhase, "tidb:v8.1.0", "tidb:v8.5.0"),
+			},
+			expect: []string{"tidb: waiting for tikv, whose phase is Upgrade"},
+		},
+		{
+			name: "tikv stores, evicting leaders and upgrade blocked",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{
+					"1": {ID: "1", PodName: "basic-tikv-0", State: v1alpha1.TiKVStateUp, LeaderCount: 12},
+					"2": {ID: "2", PodName: "basic-tikv-1", State: v1alpha1.TiKVStateDown},
+				}
+				tc.Status.TiKV.EvictLeader = map[string]*v1alpha1.EvictLeaderStatus{"basic-tikv-0": {}}
+				tc.Status.TiKV.Conditions = []metav1.Condition{
+					{Type: v1alpha1.ConditionTypeUpgradeBlocked, Status: metav1.ConditionTrue, Message: "store 2 is down"},
+				}
+			},
+			components: []*componentUpgrade{tikv(v1alpha1.UpgradePhase, "tikv:v8.1.0", "tikv:v8.5.0")},
+			pods: []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{
+				Name: "basic-tikv-1", Labels: map[string]string{label.ComponentLabelKey: label.TiKVLabelVal}}}},
+			expect: []string{
+				"tikv: store 2 is down",
+				"tikv: store 2 of pod basic-tikv-1 is Down",
+				"tikv: evicting the leaders of pod basic-tikv-0, 12 leaders left",
+				"tikv: pod basic-tikv-1 is not ready",
+			},
+		},
+		{
+			name: "running operations",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.Operations = []v1alpha1.TidbClusterOperation{
+					{Type: v1alpha1.OperationEvictLeader, Component: v1alpha1.TiKVMemberType, StoreID: "1", Result: v1alpha1.OperationRunning},
+					{Type: v1alpha1.OperationEvictLeader, Component: v1alpha1.TiKVMemberType, StoreID: "2", Result: v1alpha1.OperationSucceeded},
+				}
+			},
+			components: []*componentUpgrade{tikv(v1alpha1.NormalPhase, "tikv:v8.1.0", "tikv:v8.1.0")},
+			expect:     []string{"tikv: operation EvictLeader of store 1 is running since " + metav1.Time{}.String()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Log(test.name)
+		tc := newTC()
+		if test.update != nil {
+			test.update(tc)
+		}
+		g.Expect(upgradeBlockers(tc, test.components, test.pods)).To(Equal(test.expect), test.name)
+	}
+}
+
+func TestPrintUpgradeStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	components := []*componentUpgrade{
+		{memberType: v1alpha1.PDMemberType, phase: v1alpha1.NormalPhase, currentImage: "pd:v8.5.0", desiredImage: "pd:v8.5.0"},
+	}
+	out := &bytes.Buffer{}
+	g.Expect(printUpgradeStatus(out, components, nil)).To(Succeed())
+	g.Expect(out.String()).To(MatchRegexp(`pd\s+Normal\s+pd:v8.5.0\s+pd:v8.5.0\s+-`))
+	g.Expect(out.String()).To(ContainSubstring("Nothing blocks the upgrade."))
+
+	out.Reset()
+	g.Expect(printUpgradeStatus(out, components, []string{"the TidbCluster is paused"})).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("  - the TidbCluster is paused\n"))
+}
@@ -0,0 +1,29 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"github.com/pingcap/tidb-operator/cmd/kubectl-tidb/app/cmd"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+)
+
+// kubectl-tidb is a kubectl plugin, kubectl runs it as `kubectl tidb` if it's found in the PATH
+func main() {
+	command := cmd.NewTidbCommand(genericiooptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr})
+	if err := command.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
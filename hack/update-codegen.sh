@@ -24,7 +24,8 @@ source hack/lib.sh
 hack::ensure_codegen
 
 # `--output-base $ROOT` will output generated code to current dir
-GOBIN=$OUTPUT_BIN bash $ROOT/hack/generate-groups.sh "deepcopy,client,informer,lister" \
+# TidbClusterDiagnostics is both the singular and the plural form
+GOBIN=$OUTPUT_BIN PLURAL_EXCEPTIONS="TidbClusterDiagnostics:TidbClusterDiagnostics" bash $ROOT/hack/generate-groups.sh "deepcopy,client,informer,lister" \
     github.com/pingcap/tidb-operator/pkg/client \
     github.com/pingcap/tidb-operator/pkg/apis \
     pingcap:v1alpha1 \
@@ -46,6 +46,12 @@ const (
 	StoreIDLabelKey string = "tidb.pingcap.com/store-id"
 	// MemberIDLabelKey is member id label key
 	MemberIDLabelKey string = "tidb.pingcap.com/member-id"
+	// TiDBGroupLabelKey is the label key of the name of the TiDB group
+	TiDBGroupLabelKey string = "tidb.pingcap.com/tidb-group"
+	// TiKVPoolLabelKey is the label key of the name of the TiKV pool
+	TiKVPoolLabelKey string = "tidb.pingcap.com/tikv-pool"
+	// TiFlashRoleLabelKey is the label key of the role of the disaggregated TiFlash nodes
+	TiFlashRoleLabelKey string = "tidb.pingcap.com/tiflash-role"
 
 	// InitLabelKey is the key for TiDB initializer
 	InitLabelKey string = "tidb.pingcap.com/initializer"
@@ -143,6 +149,16 @@ const (
 	// AnnDMWorkerDeleteSlots is annotation key of dm-worker delete slots.
 	AnnDMWorkerDeleteSlots = "dm-worker.tidb.pingcap.com/delete-slots"
 
+	// AnnDrainInstance is the annotation key in a pod of an instance managed without StatefulSet,
+	// the instance is deleted first when the replicas are decreased.
+	AnnDrainInstance = "tidb.pingcap.com/drain-instance"
+	// AnnReplaceInstance is the annotation key in a pod of an instance managed without StatefulSet,
+	// a new instance is created and the instance is deleted after the store of the new instance is up.
+	AnnReplaceInstance = "tidb.pingcap.com/replace-instance"
+	// AnnMoveInstance is the annotation key in a pod of an instance managed without StatefulSet,
+	// the instance is replaced by a new instance which is not scheduled to the node of the pod.
+	AnnMoveInstance = "tidb.pingcap.com/move-instance"
+
 	// AnnSkipTLSWhenConnectTiDB describes whether skip TLS when connecting to TiDB Server
 	AnnSkipTLSWhenConnectTiDB = "tidb.tidb.pingcap.com/skip-tls-when-connect-tidb"
 
@@ -163,6 +179,20 @@ const (
 	// Listed from store status, but has a store id in label. This is an alternate way to detect tombstone stores.
 	AnnTiKVNoActiveStoreSince = "tidb.pingcap.com/tikv-no-active-store-since"
 
+	// AnnPDRecovery is the annotation key of TidbCluster to recover PD from the loss of quorum. The value is an ID of
+	// the recovery chosen by users, a new recovery is started when the value differs from the ID in the status.
+	// The recovery is failed without touching PD if the quorum of PD is not lost.
+	AnnPDRecovery = "tidb.pingcap.com/pd-recovery"
+	// AnnPDRecoveryAllocID is the annotation key of TidbCluster to override the alloc ID passed to pd-recover, which
+	// is derived from the IDs found in TiKV and TiFlash by default. The value must be larger than all the IDs allocated by the
+	// lost PD, e.g. the largest ID in the `idAllocator allocates a new id` logs of PD.
+	AnnPDRecoveryAllocID = "tidb.pingcap.com/pd-recovery-alloc-id"
+
+	// AnnTiKVUnsafeRecovery is the annotation key of TidbCluster to remove the failed TiKV stores by the online unsafe
+	// recovery of PD. The value is an ID of the recovery chosen by users, a new recovery is started when the value
+	// differs from the ID in the status. The failed stores of `spec.tikv` and all the TiKV pools are removed at once.
+	AnnTiKVUnsafeRecovery = "tidb.pingcap.com/tikv-unsafe-recovery"
+
 	// PDLabelVal is PD label value
 	PDLabelVal string = "pd"
 	// PDMSTSOLabelVal is pd microservice tso member type
@@ -175,6 +205,8 @@ const (
 	TiKVLabelVal string = "tikv"
 	// TiFlashLabelVal is TiFlash label value
 	TiFlashLabelVal string = "tiflash"
+	// TiFlashComputeRoleLabelVal is the label value of the role of the TiFlash compute nodes
+	TiFlashComputeRoleLabelVal string = "compute"
 	// TiCDCLabelVal is TiCDC label value
 	TiCDCLabelVal string = "ticdc"
 	// TiProxyLabelVal is TiProxy label value
@@ -199,6 +231,8 @@ const (
 	BackupJobLabelVal string = "backup"
 	// BackupScheduleJobLabelVal is backup schedule job label value
 	BackupScheduleJobLabelVal string = "backup-schedule"
+	// DiagnosticsJobLabelVal is diagnostics job label value
+	DiagnosticsJobLabelVal string = "diagnostics"
 	// InitJobLabelVal is TiDB initializer job label value
 	InitJobLabelVal string = "initializer"
 	// TiDBOperator is ManagedByLabelKey label value
@@ -294,6 +328,15 @@ func NewBackupSchedule() Label {
 	}
 }
 
+// NewDiagnostics initialize a new Label for Jobs of TidbClusterDiagnostics
+func NewDiagnostics() Label {
+	return Label{
+		NameLabelKey:      "tidb-diagnostics",
+		ComponentLabelKey: DiagnosticsJobLabelVal,
+		ManagedByLabelKey: TiDBOperator,
+	}
+}
+
 func NewMonitor() Label {
 	return Label{
 		// NameLabelKey is used to be compatible with helm monitor
@@ -595,6 +638,17 @@ func (l Label) String() string {
 	return strings.Join(arr, ",")
 }
 
+// ManagedByValues returns all the values of ManagedByLabelKey set by tidb-operator, including the ones of
+// the jobs of backup and restore
+func ManagedByValues() []string {
+	return []string{
+		TiDBOperator,
+		NewBackup()[ManagedByLabelKey],
+		NewRestore()[ManagedByLabelKey],
+		NewBackupSchedule()[ManagedByLabelKey],
+	}
+}
+
 // IsManagedByTiDBOperator returns whether label is a Managed by tidb-operator
 func (l Label) IsManagedByTiDBOperator() bool {
 	return l[ManagedByLabelKey] == TiDBOperator
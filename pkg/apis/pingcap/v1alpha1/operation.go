@@ -0,0 +1,147 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const defaultOperationHistoryLimit = 100
+
+// GetOperationHistoryLimit returns the max number of the operations recorded in the status
+func (tc *TidbCluster) GetOperationHistoryLimit() int {
+	if tc.Spec.OperationHistoryLimit == nil {
+		return defaultOperationHistoryLimit
+	}
+	return int(*tc.Spec.OperationHistoryLimit)
+}
+
+// RecordOperation records an operation which has finished, it succeeded if err is nil
+func (tc *TidbCluster) RecordOperation(op TidbClusterOperation, err error) {
+	op.Group = tc.operationGroup()
+	now := metav1.Now()
+	op.StartTime = now
+	op.EndTime = &now
+	op.Result = OperationSucceeded
+	if err != nil {
+		op.Result = OperationFailed
+		op.Message = err.Error()
+	}
+	tc.appendOperation(op)
+}
+
+// StartOperation records an operation which is running, nothing is done if the same operation is running
+func (tc *TidbCluster) StartOperation(op TidbClusterOperation) {
+	op.Group = tc.operationGroup()
+	if tc.runningOperation(op) != nil {
+		return
+	}
+	op.StartTime = metav1.Now()
+	op.EndTime = nil
+	op.Result = OperationRunning
+	tc.appendOperation(op)
+}
+
+// FinishOperation finishes the running operation of the same type, component, pod and store as op, it
+// succeeded if err is nil. Nothing is done if the operation is not running.
+func (tc *TidbCluster) FinishOperation(op TidbClusterOperation, err error) {
+	op.Group = tc.operationGroup()
+	running := tc.runningOperation(op)
+	if running == nil {
+		return
+	}
+	now := metav1.Now()
+	running.EndTime = &now
+	running.Result = OperationSucceeded
+	if err != nil {
+		running.Result = OperationFailed
+		running.Message = err.Error()
+	} else if op.Message != "" {
+		running.Message = op.Message
+	}
+}
+
+func (tc *TidbCluster) runningOperation(op TidbClusterOperation) *TidbClusterOperation {
+	for i := len(tc.Status.Operations) - 1; i >= 0; i-- {
+		o := &tc.Status.Operations[i]
+		if o.Result == OperationRunning && o.Type == op.Type && o.Component == op.Component && o.Group == op.Group &&
+			o.PodName == op.PodName && o.StoreID == op.StoreID && o.Target == op.Target {
+			return o
+		}
+	}
+	return nil
+}
+
+// MergeOperations merges the operations recorded by the in-memory cluster of a TiDB group, TiKV pool or the
+// TiFlash compute nodes, which is returned by TiDBGroupCluster, TiKVPoolCluster or TiFlashComputeCluster, into
+// the cluster. The operations of the group which are updated are replaced and the new ones are appended.
+func (tc *TidbCluster) MergeOperations(stc *TidbCluster) {
+	group := stc.operationGroup()
+	for _, op := range stc.Status.Operations {
+		if op.Group != group {
+			continue
+		}
+		if o := tc.operation(op); o != nil {
+			*o = op
+			continue
+		}
+		tc.appendOperation(op)
+	}
+}
+
+// operation returns the operation which is started at the same time as op on the same object
+func (tc *TidbCluster) operation(op TidbClusterOperation) *TidbClusterOperation {
+	for i := range tc.Status.Operations {
+		o := &tc.Status.Operations[i]
+		if o.Type == op.Type && o.Component == op.Component && o.Group == op.Group && o.PodName == op.PodName &&
+			o.StoreID == op.StoreID && o.Target == op.Target && o.StartTime.Equal(&op.StartTime) {
+			return o
+		}
+	}
+	return nil
+}
+
+// operationGroup returns the name of the TiDB group, TiKV pool or TiFlash compute nodes synced by the cluster
+func (tc *TidbCluster) operationGroup() string {
+	if tc.subClusterKey == "" {
+		return ""
+	}
+	return tc.Labels[tc.subClusterKey]
+}
+
+// appendOperation appends the operation and removes the oldest finished operations beyond the limit.
+// The running operations are never removed, so they may exceed the limit until they are finished.
+func (tc *TidbCluster) appendOperation(op TidbClusterOperation) {
+	limit := tc.GetOperationHistoryLimit()
+	if limit <= 0 {
+		tc.Status.Operations = nil
+		return
+	}
+	ops := append(tc.Status.Operations, op)
+	for len(ops) > limit {
+		// never remove the operation just appended
+		removed := -1
+		for i := 0; i < len(ops)-1; i++ {
+			if ops[i].Result != OperationRunning {
+				removed = i
+				break
+			}
+		}
+		if removed < 0 {
+			break
+		}
+		ops = append(ops[:removed], ops[removed+1:]...)
+	}
+	tc.Status.Operations = ops
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+)
+
+func TestTidbClusterOperations(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := &TidbCluster{}
+	evict := TidbClusterOperation{Type: OperationEvictLeader, Component: TiKVMemberType, StoreID: "1", PodName: "tikv-0"}
+
+	tc.StartOperation(evict)
+	tc.StartOperation(evict)
+	g.Expect(tc.Status.Operations).To(HaveLen(1))
+	g.Expect(tc.Status.Operations[0].Result).To(Equal(OperationRunning))
+	g.Expect(tc.Status.Operations[0].EndTime).To(BeNil())
+
+	tc.FinishOperation(evict, errors.New("timeout"))
+	g.Expect(tc.Status.Operations[0].Result).To(Equal(OperationFailed))
+	g.Expect(tc.Status.Operations[0].Message).To(Equal("timeout"))
+	g.Expect(tc.Status.Operations[0].EndTime).NotTo(BeNil())
+
+	// finishing an operation not running does nothing
+	tc.FinishOperation(evict, nil)
+	g.Expect(tc.Status.Operations[0].Result).To(Equal(OperationFailed))
+
+	tc.RecordOperation(TidbClusterOperation{Type: OperationRestartPod, Component: TiKVMemberType, PodName: "tikv-1"}, nil)
+	g.Expect(tc.Status.Operations).To(HaveLen(2))
+	g.Expect(tc.Status.Operations[1].Result).To(Equal(OperationSucceeded))
+
+	// the finished operations are removed, the running ones are kept beyond the limit
+	tc.Spec.OperationHistoryLimit = pointer.Int32Ptr(2)
+	tc.StartOperation(TidbClusterOperation{Type: OperationDeleteStore, Component: TiKVMemberType, StoreID: "2"})
+	tc.StartOperation(TidbClusterOperation{Type: OperationDeleteStore, Component: TiKVMemberType, StoreID: "3"})
+	tc.RecordOperation(TidbClusterOperation{Type: OperationRestartPod, Component: TiKVMemberType, PodName: "tikv-2"}, nil)
+	g.Expect(tc.Status.Operations).To(HaveLen(3))
+	g.Expect(tc.Status.Operations[0].StoreID).To(Equal("2"))
+	g.Expect(tc.Status.Operations[1].StoreID).To(Equal("3"))
+	g.Expect(tc.Status.Operations[2].PodName).To(Equal("tikv-2"))
+
+	// the oldest finished operation is removed once a running one finishes
+	tc.FinishOperation(TidbClusterOperation{Type: OperationDeleteStore, Component: TiKVMemberType, StoreID: "3"}, nil)
+	tc.RecordOperation(TidbClusterOperation{Type: OperationRestartPod, Component: TiKVMemberType, PodName: "tikv-3"}, nil)
+	g.Expect(tc.Status.Operations).To(HaveLen(2))
+	g.Expect(tc.Status.Operations[0].StoreID).To(Equal("2"))
+	g.Expect(tc.Status.Operations[0].Result).To(Equal(OperationRunning))
+	g.Expect(tc.Status.Operations[1].PodName).To(Equal("tikv-3"))
+
+	tc.Spec.OperationHistoryLimit = pointer.Int32Ptr(0)
+	tc.RecordOperation(evict, nil)
+	g.Expect(tc.Status.Operations).To(BeEmpty())
+}
+
+func TestTidbClusterMergeOperations(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := &TidbCluster{}
+	tc.Name = "test"
+	restart := TidbClusterOperation{Type: OperationRestartPod, Component: TiKVMemberType, PodName: "test-tikv-0"}
+	tc.RecordOperation(restart, nil)
+
+	// the operations of the pool are tagged with the pool
+	pool := &TiKVPoolSpec{Name: "ssd"}
+	ptc := tc.TiKVPoolCluster(pool)
+	evict := TidbClusterOperation{Type: OperationEvictLeader, Component: TiKVMemberType, StoreID: "2", PodName: "test-ssd-tikv-0"}
+	ptc.StartOperation(evict)
+	g.Expect(ptc.Status.Operations).To(HaveLen(2))
+	g.Expect(ptc.Status.Operations[1].Group).To(Equal("ssd"))
+	tc.MergeOperations(ptc)
+	g.Expect(tc.Status.Operations).To(HaveLen(2))
+	g.Expect(tc.Status.Operations[0].Group).To(BeEmpty())
+	g.Expect(tc.Status.Operations[1].Group).To(Equal("ssd"))
+	g.Expect(tc.Status.Operations[1].Result).To(Equal(OperationRunning))
+
+	// the operation of the cluster is not taken as the one of the pool
+	tc.StartOperation(evict)
+	g.Expect(tc.Status.Operations).To(HaveLen(3))
+
+	// the operation finished in the pool is updated instead of appended again
+	ptc = tc.TiKVPoolCluster(pool)
+	ptc.FinishOperation(evict, nil)
+	tc.MergeOperations(ptc)
+	g.Expect(tc.Status.Operations).To(HaveLen(3))
+	g.Expect(tc.Status.Operations[1].Group).To(Equal("ssd"))
+	g.Expect(tc.Status.Operations[1].Result).To(Equal(OperationSucceeded))
+	g.Expect(tc.Status.Operations[2].Group).To(BeEmpty())
+	g.Expect(tc.Status.Operations[2].Result).To(Equal(OperationRunning))
+}
@@ -51,6 +51,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&TidbCluster{},
 		&TidbClusterList{},
+		&TidbClusterDiagnostics{},
+		&TidbClusterDiagnosticsList{},
 		&Backup{},
 		&BackupList{},
 		&CompactBackup{},
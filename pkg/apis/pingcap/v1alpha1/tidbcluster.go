@@ -41,12 +41,21 @@ const (
 	// defaultEvictLeaderTimeout is the timeout limit of evict leader
 	defaultEvictLeaderTimeout            = 1500 * time.Minute
 	defaultWaitLeaderTransferBackTimeout = 400 * time.Second
+	defaultWaitRegionHealthyTimeout      = 10 * time.Minute
+	defaultImagePrePullTimeout           = 10 * time.Minute
+	defaultUpgradeMaxParallelism         = 3
 	RetryEvictLeaderInterval             = 10 * time.Minute
 	// defaultTiCDCGracefulShutdownTimeout is the timeout limit of graceful
 	// shutdown a TiCDC pod.
 	defaultTiCDCGracefulShutdownTimeout = 10 * time.Minute
 	defaultPDStartTimeout               = 30
 	defaultPDInitWaitTime               = 0
+	// defaults of rebalancing data to the new stores after scaling out
+	defaultScaleOutRebalanceStoreLimit          = 200
+	defaultScaleOutRebalanceRegionScheduleLimit = 64
+	defaultScaleOutRebalanceLeaderScheduleLimit = 16
+	defaultScaleOutRebalanceTolerancePercent    = 10
+	defaultScaleOutRebalanceTimeout             = 2 * time.Hour
 
 	// the latest version
 	versionLatest = "latest"
@@ -200,6 +209,13 @@ func (tc *TidbCluster) TiKVWaitLeaderTransferBackTimeout() time.Duration {
 	return defaultWaitLeaderTransferBackTimeout
 }
 
+func (tc *TidbCluster) TiKVWaitRegionHealthyTimeout() time.Duration {
+	if tc.Spec.TiKV != nil && tc.Spec.TiKV.WaitRegionHealthyTimeout != nil {
+		return tc.Spec.TiKV.WaitRegionHealthyTimeout.Duration
+	}
+	return defaultWaitRegionHealthyTimeout
+}
+
 // TiFlashImage return the image used by TiFlash.
 //
 // If TiFlash isn't specified, return empty string.
@@ -630,6 +646,10 @@ func (tc *TidbCluster) PDStsDesiredReplicas() int32 {
 	if tc.Spec.PD == nil {
 		return 0
 	}
+	// the replicas are pinned while recovering PD from the loss of quorum
+	if tc.Status.PD.Recovery != nil && tc.Status.PD.Recovery.Replicas != nil {
+		return *tc.Status.PD.Recovery.Replicas
+	}
 	var spareReplaceReplicas int32 = 0
 	if tc.Status.PD.VolReplaceInProgress {
 		spareReplaceReplicas = *tc.Spec.PD.SpareVolReplaceReplicas
@@ -835,7 +855,18 @@ func (tc *TidbCluster) TiProxyStsDesiredReplicas() int32 {
 		return 0
 	}
 
-	return tc.Spec.TiProxy.Replicas
+	return tc.Spec.TiProxy.Replicas + int32(len(tc.Status.TiProxy.FailureMembers))
+}
+
+func (tc *TidbCluster) TiProxyStsDesiredOrdinals(excludeFailover bool) sets.Int32 {
+	if tc.Spec.TiProxy == nil {
+		return sets.Int32{}
+	}
+	replicas := tc.Spec.TiProxy.Replicas
+	if !excludeFailover {
+		replicas = tc.TiProxyStsDesiredReplicas()
+	}
+	return GetPodOrdinalsFromReplicasAndDeleteSlots(replicas, tc.getDeleteSlots(label.TiProxyLabelVal))
 }
 
 func (tc *TidbCluster) TiProxyStsActualReplicas() int32 {
@@ -851,7 +882,42 @@ func (tc *TidbCluster) TiCDCDeployDesiredReplicas() int32 {
 		return 0
 	}
 
-	return tc.Spec.TiCDC.Replicas
+	return tc.Spec.TiCDC.Replicas + int32(len(tc.Status.TiCDC.FailureMembers))
+}
+
+func (tc *TidbCluster) TiCDCStsDesiredOrdinals(excludeFailover bool) sets.Int32 {
+	if tc.Spec.TiCDC == nil {
+		return sets.Int32{}
+	}
+	replicas := tc.Spec.TiCDC.Replicas
+	if !excludeFailover {
+		replicas = tc.TiCDCDeployDesiredReplicas()
+	}
+	return GetPodOrdinalsFromReplicasAndDeleteSlots(replicas, tc.getDeleteSlots(label.TiCDCLabelVal))
+}
+
+// TiProxyAllPodsStarted return whether all pods of TiProxy are started.
+//
+// If TiProxy isn't specified, return false.
+func (tc *TidbCluster) TiProxyAllPodsStarted() bool {
+	if tc.Spec.TiProxy == nil {
+		return false
+	}
+	return tc.TiProxyStsDesiredReplicas() == tc.TiProxyStsActualReplicas()
+}
+
+// TiCDCAllPodsStarted return whether all pods of TiCDC are started.
+//
+// If TiCDC isn't specified, return false.
+func (tc *TidbCluster) TiCDCAllPodsStarted() bool {
+	if tc.Spec.TiCDC == nil {
+		return false
+	}
+	stsStatus := tc.Status.TiCDC.StatefulSet
+	if stsStatus == nil {
+		return false
+	}
+	return tc.TiCDCDeployDesiredReplicas() == stsStatus.Replicas
 }
 
 // TiDBAllPodsStarted return whether all pods of TiDB are started.
@@ -1136,6 +1202,10 @@ func (tidb *TiDBSpec) GetScaleOutParallelism() int {
 	return int(*(tidb.ScalePolicy.ScaleOutParallelism))
 }
 
+func (tidb *TiDBSpec) ShouldHoldOnRunningDDL() bool {
+	return tidb.HoldOnRunningDDL != nil && *tidb.HoldOnRunningDDL
+}
+
 func (tikv *TiKVSpec) ShouldSeparateRocksDBLog() bool {
 	separateRocksDBLog := tikv.SeparateRocksDBLog
 	if separateRocksDBLog == nil {
@@ -1180,6 +1250,10 @@ func (tikv *TiKVSpec) GetScaleOutParallelism() int {
 	return int(*(tikv.ScalePolicy.ScaleOutParallelism))
 }
 
+func (tikv *TiKVSpec) GetScaleInStrategy() ScaleInStrategy {
+	return tikv.ScalePolicy.GetScaleInStrategy()
+}
+
 func (tiflash *TiFlashSpec) GetRecoverByUID() types.UID {
 	if tiflash.Failover == nil {
 		return ""
@@ -1201,6 +1275,96 @@ func (tiflash *TiFlashSpec) GetScaleOutParallelism() int {
 	return int(*(tiflash.ScalePolicy.ScaleOutParallelism))
 }
 
+func (tiflash *TiFlashSpec) GetScaleInStrategy() ScaleInStrategy {
+	return tiflash.ScalePolicy.GetScaleInStrategy()
+}
+
+// GetScaleInStrategy returns the scale in strategy, ScaleInStrategyOrdinal is returned if it is not set
+func (sp *ScalePolicy) GetScaleInStrategy() ScaleInStrategy {
+	if sp.ScaleInStrategy == "" {
+		return ScaleInStrategyOrdinal
+	}
+	return sp.ScaleInStrategy
+}
+
+// GetStrategy returns the upgrade strategy, it's safe to call on a nil policy
+func (p *UpgradePolicy) GetStrategy() UpgradeStrategy {
+	if p == nil || p.Strategy == "" {
+		return UpgradeStrategyOrdinal
+	}
+	return p.Strategy
+}
+
+// GetMaxParallelism returns the max number of pods upgraded together in a topology domain
+func (p *UpgradePolicy) GetMaxParallelism() int {
+	if p == nil || p.MaxParallelism <= 0 {
+		return defaultUpgradeMaxParallelism
+	}
+	return int(p.MaxParallelism)
+}
+
+// GetTimeout returns the timeout of pulling the new images before the upgrade
+func (p *ImagePrePullPolicy) GetTimeout() time.Duration {
+	if p == nil || p.Timeout == nil {
+		return defaultImagePrePullTimeout
+	}
+	return p.Timeout.Duration
+}
+
+// IsInstanceManaged returns whether the pods of TiKV are managed without StatefulSet
+func (tikv *TiKVSpec) IsInstanceManaged() bool {
+	return tikv != nil && tikv.ManagementMode == ManagementModeInstance
+}
+
+// IsInstanceManaged returns whether the pods of TiFlash are managed without StatefulSet
+func (tiflash *TiFlashSpec) IsInstanceManaged() bool {
+	return tiflash != nil && tiflash.ManagementMode == ManagementModeInstance
+}
+
+func (tikv *TiKVSpec) GetScaleOutRebalancePolicy() *ScaleOutRebalancePolicy {
+	return tikv.ScalePolicy.ScaleOutRebalance
+}
+
+// GetStoreLimit returns the add-peer store limit set to the new stores
+func (p *ScaleOutRebalancePolicy) GetStoreLimit() float64 {
+	if p.StoreLimit == nil {
+		return defaultScaleOutRebalanceStoreLimit
+	}
+	return float64(*p.StoreLimit)
+}
+
+// GetRegionScheduleLimit returns the region-schedule-limit set to PD
+func (p *ScaleOutRebalancePolicy) GetRegionScheduleLimit() uint64 {
+	if p.RegionScheduleLimit == nil {
+		return defaultScaleOutRebalanceRegionScheduleLimit
+	}
+	return uint64(*p.RegionScheduleLimit)
+}
+
+// GetLeaderScheduleLimit returns the leader-schedule-limit set to PD
+func (p *ScaleOutRebalancePolicy) GetLeaderScheduleLimit() uint64 {
+	if p.LeaderScheduleLimit == nil {
+		return defaultScaleOutRebalanceLeaderScheduleLimit
+	}
+	return uint64(*p.LeaderScheduleLimit)
+}
+
+// GetBalanceTolerancePercent returns the tolerance to regard the new stores as balanced
+func (p *ScaleOutRebalancePolicy) GetBalanceTolerancePercent() int32 {
+	if p.BalanceTolerancePercent == nil {
+		return defaultScaleOutRebalanceTolerancePercent
+	}
+	return *p.BalanceTolerancePercent
+}
+
+// GetTimeout returns the max duration to keep the raised limits
+func (p *ScaleOutRebalancePolicy) GetTimeout() time.Duration {
+	if p.Timeout == nil {
+		return defaultScaleOutRebalanceTimeout
+	}
+	return p.Timeout.Duration
+}
+
 func (tiflash *TiFlashSpec) DoesMountCMInTiflashContainer() bool {
 	return tiflash.Annotations[label.AnnTiflashMountCMInTiflashContainer] == "true"
 }
@@ -1251,6 +1415,159 @@ func (tc *TidbCluster) GetInstanceName() string {
 	return tc.Name
 }
 
+// TiDBGroupCluster returns the in-memory TidbCluster to sync the TiDB group. It joins the cluster in the
+// same way as a heterogeneous cluster named `<cluster>-<group>`, whose `spec.tidb` and `status.tidb` are
+// the spec and status of the group.
+func (tc *TidbCluster) TiDBGroupCluster(group *TiDBGroupSpec) *TidbCluster {
+	gtc := tc.subCluster(group.Name, label.TiDBGroupLabelKey)
+	gtc.Spec.TiDB = group.TiDBSpec.DeepCopy()
+	// the users are initialized by `spec.tidb` only
+	gtc.Spec.TiDB.Initializer = nil
+	if gtc.Spec.TiDB.Labels == nil {
+		gtc.Spec.TiDB.Labels = map[string]string{}
+	}
+	gtc.Spec.TiDB.Labels[label.TiDBGroupLabelKey] = group.Name
+
+	gtc.Status.TiDB = TiDBStatus{}
+	if status := tc.Status.TiDBGroups[group.Name]; status != nil {
+		gtc.Status.TiDB = *status.DeepCopy()
+	}
+	return gtc
+}
+
+// TiKVPoolCluster returns the in-memory TidbCluster to sync the TiKV pool. It joins the cluster in the
+// same way as a heterogeneous cluster named `<cluster>-<pool>`, whose `spec.tikv` and `status.tikv` are
+// the spec and status of the pool.
+func (tc *TidbCluster) TiKVPoolCluster(pool *TiKVPoolSpec) *TidbCluster {
+	ptc := tc.subCluster(pool.Name, label.TiKVPoolLabelKey)
+	ptc.Spec.TiKV = pool.TiKVSpec.DeepCopy()
+	if ptc.Spec.TiKV.Labels == nil {
+		ptc.Spec.TiKV.Labels = map[string]string{}
+	}
+	ptc.Spec.TiKV.Labels[label.TiKVPoolLabelKey] = pool.Name
+
+	ptc.Status.TiKV = TiKVStatus{}
+	if status := tc.Status.TiKVPools[pool.Name]; status != nil {
+		ptc.Status.TiKV = *status.DeepCopy()
+	}
+	return ptc
+}
+
+// TiFlashComputeCluster returns the in-memory TidbCluster to sync the TiFlash compute nodes. It joins the
+// cluster in the same way as a heterogeneous cluster named `<cluster>-compute`, whose `spec.tiflash` and
+// `status.tiflash` are the spec and status of the compute nodes.
+func (tc *TidbCluster) TiFlashComputeCluster() *TidbCluster {
+	ctc := tc.subCluster(label.TiFlashComputeRoleLabelVal, label.TiFlashRoleLabelKey)
+	write := tc.Spec.TiFlash
+	spec := &TiFlashSpec{
+		ServiceAccount: write.ServiceAccount,
+		BaseImage:      write.BaseImage,
+		Privileged:     write.Privileged,
+		Initializer:    write.Initializer,
+		LogTailer:      write.LogTailer,
+		Disaggregated:  write.Disaggregated,
+	}
+	if compute := write.Disaggregated.Compute; compute != nil {
+		spec.ComponentSpec = compute.ComponentSpec
+		spec.ResourceRequirements = compute.ResourceRequirements
+		spec.Replicas = compute.Replicas
+		spec.Config = compute.Config
+		spec.ScalePolicy = compute.ScalePolicy
+		// the image of the write nodes is used if the image of the compute nodes is not set
+		if compute.BaseImage != "" || compute.Image != "" {
+			spec.BaseImage = compute.BaseImage
+		}
+	}
+	ctc.Spec.TiFlash = spec.DeepCopy()
+	if ctc.Spec.TiFlash.Labels == nil {
+		ctc.Spec.TiFlash.Labels = map[string]string{}
+	}
+	ctc.Spec.TiFlash.Labels[label.TiFlashRoleLabelKey] = label.TiFlashComputeRoleLabelVal
+
+	ctc.Status.TiFlash = TiFlashStatus{}
+	if tc.Status.TiFlashCompute != nil {
+		ctc.Status.TiFlash = *tc.Status.TiFlashCompute.DeepCopy()
+	}
+	return ctc
+}
+
+// TiFlashDisaggregated returns whether the TiFlash is deployed in the disaggregated storage and
+// compute architecture
+func (tc *TidbCluster) TiFlashDisaggregated() bool {
+	return tc.Spec.TiFlash != nil && tc.Spec.TiFlash.Disaggregated != nil
+}
+
+// IsTiFlashCompute returns whether the cluster is returned by TiFlashComputeCluster
+func (tc *TidbCluster) IsTiFlashCompute() bool {
+	return tc.subClusterKey == label.TiFlashRoleLabelKey
+}
+
+// subClusterDeleteSlotsKeys is the keys of the delete slots annotations of the components synced by the
+// in-memory clusters returned by TiDBGroupCluster, TiKVPoolCluster or TiFlashComputeCluster
+var subClusterDeleteSlotsKeys = []string{label.AnnTiDBDeleteSlots, label.AnnTiKVDeleteSlots, label.AnnTiFlashDeleteSlots}
+
+// subCluster returns a copy of the cluster named `<cluster>-<name>` which joins the cluster, the
+// name is recorded by the label key, and the owner is recorded in memory so that the owner of the
+// resources can be found. The delete slots of the cluster are replaced by the ones set for the
+// sub-cluster, see DeleteSlotsAnnotationKey, and the unsafe recovery of TiKV is not set.
+func (tc *TidbCluster) subCluster(name, labelKey string) *TidbCluster {
+	stc := tc.DeepCopy()
+	stc.Name = fmt.Sprintf("%s-%s", tc.Name, name)
+	stc.Labels = map[string]string{}
+	for k, v := range tc.Labels {
+		stc.Labels[k] = v
+	}
+	stc.Labels[label.InstanceLabelKey] = fmt.Sprintf("%s-%s", tc.GetInstanceName(), name)
+	stc.Labels[labelKey] = name
+	stc.ownerName = tc.Name
+	stc.subClusterKey = labelKey
+	for _, key := range subClusterDeleteSlotsKeys {
+		delete(stc.Annotations, key)
+		if val, ok := tc.Annotations[stc.DeleteSlotsAnnotationKey(key)]; ok {
+			stc.Annotations[key] = val
+		}
+	}
+	// the unsafe recovery is run once for the whole cluster
+	delete(stc.Annotations, label.AnnTiKVUnsafeRecovery)
+
+	if !tc.Heterogeneous() || !tc.WithoutLocalPD() {
+		stc.Spec.Cluster = &TidbClusterRef{
+			Namespace:     tc.Namespace,
+			Name:          tc.Name,
+			ClusterDomain: tc.Spec.ClusterDomain,
+		}
+	}
+	stc.Spec.PD = nil
+	stc.Spec.PDMS = nil
+	stc.Spec.TiDBGroups = nil
+	stc.Spec.TiKVPools = nil
+	stc.Status.TiDBGroups = nil
+	stc.Status.TiKVPools = nil
+	stc.Status.TiFlashCompute = nil
+	return stc
+}
+
+// GetOwnerName returns the name of the TidbCluster which owns the resources of the cluster, it's the
+// cluster which the TiDB group, TiKV pool or TiFlash compute nodes belong to if the cluster is returned
+// by TiDBGroupCluster, TiKVPoolCluster or TiFlashComputeCluster.
+func (tc *TidbCluster) GetOwnerName() string {
+	if tc.ownerName != "" {
+		return tc.ownerName
+	}
+	return tc.Name
+}
+
+// DeleteSlotsAnnotationKey returns the key of the annotation of the owner TidbCluster which records the
+// delete slots of the component for the delete slots annotation key of the component. It's `<key>-<name>`,
+// e.g. `tikv.tidb.pingcap.com/delete-slots-<pool>`, for the TiDB group, TiKV pool or TiFlash compute nodes,
+// so the delete slots of them are not mixed up with the ones of the cluster.
+func (tc *TidbCluster) DeleteSlotsAnnotationKey(key string) string {
+	if tc.subClusterKey == "" {
+		return key
+	}
+	return fmt.Sprintf("%s-%s", key, tc.Labels[tc.subClusterKey])
+}
+
 func (tc *TidbCluster) SkipTLSWhenConnectTiDB() bool {
 	_, ok := tc.Annotations[label.AnnSkipTLSWhenConnectTiDB]
 	return ok
@@ -14,10 +14,12 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
 	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	apps "k8s.io/api/apps/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -1026,3 +1028,43 @@ func setPhaseForAllComponent(tc *TidbCluster, phase MemberPhase) {
 	tc.Status.TiFlash.Phase = phase
 	tc.Status.TiCDC.Phase = phase
 }
+
+func TestGetOwnerName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := &TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-olap"},
+		Spec: TidbClusterSpec{
+			TiDB:    &TiDBSpec{},
+			TiKV:    &TiKVSpec{},
+			TiFlash: &TiFlashSpec{Disaggregated: &TiFlashDisaggregatedSpec{}},
+		},
+	}
+	// the labels set by users don't change the owner
+	tc.Labels = map[string]string{
+		label.TiDBGroupLabelKey:   "olap",
+		label.TiKVPoolLabelKey:    "olap",
+		label.TiFlashRoleLabelKey: label.TiFlashComputeRoleLabelVal,
+	}
+	g.Expect(tc.GetOwnerName()).To(Equal("test-olap"))
+	g.Expect(tc.IsTiFlashCompute()).To(BeFalse())
+
+	tc = &TidbCluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: tc.Spec}
+	gtc := tc.TiDBGroupCluster(&TiDBGroupSpec{Name: "olap", TiDBSpec: TiDBSpec{}})
+	g.Expect(gtc.Name).To(Equal("test-olap"))
+	g.Expect(gtc.GetOwnerName()).To(Equal("test"))
+	g.Expect(gtc.DeepCopy().GetOwnerName()).To(Equal("test"))
+	g.Expect(gtc.IsTiFlashCompute()).To(BeFalse())
+
+	ctc := tc.TiFlashComputeCluster()
+	g.Expect(ctc.GetOwnerName()).To(Equal("test"))
+	g.Expect(ctc.IsTiFlashCompute()).To(BeTrue())
+
+	// the owner is never serialized
+	data, err := json.Marshal(ctc)
+	g.Expect(err).NotTo(HaveOccurred())
+	decoded := &TidbCluster{}
+	g.Expect(json.Unmarshal(data, decoded)).To(Succeed())
+	g.Expect(decoded.GetOwnerName()).To(Equal(ctc.Name))
+	g.Expect(decoded.IsTiFlashCompute()).To(BeFalse())
+}
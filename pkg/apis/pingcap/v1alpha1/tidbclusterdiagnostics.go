@@ -0,0 +1,72 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import "fmt"
+
+const (
+	defaultDiagnosticsLogSinceSeconds = int64(3600)
+	defaultDiagnosticsLogLimitBytes   = int64(10 * 1024 * 1024)
+	defaultDiagnosticsEventLimit      = int32(1000)
+)
+
+// GetJobName returns the name of the job collecting the diagnostics
+func (d *TidbClusterDiagnostics) GetJobName() string {
+	return fmt.Sprintf("diagnostics-%s", d.GetName())
+}
+
+// GetClusterNamespace returns the namespace of the TidbCluster, defaults to the namespace of the diagnostics
+func (d *TidbClusterDiagnostics) GetClusterNamespace() string {
+	if d.Spec.Cluster.Namespace != "" {
+		return d.Spec.Cluster.Namespace
+	}
+	return d.GetNamespace()
+}
+
+// GetComponents returns the components to collect the diagnostics from
+func (d *TidbClusterDiagnostics) GetComponents() []MemberType {
+	if len(d.Spec.Components) == 0 {
+		return []MemberType{PDMemberType, TiKVMemberType, TiDBMemberType}
+	}
+	return d.Spec.Components
+}
+
+// GetLogSinceSeconds returns how long in the past the pod logs are collected from
+func (d *TidbClusterDiagnostics) GetLogSinceSeconds() int64 {
+	if d.Spec.LogSinceSeconds == nil {
+		return defaultDiagnosticsLogSinceSeconds
+	}
+	return *d.Spec.LogSinceSeconds
+}
+
+// GetLogLimitBytes returns the max bytes of the logs collected from each container
+func (d *TidbClusterDiagnostics) GetLogLimitBytes() int64 {
+	if d.Spec.LogLimitBytes == nil {
+		return defaultDiagnosticsLogLimitBytes
+	}
+	return *d.Spec.LogLimitBytes
+}
+
+// GetEventLimit returns the max number of the recent events collected
+func (d *TidbClusterDiagnostics) GetEventLimit() int {
+	if d.Spec.EventLimit == nil {
+		return int(defaultDiagnosticsEventLimit)
+	}
+	return int(*d.Spec.EventLimit)
+}
+
+// IsFinished returns whether the collection is complete or failed
+func (d *TidbClusterDiagnostics) IsFinished() bool {
+	return d.Status.Phase == DiagnosticsComplete || d.Status.Phase == DiagnosticsFailed
+}
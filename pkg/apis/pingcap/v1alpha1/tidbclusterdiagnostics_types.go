@@ -0,0 +1,162 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TidbClusterDiagnostics collects a diagnostics bundle of a TiDB cluster by a job and uploads it to a storage.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path="tidbclusterdiagnostics",shortName="tcdiag"
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`,description="The TidbCluster to collect the diagnostics from"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`,description="The current phase of the collection"
+// +kubebuilder:printcolumn:name="Location",type=string,JSONPath=`.status.location`,description="The location of the diagnostics bundle"
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.message`,description="The message of the collection",priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type TidbClusterDiagnostics struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec contains the specification of the diagnostics collection.
+	Spec TidbClusterDiagnosticsSpec `json:"spec"`
+
+	// Status is the most recently observed status of the diagnostics collection.
+	//
+	// +k8s:openapi-gen=false
+	Status TidbClusterDiagnosticsStatus `json:"status,omitempty"`
+}
+
+// TidbClusterDiagnosticsList is a TidbClusterDiagnostics list.
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TidbClusterDiagnosticsList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TidbClusterDiagnostics `json:"items"`
+}
+
+// TidbClusterDiagnosticsSpec is the spec of the diagnostics collection.
+//
+// +k8s:openapi-gen=true
+type TidbClusterDiagnosticsSpec struct {
+	corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Cluster is the TidbCluster to collect the diagnostics from.
+	Cluster TidbClusterRef `json:"cluster"`
+
+	// StorageProvider configures where the diagnostics bundle is uploaded.
+	// Use the local storage to save the bundle to a PVC.
+	StorageProvider `json:",inline"`
+
+	// Components to collect the pod logs, status API outputs and configs from.
+	// Defaults to PD, TiKV and TiDB.
+	// +optional
+	Components []MemberType `json:"components,omitempty"`
+
+	// LogSinceSeconds is how long in the past the pod logs are collected from.
+	// +kubebuilder:default=3600
+	// +optional
+	LogSinceSeconds *int64 `json:"logSinceSeconds,omitempty"`
+
+	// LogLimitBytes is the max bytes of the logs collected from each container.
+	// +kubebuilder:default=10485760
+	// +optional
+	LogLimitBytes *int64 `json:"logLimitBytes,omitempty"`
+
+	// EventLimit is the max number of the recent events collected.
+	// +kubebuilder:default=1000
+	// +optional
+	EventLimit *int32 `json:"eventLimit,omitempty"`
+
+	// List of environment variables to set in the container, like v1.Container.Env.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Base tolerations of the collection pod.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images.
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// Affinity of the collection pod.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// Use KMS to decrypt the secrets
+	// +optional
+	UseKMS bool `json:"useKMS,omitempty"`
+
+	// ServiceAccount of the collection pod, it must be allowed to read the objects of the cluster.
+	// Defaults to tidb-diagnostics-collector.
+	// +optional
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// PodSecurityContext of the collection pod.
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+
+	// PriorityClassName of the collection pod.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// BackoffLimit is the number of retries of the collection job.
+	// +kubebuilder:default=2
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+}
+
+// TidbClusterDiagnosticsPhase is the phase of the diagnostics collection.
+type TidbClusterDiagnosticsPhase string
+
+const (
+	// DiagnosticsPending means the collection job is not created or not started yet
+	DiagnosticsPending TidbClusterDiagnosticsPhase = "Pending"
+	// DiagnosticsRunning means the collection job is running
+	DiagnosticsRunning TidbClusterDiagnosticsPhase = "Running"
+	// DiagnosticsComplete means the bundle is uploaded
+	DiagnosticsComplete TidbClusterDiagnosticsPhase = "Complete"
+	// DiagnosticsFailed means the collection failed
+	DiagnosticsFailed TidbClusterDiagnosticsPhase = "Failed"
+)
+
+// TidbClusterDiagnosticsStatus is the status of the diagnostics collection.
+type TidbClusterDiagnosticsStatus struct {
+	// Phase is the current phase of the collection.
+	Phase TidbClusterDiagnosticsPhase `json:"phase,omitempty"`
+	// Message is the error message of the collection.
+	Message string `json:"message,omitempty"`
+	// Location is the full path of the uploaded diagnostics bundle.
+	Location string `json:"location,omitempty"`
+	// Size is the size of the diagnostics bundle in bytes.
+	Size int64 `json:"size,omitempty"`
+	// Errors are the items that failed to be collected, the bundle is uploaded without them.
+	Errors []string `json:"errors,omitempty"`
+	// StartTime is the time the collection started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the collection finished.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
@@ -207,6 +207,12 @@ type TidbCluster struct {
 	// +k8s:openapi-gen=false
 	// Most recently observed status of the tidb cluster
 	Status TidbClusterStatus `json:"status,omitempty"`
+
+	// ownerName is the name of the TidbCluster which the in-memory cluster returned by TiDBGroupCluster,
+	// TiKVPoolCluster or TiFlashComputeCluster belongs to, it's never serialized so users can't set it.
+	ownerName string `json:"-"`
+	// subClusterKey is the label key recording the name of the in-memory cluster
+	subClusterKey string `json:"-"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -242,10 +248,35 @@ type TidbClusterSpec struct {
 	// +optional
 	TiDB *TiDBSpec `json:"tidb,omitempty"`
 
+	// TiDBGroups are the additional named groups of TiDB servers, e.g. to serve OLTP and analytical
+	// queries by different TiDB pools. Each group has its own StatefulSet, Services, config and status
+	// and is scaled and upgraded independently of `spec.tidb` and the other groups.
+	// The resources of a group are named `<cluster>-<group>-tidb`, and the TLS certificates of a group
+	// are read from the secrets of the same prefix if TLS is enabled. So the name of a group must not be
+	// the name of a TiKV pool, and `<cluster>-<group>` must not be the name of another TidbCluster.
+	// The delete slots of a group are set by annotation `tidb.tidb.pingcap.com/delete-slots-<group>`.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	TiDBGroups []TiDBGroupSpec `json:"tidbGroups,omitempty"`
+
 	// TiKV cluster spec
 	// +optional
 	TiKV *TiKVSpec `json:"tikv,omitempty"`
 
+	// TiKVPools are the additional named pools of TiKV stores, e.g. to place the data on different
+	// storage tiers. Each pool has its own StatefulSet, storage, config, failover and scaling policy,
+	// and joins the same PD as `spec.tikv`. The stores of a pool are distinguished by the labels set
+	// in `storeLabels` and `config.server.labels` of the pool, which can be used in placement rules.
+	// The resources of a pool are named `<cluster>-<pool>-tikv`, and the TLS certificates of a pool
+	// are read from the secrets of the same prefix if TLS is enabled. So the name of a pool must not be
+	// the name of a TiDB group, and `<cluster>-<pool>` must not be the name of another TidbCluster.
+	// The delete slots of a pool are set by annotation `tikv.tidb.pingcap.com/delete-slots-<pool>`.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	TiKVPools []TiKVPoolSpec `json:"tikvPools,omitempty"`
+
 	// TiFlash cluster spec
 	// +optional
 	TiFlash *TiFlashSpec `json:"tiflash,omitempty"`
@@ -432,6 +463,13 @@ type TidbClusterSpec struct {
 	// - WaitForDnsNameIpMatch indicates whether PD and TiKV has to wait until local IP address matches the one published to external DNS
 	// - PreferPDAddressesOverDiscovery advises start script to use TidbClusterSpec.PDAddresses (if supplied) as argument for pd-server, tikv-server and tidb-server commands
 	StartScriptV2FeatureFlags []StartScriptV2FeatureFlag `json:"startScriptV2FeatureFlags,omitempty"`
+
+	// OperationHistoryLimit is the max number of the disruptive operations recorded in status.operations,
+	// the oldest finished operations are removed first, the running operations are never removed.
+	// Optional: Defaults to 100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	OperationHistoryLimit *int32 `json:"operationHistoryLimit,omitempty"`
 }
 
 // TidbClusterStatus represents the current status of a tidb cluster.
@@ -449,6 +487,75 @@ type TidbClusterStatus struct {
 	// +optional
 	// +nullable
 	Conditions []TidbClusterCondition `json:"conditions,omitempty"`
+
+	// TiDBGroups is the status of the TiDB groups keyed by group name
+	// +optional
+	TiDBGroups map[string]*TiDBStatus `json:"tidbGroups,omitempty"`
+	// TiKVPools is the status of the TiKV pools keyed by pool name
+	// +optional
+	TiKVPools map[string]*TiKVStatus `json:"tikvPools,omitempty"`
+	// TiFlashCompute is the status of the TiFlash compute nodes
+	// +optional
+	TiFlashCompute *TiFlashStatus `json:"tiflashCompute,omitempty"`
+
+	// Operations are the disruptive operations performed by the operator on the cluster, e.g. evicting the
+	// leaders, deleting the stores and restarting the pods, ordered by the start time.
+	// +optional
+	Operations []TidbClusterOperation `json:"operations,omitempty"`
+}
+
+// TidbClusterOperationType is the type of a disruptive operation performed by the operator
+type TidbClusterOperationType string
+
+const (
+	// OperationEvictLeader evicts the leaders of a TiKV store
+	OperationEvictLeader TidbClusterOperationType = "EvictLeader"
+	// OperationDeleteStore deletes a TiKV or TiFlash store from PD
+	OperationDeleteStore TidbClusterOperationType = "DeleteStore"
+	// OperationDeleteMember deletes a PD member
+	OperationDeleteMember TidbClusterOperationType = "DeleteMember"
+	// OperationRestartPod force restarts a pod whose host is down
+	OperationRestartPod TidbClusterOperationType = "RestartPod"
+	// OperationDeletePod deletes the pod of a failure member or store to recreate it
+	OperationDeletePod TidbClusterOperationType = "DeletePod"
+	// OperationDeletePVC deletes a PVC of a failure member or store
+	OperationDeletePVC TidbClusterOperationType = "DeletePVC"
+	// OperationResignDDLOwner resigns the DDL owner of TiDB
+	OperationResignDDLOwner TidbClusterOperationType = "ResignDDLOwner"
+	// OperationUnsafeRecovery removes the failed TiKV stores by the online unsafe recovery
+	OperationUnsafeRecovery TidbClusterOperationType = "UnsafeRecovery"
+)
+
+// TidbClusterOperationResult is the outcome of a disruptive operation
+type TidbClusterOperationResult string
+
+const (
+	OperationRunning   TidbClusterOperationResult = "Running"
+	OperationSucceeded TidbClusterOperationResult = "Succeeded"
+	OperationFailed    TidbClusterOperationResult = "Failed"
+)
+
+// TidbClusterOperation is the record of a disruptive operation performed by the operator
+type TidbClusterOperation struct {
+	Type      TidbClusterOperationType `json:"type"`
+	Component MemberType               `json:"component,omitempty"`
+	// Group is the name of the TiDB group or TiKV pool, or `compute` for the TiFlash compute nodes, which
+	// the operation is performed on. It's empty for the components in the spec of the cluster itself.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Reason is why the operation is performed, e.g. Upgrade, ScaleIn or Failover
+	Reason  string `json:"reason,omitempty"`
+	PodName string `json:"podName,omitempty"`
+	StoreID string `json:"storeID,omitempty"`
+	// Target is the name of the object operated other than the pod and store, e.g. the PVC or the PD member
+	// +optional
+	Target    string      `json:"target,omitempty"`
+	StartTime metav1.Time `json:"startTime"`
+	// +optional
+	EndTime *metav1.Time               `json:"endTime,omitempty"`
+	Result  TidbClusterOperationResult `json:"result"`
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // TidbClusterCondition describes the state of a tidb cluster at a certain point.
@@ -670,6 +777,17 @@ type PDMSSpec struct {
 	StartTimeout int `json:"startTimeout,omitempty"`
 }
 
+// TiKVPoolSpec contains details of a named pool of TiKV members
+// +k8s:openapi-gen=true
+type TiKVPoolSpec struct {
+	// Name of the TiKV pool, it must be unique among the pools
+	// +kubebuilder:validation:MaxLength=20
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	TiKVSpec `json:",inline"`
+}
+
 // TiKVSpec contains details of TiKV members
 // +k8s:openapi-gen=true
 type TiKVSpec struct {
@@ -768,6 +886,15 @@ type TiKVSpec struct {
 	// +optional
 	WaitLeaderTransferBackTimeout *metav1.Duration `json:"waitLeaderTransferBackTimeout,omitempty"`
 
+	// WaitRegionHealthyTimeout indicates the timeout to wait for the unhealthy regions, i.e. the regions
+	// with miss, down, pending or learner peers, to return to the counts before the upgrade before
+	// evicting leaders on the next tikv. It's also used before upgrading the next topology domain of
+	// TiFlash with the Topology upgrade strategy. Set it to 0 to skip the check.
+	//
+	// Defaults to 10m
+	// +optional
+	WaitRegionHealthyTimeout *metav1.Duration `json:"waitRegionHealthyTimeout,omitempty"`
+
 	// StorageVolumes configure additional storage for TiKV pods.
 	// +optional
 	StorageVolumes []StorageVolume `json:"storageVolumes,omitempty"`
@@ -784,6 +911,10 @@ type TiKVSpec struct {
 	// +optional
 	ScalePolicy ScalePolicy `json:"scalePolicy,omitempty"`
 
+	// UpgradePolicy is the upgrade configuration for TiKV
+	// +optional
+	UpgradePolicy *UpgradePolicy `json:"upgradePolicy,omitempty"`
+
 	// The default number of spare replicas to scale up when using VolumeReplace feature.
 	// In multi-az deployments with topology spread constraints you may need to set this to number of zones to avoid
 	// zone skew after volume replace (total replicas always whole multiples of zones).
@@ -791,6 +922,14 @@ type TiKVSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	SpareVolReplaceReplicas *int32 `json:"spareVolReplaceReplicas,omitempty"`
+
+	// ManagementMode is the way the operator manages the pods of TiKV.
+	// StatefulSet (default) manages the pods by a StatefulSet, Instance manages the pods and PVCs
+	// of each instance directly without StatefulSet, with the instances recorded in the status.
+	// Only changing from StatefulSet to Instance is supported, the pods of the StatefulSet are adopted.
+	// +kubebuilder:validation:Enum=StatefulSet;Instance
+	// +optional
+	ManagementMode ManagementMode `json:"managementMode,omitempty"`
 }
 
 // TiFlashSpec contains details of TiFlash members
@@ -851,6 +990,85 @@ type TiFlashSpec struct {
 	// ScalePolicy is the scale configuration for TiFlash
 	// +optional
 	ScalePolicy ScalePolicy `json:"scalePolicy,omitempty"`
+
+	// UpgradePolicy is the upgrade configuration for TiFlash
+	// +optional
+	UpgradePolicy *UpgradePolicy `json:"upgradePolicy,omitempty"`
+
+	// Disaggregated enables the disaggregated storage and compute architecture of TiFlash, which
+	// requires TiFlash v7.0.0 or later. The TiFlash nodes of this spec become the write nodes, which
+	// upload the data to S3 and keep a local copy on the volumes of `storageClaims`, and the stateless
+	// compute nodes serve the queries with the data read from S3.
+	// The resources of the compute nodes are named `<cluster>-compute-tiflash`.
+	// +optional
+	Disaggregated *TiFlashDisaggregatedSpec `json:"disaggregated,omitempty"`
+
+	// ManagementMode is the way the operator manages the pods of TiFlash.
+	// StatefulSet (default) manages the pods by a StatefulSet, Instance manages the pods and PVCs
+	// of each instance directly without StatefulSet, with the instances recorded in the status.
+	// Only changing from StatefulSet to Instance is supported, the pods of the StatefulSet are adopted.
+	// The compute nodes of the disaggregated TiFlash are always managed by a StatefulSet.
+	// +kubebuilder:validation:Enum=StatefulSet;Instance
+	// +optional
+	ManagementMode ManagementMode `json:"managementMode,omitempty"`
+}
+
+// TiFlashDisaggregatedSpec contains details of the disaggregated architecture of TiFlash
+// +k8s:openapi-gen=true
+type TiFlashDisaggregatedSpec struct {
+	// S3 is the S3 compatible storage shared by the write and compute nodes
+	S3 TiFlashS3Storage `json:"s3"`
+
+	// Compute is the spec of the TiFlash compute nodes. The delete slots of the compute nodes are set by
+	// annotation `tiflash.tidb.pingcap.com/delete-slots-compute`.
+	// +optional
+	Compute *TiFlashComputeSpec `json:"compute,omitempty"`
+}
+
+// TiFlashS3Storage represents the S3 compatible storage of the disaggregated TiFlash
+// +k8s:openapi-gen=true
+type TiFlashS3Storage struct {
+	// Endpoint of S3 compatible storage service
+	Endpoint string `json:"endpoint"`
+	// Bucket in which to store the data
+	Bucket string `json:"bucket"`
+	// Root is the path of the data in the bucket, e.g. `/cluster1`
+	// +optional
+	Root string `json:"root,omitempty"`
+	// SecretName is the name of secret which stores S3 compliant storage access key and secret key
+	// in the keys `access_key` and `secret_key`. If not set, the credentials are got from the
+	// environment of the pods, e.g. the IAM role bound to the service account.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// TiFlashComputeSpec contains details of the TiFlash compute nodes
+// +k8s:openapi-gen=true
+type TiFlashComputeSpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// Base image of the compute nodes, defaults to the base image of the write nodes
+	// +optional
+	BaseImage string `json:"baseImage,omitempty"`
+
+	// Config is the Configuration of the compute nodes
+	// +optional
+	Config *TiFlashConfigWraper `json:"config,omitempty"`
+
+	// CacheCapacity is the capacity of the local cache of the data read from S3. The cache is stored
+	// in an emptyDir volume as the compute nodes have no persistent volumes.
+	// Optional: Defaults to no limit
+	// +optional
+	CacheCapacity *resource.Quantity `json:"cacheCapacity,omitempty"`
+
+	// ScalePolicy is the scale configuration for the compute nodes
+	// +optional
+	ScalePolicy ScalePolicy `json:"scalePolicy,omitempty"`
 }
 
 // TiCDCSpec contains details of TiCDC members
@@ -896,6 +1114,12 @@ type TiCDCSpec struct {
 	// Defaults to 10m
 	// +optional
 	GracefulShutdownTimeout *metav1.Duration `json:"gracefulShutdownTimeout,omitempty"`
+
+	// MaxFailoverCount limit the max replicas could be added in failover, 0 means no failover
+	// Optional: Defaults to 0
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxFailoverCount *int32 `json:"maxFailoverCount,omitempty"`
 }
 
 // TiCDCConfig is the configuration of tidbcdc
@@ -976,6 +1200,12 @@ type TiProxySpec struct {
 	//  - zone, topology.kubernetes.io/zone
 	//  - host
 	ServerLabels map[string]string `json:"serverLabels,omitempty"`
+
+	// MaxFailoverCount limit the max replicas could be added in failover, 0 means no failover
+	// Optional: Defaults to 0
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxFailoverCount *int32 `json:"maxFailoverCount,omitempty"`
 }
 
 // LogTailerSpec represents an optional log tailer sidecar container
@@ -1004,6 +1234,17 @@ type StorageClaim struct {
 	StorageClassName *string `json:"storageClassName,omitempty"`
 }
 
+// TiDBGroupSpec contains details of a named group of TiDB members
+// +k8s:openapi-gen=true
+type TiDBGroupSpec struct {
+	// Name of the TiDB group, it must be unique among the groups
+	// +kubebuilder:validation:MaxLength=20
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	TiDBSpec `json:",inline"`
+}
+
 // TiDBSpec contains details of TiDB members
 // +k8s:openapi-gen=true
 type TiDBSpec struct {
@@ -1104,6 +1345,13 @@ type TiDBSpec struct {
 	// +optional
 	ScalePolicy ScalePolicy `json:"scalePolicy,omitempty"`
 
+	// HoldOnRunningDDL holds upgrading and scaling in the TiDB instance which is the DDL owner
+	// while a DDL job is running, so that a long running DDL job, e.g. `ADD INDEX`, is not
+	// interrupted by the change of the DDL owner.
+	// Optional: Defaults to false
+	// +optional
+	HoldOnRunningDDL *bool `json:"holdOnRunningDDL,omitempty"`
+
 	// CustomizedStartupProbe is the customized startup probe for TiDB.
 	// You can provide your own startup probe for TiDB.
 	// The image will be an init container, and the tidb-server container will copy the probe binary from it, and execute it.
@@ -1540,6 +1788,78 @@ type PDStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Indicates that a Volume replace using VolumeReplacing feature is in progress.
 	VolReplaceInProgress bool `json:"volReplaceInProgress,omitempty"`
+	// Recovery is the status of the last recovery of PD from the loss of quorum
+	// +optional
+	Recovery *PDRecoveryStatus `json:"recovery,omitempty"`
+}
+
+// PDRecoveryStep is a step of the recovery of PD from the loss of quorum
+type PDRecoveryStep string
+
+const (
+	// PDRecoveryStepStarted means the cluster ID and alloc ID used by pd-recover are determined and the quorum of
+	// PD is lost, no step is finished while the max allocated IDs are being scanned from the stores
+	PDRecoveryStepStarted PDRecoveryStep = "Started"
+	// PDRecoveryStepPDScaledDown means all PD pods are deleted
+	PDRecoveryStepPDScaledDown PDRecoveryStep = "PDScaledDown"
+	// PDRecoveryStepPDDataCleaned means the PVCs of PD are deleted
+	PDRecoveryStepPDDataCleaned PDRecoveryStep = "PDDataCleaned"
+	// PDRecoveryStepPDStarted means a single fresh PD member is started
+	PDRecoveryStepPDStarted PDRecoveryStep = "PDStarted"
+	// PDRecoveryStepPDRecovered means pd-recover is finished
+	PDRecoveryStepPDRecovered PDRecoveryStep = "PDRecovered"
+	// PDRecoveryStepPDRestarted means the PD member is restarted as a new cluster with the recovered cluster ID
+	PDRecoveryStepPDRestarted PDRecoveryStep = "PDRestarted"
+	// PDRecoveryStepPDScaledUp means PD is scaled back to the desired replicas
+	PDRecoveryStepPDScaledUp PDRecoveryStep = "PDScaledUp"
+	// PDRecoveryStepTiKVRestarted means the TiKV pods, including the ones of the TiKV pools, and the TiFlash pods
+	// are restarted to register to the recovered PD
+	PDRecoveryStepTiKVRestarted PDRecoveryStep = "TiKVRestarted"
+	// PDRecoveryStepCompleted means all TiKV and TiFlash stores are registered and Up
+	PDRecoveryStepCompleted PDRecoveryStep = "Completed"
+	// PDRecoveryStepFailed means the recovery is failed and needs manual intervention
+	PDRecoveryStepFailed PDRecoveryStep = "Failed"
+)
+
+// PDRecoveryStatus is the status of the recovery of PD from the loss of quorum
+type PDRecoveryStatus struct {
+	// ID is the value of annotation `tidb.pingcap.com/pd-recovery` which triggers the recovery
+	ID string `json:"id"`
+	// Step is the last finished step of the recovery
+	Step PDRecoveryStep `json:"step"`
+	// ClusterID is the cluster ID passed to pd-recover
+	ClusterID string `json:"clusterID,omitempty"`
+	// AllocID is the alloc ID passed to pd-recover
+	AllocID uint64 `json:"allocID,omitempty"`
+	// MaxAllocatedIDs is the max IDs allocated by the lost PD which are found in the TiKV and TiFlash stores,
+	// keyed by pod name. The stores are scanned in background before the recovery is started unless the
+	// alloc ID is set by annotation `tidb.pingcap.com/pd-recovery-alloc-id`.
+	// +optional
+	MaxAllocatedIDs map[string]uint64 `json:"maxAllocatedIDs,omitempty"`
+	// Replicas is the replicas of PD StatefulSet pinned by the recovery, it's
+	// nil after the recovered PD member is restarted
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// +nullable
+	StartTime metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	// +nullable
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Message is the detail of the current step
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Steps records the finished steps of the recovery
+	// +optional
+	Steps []PDRecoveryStepRecord `json:"steps,omitempty"`
+}
+
+// PDRecoveryStepRecord records a finished step of the recovery of PD
+type PDRecoveryStepRecord struct {
+	Step PDRecoveryStep `json:"step"`
+	// +nullable
+	Time metav1.Time `json:"time,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // PDMSStatus is PD microservice status
@@ -1616,6 +1936,9 @@ type TiDBStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Indicates that a Volume replace using VolumeReplacing feature is in progress.
 	VolReplaceInProgress bool `json:"volReplaceInProgress,omitempty"`
+	// InPlaceResize is the status of resizing the resources of the pods in place for the latest resource change.
+	// +optional
+	InPlaceResize *InPlaceResizeStatus `json:"inPlaceResize,omitempty"`
 }
 
 // TiDBMember is TiDB member
@@ -1701,6 +2024,12 @@ const (
 	// Normally we only allow one pod evicts leader.
 	// TODO: set this condition before all leader eviction behavior
 	ConditionTypeLeaderEvicting = "LeaderEvicting"
+	// It means whether scaling in stores is refused because the remaining stores
+	// do not have enough free capacity to hold the data of the stores to be removed.
+	ConditionTypeScaleInBlocked = "ScaleInBlocked"
+	// It means whether upgrading the next store is held because the regions affected
+	// by the upgraded stores are not healthy yet.
+	ConditionTypeUpgradeBlocked = "UpgradeBlocked"
 )
 
 // TiKVStatus is TiKV status
@@ -1724,6 +2053,102 @@ type TiKVStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Indicates that a Volume replace using VolumeReplacing feature is in progress.
 	VolReplaceInProgress bool `json:"volReplaceInProgress,omitempty"`
+	// ScaleOutRebalance records the limits raised for rebalancing data to the new stores after scaling out.
+	// +optional
+	ScaleOutRebalance *ScaleOutRebalanceStatus `json:"scaleOutRebalance,omitempty"`
+	// UnsafeRecovery is the status of the latest online unsafe recovery of the failed stores.
+	// +optional
+	UnsafeRecovery *TiKVUnsafeRecoveryStatus `json:"unsafeRecovery,omitempty"`
+	// RegionHealthBaseline records the counts of the unhealthy regions before the upgrade,
+	// the leaders on the next store are not evicted until the counts return to the baseline.
+	// +optional
+	RegionHealthBaseline *RegionHealthStats `json:"regionHealthBaseline,omitempty"`
+	// ImagePrePull is the status of pulling the new images before the latest upgrade.
+	// +optional
+	ImagePrePull *ImagePrePullStatus `json:"imagePrePull,omitempty"`
+	// InPlaceResize is the status of resizing the resources of the pods in place for the latest resource change.
+	// +optional
+	InPlaceResize *InPlaceResizeStatus `json:"inPlaceResize,omitempty"`
+	// Instances are the instances managed without StatefulSet, keyed by the pod names.
+	// +optional
+	Instances map[string]*InstanceStatus `json:"instances,omitempty"`
+}
+
+// RegionHealthStats is the counts of the unhealthy regions
+type RegionHealthStats struct {
+	MissPeerRegionCount    int32 `json:"missPeerRegionCount"`
+	DownPeerRegionCount    int32 `json:"downPeerRegionCount"`
+	PendingPeerRegionCount int32 `json:"pendingPeerRegionCount"`
+	LearnerPeerRegionCount int32 `json:"learnerPeerRegionCount"`
+}
+
+// TiKVUnsafeRecoveryPhase is the phase of the online unsafe recovery of TiKV
+type TiKVUnsafeRecoveryPhase string
+
+const (
+	// TiKVUnsafeRecoveryPhaseRunning means PD is recovering the regions that lost the majority of replicas
+	TiKVUnsafeRecoveryPhaseRunning TiKVUnsafeRecoveryPhase = "Running"
+	// TiKVUnsafeRecoveryPhaseCleaningUp means the failed stores are being deleted from PD
+	// and their pods and PVCs are being recreated
+	TiKVUnsafeRecoveryPhaseCleaningUp TiKVUnsafeRecoveryPhase = "CleaningUp"
+	// TiKVUnsafeRecoveryPhaseCompleted means the online unsafe recovery is completed
+	TiKVUnsafeRecoveryPhaseCompleted TiKVUnsafeRecoveryPhase = "Completed"
+	// TiKVUnsafeRecoveryPhaseFailed means the online unsafe recovery is failed
+	TiKVUnsafeRecoveryPhaseFailed TiKVUnsafeRecoveryPhase = "Failed"
+)
+
+// DataLossRisk is the risk of losing data by the online unsafe recovery
+type DataLossRisk string
+
+const (
+	// DataLossRiskNone means no region lost the majority of replicas
+	DataLossRiskNone DataLossRisk = "None"
+	// DataLossRiskLow means some regions are recovered from the remaining minority of replicas,
+	// the latest writes which are not replicated to the remaining replicas may be lost
+	DataLossRiskLow DataLossRisk = "Low"
+	// DataLossRiskHigh means some regions lost all replicas and are recreated as empty regions,
+	// the data in these regions is lost
+	DataLossRiskHigh DataLossRisk = "High"
+)
+
+// TiKVUnsafeRecoveryStatus is the status of the online unsafe recovery of TiKV
+type TiKVUnsafeRecoveryStatus struct {
+	// ID is the value of the annotation which triggers the recovery
+	ID    string                  `json:"id"`
+	Phase TiKVUnsafeRecoveryPhase `json:"phase,omitempty"`
+	// FailedStores are the IDs of the stores removed by the recovery
+	FailedStores []string    `json:"failedStores,omitempty"`
+	StartTime    metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Stage is the latest stage of the recovery reported by PD
+	Stage string `json:"stage,omitempty"`
+	// AffectedRegionCount is the count of the regions which lost the majority of replicas
+	AffectedRegionCount int32 `json:"affectedRegionCount,omitempty"`
+	// EmptyRegionCount is the count of the regions which lost all replicas and are recreated as empty regions
+	EmptyRegionCount int32        `json:"emptyRegionCount,omitempty"`
+	DataLossRisk     DataLossRisk `json:"dataLossRisk,omitempty"`
+	// Details are the details of the recovery result reported by PD, e.g. the IDs of the affected tables
+	Details []string `json:"details,omitempty"`
+	Message string   `json:"message,omitempty"`
+}
+
+// ScaleOutRebalanceStatus is the status of rebalancing data to the new stores after scaling out
+type ScaleOutRebalanceStatus struct {
+	// StartTime is the time when the latest new stores are added
+	StartTime metav1.Time `json:"startTime"`
+	// PodNames are the pods added by scaling out
+	PodNames []string `json:"podNames,omitempty"`
+	// OriginalStoreLimits records the add-peer store limits of the new stores before they are raised.
+	// key: store id
+	OriginalStoreLimits map[string]float64 `json:"originalStoreLimits,omitempty"`
+	// OriginalRegionScheduleLimit records the region-schedule-limit of PD before it is raised
+	OriginalRegionScheduleLimit *int64 `json:"originalRegionScheduleLimit,omitempty"`
+	// OriginalLeaderScheduleLimit records the leader-schedule-limit of PD before it is raised
+	OriginalLeaderScheduleLimit *int64 `json:"originalLeaderScheduleLimit,omitempty"`
+	// Progress is the percentage of the region count of the least balanced new store
+	// to the average region count of all stores
+	Progress int32 `json:"progress,omitempty"`
 }
 
 // TiFlashStatus is TiFlash status
@@ -1745,6 +2170,16 @@ type TiFlashStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Indicates that a Volume replace using VolumeReplacing feature is in progress.
 	VolReplaceInProgress bool `json:"volReplaceInProgress,omitempty"`
+	// RegionHealthBaseline records the counts of the unhealthy regions before the upgrade with
+	// the Topology strategy, the next topology domain is not upgraded until the counts return to the baseline.
+	// +optional
+	RegionHealthBaseline *RegionHealthStats `json:"regionHealthBaseline,omitempty"`
+	// ImagePrePull is the status of pulling the new images before the latest upgrade.
+	// +optional
+	ImagePrePull *ImagePrePullStatus `json:"imagePrePull,omitempty"`
+	// Instances are the instances managed without StatefulSet, keyed by the pod names.
+	// +optional
+	Instances map[string]*InstanceStatus `json:"instances,omitempty"`
 }
 
 // TiProxyMember is TiProxy member
@@ -1762,11 +2197,12 @@ type TiProxyMember struct {
 
 // TiProxyStatus is TiProxy status
 type TiProxyStatus struct {
-	Synced      bool                                       `json:"synced,omitempty"`
-	Phase       MemberPhase                                `json:"phase,omitempty"`
-	Members     map[string]TiProxyMember                   `json:"members,omitempty"`
-	StatefulSet *apps.StatefulSetStatus                    `json:"statefulSet,omitempty"`
-	Volumes     map[StorageVolumeName]*StorageVolumeStatus `json:"volumes,omitempty"`
+	Synced         bool                                       `json:"synced,omitempty"`
+	Phase          MemberPhase                                `json:"phase,omitempty"`
+	Members        map[string]TiProxyMember                   `json:"members,omitempty"`
+	FailureMembers map[string]StatelessFailureMember          `json:"failureMembers,omitempty"`
+	StatefulSet    *apps.StatefulSetStatus                    `json:"statefulSet,omitempty"`
+	Volumes        map[StorageVolumeName]*StorageVolumeStatus `json:"volumes,omitempty"`
 	// Represents the latest available observations of a component's state.
 	// +optional
 	// +nullable
@@ -1775,10 +2211,11 @@ type TiProxyStatus struct {
 
 // TiCDCStatus is TiCDC status
 type TiCDCStatus struct {
-	Synced      bool                    `json:"synced,omitempty"`
-	Phase       MemberPhase             `json:"phase,omitempty"`
-	StatefulSet *apps.StatefulSetStatus `json:"statefulSet,omitempty"`
-	Captures    map[string]TiCDCCapture `json:"captures,omitempty"`
+	Synced         bool                              `json:"synced,omitempty"`
+	Phase          MemberPhase                       `json:"phase,omitempty"`
+	StatefulSet    *apps.StatefulSetStatus           `json:"statefulSet,omitempty"`
+	Captures       map[string]TiCDCCapture           `json:"captures,omitempty"`
+	FailureMembers map[string]StatelessFailureMember `json:"failureMembers,omitempty"`
 	// Volumes contains the status of all volumes.
 	Volumes map[StorageVolumeName]*StorageVolumeStatus `json:"volumes,omitempty"`
 	// Represents the latest available observations of a component's state.
@@ -1794,6 +2231,18 @@ type TiCDCCapture struct {
 	Version string `json:"version,omitempty"`
 	IsOwner bool   `json:"isOwner,omitempty"`
 	Ready   bool   `json:"ready,omitempty"`
+	// Last time the readiness transitioned from one to another.
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// StatelessFailureMember is the failure member information of a component without
+// persistent data, like TiProxy and TiCDC
+type StatelessFailureMember struct {
+	PodName  string `json:"podName,omitempty"`
+	HostDown bool   `json:"hostDown,omitempty"`
+	// +nullable
+	CreatedAt metav1.Time `json:"createdAt,omitempty"`
 }
 
 // TiKVStores is either Up/Down/Offline/Tombstone
@@ -1813,6 +2262,29 @@ type TiKVStore struct {
 	// It is set when evicting leader and used to wait for most leaders to transfer back after upgrade.
 	// It is unset after leader transfer is completed.
 	LeaderCountBeforeUpgrade *int32 `json:"leaderCountBeforeUpgrade,omitempty"`
+	// DataMigration records the progress of moving data out of the store while it is Offline.
+	// It is unset when the store is not Offline.
+	// +optional
+	DataMigration *StoreDataMigrationStatus `json:"dataMigration,omitempty"`
+}
+
+// StoreDataMigrationStatus is the progress of moving data out of an Offline store
+type StoreDataMigrationStatus struct {
+	// StartTime is the time when the store is observed to be Offline
+	StartTime metav1.Time `json:"startTime"`
+	// InitialRegionCount is the region count of the store when it becomes Offline
+	InitialRegionCount int64 `json:"initialRegionCount"`
+	// InitialUsedSize is the used size (bytes) of the store when it becomes Offline
+	InitialUsedSize int64 `json:"initialUsedSize"`
+	// RemainingRegionCount is the region count still in the store
+	RemainingRegionCount int64 `json:"remainingRegionCount"`
+	// MovedBytes is the bytes moved out of the store since it becomes Offline
+	MovedBytes int64 `json:"movedBytes"`
+	// EstimatedCompletionTime is the estimated time when all regions are moved out of the store,
+	// it is calculated from the region migration rate since the store becomes Offline.
+	// +optional
+	// +nullable
+	EstimatedCompletionTime *metav1.Time `json:"estimatedCompletionTime,omitempty"`
 }
 
 // TiKVFailureStore is the tikv failure store information
@@ -3525,8 +3997,223 @@ type ScalePolicy struct {
 	// +kubebuilder:default=1
 	// +optional
 	ScaleOutParallelism *int32 `json:"scaleOutParallelism,omitempty"`
+
+	// ScaleInStrategy decides which stores are removed when scaling in TiKV or TiFlash.
+	// - Ordinal: remove the pods with the highest ordinals, unless the delete slots annotation is set (default)
+	// - Balanced: TiDB Operator chooses the stores to remove and records them in the delete slots annotation,
+	//   it keeps the topology domains balanced, avoids the stores which are evicting leaders and
+	//   prefers the stores with fewer regions and leaders. It requires the AdvancedStatefulSet feature.
+	// +kubebuilder:validation:Enum:="";"Ordinal";"Balanced"
+	// +optional
+	ScaleInStrategy ScaleInStrategy `json:"scaleInStrategy,omitempty"`
+
+	// ScaleInTopologyKey is the node label (or store label) used to keep the stores balanced across
+	// topology domains when ScaleInStrategy is Balanced.
+	// Defaults to the first topologyKey of the TopologySpreadConstraints of the component.
+	// +optional
+	ScaleInTopologyKey string `json:"scaleInTopologyKey,omitempty"`
+
+	// ScaleOutRebalance temporarily raises the PD store limit of the new stores and the
+	// region/leader schedule limits of PD after scaling out TiKV, so that the data is rebalanced
+	// to the new stores faster. The original limits are restored when the new stores are balanced
+	// or the timeout is hit. It only takes effect for TiKV.
+	// +optional
+	ScaleOutRebalance *ScaleOutRebalancePolicy `json:"scaleOutRebalance,omitempty"`
 }
 
+// ScaleOutRebalancePolicy is the policy to speed up rebalancing data to the new stores after scaling out
+type ScaleOutRebalancePolicy struct {
+	// StoreLimit is the add-peer store limit set to the new stores.
+	// Defaults to 200.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	StoreLimit *int32 `json:"storeLimit,omitempty"`
+
+	// RegionScheduleLimit is the region-schedule-limit set to PD.
+	// Defaults to 64.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RegionScheduleLimit *int32 `json:"regionScheduleLimit,omitempty"`
+
+	// LeaderScheduleLimit is the leader-schedule-limit set to PD.
+	// Defaults to 16.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	LeaderScheduleLimit *int32 `json:"leaderScheduleLimit,omitempty"`
+
+	// BalanceTolerancePercent is how far the region count of the new stores can be below the
+	// average region count of all stores when they are regarded as balanced.
+	// Defaults to 10.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	BalanceTolerancePercent *int32 `json:"balanceTolerancePercent,omitempty"`
+
+	// Timeout is the max duration to keep the raised limits.
+	// Defaults to 2h.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// UpgradePolicy is the upgrade configuration for TiKV or TiFlash
+type UpgradePolicy struct {
+	// Strategy decides the order in which the pods are upgraded.
+	// - Ordinal: upgrade the pods one by one from the highest ordinal (default)
+	// - Topology: upgrade the pods in the same topology domain together, one domain after another.
+	//   The domain of the pod with the highest ordinal goes first, and all the pods in a domain are
+	//   upgraded together whatever their ordinals are, the StatefulSet uses the OnDelete strategy during
+	//   the upgrade so that only the deleted pods are recreated with the new revision. Neither the
+	//   partition nor the delete slots of the StatefulSet can select the non-adjacent ordinals of a domain,
+	//   so the whole StatefulSet is switched to OnDelete, and a pod deleted by others during the upgrade,
+	//   e.g. by a node drain, is recreated with the new revision too even if it's not in the domain.
+	//   The leaders of their stores are evicted concurrently, then the pods are recreated together,
+	//   and the next domain is upgraded after the regions are healthy again.
+	//   The topology key must be one of the location-labels of PD at or under its isolation-level,
+	//   so that no region has more than one replica in a domain, otherwise Ordinal is used instead.
+	// +kubebuilder:validation:Enum:="";"Ordinal";"Topology"
+	// +optional
+	Strategy UpgradeStrategy `json:"strategy,omitempty"`
+
+	// TopologyKey is the node label (or store label) which divides the pods into topology domains
+	// when Strategy is Topology, it should be one of the location-labels of PD.
+	// Defaults to the first topologyKey of the TopologySpreadConstraints of the component.
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+
+	// MaxParallelism limits the number of pods upgraded together in a topology domain.
+	// Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxParallelism int32 `json:"maxParallelism,omitempty"`
+
+	// ImagePrePull pulls the new images on the nodes hosting the pods before the upgrade begins
+	// if the images are changed, so that the pods can be restarted quickly.
+	// +optional
+	ImagePrePull *ImagePrePullPolicy `json:"imagePrePull,omitempty"`
+}
+
+// ImagePrePullPolicy is the configuration to pull the new images before the upgrade
+type ImagePrePullPolicy struct {
+	// Timeout is the max time to wait for the images to be pulled, the upgrade begins after the timeout
+	// even if the images are not pulled on all the nodes.
+	// Defaults to 10m.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ImagePrePullPhase is the phase of pulling the new images before the upgrade
+type ImagePrePullPhase string
+
+const (
+	// ImagePrePullPhasePulling means the new images are being pulled
+	ImagePrePullPhasePulling ImagePrePullPhase = "Pulling"
+	// ImagePrePullPhaseCompleted means the new images are pulled on all the nodes
+	ImagePrePullPhaseCompleted ImagePrePullPhase = "Completed"
+	// ImagePrePullPhaseTimeout means the new images are not pulled on all the nodes before the timeout
+	ImagePrePullPhaseTimeout ImagePrePullPhase = "Timeout"
+)
+
+// ImagePrePullStatus is the status of pulling the new images before the upgrade
+type ImagePrePullStatus struct {
+	// Images are the new images being pulled
+	Images []string          `json:"images,omitempty"`
+	Phase  ImagePrePullPhase `json:"phase,omitempty"`
+	// DesiredNodes is the number of the nodes hosting the pods
+	DesiredNodes int32 `json:"desiredNodes"`
+	// PulledNodes is the number of the nodes on which the new images are pulled
+	PulledNodes    int32        `json:"pulledNodes"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// ManagementMode is the way the operator manages the pods of a component
+type ManagementMode string
+
+const (
+	// ManagementModeStatefulSet manages the pods by a StatefulSet
+	ManagementModeStatefulSet ManagementMode = "StatefulSet"
+	// ManagementModeInstance manages the pods and PVCs of each instance directly without StatefulSet
+	ManagementModeInstance ManagementMode = "Instance"
+)
+
+// InstancePhase is the phase of an instance managed without StatefulSet
+type InstancePhase string
+
+const (
+	// InstancePhaseRunning means the pod of the instance is created from the recorded revision
+	InstancePhaseRunning InstancePhase = "Running"
+	// InstancePhaseUpgrading means the pod of the instance is being recreated from the new revision
+	InstancePhaseUpgrading InstancePhase = "Upgrading"
+	// InstancePhaseDeleting means the store of the instance is being removed, the pod is deleted
+	// and the PVCs are marked to be deleted after the store becomes tombstone
+	InstancePhaseDeleting InstancePhase = "Deleting"
+)
+
+// InstanceStatus is the status of an instance managed without StatefulSet.
+// An instance is a pod named `<cluster>-<component>-<ordinal>` with its PVCs, the ordinal is never
+// changed in the lifetime of the instance, and it's not reused until the instance is deleted.
+type InstanceStatus struct {
+	Ordinal int32 `json:"ordinal"`
+	// Revision is the hash of the pod template the pod of the instance is created from
+	Revision string        `json:"revision,omitempty"`
+	Phase    InstancePhase `json:"phase,omitempty"`
+	// ReplacedBy is the instance created to replace this instance, this instance is deleted
+	// after the store of the new instance is up.
+	// +optional
+	ReplacedBy string `json:"replacedBy,omitempty"`
+	// ExcludedNode is the node the pod of the instance must not be scheduled to, it's set to the
+	// node of the replaced instance when the instance is created to move that instance.
+	// +optional
+	ExcludedNode       string      `json:"excludedNode,omitempty"`
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// InPlaceResizePhase is the phase of resizing the resources of the pods in place
+type InPlaceResizePhase string
+
+const (
+	// InPlaceResizePhaseResizing means the resources of the pods are being resized in place
+	InPlaceResizePhaseResizing InPlaceResizePhase = "Resizing"
+	// InPlaceResizePhaseCompleted means the resources of all the pods are resized in place
+	InPlaceResizePhaseCompleted InPlaceResizePhase = "Completed"
+	// InPlaceResizePhaseFallback means the pods can not be resized in place, the pods not resized
+	// are restarted by the rolling upgrade instead
+	InPlaceResizePhaseFallback InPlaceResizePhase = "Fallback"
+)
+
+// InPlaceResizeStatus is the status of resizing the resources of the pods in place
+type InPlaceResizeStatus struct {
+	Phase InPlaceResizePhase `json:"phase,omitempty"`
+	// Resources are the new resources of the containers, keyed by the container names
+	Resources map[string]corev1.ResourceRequirements `json:"resources,omitempty"`
+	// ResizedPods are the pods whose resources are resized in place
+	ResizedPods []string `json:"resizedPods,omitempty"`
+	// Message is the reason of falling back to the rolling upgrade
+	Message        string       `json:"message,omitempty"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// UpgradeStrategy represents the order in which the pods of TiKV or TiFlash are upgraded
+type UpgradeStrategy string
+
+const (
+	// UpgradeStrategyOrdinal upgrades the pods one by one from the highest ordinal
+	UpgradeStrategyOrdinal UpgradeStrategy = "Ordinal"
+	// UpgradeStrategyTopology upgrades the pods in the same topology domain together
+	UpgradeStrategyTopology UpgradeStrategy = "Topology"
+)
+
+// ScaleInStrategy represents the strategy to choose the stores to remove when scaling in
+type ScaleInStrategy string
+
+const (
+	// ScaleInStrategyOrdinal removes the pods with the highest ordinals
+	ScaleInStrategyOrdinal ScaleInStrategy = "Ordinal"
+	// ScaleInStrategyBalanced lets TiDB Operator choose the stores to remove
+	ScaleInStrategyBalanced ScaleInStrategy = "Balanced"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +k8s:openapi-gen=true
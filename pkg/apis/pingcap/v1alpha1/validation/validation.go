@@ -138,14 +138,23 @@ func validateTiDBClusterSpec(spec *v1alpha1.TidbClusterSpec, fldPath *field.Path
 	if spec.TiKV != nil {
 		allErrs = append(allErrs, validateTiKVSpec(spec.TiKV, fldPath.Child("tikv"))...)
 	}
+	if len(spec.TiKVPools) > 0 {
+		allErrs = append(allErrs, validateTiKVPools(spec, fldPath.Child("tikvPools"))...)
+	}
 	if spec.TiDB != nil {
 		allErrs = append(allErrs, validateTiDBSpec(spec.TiDB, fldPath.Child("tidb"))...)
 	}
+	if len(spec.TiDBGroups) > 0 {
+		allErrs = append(allErrs, validateTiDBGroups(spec, fldPath.Child("tidbGroups"))...)
+	}
 	if spec.Pump != nil {
 		allErrs = append(allErrs, validatePumpSpec(spec.Pump, fldPath.Child("pump"))...)
 	}
 	if spec.TiFlash != nil {
 		allErrs = append(allErrs, validateTiFlashSpec(spec.TiFlash, fldPath.Child("tiflash"))...)
+		if spec.TiFlash.Disaggregated != nil {
+			allErrs = append(allErrs, validateTiFlashDisaggregated(spec, fldPath.Child("tiflash", "disaggregated"))...)
+		}
 	}
 	if spec.TiCDC != nil {
 		allErrs = append(allErrs, validateTiCDCSpec(spec.TiCDC, fldPath.Child("ticdc"))...)
@@ -333,6 +342,102 @@ func validateTiDBSpec(spec *v1alpha1.TiDBSpec, fldPath *field.Path) field.ErrorL
 	return allErrs
 }
 
+func validateTiDBGroups(spec *v1alpha1.TidbClusterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.AcrossK8s {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "TiDB groups are not supported when acrossK8s is enabled"))
+	}
+	if spec.PD == nil && (spec.Cluster == nil || spec.Cluster.Name == "") {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "TiDB groups require spec.pd or spec.cluster"))
+	}
+	names := map[string]bool{}
+	for i := range spec.TiDBGroups {
+		group := &spec.TiDBGroups[i]
+		idxPath := fldPath.Index(i)
+		for _, msg := range validation.IsDNS1123Label(group.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), group.Name, msg))
+		}
+		if names[group.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), group.Name))
+		}
+		names[group.Name] = true
+		allErrs = append(allErrs, validateTiDBSpec(&group.TiDBSpec, idxPath)...)
+	}
+	return allErrs
+}
+
+func validateTiKVPools(spec *v1alpha1.TidbClusterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.AcrossK8s {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "TiKV pools are not supported when acrossK8s is enabled"))
+	}
+	if spec.PD == nil && (spec.Cluster == nil || spec.Cluster.Name == "") {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "TiKV pools require spec.pd or spec.cluster"))
+	}
+	names := map[string]bool{}
+	for i := range spec.TiKVPools {
+		pool := &spec.TiKVPools[i]
+		idxPath := fldPath.Index(i)
+		for _, msg := range validation.IsDNS1123Label(pool.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), pool.Name, msg))
+		}
+		if names[pool.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), pool.Name))
+		}
+		names[pool.Name] = true
+		// the pool shares the instance name `<cluster>-<pool>` with the TiDB group of the same name
+		for j := range spec.TiDBGroups {
+			if spec.TiDBGroups[j].Name == pool.Name {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), pool.Name, "the name is used by a TiDB group"))
+			}
+		}
+		allErrs = append(allErrs, validateTiKVSpec(&pool.TiKVSpec, idxPath)...)
+	}
+	return allErrs
+}
+
+func validateTiFlashDisaggregated(spec *v1alpha1.TidbClusterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	disaggregated := spec.TiFlash.Disaggregated
+	if disaggregated.S3.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("s3", "endpoint"), "S3 endpoint must not be empty"))
+	}
+	if disaggregated.S3.Bucket == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("s3", "bucket"), "S3 bucket must not be empty"))
+	}
+
+	compute := disaggregated.Compute
+	if compute == nil {
+		return allErrs
+	}
+	computePath := fldPath.Child("compute")
+	if spec.AcrossK8s {
+		allErrs = append(allErrs, field.Forbidden(computePath, "TiFlash compute nodes are not supported when acrossK8s is enabled"))
+	}
+	if spec.PD == nil && (spec.Cluster == nil || spec.Cluster.Name == "") {
+		allErrs = append(allErrs, field.Forbidden(computePath, "TiFlash compute nodes require spec.pd or spec.cluster"))
+	}
+	// the compute nodes share the instance name `<cluster>-compute` with the group or pool of the same name
+	for i := range spec.TiDBGroups {
+		if spec.TiDBGroups[i].Name == label.TiFlashComputeRoleLabelVal {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tidbGroups").Index(i).Child("name"), spec.TiDBGroups[i].Name,
+				"the name is reserved for the TiFlash compute nodes"))
+		}
+	}
+	for i := range spec.TiKVPools {
+		if spec.TiKVPools[i].Name == label.TiFlashComputeRoleLabelVal {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tikvPools").Index(i).Child("name"), spec.TiKVPools[i].Name,
+				"the name is reserved for the TiFlash compute nodes"))
+		}
+	}
+	allErrs = append(allErrs, validateComponentSpec(&compute.ComponentSpec, computePath)...)
+	allErrs = append(allErrs, validateScalePolicy(&compute.ScalePolicy, computePath.Child("scalePolicy"))...)
+	if compute.CacheCapacity != nil && compute.CacheCapacity.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(computePath.Child("cacheCapacity"), compute.CacheCapacity.String(), "cache capacity must be positive"))
+	}
+	return allErrs
+}
+
 func validatePumpSpec(spec *v1alpha1.PumpSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateComponentSpec(&spec.ComponentSpec, fldPath)...)
@@ -577,10 +682,26 @@ func ValidateUpdateTidbCluster(old, tc *v1alpha1.TidbCluster) field.ErrorList {
 	allErrs = append(allErrs, validateUpdatePDConfig(old.Spec.PD, tc.Spec.PD, field.NewPath("spec.pd.config"))...)
 	allErrs = append(allErrs, disallowMutateBootstrapSQLConfigMapName(old.Spec.TiDB, tc.Spec.TiDB, field.NewPath("spec.tidb.bootstrapSQLConfigMapName"))...)
 	allErrs = append(allErrs, disallowUsingLegacyAPIInNewCluster(old, tc)...)
+	allErrs = append(allErrs, disallowRevertingManagementMode(old, tc)...)
 
 	return allErrs
 }
 
+// disallowRevertingManagementMode forbids changing the management mode of TiKV and TiFlash from Instance back
+// to StatefulSet, as the instances may be at the ordinals that can not be mapped onto a StatefulSet.
+func disallowRevertingManagementMode(old, tc *v1alpha1.TidbCluster) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if old.Spec.TiKV.IsInstanceManaged() && tc.Spec.TiKV != nil && !tc.Spec.TiKV.IsInstanceManaged() {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "tikv", "managementMode"),
+			"can not be changed from Instance back to StatefulSet"))
+	}
+	if old.Spec.TiFlash.IsInstanceManaged() && tc.Spec.TiFlash != nil && !tc.Spec.TiFlash.IsInstanceManaged() {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "tiflash", "managementMode"),
+			"can not be changed from Instance back to StatefulSet"))
+	}
+	return allErrs
+}
+
 // For now we limit some validations only in Create phase to keep backward compatibility
 // TODO(aylei): call this in ValidateTidbCluster after we deprecated the old versions of helm chart officially
 func validateNewTidbClusterSpec(spec *v1alpha1.TidbClusterSpec, path *field.Path) field.ErrorList {
@@ -796,6 +796,174 @@ func TestValidatePDSpec(t *testing.T) {
 	}
 }
 
+func TestValidateTiDBGroups(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		update         func(tc *v1alpha1.TidbCluster)
+		expectedErrors int
+	}{
+		{
+			name:           "valid groups",
+			update:         func(tc *v1alpha1.TidbCluster) {},
+			expectedErrors: 0,
+		},
+		{
+			name: "duplicated and invalid names",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiDBGroups = append(tc.Spec.TiDBGroups, v1alpha1.TiDBGroupSpec{Name: "oltp"}, v1alpha1.TiDBGroupSpec{Name: "OLAP"})
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "without PD",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PD = nil
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "across Kubernetes",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.AcrossK8s = true
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbCluster()
+			tc.Spec.TiDBGroups = []v1alpha1.TiDBGroupSpec{{Name: "oltp"}, {Name: "olap"}}
+			tt.update(tc)
+			err := validateTiDBGroups(&tc.Spec, field.NewPath("spec", "tidbGroups"))
+			g.Expect(err).To(HaveLen(tt.expectedErrors))
+		})
+	}
+}
+
+func TestValidateTiKVPools(t *testing.T) {
+	g := NewGomegaWithT(t)
+	newPool := func(name string) v1alpha1.TiKVPoolSpec {
+		pool := v1alpha1.TiKVPoolSpec{Name: name}
+		pool.Requests = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Ti")}
+		return pool
+	}
+	tests := []struct {
+		name           string
+		update         func(tc *v1alpha1.TidbCluster)
+		expectedErrors int
+	}{
+		{
+			name:           "valid pools",
+			update:         func(tc *v1alpha1.TidbCluster) {},
+			expectedErrors: 0,
+		},
+		{
+			name: "duplicated and invalid names",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiKVPools = append(tc.Spec.TiKVPools, newPool("ssd"), newPool("cold_data"))
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "name of a TiDB group",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiDBGroups = []v1alpha1.TiDBGroupSpec{{Name: "oltp"}, {Name: "hdd"}}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "heterogeneous cluster",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PD = nil
+				tc.Spec.Cluster = &v1alpha1.TidbClusterRef{Name: "basic"}
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "without PD",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PD = nil
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbCluster()
+			tc.Spec.TiKVPools = []v1alpha1.TiKVPoolSpec{newPool("ssd"), newPool("hdd")}
+			tt.update(tc)
+			err := validateTiKVPools(&tc.Spec, field.NewPath("spec", "tikvPools"))
+			g.Expect(err).To(HaveLen(tt.expectedErrors))
+		})
+	}
+}
+
+func TestValidateTiFlashDisaggregated(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		update         func(tc *v1alpha1.TidbCluster)
+		expectedErrors int
+	}{
+		{
+			name:           "valid",
+			update:         func(tc *v1alpha1.TidbCluster) {},
+			expectedErrors: 0,
+		},
+		{
+			name: "without S3",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiFlash.Disaggregated.S3 = v1alpha1.TiFlashS3Storage{}
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "reserved name",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiKVPools = []v1alpha1.TiKVPoolSpec{{Name: "compute"}}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "invalid cache capacity",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiFlash.Disaggregated.Compute.CacheCapacity = resource.NewQuantity(0, resource.BinarySI)
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "without compute nodes",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PD = nil
+				tc.Spec.TiFlash.Disaggregated.Compute = nil
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "compute nodes without PD",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PD = nil
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbCluster()
+			tc.Spec.TiFlash = &v1alpha1.TiFlashSpec{
+				Disaggregated: &v1alpha1.TiFlashDisaggregatedSpec{
+					S3:      v1alpha1.TiFlashS3Storage{Endpoint: "http://minio:9000", Bucket: "tiflash"},
+					Compute: &v1alpha1.TiFlashComputeSpec{Replicas: 1},
+				},
+			}
+			tt.update(tc)
+			err := validateTiFlashDisaggregated(&tc.Spec, field.NewPath("spec", "tiflash", "disaggregated"))
+			g.Expect(err).To(HaveLen(tt.expectedErrors))
+		})
+	}
+}
+
 func TestValidateTiFlashSpec(t *testing.T) {
 	g := NewGomegaWithT(t)
 	tests := []struct {
@@ -922,3 +1090,54 @@ func Test_disallowMutateBootstrapSQLConfigMapName(t *testing.T) {
 		})
 	}
 }
+
+func Test_disallowRevertingManagementMode(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name      string
+		old       v1alpha1.ManagementMode
+		new       v1alpha1.ManagementMode
+		wantError bool
+	}{
+		{
+			name:      "no change",
+			old:       v1alpha1.ManagementModeInstance,
+			new:       v1alpha1.ManagementModeInstance,
+			wantError: false,
+		},
+		{
+			name:      "migrate from StatefulSet to Instance",
+			old:       "",
+			new:       v1alpha1.ManagementModeInstance,
+			wantError: false,
+		},
+		{
+			name:      "revert from Instance to StatefulSet",
+			old:       v1alpha1.ManagementModeInstance,
+			new:       v1alpha1.ManagementModeStatefulSet,
+			wantError: true,
+		},
+		{
+			name:      "revert from Instance to default",
+			old:       v1alpha1.ManagementModeInstance,
+			new:       "",
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := &v1alpha1.TidbCluster{}
+			old.Spec.TiKV = &v1alpha1.TiKVSpec{ManagementMode: tt.old}
+			old.Spec.TiFlash = &v1alpha1.TiFlashSpec{ManagementMode: tt.old}
+			tc := old.DeepCopy()
+			tc.Spec.TiKV.ManagementMode = tt.new
+			tc.Spec.TiFlash.ManagementMode = tt.new
+			errs := disallowRevertingManagementMode(old, tc)
+			if tt.wantError {
+				g.Expect(len(errs)).To(Equal(2))
+			} else {
+				g.Expect(len(errs)).To(Equal(0))
+			}
+		})
+	}
+}
@@ -2352,6 +2352,92 @@ func (in *HelperSpec) DeepCopy() *HelperSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrePullPolicy) DeepCopyInto(out *ImagePrePullPolicy) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrePullPolicy.
+func (in *ImagePrePullPolicy) DeepCopy() *ImagePrePullPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrePullPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrePullStatus) DeepCopyInto(out *ImagePrePullStatus) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrePullStatus.
+func (in *ImagePrePullStatus) DeepCopy() *ImagePrePullStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrePullStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InPlaceResizeStatus) DeepCopyInto(out *InPlaceResizeStatus) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(map[string]v1.ResourceRequirements, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ResizedPods != nil {
+		in, out := &in.ResizedPods, &out.ResizedPods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InPlaceResizeStatus.
+func (in *InPlaceResizeStatus) DeepCopy() *InPlaceResizeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InPlaceResizeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressSpec) DeepCopyInto(out *IngressSpec) {
 	*out = *in
@@ -2428,6 +2514,23 @@ func (in *InitializerSpec) DeepCopy() *InitializerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatus.
+func (in *InstanceStatus) DeepCopy() *InstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Interval) DeepCopyInto(out *Interval) {
 	*out = *in
@@ -3664,6 +3767,63 @@ func (in *PDNamespaceConfig) DeepCopy() *PDNamespaceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PDRecoveryStatus) DeepCopyInto(out *PDRecoveryStatus) {
+	*out = *in
+	if in.MaxAllocatedIDs != nil {
+		in, out := &in.MaxAllocatedIDs, &out.MaxAllocatedIDs
+		*out = make(map[string]uint64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PDRecoveryStepRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PDRecoveryStatus.
+func (in *PDRecoveryStatus) DeepCopy() *PDRecoveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PDRecoveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PDRecoveryStepRecord) DeepCopyInto(out *PDRecoveryStepRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PDRecoveryStepRecord.
+func (in *PDRecoveryStepRecord) DeepCopy() *PDRecoveryStepRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(PDRecoveryStepRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PDReplicationConfig) DeepCopyInto(out *PDReplicationConfig) {
 	*out = *in
@@ -4094,6 +4254,11 @@ func (in *PDStatus) DeepCopyInto(out *PDStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Recovery != nil {
+		in, out := &in.Recovery, &out.Recovery
+		*out = new(PDRecoveryStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4824,6 +4989,22 @@ func (in *Quota) DeepCopy() *Quota {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionHealthStats) DeepCopyInto(out *RegionHealthStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionHealthStats.
+func (in *RegionHealthStats) DeepCopy() *RegionHealthStats {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionHealthStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RelabelConfig) DeepCopyInto(out *RelabelConfig) {
 	*out = *in
@@ -5164,6 +5345,86 @@ func (in *SafeTLSConfig) DeepCopy() *SafeTLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleOutRebalancePolicy) DeepCopyInto(out *ScaleOutRebalancePolicy) {
+	*out = *in
+	if in.StoreLimit != nil {
+		in, out := &in.StoreLimit, &out.StoreLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RegionScheduleLimit != nil {
+		in, out := &in.RegionScheduleLimit, &out.RegionScheduleLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LeaderScheduleLimit != nil {
+		in, out := &in.LeaderScheduleLimit, &out.LeaderScheduleLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BalanceTolerancePercent != nil {
+		in, out := &in.BalanceTolerancePercent, &out.BalanceTolerancePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleOutRebalancePolicy.
+func (in *ScaleOutRebalancePolicy) DeepCopy() *ScaleOutRebalancePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleOutRebalancePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleOutRebalanceStatus) DeepCopyInto(out *ScaleOutRebalanceStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.PodNames != nil {
+		in, out := &in.PodNames, &out.PodNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OriginalStoreLimits != nil {
+		in, out := &in.OriginalStoreLimits, &out.OriginalStoreLimits
+		*out = make(map[string]float64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.OriginalRegionScheduleLimit != nil {
+		in, out := &in.OriginalRegionScheduleLimit, &out.OriginalRegionScheduleLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OriginalLeaderScheduleLimit != nil {
+		in, out := &in.OriginalLeaderScheduleLimit, &out.OriginalLeaderScheduleLimit
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleOutRebalanceStatus.
+func (in *ScaleOutRebalanceStatus) DeepCopy() *ScaleOutRebalanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleOutRebalanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalePolicy) DeepCopyInto(out *ScalePolicy) {
 	*out = *in
@@ -5177,6 +5438,11 @@ func (in *ScalePolicy) DeepCopyInto(out *ScalePolicy) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ScaleOutRebalance != nil {
+		in, out := &in.ScaleOutRebalance, &out.ScaleOutRebalance
+		*out = new(ScaleOutRebalancePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5348,6 +5614,23 @@ func (in *ServiceSpec) DeepCopy() *ServiceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatelessFailureMember) DeepCopyInto(out *StatelessFailureMember) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatelessFailureMember.
+func (in *StatelessFailureMember) DeepCopy() *StatelessFailureMember {
+	if in == nil {
+		return nil
+	}
+	out := new(StatelessFailureMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Status) DeepCopyInto(out *Status) {
 	*out = *in
@@ -5526,6 +5809,27 @@ func (in *StorageVolumeStatus) DeepCopy() *StorageVolumeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoreDataMigrationStatus) DeepCopyInto(out *StoreDataMigrationStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.EstimatedCompletionTime != nil {
+		in, out := &in.EstimatedCompletionTime, &out.EstimatedCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreDataMigrationStatus.
+func (in *StoreDataMigrationStatus) DeepCopy() *StoreDataMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StoreDataMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuspendAction) DeepCopyInto(out *SuspendAction) {
 	*out = *in
@@ -5627,6 +5931,7 @@ func (in *ThanosSpec) DeepCopy() *ThanosSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiCDCCapture) DeepCopyInto(out *TiCDCCapture) {
 	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 	return
 }
 
@@ -5708,6 +6013,11 @@ func (in *TiCDCSpec) DeepCopyInto(out *TiCDCSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.MaxFailoverCount != nil {
+		in, out := &in.MaxFailoverCount, &out.MaxFailoverCount
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -5733,7 +6043,14 @@ func (in *TiCDCStatus) DeepCopyInto(out *TiCDCStatus) {
 		in, out := &in.Captures, &out.Captures
 		*out = make(map[string]TiCDCCapture, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.FailureMembers != nil {
+		in, out := &in.FailureMembers, &out.FailureMembers
+		*out = make(map[string]StatelessFailureMember, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
 	if in.Volumes != nil {
@@ -6072,6 +6389,23 @@ func (in *TiDBFailureMember) DeepCopy() *TiDBFailureMember {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiDBGroupSpec) DeepCopyInto(out *TiDBGroupSpec) {
+	*out = *in
+	in.TiDBSpec.DeepCopyInto(&out.TiDBSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBGroupSpec.
+func (in *TiDBGroupSpec) DeepCopy() *TiDBGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiDBGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiDBInitializer) DeepCopyInto(out *TiDBInitializer) {
 	*out = *in
@@ -6254,6 +6588,11 @@ func (in *TiDBSpec) DeepCopyInto(out *TiDBSpec) {
 		**out = **in
 	}
 	in.ScalePolicy.DeepCopyInto(&out.ScalePolicy)
+	if in.HoldOnRunningDDL != nil {
+		in, out := &in.HoldOnRunningDDL, &out.HoldOnRunningDDL
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CustomizedStartupProbe != nil {
 		in, out := &in.CustomizedStartupProbe, &out.CustomizedStartupProbe
 		*out = new(CustomizedProbe)
@@ -6333,6 +6672,11 @@ func (in *TiDBStatus) DeepCopyInto(out *TiDBStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InPlaceResize != nil {
+		in, out := &in.InPlaceResize, &out.InPlaceResize
+		*out = new(InPlaceResizeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6383,17 +6727,46 @@ func (in *TiFlashCommonConfigWraper) DeepCopy() *TiFlashCommonConfigWraper {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TiFlashConfig) DeepCopyInto(out *TiFlashConfig) {
+func (in *TiFlashComputeSpec) DeepCopyInto(out *TiFlashComputeSpec) {
 	*out = *in
-	if in.CommonConfig != nil {
-		in, out := &in.CommonConfig, &out.CommonConfig
-		*out = new(CommonConfig)
+	in.ComponentSpec.DeepCopyInto(&out.ComponentSpec)
+	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(TiFlashConfigWraper)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ProxyConfig != nil {
-		in, out := &in.ProxyConfig, &out.ProxyConfig
-		*out = new(ProxyConfig)
-		(*in).DeepCopyInto(*out)
+	if in.CacheCapacity != nil {
+		in, out := &in.CacheCapacity, &out.CacheCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	in.ScalePolicy.DeepCopyInto(&out.ScalePolicy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiFlashComputeSpec.
+func (in *TiFlashComputeSpec) DeepCopy() *TiFlashComputeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiFlashComputeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiFlashConfig) DeepCopyInto(out *TiFlashConfig) {
+	*out = *in
+	if in.CommonConfig != nil {
+		in, out := &in.CommonConfig, &out.CommonConfig
+		*out = new(CommonConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProxyConfig != nil {
+		in, out := &in.ProxyConfig, &out.ProxyConfig
+		*out = new(ProxyConfig)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -6434,6 +6807,28 @@ func (in *TiFlashConfigWraper) DeepCopy() *TiFlashConfigWraper {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiFlashDisaggregatedSpec) DeepCopyInto(out *TiFlashDisaggregatedSpec) {
+	*out = *in
+	out.S3 = in.S3
+	if in.Compute != nil {
+		in, out := &in.Compute, &out.Compute
+		*out = new(TiFlashComputeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiFlashDisaggregatedSpec.
+func (in *TiFlashDisaggregatedSpec) DeepCopy() *TiFlashDisaggregatedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiFlashDisaggregatedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiFlashProxyConfigWraper) DeepCopyInto(out *TiFlashProxyConfigWraper) {
 	*out = *in
@@ -6454,6 +6849,22 @@ func (in *TiFlashProxyConfigWraper) DeepCopy() *TiFlashProxyConfigWraper {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiFlashS3Storage) DeepCopyInto(out *TiFlashS3Storage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiFlashS3Storage.
+func (in *TiFlashS3Storage) DeepCopy() *TiFlashS3Storage {
+	if in == nil {
+		return nil
+	}
+	out := new(TiFlashS3Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiFlashSpec) DeepCopyInto(out *TiFlashSpec) {
 	*out = *in
@@ -6497,6 +6908,16 @@ func (in *TiFlashSpec) DeepCopyInto(out *TiFlashSpec) {
 		**out = **in
 	}
 	in.ScalePolicy.DeepCopyInto(&out.ScalePolicy)
+	if in.UpgradePolicy != nil {
+		in, out := &in.UpgradePolicy, &out.UpgradePolicy
+		*out = new(UpgradePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disaggregated != nil {
+		in, out := &in.Disaggregated, &out.Disaggregated
+		*out = new(TiFlashDisaggregatedSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6568,6 +6989,31 @@ func (in *TiFlashStatus) DeepCopyInto(out *TiFlashStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RegionHealthBaseline != nil {
+		in, out := &in.RegionHealthBaseline, &out.RegionHealthBaseline
+		*out = new(RegionHealthStats)
+		**out = **in
+	}
+	if in.ImagePrePull != nil {
+		in, out := &in.ImagePrePull, &out.ImagePrePull
+		*out = new(ImagePrePullStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]*InstanceStatus, len(*in))
+		for key, val := range *in {
+			var outVal *InstanceStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(InstanceStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	return
 }
 
@@ -7576,6 +8022,23 @@ func (in *TiKVPessimisticTxn) DeepCopy() *TiKVPessimisticTxn {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiKVPoolSpec) DeepCopyInto(out *TiKVPoolSpec) {
+	*out = *in
+	in.TiKVSpec.DeepCopyInto(&out.TiKVSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiKVPoolSpec.
+func (in *TiKVPoolSpec) DeepCopy() *TiKVPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiKVPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiKVRaftDBConfig) DeepCopyInto(out *TiKVRaftDBConfig) {
 	*out = *in
@@ -8375,6 +8838,11 @@ func (in *TiKVSpec) DeepCopyInto(out *TiKVSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.WaitRegionHealthyTimeout != nil {
+		in, out := &in.WaitRegionHealthyTimeout, &out.WaitRegionHealthyTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.StorageVolumes != nil {
 		in, out := &in.StorageVolumes, &out.StorageVolumes
 		*out = make([]StorageVolume, len(*in))
@@ -8388,6 +8856,11 @@ func (in *TiKVSpec) DeepCopyInto(out *TiKVSpec) {
 		copy(*out, *in)
 	}
 	in.ScalePolicy.DeepCopyInto(&out.ScalePolicy)
+	if in.UpgradePolicy != nil {
+		in, out := &in.UpgradePolicy, &out.UpgradePolicy
+		*out = new(UpgradePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SpareVolReplaceReplicas != nil {
 		in, out := &in.SpareVolReplaceReplicas, &out.SpareVolReplaceReplicas
 		*out = new(int32)
@@ -8479,6 +8952,46 @@ func (in *TiKVStatus) DeepCopyInto(out *TiKVStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ScaleOutRebalance != nil {
+		in, out := &in.ScaleOutRebalance, &out.ScaleOutRebalance
+		*out = new(ScaleOutRebalanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnsafeRecovery != nil {
+		in, out := &in.UnsafeRecovery, &out.UnsafeRecovery
+		*out = new(TiKVUnsafeRecoveryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RegionHealthBaseline != nil {
+		in, out := &in.RegionHealthBaseline, &out.RegionHealthBaseline
+		*out = new(RegionHealthStats)
+		**out = **in
+	}
+	if in.ImagePrePull != nil {
+		in, out := &in.ImagePrePull, &out.ImagePrePull
+		*out = new(ImagePrePullStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InPlaceResize != nil {
+		in, out := &in.InPlaceResize, &out.InPlaceResize
+		*out = new(InPlaceResizeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]*InstanceStatus, len(*in))
+		for key, val := range *in {
+			var outVal *InstanceStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(InstanceStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	return
 }
 
@@ -8608,6 +9121,11 @@ func (in *TiKVStore) DeepCopyInto(out *TiKVStore) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.DataMigration != nil {
+		in, out := &in.DataMigration, &out.DataMigration
+		*out = new(StoreDataMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -8769,6 +9287,37 @@ func (in *TiKVUnifiedReadPoolConfig) DeepCopy() *TiKVUnifiedReadPoolConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiKVUnsafeRecoveryStatus) DeepCopyInto(out *TiKVUnsafeRecoveryStatus) {
+	*out = *in
+	if in.FailedStores != nil {
+		in, out := &in.FailedStores, &out.FailedStores
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Details != nil {
+		in, out := &in.Details, &out.Details
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiKVUnsafeRecoveryStatus.
+func (in *TiKVUnsafeRecoveryStatus) DeepCopy() *TiKVUnsafeRecoveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TiKVUnsafeRecoveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiProxyConfigWraper) DeepCopyInto(out *TiProxyConfigWraper) {
 	*out = *in
@@ -8840,6 +9389,11 @@ func (in *TiProxySpec) DeepCopyInto(out *TiProxySpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.MaxFailoverCount != nil {
+		in, out := &in.MaxFailoverCount, &out.MaxFailoverCount
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -8863,6 +9417,13 @@ func (in *TiProxyStatus) DeepCopyInto(out *TiProxyStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.FailureMembers != nil {
+		in, out := &in.FailureMembers, &out.FailureMembers
+		*out = make(map[string]StatelessFailureMember, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.StatefulSet != nil {
 		in, out := &in.StatefulSet, &out.StatefulSet
 		*out = new(appsv1.StatefulSetStatus)
@@ -8949,6 +9510,169 @@ func (in *TidbClusterCondition) DeepCopy() *TidbClusterCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TidbClusterDiagnostics) DeepCopyInto(out *TidbClusterDiagnostics) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TidbClusterDiagnostics.
+func (in *TidbClusterDiagnostics) DeepCopy() *TidbClusterDiagnostics {
+	if in == nil {
+		return nil
+	}
+	out := new(TidbClusterDiagnostics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TidbClusterDiagnostics) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TidbClusterDiagnosticsList) DeepCopyInto(out *TidbClusterDiagnosticsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TidbClusterDiagnostics, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TidbClusterDiagnosticsList.
+func (in *TidbClusterDiagnosticsList) DeepCopy() *TidbClusterDiagnosticsList {
+	if in == nil {
+		return nil
+	}
+	out := new(TidbClusterDiagnosticsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TidbClusterDiagnosticsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TidbClusterDiagnosticsSpec) DeepCopyInto(out *TidbClusterDiagnosticsSpec) {
+	*out = *in
+	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
+	out.Cluster = in.Cluster
+	in.StorageProvider.DeepCopyInto(&out.StorageProvider)
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]MemberType, len(*in))
+		copy(*out, *in)
+	}
+	if in.LogSinceSeconds != nil {
+		in, out := &in.LogSinceSeconds, &out.LogSinceSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LogLimitBytes != nil {
+		in, out := &in.LogLimitBytes, &out.LogLimitBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EventLimit != nil {
+		in, out := &in.EventLimit, &out.EventLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodSecurityContext != nil {
+		in, out := &in.PodSecurityContext, &out.PodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TidbClusterDiagnosticsSpec.
+func (in *TidbClusterDiagnosticsSpec) DeepCopy() *TidbClusterDiagnosticsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TidbClusterDiagnosticsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TidbClusterDiagnosticsStatus) DeepCopyInto(out *TidbClusterDiagnosticsStatus) {
+	*out = *in
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TidbClusterDiagnosticsStatus.
+func (in *TidbClusterDiagnosticsStatus) DeepCopy() *TidbClusterDiagnosticsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TidbClusterDiagnosticsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TidbClusterList) DeepCopyInto(out *TidbClusterList) {
 	*out = *in
@@ -8982,6 +9706,27 @@ func (in *TidbClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TidbClusterOperation) DeepCopyInto(out *TidbClusterOperation) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TidbClusterOperation.
+func (in *TidbClusterOperation) DeepCopy() *TidbClusterOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(TidbClusterOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TidbClusterRef) DeepCopyInto(out *TidbClusterRef) {
 	*out = *in
@@ -9023,11 +9768,25 @@ func (in *TidbClusterSpec) DeepCopyInto(out *TidbClusterSpec) {
 		*out = new(TiDBSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TiDBGroups != nil {
+		in, out := &in.TiDBGroups, &out.TiDBGroups
+		*out = make([]TiDBGroupSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.TiKV != nil {
 		in, out := &in.TiKV, &out.TiKV
 		*out = new(TiKVSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TiKVPools != nil {
+		in, out := &in.TiKVPools, &out.TiKVPools
+		*out = make([]TiKVPoolSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.TiFlash != nil {
 		in, out := &in.TiFlash, &out.TiFlash
 		*out = new(TiFlashSpec)
@@ -9168,6 +9927,11 @@ func (in *TidbClusterSpec) DeepCopyInto(out *TidbClusterSpec) {
 		*out = make([]StartScriptV2FeatureFlag, len(*in))
 		copy(*out, *in)
 	}
+	if in.OperationHistoryLimit != nil {
+		in, out := &in.OperationHistoryLimit, &out.OperationHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -9213,6 +9977,48 @@ func (in *TidbClusterStatus) DeepCopyInto(out *TidbClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TiDBGroups != nil {
+		in, out := &in.TiDBGroups, &out.TiDBGroups
+		*out = make(map[string]*TiDBStatus, len(*in))
+		for key, val := range *in {
+			var outVal *TiDBStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(TiDBStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.TiKVPools != nil {
+		in, out := &in.TiKVPools, &out.TiKVPools
+		*out = make(map[string]*TiKVStatus, len(*in))
+		for key, val := range *in {
+			var outVal *TiKVStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(TiKVStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.TiFlashCompute != nil {
+		in, out := &in.TiFlashCompute, &out.TiFlashCompute
+		*out = new(TiFlashStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]TidbClusterOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -9947,6 +10753,27 @@ func (in *UnjoinedMember) DeepCopy() *UnjoinedMember {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePolicy) DeepCopyInto(out *UpgradePolicy) {
+	*out = *in
+	if in.ImagePrePull != nil {
+		in, out := &in.ImagePrePull, &out.ImagePrePull
+		*out = new(ImagePrePullPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePolicy.
+func (in *UpgradePolicy) DeepCopy() *UpgradePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -1031,7 +1031,9 @@ func (bm *backupManager) ensureBackupPVCExist(backup *v1alpha1.Backup) (string,
 		return "ParseStorageSizeFailed", errMsg
 	}
 	backupPVCName := backup.GetBackupPVCName()
-	pvc, err := bm.deps.PVCLister.PersistentVolumeClaims(ns).Get(backupPVCName)
+	// the PVC may be created by the user without the labels of the operator, which is not
+	// in the informer cache if FilterInformerCache is enabled, so get it from the API server
+	pvc, err := bm.deps.KubeClientset.CoreV1().PersistentVolumeClaims(ns).Get(context.TODO(), backupPVCName, metav1.GetOptions{})
 
 	if err == nil {
 		if pvcRs := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; pvcRs.Cmp(rs) == -1 {
@@ -73,15 +73,26 @@ func NewBackupTracker(deps *controller.Dependencies, statusUpdater controller.Ba
 
 // initTrackLogBackupsProgress lists all log backups and track their progress.
 func (bt *backupTracker) initTrackLogBackupsProgress() {
+	namespaces := bt.deps.WatchedNamespaces()
+	if namespaces == nil {
+		ns := ""
+		if !bt.deps.CLIConfig.ClusterScoped {
+			ns = os.Getenv("NAMESPACE")
+		}
+		namespaces = []string{ns}
+	}
+
+	for _, ns := range namespaces {
+		bt.initTrackLogBackupsProgressInNamespace(ns)
+	}
+}
+
+// initTrackLogBackupsProgressInNamespace lists the log backups in a namespace and track their progress.
+func (bt *backupTracker) initTrackLogBackupsProgressInNamespace(ns string) {
 	var (
 		backups *v1alpha1.BackupList
 		err     error
 	)
-	ns := ""
-	if !bt.deps.CLIConfig.ClusterScoped {
-		ns = os.Getenv("NAMESPACE")
-	}
-
 	err = retry.OnError(retry.DefaultRetry, func(e error) bool { return e != nil }, func() error {
 		backups, err = bt.deps.Clientset.PingcapV1alpha1().Backups(ns).List(context.TODO(), metav1.ListOptions{})
 		if err != nil {
@@ -91,11 +102,11 @@ func (bt *backupTracker) initTrackLogBackupsProgress() {
 		return nil
 	})
 	if err != nil {
-		klog.Errorf("list backups error %v after retry, skip track all log backups progress when init, will track when log backup start", err)
+		klog.Errorf("list backups from namespace %s error %v after retry, skip track all log backups progress when init, will track when log backup start", ns, err)
 		return
 	}
 
-	klog.Infof("list backups success, size %d", len(backups.Items))
+	klog.Infof("list backups from namespace %s success, size %d", ns, len(backups.Items))
 	for i := range backups.Items {
 		backup := backups.Items[i]
 		if backup.Spec.Mode == v1alpha1.BackupModeLog {
@@ -1575,10 +1575,14 @@ func (rm *restoreManager) ensureRestorePVCExist(restore *v1alpha1.Restore) (stri
 	}
 
 	restorePVCName := restore.GetRestorePVCName()
-	pvc, err := rm.deps.PVCLister.PersistentVolumeClaims(ns).Get(restorePVCName)
+	// the PVC may be created by the user without the labels of the operator, which is not
+	// in the informer cache if FilterInformerCache is enabled, so get it from the API server
+	pvc, err := rm.deps.KubeClientset.CoreV1().PersistentVolumeClaims(ns).Get(context.TODO(), restorePVCName, metav1.GetOptions{})
 	if err != nil {
-		// get the object from the local cache, the error can only be IsNotFound,
-		// so we need to create PVC for restore job
+		if !errors.IsNotFound(err) {
+			return "GetPVCFailed", fmt.Errorf("restore %s/%s get pvc %s failed, err: %v", ns, name, restorePVCName, err)
+		}
+		// not found PVC, so we need to create PVC for restore job
 		pvc := &corev1.PersistentVolumeClaim{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      restorePVCName,
@@ -32,6 +32,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/pointer"
@@ -1272,3 +1273,37 @@ grpc-keepalive-timeout = "30s"
 		return nil
 	}, time.Second*10).Should(BeNil())
 }
+
+func TestEnsureRestorePVCExistWithUserPVC(t *testing.T) {
+	g := NewGomegaWithT(t)
+	helper := newHelper(t)
+	defer helper.Close()
+	deps := helper.Deps
+
+	restore := validDumpRestore.DeepCopy()
+	restore.Namespace = "ns"
+	restore.Name = "name"
+	restore.Spec.StorageSize = "10Gi"
+
+	// the PVC created by the user has no labels of the operator, so it is not in the informer cache
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: restore.GetRestorePVCName(), Namespace: restore.Namespace},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+		},
+	}
+	_, err := deps.KubeClientset.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.TODO(), pvc, metav1.CreateOptions{})
+	g.Expect(err).Should(BeNil())
+
+	rm := NewRestoreManager(deps).(*restoreManager)
+	reason, err := rm.ensureRestorePVCExist(restore)
+	g.Expect(reason).To(Equal("PVCStorageSizeTooSmall"))
+	g.Expect(err).Should(HaveOccurred())
+
+	restore.Spec.StorageSize = "1Gi"
+	reason, err = rm.ensureRestorePVCExist(restore)
+	g.Expect(reason).To(BeEmpty())
+	g.Expect(err).Should(BeNil())
+}
@@ -53,6 +53,10 @@ func (c *FakePingcapV1alpha1) TidbClusters(namespace string) v1alpha1.TidbCluste
 	return &FakeTidbClusters{c, namespace}
 }
 
+func (c *FakePingcapV1alpha1) TidbClusterDiagnostics(namespace string) v1alpha1.TidbClusterDiagnosticsInterface {
+	return &FakeTidbClusterDiagnostics{c, namespace}
+}
+
 func (c *FakePingcapV1alpha1) TidbDashboards(namespace string) v1alpha1.TidbDashboardInterface {
 	return &FakeTidbDashboards{c, namespace}
 }
@@ -0,0 +1,138 @@
+// Copyright PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeTidbClusterDiagnostics implements TidbClusterDiagnosticsInterface
+type FakeTidbClusterDiagnostics struct {
+	Fake *FakePingcapV1alpha1
+	ns   string
+}
+
+var tidbclusterdiagnosticsResource = v1alpha1.SchemeGroupVersion.WithResource("tidbclusterdiagnostics")
+
+var tidbclusterdiagnosticsKind = v1alpha1.SchemeGroupVersion.WithKind("TidbClusterDiagnostics")
+
+// Get takes name of the tidbClusterDiagnostics, and returns the corresponding tidbClusterDiagnostics object, and an error if there is any.
+func (c *FakeTidbClusterDiagnostics) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.TidbClusterDiagnostics, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(tidbclusterdiagnosticsResource, c.ns, name), &v1alpha1.TidbClusterDiagnostics{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TidbClusterDiagnostics), err
+}
+
+// List takes label and field selectors, and returns the list of TidbClusterDiagnostics that match those selectors.
+func (c *FakeTidbClusterDiagnostics) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.TidbClusterDiagnosticsList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(tidbclusterdiagnosticsResource, tidbclusterdiagnosticsKind, c.ns, opts), &v1alpha1.TidbClusterDiagnosticsList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.TidbClusterDiagnosticsList{ListMeta: obj.(*v1alpha1.TidbClusterDiagnosticsList).ListMeta}
+	for _, item := range obj.(*v1alpha1.TidbClusterDiagnosticsList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested tidbClusterDiagnostics.
+func (c *FakeTidbClusterDiagnostics) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(tidbclusterdiagnosticsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a tidbClusterDiagnostics and creates it.  Returns the server's representation of the tidbClusterDiagnostics, and an error, if there is any.
+func (c *FakeTidbClusterDiagnostics) Create(ctx context.Context, tidbClusterDiagnostics *v1alpha1.TidbClusterDiagnostics, opts v1.CreateOptions) (result *v1alpha1.TidbClusterDiagnostics, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(tidbclusterdiagnosticsResource, c.ns, tidbClusterDiagnostics), &v1alpha1.TidbClusterDiagnostics{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TidbClusterDiagnostics), err
+}
+
+// Update takes the representation of a tidbClusterDiagnostics and updates it. Returns the server's representation of the tidbClusterDiagnostics, and an error, if there is any.
+func (c *FakeTidbClusterDiagnostics) Update(ctx context.Context, tidbClusterDiagnostics *v1alpha1.TidbClusterDiagnostics, opts v1.UpdateOptions) (result *v1alpha1.TidbClusterDiagnostics, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(tidbclusterdiagnosticsResource, c.ns, tidbClusterDiagnostics), &v1alpha1.TidbClusterDiagnostics{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TidbClusterDiagnostics), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeTidbClusterDiagnostics) UpdateStatus(ctx context.Context, tidbClusterDiagnostics *v1alpha1.TidbClusterDiagnostics, opts v1.UpdateOptions) (*v1alpha1.TidbClusterDiagnostics, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(tidbclusterdiagnosticsResource, "status", c.ns, tidbClusterDiagnostics), &v1alpha1.TidbClusterDiagnostics{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TidbClusterDiagnostics), err
+}
+
+// Delete takes name of the tidbClusterDiagnostics and deletes it. Returns an error if one occurs.
+func (c *FakeTidbClusterDiagnostics) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(tidbclusterdiagnosticsResource, c.ns, name, opts), &v1alpha1.TidbClusterDiagnostics{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeTidbClusterDiagnostics) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(tidbclusterdiagnosticsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.TidbClusterDiagnosticsList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched tidbClusterDiagnostics.
+func (c *FakeTidbClusterDiagnostics) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.TidbClusterDiagnostics, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(tidbclusterdiagnosticsResource, c.ns, name, pt, data, subresources...), &v1alpha1.TidbClusterDiagnostics{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TidbClusterDiagnostics), err
+}
@@ -29,6 +29,8 @@ type RestoreExpansion interface{}
 
 type TidbClusterExpansion interface{}
 
+type TidbClusterDiagnosticsExpansion interface{}
+
 type TidbDashboardExpansion interface{}
 
 type TidbInitializerExpansion interface{}
@@ -32,6 +32,7 @@ type PingcapV1alpha1Interface interface {
 	DataResourcesGetter
 	RestoresGetter
 	TidbClustersGetter
+	TidbClusterDiagnosticsGetter
 	TidbDashboardsGetter
 	TidbInitializersGetter
 	TidbMonitorsGetter
@@ -71,6 +72,10 @@ func (c *PingcapV1alpha1Client) TidbClusters(namespace string) TidbClusterInterf
 	return newTidbClusters(c, namespace)
 }
 
+func (c *PingcapV1alpha1Client) TidbClusterDiagnostics(namespace string) TidbClusterDiagnosticsInterface {
+	return newTidbClusterDiagnostics(c, namespace)
+}
+
 func (c *PingcapV1alpha1Client) TidbDashboards(namespace string) TidbDashboardInterface {
 	return newTidbDashboards(c, namespace)
 }
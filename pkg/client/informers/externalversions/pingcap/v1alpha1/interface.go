@@ -35,6 +35,8 @@ type Interface interface {
 	Restores() RestoreInformer
 	// TidbClusters returns a TidbClusterInformer.
 	TidbClusters() TidbClusterInformer
+	// TidbClusterDiagnostics returns a TidbClusterDiagnosticsInformer.
+	TidbClusterDiagnostics() TidbClusterDiagnosticsInformer
 	// TidbDashboards returns a TidbDashboardInformer.
 	TidbDashboards() TidbDashboardInformer
 	// TidbInitializers returns a TidbInitializerInformer.
@@ -91,6 +93,11 @@ func (v *version) TidbClusters() TidbClusterInformer {
 	return &tidbClusterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TidbClusterDiagnostics returns a TidbClusterDiagnosticsInformer.
+func (v *version) TidbClusterDiagnostics() TidbClusterDiagnosticsInformer {
+	return &tidbClusterDiagnosticsInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TidbDashboards returns a TidbDashboardInformer.
 func (v *version) TidbDashboards() TidbDashboardInformer {
 	return &tidbDashboardInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -71,6 +71,14 @@ type TidbClusterListerExpansion interface{}
 // TidbClusterNamespaceLister.
 type TidbClusterNamespaceListerExpansion interface{}
 
+// TidbClusterDiagnosticsListerExpansion allows custom methods to be added to
+// TidbClusterDiagnosticsLister.
+type TidbClusterDiagnosticsListerExpansion interface{}
+
+// TidbClusterDiagnosticsNamespaceListerExpansion allows custom methods to be added to
+// TidbClusterDiagnosticsNamespaceLister.
+type TidbClusterDiagnosticsNamespaceListerExpansion interface{}
+
 // TidbDashboardListerExpansion allows custom methods to be added to
 // TidbDashboardLister.
 type TidbDashboardListerExpansion interface{}
@@ -0,0 +1,96 @@
+// Copyright PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TidbClusterDiagnosticsLister helps list TidbClusterDiagnostics.
+// All objects returned here must be treated as read-only.
+type TidbClusterDiagnosticsLister interface {
+	// List lists all TidbClusterDiagnostics in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.TidbClusterDiagnostics, err error)
+	// TidbClusterDiagnostics returns an object that can list and get TidbClusterDiagnostics.
+	TidbClusterDiagnostics(namespace string) TidbClusterDiagnosticsNamespaceLister
+	TidbClusterDiagnosticsListerExpansion
+}
+
+// tidbClusterDiagnosticsLister implements the TidbClusterDiagnosticsLister interface.
+type tidbClusterDiagnosticsLister struct {
+	indexer cache.Indexer
+}
+
+// NewTidbClusterDiagnosticsLister returns a new TidbClusterDiagnosticsLister.
+func NewTidbClusterDiagnosticsLister(indexer cache.Indexer) TidbClusterDiagnosticsLister {
+	return &tidbClusterDiagnosticsLister{indexer: indexer}
+}
+
+// List lists all TidbClusterDiagnostics in the indexer.
+func (s *tidbClusterDiagnosticsLister) List(selector labels.Selector) (ret []*v1alpha1.TidbClusterDiagnostics, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.TidbClusterDiagnostics))
+	})
+	return ret, err
+}
+
+// TidbClusterDiagnostics returns an object that can list and get TidbClusterDiagnostics.
+func (s *tidbClusterDiagnosticsLister) TidbClusterDiagnostics(namespace string) TidbClusterDiagnosticsNamespaceLister {
+	return tidbClusterDiagnosticsNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// TidbClusterDiagnosticsNamespaceLister helps list and get TidbClusterDiagnostics.
+// All objects returned here must be treated as read-only.
+type TidbClusterDiagnosticsNamespaceLister interface {
+	// List lists all TidbClusterDiagnostics in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.TidbClusterDiagnostics, err error)
+	// Get retrieves the TidbClusterDiagnostics from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.TidbClusterDiagnostics, error)
+	TidbClusterDiagnosticsNamespaceListerExpansion
+}
+
+// tidbClusterDiagnosticsNamespaceLister implements the TidbClusterDiagnosticsNamespaceLister
+// interface.
+type tidbClusterDiagnosticsNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all TidbClusterDiagnostics in the indexer for a given namespace.
+func (s tidbClusterDiagnosticsNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.TidbClusterDiagnostics, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.TidbClusterDiagnostics))
+	})
+	return ret, err
+}
+
+// Get retrieves the TidbClusterDiagnostics from the indexer for a given namespace and name.
+func (s tidbClusterDiagnosticsNamespaceLister) Get(name string) (*v1alpha1.TidbClusterDiagnostics, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("tidbclusterdiagnostics"), name)
+	}
+	return obj.(*v1alpha1.TidbClusterDiagnostics), nil
+}
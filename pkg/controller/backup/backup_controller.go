@@ -19,9 +19,12 @@ import (
 
 	perrors "github.com/pingcap/errors"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
 	"github.com/pingcap/tidb-operator/pkg/backup/backup"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"github.com/pingcap/tidb-operator/pkg/util/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -59,9 +62,11 @@ func NewController(deps *controller.Dependencies) *Controller {
 	backupInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: c.updateBackup,
 		UpdateFunc: func(old, cur interface{}) {
+			recordBackupLifecycle(old.(*v1alpha1.Backup), cur.(*v1alpha1.Backup))
+			observeBackupMetrics(old.(*v1alpha1.Backup), cur.(*v1alpha1.Backup))
 			c.updateBackup(cur)
 		},
-		DeleteFunc: c.updateBackup,
+		DeleteFunc: c.deleteBackup,
 	})
 	jobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		DeleteFunc: c.deleteJob,
@@ -158,10 +163,121 @@ func (c *Controller) sync(key string) (err error) {
 	return c.syncBackup(backup.DeepCopy())
 }
 
-func (c *Controller) syncBackup(backup *v1alpha1.Backup) error {
+func (c *Controller) syncBackup(backup *v1alpha1.Backup) (err error) {
+	span := tracing.StartSpan("UpdateBackup", tracing.Key{Kind: v1alpha1.BackupKind, Namespace: backup.Namespace, Name: backup.Name})
+	defer func() { span.End(err) }()
 	return c.control.UpdateBackup(backup)
 }
 
+// recordBackupLifecycle records the span from the start to the end of the backup when it's complete or failed
+func recordBackupLifecycle(oldBackup, newBackup *v1alpha1.Backup) {
+	if v1alpha1.IsBackupComplete(oldBackup) || v1alpha1.IsBackupFailed(oldBackup) {
+		return
+	}
+	var err error
+	if v1alpha1.IsBackupFailed(newBackup) {
+		_, condition := v1alpha1.GetBackupCondition(&newBackup.Status, v1alpha1.BackupFailed)
+		if condition != nil {
+			err = fmt.Errorf("%s: %s", condition.Reason, condition.Message)
+		} else {
+			err = fmt.Errorf("backup failed")
+		}
+	} else if !v1alpha1.IsBackupComplete(newBackup) {
+		return
+	}
+
+	start, end := newBackup.Status.TimeStarted.Time, newBackup.Status.TimeCompleted.Time
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if start.IsZero() {
+		start = newBackup.CreationTimestamp.Time
+	}
+	attrs := []attribute.KeyValue{tracing.BackupModeKey.String(string(newBackup.Spec.Mode))}
+	if br := newBackup.Spec.BR; br != nil {
+		attrs = append(attrs, tracing.ClusterAttributes(br.ClusterNamespace, br.Cluster, newBackup.Namespace)...)
+	}
+	key := tracing.Key{Kind: v1alpha1.BackupKind, Namespace: newBackup.Namespace, Name: newBackup.Name}
+	tracing.RecordSpan("Backup", key, start, end, err, attrs...)
+}
+
+// observeBackupMetrics records the duration, size and completion time of the backup when it's complete or failed,
+// and the checkpoint lag of the log backup
+func observeBackupMetrics(oldBackup, newBackup *v1alpha1.Backup) {
+	ns, tcName := newBackup.Namespace, backupClusterName(newBackup)
+	backupType := string(newBackup.Spec.Mode)
+	if backupType == "" {
+		backupType = string(v1alpha1.BackupModeSnapshot)
+	}
+
+	if newBackup.Spec.Mode == v1alpha1.BackupModeLog {
+		if checkpoint, err := config.ParseTSStringToGoTime(newBackup.Status.LogCheckpointTs); err == nil && !checkpoint.IsZero() {
+			metrics.LogBackupCheckpointLag.WithLabelValues(ns, newBackup.Name, tcName).Set(time.Since(checkpoint).Seconds())
+		}
+		// the log backup runs until it's stopped, its duration is meaningless
+		return
+	}
+
+	if v1alpha1.IsBackupComplete(oldBackup) || v1alpha1.IsBackupFailed(oldBackup) {
+		return
+	}
+	status := ""
+	switch {
+	case v1alpha1.IsBackupComplete(newBackup):
+		status = string(v1alpha1.BackupComplete)
+	case v1alpha1.IsBackupFailed(newBackup):
+		status = string(v1alpha1.BackupFailed)
+	default:
+		return
+	}
+
+	started, completed := newBackup.Status.TimeStarted.Time, newBackup.Status.TimeCompleted.Time
+	if !started.IsZero() && !completed.IsZero() {
+		metrics.BackupDuration.WithLabelValues(ns, tcName, backupType, status).Observe(completed.Sub(started).Seconds())
+	}
+	if status == string(v1alpha1.BackupComplete) {
+		metrics.BackupSize.WithLabelValues(ns, tcName, backupType).Set(float64(newBackup.Status.BackupSize))
+		if completed.IsZero() {
+			completed = time.Now()
+		}
+		metrics.BackupLastSuccessTimestamp.WithLabelValues(ns, tcName, backupType).Set(float64(completed.Unix()))
+	}
+}
+
+// backupClusterName returns the name of the TidbCluster backed up, it's empty for the backups not using BR
+func backupClusterName(backup *v1alpha1.Backup) string {
+	if backup.Spec.BR == nil {
+		return ""
+	}
+	return backup.Spec.BR.Cluster
+}
+
+// deleteBackup cleans up the metrics of the backup and enqueues it, accounting for deletion tombstones.
+func (c *Controller) deleteBackup(obj interface{}) {
+	backup, ok := obj.(*v1alpha1.Backup)
+
+	// When a delete is dropped, the relist will notice a backup in the store not
+	// in the list, leading to the insertion of a tombstone object which contains
+	// the deleted key/value.
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("couldn't get object from tombstone %+v", obj))
+			return
+		}
+		backup, ok = tombstone.Obj.(*v1alpha1.Backup)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("tombstone contained object that is not a backup %+v", obj))
+			return
+		}
+	}
+
+	if backup.Spec.Mode == v1alpha1.BackupModeLog {
+		metrics.LogBackupCheckpointLag.DeleteLabelValues(backup.Namespace, backup.Name, backupClusterName(backup))
+	}
+	c.updateBackup(backup)
+}
+
 func (c *Controller) updateBackup(cur interface{}) {
 	newBackup := cur.(*v1alpha1.Backup)
 	ns := newBackup.GetNamespace()
@@ -23,6 +23,8 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -206,6 +208,37 @@ func TestBackupControllerUpdateBackup(t *testing.T) {
 	}
 }
 
+func TestBackupControllerDeleteBackup(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		name      string
+		tombstone bool
+	}{
+		{name: "backup"},
+		{name: "tombstone", tombstone: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backup := newBackup()
+			backup.Spec.Mode = v1alpha1.BackupModeLog
+			backup.Spec.BR = &v1alpha1.BRConfig{Cluster: "demo1"}
+			bkc, _, _ := newFakeBackupController()
+
+			metrics.LogBackupCheckpointLag.WithLabelValues(backup.Namespace, backup.Name, "demo1").Set(60)
+			g.Expect(testutil.CollectAndCount(metrics.LogBackupCheckpointLag)).To(Equal(1))
+
+			var obj interface{} = backup
+			if tt.tombstone {
+				obj = cache.DeletedFinalStateUnknown{Key: backup.Namespace + "/" + backup.Name, Obj: backup}
+			}
+			bkc.deleteBackup(obj)
+			g.Expect(testutil.CollectAndCount(metrics.LogBackupCheckpointLag)).To(Equal(0))
+		})
+	}
+}
+
 func TestBackupControllerSync(t *testing.T) {
 	g := NewGomegaWithT(t)
 	type testcase struct {
@@ -52,6 +52,9 @@ var (
 	// CompactBackupControllerKind contains the schema.GroupVersionKind for backup controller type.
 	CompactBackupControllerKind = v1alpha1.SchemeGroupVersion.WithKind("CompactBackup")
 
+	// DiagnosticsControllerKind contains the schema.GroupVersionKind for diagnostics controller type.
+	DiagnosticsControllerKind = v1alpha1.SchemeGroupVersion.WithKind("TidbClusterDiagnostics")
+
 	// RestoreControllerKind contains the schema.GroupVersionKind for restore controller type.
 	RestoreControllerKind = v1alpha1.SchemeGroupVersion.WithKind("Restore")
 
@@ -124,7 +127,7 @@ func GetOwnerRef(tc *v1alpha1.TidbCluster) metav1.OwnerReference {
 	return metav1.OwnerReference{
 		APIVersion:         ControllerKind.GroupVersion().String(),
 		Kind:               ControllerKind.Kind,
-		Name:               tc.GetName(),
+		Name:               tc.GetOwnerName(),
 		UID:                tc.GetUID(),
 		Controller:         &controller,
 		BlockOwnerDeletion: &blockOwnerDeletion,
@@ -173,6 +176,20 @@ func GetCompactBackupOwnerRef(backup *v1alpha1.CompactBackup) metav1.OwnerRefere
 	}
 }
 
+// GetDiagnosticsOwnerRef returns TidbClusterDiagnostics's OwnerReference
+func GetDiagnosticsOwnerRef(diag *v1alpha1.TidbClusterDiagnostics) metav1.OwnerReference {
+	controller := true
+	blockOwnerDeletion := true
+	return metav1.OwnerReference{
+		APIVersion:         DiagnosticsControllerKind.GroupVersion().String(),
+		Kind:               DiagnosticsControllerKind.Kind,
+		Name:               diag.GetName(),
+		UID:                diag.GetUID(),
+		Controller:         &controller,
+		BlockOwnerDeletion: &blockOwnerDeletion,
+	}
+}
+
 // GetRestoreOwnerRef returns Restore's OwnerReference
 func GetRestoreOwnerRef(restore *v1alpha1.Restore) metav1.OwnerReference {
 	controller := true
@@ -17,6 +17,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -50,6 +51,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/tiflashapi"
 	"github.com/pingcap/tidb-operator/pkg/tikvapi"
 	utildiscovery "github.com/pingcap/tidb-operator/pkg/util/discovery"
+	"github.com/pingcap/tidb-operator/pkg/util/tracing"
 )
 
 // CLIConfig is used save all configuration read from command line parameters
@@ -74,6 +76,8 @@ type CLIConfig struct {
 	TiFlashFailoverPeriod time.Duration
 	MasterFailoverPeriod  time.Duration
 	WorkerFailoverPeriod  time.Duration
+	TiProxyFailoverPeriod time.Duration
+	TiCDCFailoverPeriod   time.Duration
 	LeaseDuration         time.Duration
 	RenewDeadline         time.Duration
 	RetryPeriod           time.Duration
@@ -93,6 +97,22 @@ type CLIConfig struct {
 	// Selector is used to filter CR labels to decide
 	// what resources should be watched and synced by controller
 	Selector string
+	// FilterInformerCache only caches the Kubernetes objects created by tidb-operator in the informers, and reads
+	// the objects created by the users such as the secrets from the API server directly
+	FilterInformerCache bool
+	// WatchNamespaces is the comma separated namespaces managed by the operator, it's used if the operator
+	// manages multiple namespaces without the cluster wide permissions
+	WatchNamespaces string
+	// WatchNamespaceSelector selects the namespaces managed by the operator by their labels, the namespaces
+	// labeled later are also managed
+	WatchNamespaceSelector string
+	// TracingEndpoint is the address of the OTLP gRPC receiver to export the spans, tracing is disabled if it's
+	// empty
+	TracingEndpoint string
+	// TracingInsecure disables the TLS of the connection to the OTLP receiver
+	TracingInsecure bool
+	// TracingSamplingRatio is the ratio of the reconciliations traced
+	TracingSamplingRatio float64
 
 	// KubeClientQPS indicates the maximum QPS to the kubenetes API server from client.
 	KubeClientQPS   float64
@@ -111,6 +131,8 @@ func DefaultCLIConfig() *CLIConfig {
 		TiFlashFailoverPeriod:  5 * time.Minute,
 		MasterFailoverPeriod:   5 * time.Minute,
 		WorkerFailoverPeriod:   5 * time.Minute,
+		TiProxyFailoverPeriod:  5 * time.Minute,
+		TiCDCFailoverPeriod:    5 * time.Minute,
 		LeaseDuration:          15 * time.Second,
 		RenewDeadline:          10 * time.Second,
 		RetryPeriod:            2 * time.Second,
@@ -122,6 +144,8 @@ func DefaultCLIConfig() *CLIConfig {
 		TiDBBackupManagerImage: "pingcap/tidb-backup-manager:latest",
 		TiDBDiscoveryImage:     "pingcap/tidb-operator:latest",
 		Selector:               "",
+		FilterInformerCache:    true,
+		TracingSamplingRatio:   0.1,
 	}
 }
 
@@ -141,6 +165,8 @@ func (c *CLIConfig) AddFlag(_ *flag.FlagSet) {
 	flag.DurationVar(&c.TiDBFailoverPeriod, "tidb-failover-period", c.TiDBFailoverPeriod, "TiDB failover period")
 	flag.DurationVar(&c.MasterFailoverPeriod, "dm-master-failover-period", c.MasterFailoverPeriod, "dm-master failover period")
 	flag.DurationVar(&c.WorkerFailoverPeriod, "dm-worker-failover-period", c.WorkerFailoverPeriod, "dm-worker failover period")
+	flag.DurationVar(&c.TiProxyFailoverPeriod, "tiproxy-failover-period", c.TiProxyFailoverPeriod, "TiProxy failover period default(5m)")
+	flag.DurationVar(&c.TiCDCFailoverPeriod, "ticdc-failover-period", c.TiCDCFailoverPeriod, "TiCDC failover period default(5m)")
 	flag.DurationVar(&c.PodHardRecoveryPeriod, "pod-hard-recovery-period", c.PodHardRecoveryPeriod, "Hard recovery period for a failure pod default(24h)")
 	flag.BoolVar(&c.DetectNodeFailure, "detect-node-failure", c.DetectNodeFailure, "Automatically detect node failures")
 	flag.DurationVar(&c.ResyncDuration, "resync-duration", c.ResyncDuration, "Resync time of informer")
@@ -149,6 +175,12 @@ func (c *CLIConfig) AddFlag(_ *flag.FlagSet) {
 	// TODO: actually we just want to use the same image with tidb-controller-manager, but DownwardAPI cannot get image ID, see if there is any better solution
 	flag.StringVar(&c.TiDBDiscoveryImage, "tidb-discovery-image", c.TiDBDiscoveryImage, "The image of the tidb discovery service")
 	flag.StringVar(&c.Selector, "selector", c.Selector, "Selector (label query) to filter on, supports '=', '==', and '!='")
+	flag.BoolVar(&c.FilterInformerCache, "filter-informer-cache", c.FilterInformerCache, "Only cache the Kubernetes objects created by tidb-operator, and read the secrets and storage classes from the API server directly")
+	flag.StringVar(&c.WatchNamespaces, "watch-namespaces", c.WatchNamespaces, "The comma separated namespaces managed by tidb-operator, cluster-scoped must be false")
+	flag.StringVar(&c.WatchNamespaceSelector, "watch-namespace-selector", c.WatchNamespaceSelector, "Selector (label query) of the namespaces managed by tidb-operator, cluster-scoped must be false")
+	flag.StringVar(&c.TracingEndpoint, "tracing-endpoint", c.TracingEndpoint, "The address of the OTLP gRPC receiver to export the OpenTelemetry spans, e.g. otel-collector.monitoring:4317, tracing is disabled if it's empty")
+	flag.BoolVar(&c.TracingInsecure, "tracing-insecure", c.TracingInsecure, "Disable the TLS of the connection to the OTLP receiver")
+	flag.Float64Var(&c.TracingSamplingRatio, "tracing-sampling-ratio", c.TracingSamplingRatio, "The ratio of the reconciliations traced, between 0 and 1")
 
 	// see https://pkg.go.dev/k8s.io/client-go/tools/leaderelection#LeaderElectionConfig for the config
 	flag.DurationVar(&c.LeaseDuration, "leader-lease-duration", c.LeaseDuration, "leader-lease-duration is the duration that non-leader candidates will wait to force acquire leadership")
@@ -159,6 +191,32 @@ func (c *CLIConfig) AddFlag(_ *flag.FlagSet) {
 	flag.IntVar(&c.KubeClientBurst, "kube-client-burst", c.KubeClientBurst, "The maximum burst for throttle to the kubenetes API server from client")
 }
 
+// TracingConfig returns the configuration of the OpenTelemetry exporter
+func (c *CLIConfig) TracingConfig() tracing.Config {
+	return tracing.Config{
+		Endpoint:      c.TracingEndpoint,
+		Insecure:      c.TracingInsecure,
+		SamplingRatio: c.TracingSamplingRatio,
+	}
+}
+
+// WatchNamespaceList returns the namespaces in WatchNamespaces
+func (c *CLIConfig) WatchNamespaceList() []string {
+	var namespaces []string
+	for _, ns := range strings.Split(c.WatchNamespaces, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+// WatchesMultipleNamespaces returns whether the operator manages the namespaces in WatchNamespaces or selected by
+// WatchNamespaceSelector instead of its own namespace
+func (c *CLIConfig) WatchesMultipleNamespaces() bool {
+	return len(c.WatchNamespaceList()) > 0 || c.WatchNamespaceSelector != ""
+}
+
 // HasNodePermission returns whether the user has permission for node operations.
 func (c *CLIConfig) HasNodePermission() bool {
 	return c.ClusterScoped || c.ClusterPermissionNode
@@ -213,7 +271,9 @@ type Dependencies struct {
 	InformerFactory                informers.SharedInformerFactory
 	KubeInformerFactory            kubeinformers.SharedInformerFactory
 	LabelFilterKubeInformerFactory kubeinformers.SharedInformerFactory
-	Recorder                       record.EventRecorder
+	// ClusterKubeInformerFactory caches the nodes and the persistent volumes, which are not created by tidb-operator
+	ClusterKubeInformerFactory kubeinformers.SharedInformerFactory
+	Recorder                   record.EventRecorder
 
 	// Listers
 	ServiceLister          corelisterv1.ServiceLister
@@ -240,11 +300,24 @@ type Dependencies struct {
 	TiDBMonitorLister      listers.TidbMonitorLister
 	TiDBNGMonitoringLister listers.TidbNGMonitoringLister
 	TiDBDashboardLister    listers.TidbDashboardLister
+	DiagnosticsLister      listers.TidbClusterDiagnosticsLister
 
 	// Controls
 	Controls
 
 	AWSConfig aws.Config
+
+	watchedNamespaces *watchedNamespaces
+}
+
+// WatchedNamespaces returns the namespaces managed by the operator now if it watches multiple namespaces,
+// otherwise it returns nil
+func (d *Dependencies) WatchedNamespaces() []string {
+	if d.watchedNamespaces == nil {
+		return nil
+	}
+	namespaces, _ := d.watchedNamespaces.List()
+	return namespaces
 }
 
 func newRealControls(
@@ -254,10 +327,11 @@ func newRealControls(
 	genericCli client.Client,
 	informerFactory informers.SharedInformerFactory,
 	kubeInformerFactory kubeinformers.SharedInformerFactory,
+	secretLister corelisterv1.SecretLister,
+	pvLister corelisterv1.PersistentVolumeLister,
 	recorder record.EventRecorder) Controls {
 	// Shared variables to construct `Dependencies` and some of its fields
 	var (
-		secretLister      = kubeInformerFactory.Core().V1().Secrets().Lister()
 		pdControl         = pdapi.NewDefaultPDControl(secretLister)
 		tikvControl       = tikvapi.NewDefaultTiKVControl(secretLister)
 		tiflashControl    = tiflashapi.NewDefaultTiFlashControl(secretLister)
@@ -270,11 +344,7 @@ func newRealControls(
 		serviceLister     = kubeInformerFactory.Core().V1().Services().Lister()
 		pvcLister         = kubeInformerFactory.Core().V1().PersistentVolumeClaims().Lister()
 		podLister         = kubeInformerFactory.Core().V1().Pods().Lister()
-		pvLister          corelisterv1.PersistentVolumeLister
 	)
-	if cliCfg.HasPVPermission() {
-		pvLister = kubeInformerFactory.Core().V1().PersistentVolumes().Lister()
-	}
 
 	return Controls{
 		JobControl:         NewRealJobControl(kubeClientset, recorder),
@@ -303,6 +373,14 @@ func newRealControls(
 	}
 }
 
+func isIngressV1Supported(kubeClientset kubernetes.Interface) (bool, error) {
+	supported, err := utildiscovery.IsAPIGroupVersionResourceSupported(kubeClientset.Discovery(), "networking.k8s.io/v1", "ingresses")
+	if err != nil {
+		return false, fmt.Errorf("failed to check resource networking.k8s.io/v1/ingresses: %s", err)
+	}
+	return supported, nil
+}
+
 func newDependencies(
 	cliCfg *CLIConfig,
 	clientset versioned.Interface,
@@ -311,34 +389,43 @@ func newDependencies(
 	informerFactory informers.SharedInformerFactory,
 	kubeInformerFactory kubeinformers.SharedInformerFactory,
 	labelFilterKubeInformerFactory kubeinformers.SharedInformerFactory,
+	clusterKubeInformerFactory kubeinformers.SharedInformerFactory,
 	recorder record.EventRecorder) (*Dependencies, error) {
 
 	var (
 		nodeLister       corelisterv1.NodeLister
 		pvLister         corelisterv1.PersistentVolumeLister
 		scLister         storagelister.StorageClassLister
+		secretLister     corelisterv1.SecretLister
 		ingLister        networklister.IngressLister
 		ingv1beta1Lister extensionslister.IngressLister
 	)
 	if cliCfg.HasNodePermission() {
-		nodeLister = kubeInformerFactory.Core().V1().Nodes().Lister()
+		nodeLister = clusterKubeInformerFactory.Core().V1().Nodes().Lister()
 	} else {
 		klog.Info("no permission for nodes, skip creating node lister")
 	}
 	if cliCfg.HasPVPermission() {
-		pvLister = kubeInformerFactory.Core().V1().PersistentVolumes().Lister()
+		pvLister = clusterKubeInformerFactory.Core().V1().PersistentVolumes().Lister()
 	} else {
 		klog.Info("no permission for persistent volumes, skip creating pv lister")
 	}
-	if cliCfg.HasSCPermission() {
+	if !cliCfg.HasSCPermission() {
+		klog.Info("no permission for storage classes, skip creating sc lister")
+	} else if cliCfg.FilterInformerCache {
+		scLister = newAPIStorageClassLister(kubeClientset)
+	} else {
 		scLister = kubeInformerFactory.Storage().V1().StorageClasses().Lister()
+	}
+	if cliCfg.FilterInformerCache {
+		secretLister = newAPISecretLister(kubeClientset)
 	} else {
-		klog.Info("no permission for storage classes, skip creating sc lister")
+		secretLister = kubeInformerFactory.Core().V1().Secrets().Lister()
 	}
 
-	supported, err := utildiscovery.IsAPIGroupVersionResourceSupported(kubeClientset.Discovery(), "networking.k8s.io/v1", "ingresses")
+	supported, err := isIngressV1Supported(kubeClientset)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check resource networking.k8s.io/v1/ingresses: %s", err)
+		return nil, err
 	}
 	if supported {
 		ingLister = kubeInformerFactory.Networking().V1().Ingresses().Lister()
@@ -359,6 +446,7 @@ func newDependencies(
 		GenericClient:                  genericCli,
 		KubeInformerFactory:            kubeInformerFactory,
 		LabelFilterKubeInformerFactory: labelFilterKubeInformerFactory,
+		ClusterKubeInformerFactory:     clusterKubeInformerFactory,
 		Recorder:                       recorder,
 
 		// Listers
@@ -368,7 +456,7 @@ func newDependencies(
 		PVLister:               pvLister,
 		PodLister:              kubeInformerFactory.Core().V1().Pods().Lister(),
 		NodeLister:             nodeLister,
-		SecretLister:           kubeInformerFactory.Core().V1().Secrets().Lister(),
+		SecretLister:           secretLister,
 		ConfigMapLister:        labelFilterKubeInformerFactory.Core().V1().ConfigMaps().Lister(),
 		StatefulSetLister:      kubeInformerFactory.Apps().V1().StatefulSets().Lister(),
 		DeploymentLister:       kubeInformerFactory.Apps().V1().Deployments().Lister(),
@@ -386,6 +474,7 @@ func newDependencies(
 		TiDBMonitorLister:      informerFactory.Pingcap().V1alpha1().TidbMonitors().Lister(),
 		TiDBNGMonitoringLister: informerFactory.Pingcap().V1alpha1().TidbNGMonitorings().Lister(),
 		TiDBDashboardLister:    informerFactory.Pingcap().V1alpha1().TidbDashboards().Lister(),
+		DiagnosticsLister:      informerFactory.Pingcap().V1alpha1().TidbClusterDiagnostics().Lister(),
 
 		AWSConfig: cfg,
 	}, nil
@@ -396,8 +485,18 @@ func NewDependencies(ns string, cliCfg *CLIConfig, clientset versioned.Interface
 	var (
 		options     []informers.SharedInformerOption
 		kubeoptions []kubeinformers.SharedInformerOption
+		namespaces  *watchedNamespaces
 	)
-	if !cliCfg.ClusterScoped {
+	if cliCfg.WatchesMultipleNamespaces() {
+		if cliCfg.ClusterScoped {
+			return nil, fmt.Errorf("cluster-scoped must be false to watch the namespaces %q or the namespaces selected by %q", cliCfg.WatchNamespaces, cliCfg.WatchNamespaceSelector)
+		}
+		var err error
+		namespaces, err = newWatchedNamespaces(cliCfg, kubeClientset, cliCfg.ResyncDuration)
+		if err != nil {
+			return nil, err
+		}
+	} else if !cliCfg.ClusterScoped {
 		options = append(options, informers.WithNamespace(ns))
 		kubeoptions = append(kubeoptions, kubeinformers.WithNamespace(ns))
 	}
@@ -420,6 +519,24 @@ func NewDependencies(ns string, cliCfg *CLIConfig, clientset versioned.Interface
 	informerFactory := informers.NewSharedInformerFactoryWithOptions(clientset, cliCfg.ResyncDuration, options...)
 	kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeClientset, cliCfg.ResyncDuration, kubeoptions...)
 	labelFilterKubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeClientset, cliCfg.ResyncDuration, labelKubeOptions...)
+	clusterKubeInformerFactory := kubeInformerFactory
+	ingressV1, err := isIngressV1Supported(kubeClientset)
+	if err != nil {
+		return nil, err
+	}
+	scope := informerScope{namespace: metav1.NamespaceAll}
+	if namespaces != nil {
+		scope.factories = newNamespaceInformerFactories(namespaces, kubeClientset, cliCfg.ResyncDuration)
+	} else if !cliCfg.ClusterScoped {
+		scope.namespace = ns
+	}
+	if cliCfg.FilterInformerCache {
+		clusterKubeInformerFactory = kubeinformers.NewSharedInformerFactory(kubeClientset, cliCfg.ResyncDuration)
+		registerFilteredInformers(kubeInformerFactory, clusterKubeInformerFactory, kubeClientset, scope, ingressV1)
+	}
+	if namespaces != nil {
+		registerMultiNamespaceInformers(cliCfg, informerFactory, kubeInformerFactory, labelFilterKubeInformerFactory, clientset, kubeClientset, scope, ingressV1)
+	}
 
 	// Initialize the event recorder
 	eventBroadcaster := record.NewBroadcasterWithCorrelatorOptions(record.CorrelatorOptions{QPS: 1})
@@ -427,11 +544,12 @@ func NewDependencies(ns string, cliCfg *CLIConfig, clientset versioned.Interface
 	eventBroadcaster.StartRecordingToSink(&eventv1.EventSinkImpl{
 		Interface: eventv1.New(kubeClientset.CoreV1().RESTClient()).Events("")})
 	recorder := eventBroadcaster.NewRecorder(v1alpha1.Scheme, corev1.EventSource{Component: "tidb-controller-manager"})
-	deps, err := newDependencies(cliCfg, clientset, kubeClientset, genericCli, informerFactory, kubeInformerFactory, labelFilterKubeInformerFactory, recorder)
+	deps, err := newDependencies(cliCfg, clientset, kubeClientset, genericCli, informerFactory, kubeInformerFactory, labelFilterKubeInformerFactory, clusterKubeInformerFactory, recorder)
 	if err != nil {
 		return nil, err
 	}
-	deps.Controls = newRealControls(cliCfg, clientset, kubeClientset, genericCli, informerFactory, kubeInformerFactory, recorder)
+	deps.Controls = newRealControls(cliCfg, clientset, kubeClientset, genericCli, informerFactory, kubeInformerFactory, deps.SecretLister, deps.PVLister, recorder)
+	deps.watchedNamespaces = namespaces
 	return deps, nil
 }
 
@@ -481,6 +599,8 @@ func NewFakeDependencies() *Dependencies {
 	kubeCli := kubefake.NewSimpleClientset()
 	genCli := controllerfake.NewFakeClientWithScheme(scheme.Scheme)
 	cliCfg := DefaultCLIConfig()
+	// the objects are added to the informers directly in tests
+	cliCfg.FilterInformerCache = false
 	informerFactory := informers.NewSharedInformerFactory(cli, 0)
 	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(kubeCli, 0)
 	labelFilterKubeInformerFactory := kubeinformers.NewSharedInformerFactory(kubeCli, 0)
@@ -495,7 +615,7 @@ func NewFakeDependencies() *Dependencies {
 		},
 	})
 
-	deps, err := newDependencies(cliCfg, cli, kubeCli, genCli, informerFactory, kubeInformerFactory, labelFilterKubeInformerFactory, recorder)
+	deps, err := newDependencies(cliCfg, cli, kubeCli, genCli, informerFactory, kubeInformerFactory, labelFilterKubeInformerFactory, kubeInformerFactory, recorder)
 	if err != nil {
 		klog.Fatalf("failed to create Dependencies: %s", err)
 	}
@@ -0,0 +1,165 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	informers "github.com/pingcap/tidb-operator/pkg/client/informers/externalversions/pingcap/v1alpha1"
+	listers "github.com/pingcap/tidb-operator/pkg/client/listers/pingcap/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+// DiagnosticsStatusUpdaterInterface is an interface used to update the status of a TidbClusterDiagnostics,
+// it's used by both the controller and the collection job.
+type DiagnosticsStatusUpdaterInterface interface {
+	// Update merges newStatus into the status of the diagnostics, the empty fields of newStatus are not changed.
+	// Implementations are required to retry on conflicts, but fail on other errors.
+	Update(diag *v1alpha1.TidbClusterDiagnostics, newStatus *v1alpha1.TidbClusterDiagnosticsStatus) error
+}
+
+type realDiagnosticsStatusUpdater struct {
+	recorder record.EventRecorder
+	lister   listers.TidbClusterDiagnosticsLister
+	cli      versioned.Interface
+}
+
+// NewRealDiagnosticsStatusUpdater returns a DiagnosticsStatusUpdaterInterface that updates the status by the client
+func NewRealDiagnosticsStatusUpdater(recorder record.EventRecorder, lister listers.TidbClusterDiagnosticsLister, cli versioned.Interface) DiagnosticsStatusUpdaterInterface {
+	return &realDiagnosticsStatusUpdater{
+		recorder: recorder,
+		lister:   lister,
+		cli:      cli,
+	}
+}
+
+func (u *realDiagnosticsStatusUpdater) Update(diag *v1alpha1.TidbClusterDiagnostics, newStatus *v1alpha1.TidbClusterDiagnosticsStatus) error {
+	ns := diag.GetNamespace()
+	name := diag.GetName()
+
+	var phaseChanged bool
+	err := retry.OnError(retry.DefaultRetry, func(e error) bool { return e != nil }, func() error {
+		// always get the latest diagnostics before update
+		if updated, err := u.lister.TidbClusterDiagnostics(ns).Get(name); err == nil {
+			// make a copy so we don't mutate the shared cache
+			*diag = *(updated.DeepCopy())
+		} else {
+			utilruntime.HandleError(fmt.Errorf("error getting updated diagnostics %s/%s from lister: %v", ns, name, err))
+			return err
+		}
+
+		phaseChanged = newStatus.Phase != "" && diag.Status.Phase != newStatus.Phase
+		if !mergeDiagnosticsStatus(&diag.Status, newStatus) {
+			return nil
+		}
+		_, updateErr := u.cli.PingcapV1alpha1().TidbClusterDiagnostics(ns).Update(context.TODO(), diag, metav1.UpdateOptions{})
+		if updateErr == nil {
+			klog.Infof("TidbClusterDiagnostics: [%s/%s] updated successfully", ns, name)
+			return nil
+		}
+		klog.Errorf("Failed to update TidbClusterDiagnostics [%s/%s], error: %v", ns, name, updateErr)
+		return updateErr
+	})
+	if err != nil || !phaseChanged {
+		return err
+	}
+
+	switch diag.Status.Phase {
+	case v1alpha1.DiagnosticsComplete:
+		u.recorder.Eventf(diag, corev1.EventTypeNormal, "Complete", "Diagnostics bundle is uploaded to %s", diag.Status.Location)
+	case v1alpha1.DiagnosticsFailed:
+		u.recorder.Event(diag, corev1.EventTypeWarning, "Failed", diag.Status.Message)
+	}
+	return nil
+}
+
+// mergeDiagnosticsStatus merges the non-empty fields of newStatus into status, returns whether status is changed
+func mergeDiagnosticsStatus(status, newStatus *v1alpha1.TidbClusterDiagnosticsStatus) bool {
+	updated := false
+	if newStatus.Phase != "" && status.Phase != newStatus.Phase {
+		status.Phase = newStatus.Phase
+		updated = true
+	}
+	if newStatus.Message != "" && status.Message != newStatus.Message {
+		status.Message = newStatus.Message
+		updated = true
+	}
+	if newStatus.Location != "" && status.Location != newStatus.Location {
+		status.Location = newStatus.Location
+		updated = true
+	}
+	if newStatus.Size != 0 && status.Size != newStatus.Size {
+		status.Size = newStatus.Size
+		updated = true
+	}
+	if newStatus.Errors != nil {
+		status.Errors = newStatus.Errors
+		updated = true
+	}
+	if newStatus.StartTime != nil && status.StartTime == nil {
+		status.StartTime = newStatus.StartTime
+		updated = true
+	}
+	if newStatus.CompletionTime != nil && status.CompletionTime == nil {
+		status.CompletionTime = newStatus.CompletionTime
+		updated = true
+	}
+	return updated
+}
+
+var _ DiagnosticsStatusUpdaterInterface = &realDiagnosticsStatusUpdater{}
+
+// FakeDiagnosticsStatusUpdater is a fake DiagnosticsStatusUpdaterInterface
+type FakeDiagnosticsStatusUpdater struct {
+	lister        listers.TidbClusterDiagnosticsLister
+	indexer       cache.Indexer
+	updateTracker RequestTracker
+}
+
+// NewFakeDiagnosticsStatusUpdater returns a FakeDiagnosticsStatusUpdater
+func NewFakeDiagnosticsStatusUpdater(informer informers.TidbClusterDiagnosticsInformer) *FakeDiagnosticsStatusUpdater {
+	return &FakeDiagnosticsStatusUpdater{
+		informer.Lister(),
+		informer.Informer().GetIndexer(),
+		RequestTracker{},
+	}
+}
+
+// SetUpdateError sets the error attributes of updateTracker
+func (u *FakeDiagnosticsStatusUpdater) SetUpdateError(err error, after int) {
+	u.updateTracker.SetError(err).SetAfter(after)
+}
+
+// Update updates the status of the diagnostics in the indexer
+func (u *FakeDiagnosticsStatusUpdater) Update(diag *v1alpha1.TidbClusterDiagnostics, newStatus *v1alpha1.TidbClusterDiagnosticsStatus) error {
+	defer u.updateTracker.Inc()
+	if u.updateTracker.ErrorReady() {
+		defer u.updateTracker.Reset()
+		return u.updateTracker.GetError()
+	}
+
+	mergeDiagnosticsStatus(&diag.Status, newStatus)
+	return u.indexer.Update(diag)
+}
+
+var _ DiagnosticsStatusUpdaterInterface = &FakeDiagnosticsStatusUpdater{}
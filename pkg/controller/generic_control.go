@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/scheme"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -572,6 +573,12 @@ func setControllerReference(controller, obj runtime.Object) error {
 	if !ok {
 		return fmt.Errorf("%T is not a metav1.Object, cannot call setControllerReference", obj)
 	}
+	// the resources of a TiDB group are owned by the TidbCluster which the group belongs to
+	if tc, ok := controller.(*v1alpha1.TidbCluster); ok && tc.GetOwnerName() != tc.GetName() {
+		controllerMo = &v1alpha1.TidbCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: tc.GetNamespace(), Name: tc.GetOwnerName(), UID: tc.GetUID()},
+		}
+	}
 	return controllerutil.SetControllerReference(controllerMo, objMo, scheme.Scheme)
 }
 
@@ -21,16 +21,20 @@ import (
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/tracing"
 	v1 "k8s.io/api/core/v1"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 )
 
 type httpClient struct {
 	secretLister corelisterv1.SecretLister
+	// component is the component called by the client, e.g. tidb
+	component string
 }
 
 func (c *httpClient) getHTTPClient(tc *v1alpha1.TidbCluster) (*http.Client, error) {
-	httpClient := &http.Client{Timeout: timeout}
+	key := tracing.Key{Kind: v1alpha1.TiDBClusterKind, Namespace: tc.Namespace, Name: tc.Name}
+	httpClient := &http.Client{Timeout: timeout, Transport: tracing.NewTransport(nil, c.component, key)}
 	if !tc.IsTLSClusterEnabled() {
 		return httpClient, nil
 	}
@@ -60,7 +64,7 @@ func (c *httpClient) getHTTPClient(tc *v1alpha1.TidbCluster) (*http.Client, erro
 		RootCAs:      rootCAs,
 		Certificates: []tls.Certificate{tlsCert},
 	}
-	httpClient.Transport = &http.Transport{TLSClientConfig: config, DisableKeepAlives: true}
+	httpClient.Transport = tracing.NewTransport(&http.Transport{TLSClientConfig: config, DisableKeepAlives: true}, c.component, key)
 
 	return httpClient, nil
 }
@@ -0,0 +1,283 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	apps "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/watch"
+	kubeinformers "k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
+	storagelister "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// apiListerCacheTTL is how long the objects read by the API listers are cached, they are read in every sync
+// of the clusters, e.g. the TLS secrets to create the PD clients
+const apiListerCacheTTL = 30 * time.Second
+
+// managedBySelector selects the Kubernetes objects created by tidb-operator
+func managedBySelector() string {
+	return fmt.Sprintf("%s in (%s)", label.ManagedByLabelKey, strings.Join(label.ManagedByValues(), ","))
+}
+
+// tweakManagedListOptions only lists and watches the objects created by tidb-operator
+func tweakManagedListOptions(options *metav1.ListOptions) {
+	if len(options.LabelSelector) > 0 {
+		options.LabelSelector += "," + managedBySelector()
+	} else {
+		options.LabelSelector = managedBySelector()
+	}
+}
+
+// listWatchClient is the typed client of a kind in a namespace
+type listWatchClient[T runtime.Object] interface {
+	List(ctx context.Context, opts metav1.ListOptions) (T, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+}
+
+// newListWatchFunc returns the function creating the ListerWatcher of a kind in a namespace with the list
+// options tweaked
+func newListWatchFunc[T runtime.Object](client func(ns string) listWatchClient[T], tweak func(*metav1.ListOptions)) func(ns string) cache.ListerWatcher {
+	return func(ns string) cache.ListerWatcher {
+		c := client(ns)
+		return &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweak != nil {
+					tweak(&options)
+				}
+				return c.List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweak != nil {
+					tweak(&options)
+				}
+				return c.Watch(context.TODO(), options)
+			},
+		}
+	}
+}
+
+// namespacedKind is a namespaced kind cached by the informers
+type namespacedKind struct {
+	obj       runtime.Object
+	listWatch func(ns string) cache.ListerWatcher
+}
+
+// kubeKinds returns the namespaced Kubernetes kinds created by tidb-operator
+func kubeKinds(kubeCli kubernetes.Interface, tweak func(*metav1.ListOptions), ingressV1 bool) []namespacedKind {
+	kinds := []namespacedKind{
+		{&corev1.Pod{}, newListWatchFunc(func(ns string) listWatchClient[*corev1.PodList] { return kubeCli.CoreV1().Pods(ns) }, tweak)},
+		{&corev1.PersistentVolumeClaim{}, newListWatchFunc(func(ns string) listWatchClient[*corev1.PersistentVolumeClaimList] {
+			return kubeCli.CoreV1().PersistentVolumeClaims(ns)
+		}, tweak)},
+		{&corev1.Service{}, newListWatchFunc(func(ns string) listWatchClient[*corev1.ServiceList] { return kubeCli.CoreV1().Services(ns) }, tweak)},
+		// the endpoints inherit the labels of the services
+		{&corev1.Endpoints{}, newListWatchFunc(func(ns string) listWatchClient[*corev1.EndpointsList] { return kubeCli.CoreV1().Endpoints(ns) }, tweak)},
+		{&apps.StatefulSet{}, newListWatchFunc(func(ns string) listWatchClient[*apps.StatefulSetList] { return kubeCli.AppsV1().StatefulSets(ns) }, tweak)},
+		{&apps.Deployment{}, newListWatchFunc(func(ns string) listWatchClient[*apps.DeploymentList] { return kubeCli.AppsV1().Deployments(ns) }, tweak)},
+		{&batchv1.Job{}, newListWatchFunc(func(ns string) listWatchClient[*batchv1.JobList] { return kubeCli.BatchV1().Jobs(ns) }, tweak)},
+	}
+	// only the supported version of the ingresses is watched
+	if ingressV1 {
+		return append(kinds, namespacedKind{&networkingv1.Ingress{}, newListWatchFunc(func(ns string) listWatchClient[*networkingv1.IngressList] {
+			return kubeCli.NetworkingV1().Ingresses(ns)
+		}, tweak)})
+	}
+	return append(kinds, namespacedKind{&extensionsv1beta1.Ingress{}, newListWatchFunc(func(ns string) listWatchClient[*extensionsv1beta1.IngressList] {
+		return kubeCli.ExtensionsV1beta1().Ingresses(ns)
+	}, tweak)})
+}
+
+// informerScope is the namespaces watched by the informers, it's a single namespace, all the namespaces, or
+// the namespaces watched by the informer factories of the namespaces if the operator manages multiple namespaces
+type informerScope struct {
+	namespace string
+	factories *namespaceInformerFactories
+}
+
+// newInformer returns the informer of the kind in the scope, the transform is optional
+func (s informerScope) newInformer(kind namespacedKind, resync time.Duration, transform cache.TransformFunc) cache.SharedIndexInformer {
+	newInformer := func(ns string) cache.SharedIndexInformer {
+		return withTransform(cache.NewSharedIndexInformer(kind.listWatch(ns), kind.obj, resync, namespaceIndexers()), transform)
+	}
+	if s.factories != nil {
+		return s.factories.informerFor(kind.obj, newInformer)
+	}
+	return newInformer(s.namespace)
+}
+
+// registerFilteredInformers registers the informers of the kinds the operator owns to the factory before they
+// are used, so that only the objects created by tidb-operator are cached. The nodes and persistent volumes are
+// not labeled by tidb-operator, they are registered to the cluster factory without the label filter, but the
+// fields never read by the operator are stripped from the cache.
+func registerFilteredInformers(factory, clusterFactory kubeinformers.SharedInformerFactory, kubeCli kubernetes.Interface, scope informerScope, ingressV1 bool) {
+	for _, kind := range kubeKinds(kubeCli, tweakManagedListOptions, ingressV1) {
+		registerKubeInformer(factory, kind, scope, stripManagedFields)
+	}
+
+	clusterFactory.InformerFor(&corev1.Node{}, func(cli kubernetes.Interface, resync time.Duration) cache.SharedIndexInformer {
+		return withTransform(coreinformers.NewFilteredNodeInformer(cli, resync, cache.Indexers{}, nil), stripNode)
+	})
+	clusterFactory.InformerFor(&corev1.PersistentVolume{}, func(cli kubernetes.Interface, resync time.Duration) cache.SharedIndexInformer {
+		return withTransform(coreinformers.NewFilteredPersistentVolumeInformer(cli, resync, cache.Indexers{}, nil), stripManagedFields)
+	})
+}
+
+// registerKubeInformer registers the informer of a namespaced kind in the scope to the factory, the transform is optional
+func registerKubeInformer(factory kubeinformers.SharedInformerFactory, kind namespacedKind, scope informerScope, transform cache.TransformFunc) {
+	factory.InformerFor(kind.obj, func(_ kubernetes.Interface, resync time.Duration) cache.SharedIndexInformer {
+		return scope.newInformer(kind, resync, transform)
+	})
+}
+
+func withTransform(informer cache.SharedIndexInformer, transform cache.TransformFunc) cache.SharedIndexInformer {
+	if transform == nil {
+		return informer
+	}
+	if err := informer.SetTransform(transform); err != nil {
+		klog.Warningf("failed to set the transform of the informer: %v", err)
+	}
+	return informer
+}
+
+func namespaceIndexers() cache.Indexers {
+	return cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+}
+
+// stripManagedFields removes the managed fields from the cached objects, they are never read by the operator
+// and usually take more memory than the objects themselves. The managed fields are kept by the API server if
+// they are not set in an update.
+func stripManagedFields(obj interface{}) (interface{}, error) {
+	if accessor, err := meta.Accessor(obj); err == nil {
+		accessor.SetManagedFields(nil)
+	}
+	return obj, nil
+}
+
+// stripNode removes the images and the volumes from the status of the cached nodes additionally, the operator
+// only reads the labels, the taints and the conditions of the nodes
+func stripNode(obj interface{}) (interface{}, error) {
+	if node, ok := obj.(*corev1.Node); ok {
+		node.Status.Images = nil
+		node.Status.VolumesInUse = nil
+		node.Status.VolumesAttached = nil
+	}
+	return stripManagedFields(obj)
+}
+
+// apiSecretLister reads the secrets from the API server instead of caching all the secrets, most of the secrets
+// read by the operator are created by the users, e.g. the TLS certificates and the credentials of the storage
+type apiSecretLister struct {
+	kubeCli kubernetes.Interface
+	cache   *utilcache.Expiring
+}
+
+var _ corelisterv1.SecretLister = &apiSecretLister{}
+
+func newAPISecretLister(kubeCli kubernetes.Interface) corelisterv1.SecretLister {
+	return &apiSecretLister{kubeCli: kubeCli, cache: utilcache.NewExpiring()}
+}
+
+func (l *apiSecretLister) List(selector labels.Selector) ([]*corev1.Secret, error) {
+	return l.Secrets(metav1.NamespaceAll).List(selector)
+}
+
+func (l *apiSecretLister) Secrets(namespace string) corelisterv1.SecretNamespaceLister {
+	return &apiSecretNamespaceLister{apiSecretLister: l, namespace: namespace}
+}
+
+type apiSecretNamespaceLister struct {
+	*apiSecretLister
+	namespace string
+}
+
+func (l *apiSecretNamespaceLister) List(selector labels.Selector) ([]*corev1.Secret, error) {
+	list, err := l.kubeCli.CoreV1().Secrets(l.namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	secrets := make([]*corev1.Secret, 0, len(list.Items))
+	for i := range list.Items {
+		secrets = append(secrets, &list.Items[i])
+	}
+	return secrets, nil
+}
+
+func (l *apiSecretNamespaceLister) Get(name string) (*corev1.Secret, error) {
+	key := l.namespace + "/" + name
+	if obj, ok := l.cache.Get(key); ok {
+		return obj.(*corev1.Secret), nil
+	}
+	secret, err := l.kubeCli.CoreV1().Secrets(l.namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	l.cache.Set(key, secret, apiListerCacheTTL)
+	return secret, nil
+}
+
+// apiStorageClassLister reads the storage classes from the API server, they are only read when the volumes are modified
+type apiStorageClassLister struct {
+	kubeCli kubernetes.Interface
+	cache   *utilcache.Expiring
+}
+
+var _ storagelister.StorageClassLister = &apiStorageClassLister{}
+
+func newAPIStorageClassLister(kubeCli kubernetes.Interface) storagelister.StorageClassLister {
+	return &apiStorageClassLister{kubeCli: kubeCli, cache: utilcache.NewExpiring()}
+}
+
+func (l *apiStorageClassLister) List(selector labels.Selector) ([]*storagev1.StorageClass, error) {
+	list, err := l.kubeCli.StorageV1().StorageClasses().List(context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	scs := make([]*storagev1.StorageClass, 0, len(list.Items))
+	for i := range list.Items {
+		scs = append(scs, &list.Items[i])
+	}
+	return scs, nil
+}
+
+func (l *apiStorageClassLister) Get(name string) (*storagev1.StorageClass, error) {
+	if obj, ok := l.cache.Get(name); ok {
+		return obj.(*storagev1.StorageClass), nil
+	}
+	sc, err := l.kubeCli.StorageV1().StorageClasses().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	l.cache.Set(name, sc, apiListerCacheTTL)
+	return sc, nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+// newPodsForInformerCache returns the pods of a shared Kubernetes cluster, only some of them are created by tidb-operator
+func newPodsForInformerCache(total, managed int) []*corev1.Pod {
+	fields := &metav1.FieldsV1{Raw: []byte(fmt.Sprintf(`{"f:metadata":{"f:labels":{}},"f:spec":{"f:containers":%q}}`, strings.Repeat("x", 1024)))}
+	pods := make([]*corev1.Pod, 0, total)
+	for i := 0; i < total; i++ {
+		l := label.Label{"app": "user-app"}
+		if i < managed {
+			l = label.New().Instance("tc").TiKV()
+		}
+		pods = append(pods, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:          fmt.Sprintf("pod-%d", i),
+				Namespace:     metav1.NamespaceDefault,
+				Labels:        l,
+				ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubelet", FieldsV1: fields}},
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "image"}}},
+		})
+	}
+	return pods
+}
+
+func newKubeClientForInformerCache(pods []*corev1.Pod) kubernetes.Interface {
+	kubeCli := kubefake.NewSimpleClientset()
+	for _, pod := range pods {
+		if _, err := kubeCli.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+			panic(err)
+		}
+	}
+	return kubeCli
+}
+
+func syncPodInformer(kubeCli kubernetes.Interface, filter bool) kubeinformers.SharedInformerFactory {
+	factory := kubeinformers.NewSharedInformerFactory(kubeCli, 0)
+	if filter {
+		registerFilteredInformers(factory, kubeinformers.NewSharedInformerFactory(kubeCli, 0), kubeCli, informerScope{namespace: metav1.NamespaceAll}, true)
+	}
+	factory.Core().V1().Pods().Lister()
+	stop := make(chan struct{})
+	defer close(stop)
+	factory.Start(stop)
+	factory.WaitForCacheSync(stop)
+	return factory
+}
+
+func TestFilteredInformers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	pods := newPodsForInformerCache(10, 3)
+	backupPod := pods[9].DeepCopy()
+	backupPod.Name = "backup-pod"
+	backupPod.Labels = label.NewBackup().Instance("backup")
+	kubeCli := newKubeClientForInformerCache(append(pods, backupPod))
+
+	factory := syncPodInformer(kubeCli, true)
+	cached, err := factory.Core().V1().Pods().Lister().List(labels.Everything())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cached).To(HaveLen(4))
+	for _, pod := range cached {
+		g.Expect(pod.Labels[label.ManagedByLabelKey]).To(BeElementOf(label.ManagedByValues()))
+		g.Expect(pod.ManagedFields).To(BeEmpty())
+	}
+
+	factory = syncPodInformer(kubeCli, false)
+	cached, err = factory.Core().V1().Pods().Lister().List(labels.Everything())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cached).To(HaveLen(11))
+}
+
+func TestStripNode(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:          "node",
+			Labels:        map[string]string{corev1.LabelTopologyZone: "zone-1"},
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubelet"}},
+		},
+		Status: corev1.NodeStatus{
+			Conditions:   []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			Images:       []corev1.ContainerImage{{Names: []string{"image"}}},
+			VolumesInUse: []corev1.UniqueVolumeName{"volume"},
+		},
+	}
+	obj, err := stripNode(node)
+	g.Expect(err).NotTo(HaveOccurred())
+	stripped := obj.(*corev1.Node)
+	g.Expect(stripped.ManagedFields).To(BeNil())
+	g.Expect(stripped.Status.Images).To(BeNil())
+	g.Expect(stripped.Status.VolumesInUse).To(BeNil())
+	g.Expect(stripped.Labels).To(HaveKey(corev1.LabelTopologyZone))
+	g.Expect(stripped.Status.Conditions).To(HaveLen(1))
+}
+
+func TestAPISecretLister(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	kubeCli := kubefake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: metav1.NamespaceDefault},
+		Data:       map[string][]byte{"ca.crt": []byte("ca")},
+	})
+	lister := newAPISecretLister(kubeCli)
+
+	secret, err := lister.Secrets(metav1.NamespaceDefault).Get("tls")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(secret.Data["ca.crt"]).To(Equal([]byte("ca")))
+	_, err = lister.Secrets(metav1.NamespaceDefault).Get("not-exist")
+	g.Expect(err).To(HaveOccurred())
+
+	// the secret read is cached for a while
+	g.Expect(kubeCli.CoreV1().Secrets(metav1.NamespaceDefault).Delete(context.TODO(), "tls", metav1.DeleteOptions{})).To(Succeed())
+	_, err = lister.Secrets(metav1.NamespaceDefault).Get("tls")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	secrets, err := lister.List(labels.Everything())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(secrets).To(BeEmpty())
+}
+
+// BenchmarkInformerCache compares the memory of the pod cache on a shared Kubernetes cluster, in which only
+// 10% of the pods are created by tidb-operator, e.g.
+//
+//	BenchmarkInformerCache/unfiltered   16911453 cache-bytes   5000 cached-pods
+//	BenchmarkInformerCache/filtered      1190216 cache-bytes    500 cached-pods
+func BenchmarkInformerCache(b *testing.B) {
+	kubeCli := newKubeClientForInformerCache(newPodsForInformerCache(5000, 500))
+
+	for _, filter := range []bool{false, true} {
+		name := "unfiltered"
+		if filter {
+			name = "filtered"
+		}
+		b.Run(name, func(b *testing.B) {
+			var bytes, pods float64
+			for i := 0; i < b.N; i++ {
+				var before, after runtime.MemStats
+				runtime.GC()
+				runtime.ReadMemStats(&before)
+				factory := syncPodInformer(kubeCli, filter)
+				runtime.GC()
+				runtime.ReadMemStats(&after)
+
+				cached, err := factory.Core().V1().Pods().Lister().List(labels.Everything())
+				if err != nil {
+					b.Fatal(err)
+				}
+				bytes += float64(after.HeapAlloc) - float64(before.HeapAlloc)
+				pods += float64(len(cached))
+			}
+			b.ReportMetric(bytes/float64(b.N), "cache-bytes")
+			b.ReportMetric(pods/float64(b.N), "cached-pods")
+		})
+	}
+}
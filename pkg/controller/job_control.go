@@ -19,8 +19,11 @@ import (
 	"strings"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/util/tracing"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	batchinformers "k8s.io/client-go/informers/batch/v1"
@@ -59,7 +62,10 @@ func (c *realJobControl) CreateJob(object runtime.Object, job *batchv1.Job) erro
 	instanceName := job.GetLabels()[label.InstanceLabelKey]
 	kind := object.GetObjectKind().GroupVersionKind().Kind
 
+	span := tracing.StartSpan("CreateJob", jobTracingKey(object))
+	span.SetAttributes(tracing.JobKey.String(jobName))
 	_, err := c.kubeCli.BatchV1().Jobs(ns).Create(context.TODO(), job, metav1.CreateOptions{})
+	span.End(err)
 	if err != nil {
 		klog.Errorf("failed to create %s job: [%s/%s], cluster: %s, err: %v", strings.ToLower(kind), ns, jobName, instanceName, err)
 	} else {
@@ -79,7 +85,10 @@ func (c *realJobControl) DeleteJob(object runtime.Object, job *batchv1.Job) erro
 	opts := metav1.DeleteOptions{
 		PropagationPolicy: &propForeground,
 	}
+	span := tracing.StartSpan("DeleteJob", jobTracingKey(object))
+	span.SetAttributes(tracing.JobKey.String(jobName))
 	err := c.kubeCli.BatchV1().Jobs(ns).Delete(context.TODO(), jobName, opts)
+	span.End(err)
 	if err != nil {
 		klog.Errorf("failed to delete %s job: [%s/%s], cluster: %s, err: %v", strings.ToLower(kind), ns, jobName, instanceName, err)
 	} else {
@@ -89,6 +98,21 @@ func (c *realJobControl) DeleteJob(object runtime.Object, job *batchv1.Job) erro
 	return err
 }
 
+// jobTracingKey returns the key of the spans of the object the job belongs to
+func jobTracingKey(object runtime.Object) tracing.Key {
+	key := tracing.Key{Kind: object.GetObjectKind().GroupVersionKind().Kind}
+	switch object.(type) {
+	case *v1alpha1.Backup:
+		key.Kind = v1alpha1.BackupKind
+	case *v1alpha1.Restore:
+		key.Kind = v1alpha1.RestoreKind
+	}
+	if accessor, err := meta.Accessor(object); err == nil {
+		key.Namespace, key.Name = accessor.GetNamespace(), accessor.GetName()
+	}
+	return key
+}
+
 func (c *realJobControl) recordJobEvent(verb string, obj runtime.Object, job *batchv1.Job, err error) {
 	jobName := job.GetName()
 	ns := job.GetNamespace()
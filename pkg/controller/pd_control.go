@@ -22,7 +22,7 @@ import (
 func getPDClientFromService(pdControl pdapi.PDControlInterface, tc *v1alpha1.TidbCluster) pdapi.PDClient {
 	if tc.Heterogeneous() && tc.WithoutLocalPD() {
 		return pdControl.GetPDClient(pdapi.Namespace(tc.Spec.Cluster.Namespace), tc.Spec.Cluster.Name, tc.IsTLSClusterEnabled(),
-			pdapi.TLSCertFromTC(pdapi.Namespace(tc.GetNamespace()), tc.GetName()),
+			pdapi.TLSCertFromTC(pdapi.Namespace(tc.GetNamespace()), tc.GetOwnerName()),
 			pdapi.ClusterRef(tc.Spec.Cluster.ClusterDomain),
 			pdapi.UseHeadlessService(tc.Spec.AcrossK8s),
 		)
@@ -35,7 +35,7 @@ func getPDClientFromService(pdControl pdapi.PDControlInterface, tc *v1alpha1.Tid
 func getPDMSClientFromService(pdControl pdapi.PDControlInterface, tc *v1alpha1.TidbCluster, serviceName string) pdapi.PDMSClient {
 	if tc.Heterogeneous() && tc.WithoutLocalPD() {
 		return pdControl.GetPDMSClient(pdapi.Namespace(tc.Spec.Cluster.Namespace), tc.Spec.Cluster.Name, serviceName, tc.IsTLSClusterEnabled(),
-			pdapi.TLSCertFromTC(pdapi.Namespace(tc.GetNamespace()), tc.GetName()),
+			pdapi.TLSCertFromTC(pdapi.Namespace(tc.GetNamespace()), tc.GetOwnerName()),
 			pdapi.ClusterRef(tc.Spec.Cluster.ClusterDomain),
 			pdapi.UseHeadlessService(tc.Spec.AcrossK8s),
 		)
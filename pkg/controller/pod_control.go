@@ -44,6 +44,7 @@ type PodControlInterface interface {
 	DeletePod(runtime.Object, *corev1.Pod) error
 	ForceDeletePod(runtime.Object, *corev1.Pod) error
 	UpdatePod(runtime.Object, *corev1.Pod) (*corev1.Pod, error)
+	CreatePod(runtime.Object, *corev1.Pod) error
 }
 
 type realPodControl struct {
@@ -228,6 +229,26 @@ func (c *realPodControl) UpdateMetaInfo(tc *v1alpha1.TidbCluster, pod *corev1.Po
 	return updatePod, err
 }
 
+func (c *realPodControl) CreatePod(controller runtime.Object, pod *corev1.Pod) error {
+	controllerMo, ok := controller.(metav1.Object)
+	if !ok {
+		return fmt.Errorf("%T is not a metav1.Object, cannot call setControllerReference", controller)
+	}
+	kind := controller.GetObjectKind().GroupVersionKind().Kind
+	name := controllerMo.GetName()
+	namespace := controllerMo.GetNamespace()
+
+	podName := pod.GetName()
+	_, err := c.kubeCli.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	if err != nil {
+		klog.Errorf("failed to create Pod: [%s/%s], %s: %s, %v", namespace, podName, kind, name, err)
+	} else {
+		klog.V(4).Infof("create Pod: [%s/%s] successfully, %s: %s", namespace, podName, kind, name)
+	}
+	c.recordPodEvent("create", kind, name, controller, podName, err)
+	return err
+}
+
 func (c *realPodControl) DeletePod(controller runtime.Object, pod *corev1.Pod) error {
 	return c.deletePod(controller, pod, false)
 }
@@ -299,6 +320,7 @@ type FakePodControl struct {
 	PodIndexer        cache.Indexer
 	updatePodTracker  RequestTracker
 	deletePodTracker  RequestTracker
+	createPodTracker  RequestTracker
 	getClusterTracker RequestTracker
 	getMemberTracker  RequestTracker
 	getStoreTracker   RequestTracker
@@ -313,6 +335,7 @@ func NewFakePodControl(podInformer coreinformers.PodInformer) *FakePodControl {
 		RequestTracker{},
 		RequestTracker{},
 		RequestTracker{},
+		RequestTracker{},
 	}
 }
 
@@ -386,6 +409,21 @@ func (c *FakePodControl) DeletePod(_ runtime.Object, pod *corev1.Pod) error {
 	return c.PodIndexer.Delete(pod)
 }
 
+// SetCreatePodError sets the error attributes of createPodTracker
+func (c *FakePodControl) SetCreatePodError(err error, after int) {
+	c.createPodTracker.SetError(err).SetAfter(after)
+}
+
+func (c *FakePodControl) CreatePod(_ runtime.Object, pod *corev1.Pod) error {
+	defer c.createPodTracker.Inc()
+	if c.createPodTracker.ErrorReady() {
+		defer c.createPodTracker.Reset()
+		return c.createPodTracker.GetError()
+	}
+
+	return c.PodIndexer.Add(pod)
+}
+
 func (c *FakePodControl) ForceDeletePod(rObj runtime.Object, pod *corev1.Pod) error {
 	return c.DeletePod(rObj, pod)
 }
@@ -22,6 +22,8 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/backup/restore"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"github.com/pingcap/tidb-operator/pkg/util/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -57,6 +59,8 @@ func NewController(deps *controller.Dependencies) *Controller {
 	restoreInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: c.updateRestore,
 		UpdateFunc: func(old, cur interface{}) {
+			recordRestoreLifecycle(old.(*v1alpha1.Restore), cur.(*v1alpha1.Restore))
+			observeRestoreMetrics(old.(*v1alpha1.Restore), cur.(*v1alpha1.Restore))
 			c.updateRestore(cur)
 		},
 		DeleteFunc: c.enqueueRestore,
@@ -152,10 +156,74 @@ func (c *Controller) sync(key string) (err error) {
 	return c.syncRestore(restore.DeepCopy())
 }
 
-func (c *Controller) syncRestore(restore *v1alpha1.Restore) error {
+func (c *Controller) syncRestore(restore *v1alpha1.Restore) (err error) {
+	span := tracing.StartSpan("UpdateRestore", tracing.Key{Kind: v1alpha1.RestoreKind, Namespace: restore.Namespace, Name: restore.Name})
+	defer func() { span.End(err) }()
 	return c.control.UpdateRestore(restore)
 }
 
+// recordRestoreLifecycle records the span from the start to the end of the restore when it's complete or failed
+func recordRestoreLifecycle(oldRestore, newRestore *v1alpha1.Restore) {
+	if v1alpha1.IsRestoreComplete(oldRestore) || v1alpha1.IsRestoreFailed(oldRestore) {
+		return
+	}
+	var err error
+	if v1alpha1.IsRestoreFailed(newRestore) {
+		_, condition := v1alpha1.GetRestoreCondition(&newRestore.Status, v1alpha1.RestoreFailed)
+		if condition != nil {
+			err = fmt.Errorf("%s: %s", condition.Reason, condition.Message)
+		} else {
+			err = fmt.Errorf("restore failed")
+		}
+	} else if !v1alpha1.IsRestoreComplete(newRestore) {
+		return
+	}
+
+	start, end := newRestore.Status.TimeStarted.Time, newRestore.Status.TimeCompleted.Time
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if start.IsZero() {
+		start = newRestore.CreationTimestamp.Time
+	}
+	var attrs []attribute.KeyValue
+	if br := newRestore.Spec.BR; br != nil {
+		attrs = tracing.ClusterAttributes(br.ClusterNamespace, br.Cluster, newRestore.Namespace)
+	}
+	key := tracing.Key{Kind: v1alpha1.RestoreKind, Namespace: newRestore.Namespace, Name: newRestore.Name}
+	tracing.RecordSpan("Restore", key, start, end, err, attrs...)
+}
+
+// observeRestoreMetrics records the duration of the restore when it's complete or failed
+func observeRestoreMetrics(oldRestore, newRestore *v1alpha1.Restore) {
+	if v1alpha1.IsRestoreComplete(oldRestore) || v1alpha1.IsRestoreFailed(oldRestore) {
+		return
+	}
+	status := ""
+	switch {
+	case v1alpha1.IsRestoreComplete(newRestore):
+		status = string(v1alpha1.RestoreComplete)
+	case v1alpha1.IsRestoreFailed(newRestore):
+		status = string(v1alpha1.RestoreFailed)
+	default:
+		return
+	}
+
+	started, completed := newRestore.Status.TimeStarted.Time, newRestore.Status.TimeCompleted.Time
+	if started.IsZero() || completed.IsZero() {
+		return
+	}
+	tcName := ""
+	if newRestore.Spec.BR != nil {
+		tcName = newRestore.Spec.BR.Cluster
+	}
+	restoreType := string(newRestore.Spec.Mode)
+	if restoreType == "" {
+		restoreType = string(v1alpha1.RestoreModeSnapshot)
+	}
+	metrics.RestoreDuration.WithLabelValues(newRestore.Namespace, tcName, restoreType, status).Observe(completed.Sub(started).Seconds())
+}
+
 func (c *Controller) updateRestore(cur interface{}) {
 	newRestore := cur.(*v1alpha1.Restore)
 	klog.V(4).Infof("restore-manager update %v", newRestore)
@@ -76,7 +76,7 @@ type defaultTiCDCControl struct {
 
 // NewDefaultTiCDCControl returns a defaultTiCDCControl instance
 func NewDefaultTiCDCControl(secretLister corelisterv1.SecretLister) *defaultTiCDCControl {
-	return &defaultTiCDCControl{httpClient: httpClient{secretLister: secretLister}}
+	return &defaultTiCDCControl{httpClient: httpClient{secretLister: secretLister, component: "ticdc"}}
 }
 
 func (c *defaultTiCDCControl) GetStatus(tc *v1alpha1.TidbCluster, ordinal int32) (*CaptureStatus, error) {
@@ -19,10 +19,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	httputil "github.com/pingcap/tidb-operator/pkg/util/http"
+	"github.com/prometheus/common/expfmt"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 )
 
@@ -31,12 +33,40 @@ const (
 	// NotDDLOwnerError is the error message which was returned when the tidb node is not a ddl owner
 	NotDDLOwnerError = "This node is not a ddl owner, can't be resigned."
 	timeout          = 5 * time.Second
+
+	metricNameDDLWaitingJobs = "tidb_ddl_waiting_jobs"
+	metricNameDDLRunningJobs = "tidb_ddl_running_job_count"
 )
 
 type DBInfo struct {
 	IsOwner bool `json:"is_owner"`
 }
 
+// ServerInfo is the info of a TiDB server returned from TiDB status API
+type ServerInfo struct {
+	ID         string `json:"ddl_id"`
+	IP         string `json:"ip"`
+	Port       uint   `json:"listening_port"`
+	StatusPort uint   `json:"status_port"`
+	Version    string `json:"version"`
+}
+
+// ClusterServerInfo is the info of all TiDB servers returned from TiDB status API
+type ClusterServerInfo struct {
+	ServersNum int    `json:"servers_num,omitempty"`
+	OwnerID    string `json:"owner_id"`
+	// AllServersInfo is the info of all TiDB servers, the key is the DDL ID of the server
+	AllServersInfo map[string]*ServerInfo `json:"all_servers_info,omitempty"`
+}
+
+// Owner returns the info of the DDL owner, nil is returned if the owner is not found
+func (info *ClusterServerInfo) Owner() *ServerInfo {
+	if info == nil || info.OwnerID == "" {
+		return nil
+	}
+	return info.AllServersInfo[info.OwnerID]
+}
+
 // TiDBControlInterface is the interface that knows how to manage tidb peers
 type TiDBControlInterface interface {
 	// GetHealth returns tidb's health info
@@ -45,6 +75,13 @@ type TiDBControlInterface interface {
 	GetInfo(tc *v1alpha1.TidbCluster, ordinal int32) (*DBInfo, error)
 	// SetServerLabels update TiDB's labels config
 	SetServerLabels(tc *v1alpha1.TidbCluster, ordinal int32, labels map[string]string) error
+	// GetAllServersInfo returns the info of all TiDB servers and the DDL owner
+	GetAllServersInfo(tc *v1alpha1.TidbCluster, ordinal int32) (*ClusterServerInfo, error)
+	// ResignDDLOwner asks the TiDB to resign the DDL owner, false is returned if it's not the DDL owner
+	ResignDDLOwner(tc *v1alpha1.TidbCluster, ordinal int32) (bool, error)
+	// GetDDLJobCount returns the count of the running and waiting DDL jobs from the metrics of the TiDB,
+	// only the DDL owner reports the jobs
+	GetDDLJobCount(tc *v1alpha1.TidbCluster, ordinal int32) (int, error)
 }
 
 // defaultTiDBControl is default implementation of TiDBControlInterface.
@@ -56,7 +93,7 @@ type defaultTiDBControl struct {
 
 // NewDefaultTiDBControl returns a defaultTiDBControl instance
 func NewDefaultTiDBControl(secretLister corelisterv1.SecretLister) *defaultTiDBControl {
-	return &defaultTiDBControl{httpClient: httpClient{secretLister: secretLister}}
+	return &defaultTiDBControl{httpClient: httpClient{secretLister: secretLister, component: "tidb"}}
 }
 
 func (c *defaultTiDBControl) GetHealth(tc *v1alpha1.TidbCluster, ordinal int32) (bool, error) {
@@ -121,6 +158,82 @@ func (c *defaultTiDBControl) SetServerLabels(tc *v1alpha1.TidbCluster, ordinal i
 	return err
 }
 
+func (c *defaultTiDBControl) GetAllServersInfo(tc *v1alpha1.TidbCluster, ordinal int32) (*ClusterServerInfo, error) {
+	httpClient, err := c.getHTTPClient(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/info/all", c.getBaseURL(tc, ordinal))
+	body, err := getBodyOK(httpClient, url)
+	if err != nil {
+		return nil, err
+	}
+	info := &ClusterServerInfo{}
+	if err := json.Unmarshal(body, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (c *defaultTiDBControl) ResignDDLOwner(tc *v1alpha1.TidbCluster, ordinal int32) (bool, error) {
+	httpClient, err := c.getHTTPClient(tc)
+	if err != nil {
+		return false, err
+	}
+
+	url := fmt.Sprintf("%s/ddl/owner/resign", c.getBaseURL(tc, ordinal))
+	req, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return false, err
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer httputil.DeferClose(res.Body)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return false, err
+	}
+	if res.StatusCode == http.StatusOK {
+		return true, nil
+	}
+	if strings.Contains(string(body), NotDDLOwnerError) {
+		return false, nil
+	}
+	return false, fmt.Errorf("Error response %s:%v URL: %s", string(body), res.StatusCode, url)
+}
+
+func (c *defaultTiDBControl) GetDDLJobCount(tc *v1alpha1.TidbCluster, ordinal int32) (int, error) {
+	httpClient, err := c.getHTTPClient(tc)
+	if err != nil {
+		return 0, err
+	}
+
+	url := fmt.Sprintf("%s/metrics", c.getBaseURL(tc, ordinal))
+	body, err := getBodyOK(httpClient, url)
+	if err != nil {
+		return 0, err
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse metrics from %s: %v", url, err)
+	}
+	var count float64
+	for _, name := range []string{metricNameDDLWaitingJobs, metricNameDDLRunningJobs} {
+		family, ok := families[name]
+		if !ok {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			count += m.GetGauge().GetValue()
+		}
+	}
+	return int(count), nil
+}
+
 func getBodyOK(httpClient *http.Client, apiURL string) ([]byte, error) {
 	res, err := httpClient.Get(apiURL)
 	if err != nil {
@@ -158,10 +271,13 @@ func (c *defaultTiDBControl) getBaseURL(tc *v1alpha1.TidbCluster, ordinal int32)
 
 // FakeTiDBControl is a fake implementation of TiDBControlInterface.
 type FakeTiDBControl struct {
-	healthInfo     map[string]bool
-	tiDBInfo       *DBInfo
-	getInfoError   error
-	setLabelsError error
+	healthInfo        map[string]bool
+	tiDBInfo          *DBInfo
+	getInfoError      error
+	setLabelsError    error
+	clusterServerInfo *ClusterServerInfo
+	ddlJobCount       int
+	resignedOrdinals  []int32
 }
 
 // NewFakeTiDBControl returns a FakeTiDBControl instance
@@ -178,6 +294,22 @@ func (c *FakeTiDBControl) SetLabelsErr(err error) {
 	c.setLabelsError = err
 }
 
+// SetClusterServerInfo sets the info of all TiDB servers for FakeTiDBControl, an error is
+// returned by GetAllServersInfo if it's nil
+func (c *FakeTiDBControl) SetClusterServerInfo(info *ClusterServerInfo) {
+	c.clusterServerInfo = info
+}
+
+// SetDDLJobCount sets the count of DDL jobs for FakeTiDBControl
+func (c *FakeTiDBControl) SetDDLJobCount(count int) {
+	c.ddlJobCount = count
+}
+
+// GetResignedOrdinals returns the ordinals of the TiDB asked to resign the DDL owner
+func (c *FakeTiDBControl) GetResignedOrdinals() []int32 {
+	return c.resignedOrdinals
+}
+
 func (c *FakeTiDBControl) GetHealth(tc *v1alpha1.TidbCluster, ordinal int32) (bool, error) {
 	podName := fmt.Sprintf("%s-%d", TiDBMemberName(tc.GetName()), ordinal)
 	if c.healthInfo == nil {
@@ -196,3 +328,19 @@ func (c *FakeTiDBControl) GetInfo(tc *v1alpha1.TidbCluster, ordinal int32) (*DBI
 func (c *FakeTiDBControl) SetServerLabels(tc *v1alpha1.TidbCluster, ordinal int32, labels map[string]string) error {
 	return c.setLabelsError
 }
+
+func (c *FakeTiDBControl) GetAllServersInfo(tc *v1alpha1.TidbCluster, ordinal int32) (*ClusterServerInfo, error) {
+	if c.clusterServerInfo == nil {
+		return nil, fmt.Errorf("cluster server info is not set")
+	}
+	return c.clusterServerInfo, nil
+}
+
+func (c *FakeTiDBControl) ResignDDLOwner(tc *v1alpha1.TidbCluster, ordinal int32) (bool, error) {
+	c.resignedOrdinals = append(c.resignedOrdinals, ordinal)
+	return true, nil
+}
+
+func (c *FakeTiDBControl) GetDDLJobCount(tc *v1alpha1.TidbCluster, ordinal int32) (int, error) {
+	return c.ddlJobCount, nil
+}
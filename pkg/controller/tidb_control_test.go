@@ -318,6 +318,116 @@ func TestSetLabels(t *testing.T) {
 	}
 }
 
+func TestGetAllServersInfo(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal(http.MethodGet), "check method")
+		g.Expect(request.URL.Path).To(Equal("/info/all"), "check url")
+
+		w.Header().Set("Content-Type", ContentTypeJSON)
+		w.Write([]byte(`{"servers_num":2,"owner_id":"id-1","is_all_server_version_consistent":true,"all_servers_info":{` +
+			`"id-0":{"ddl_id":"id-0","ip":"demo-tidb-0.demo-tidb-peer.default.svc","listening_port":4000,"status_port":10080,"version":"v8.1.0"},` +
+			`"id-1":{"ddl_id":"id-1","ip":"demo-tidb-1.demo-tidb-peer.default.svc","listening_port":4000,"status_port":10080,"version":"v8.1.0"}}}`))
+	})
+	defer svc.Close()
+
+	fakeClient := &fake.Clientset{}
+	informer := kubeinformers.NewSharedInformerFactory(fakeClient, 0)
+	control := NewDefaultTiDBControl(informer.Core().V1().Secrets().Lister())
+	control.testURL = svc.URL
+	info, err := control.GetAllServersInfo(getTidbCluster(), 0)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(info.ServersNum).To(Equal(2))
+	g.Expect(info.AllServersInfo).To(HaveLen(2))
+	g.Expect(info.Owner()).To(Equal(&ServerInfo{
+		ID:         "id-1",
+		IP:         "demo-tidb-1.demo-tidb-peer.default.svc",
+		Port:       4000,
+		StatusPort: 10080,
+		Version:    "v8.1.0",
+	}))
+}
+
+func TestResignDDLOwner(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cases := []struct {
+		caseName string
+		status   int
+		body     string
+		resigned bool
+		failed   bool
+	}{
+		{
+			caseName: "resigned",
+			status:   http.StatusOK,
+			resigned: true,
+		},
+		{
+			caseName: "not owner",
+			status:   http.StatusBadRequest,
+			body:     NotDDLOwnerError,
+		},
+		{
+			caseName: "failed",
+			status:   http.StatusInternalServerError,
+			body:     "internal error",
+			failed:   true,
+		},
+	}
+
+	for _, c := range cases {
+		svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+			g.Expect(request.Method).To(Equal(http.MethodPost), "check method")
+			g.Expect(request.URL.Path).To(Equal("/ddl/owner/resign"), "check url")
+
+			w.WriteHeader(c.status)
+			w.Write([]byte(c.body))
+		})
+		defer svc.Close()
+
+		fakeClient := &fake.Clientset{}
+		informer := kubeinformers.NewSharedInformerFactory(fakeClient, 0)
+		control := NewDefaultTiDBControl(informer.Core().V1().Secrets().Lister())
+		control.testURL = svc.URL
+		resigned, err := control.ResignDDLOwner(getTidbCluster(), 0)
+		if c.failed {
+			g.Expect(err).To(HaveOccurred(), c.caseName)
+		} else {
+			g.Expect(err).NotTo(HaveOccurred(), c.caseName)
+		}
+		g.Expect(resigned).To(Equal(c.resigned), c.caseName)
+	}
+}
+
+func TestGetDDLJobCount(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal(http.MethodGet), "check method")
+		g.Expect(request.URL.Path).To(Equal("/metrics"), "check url")
+
+		w.Write([]byte(`# HELP tidb_ddl_waiting_jobs Gauge of jobs.
+# TYPE tidb_ddl_waiting_jobs gauge
+tidb_ddl_waiting_jobs{type="add index"} 1
+tidb_ddl_waiting_jobs{type="create table"} 0
+# HELP tidb_ddl_running_job_count Running DDL jobs count
+# TYPE tidb_ddl_running_job_count gauge
+tidb_ddl_running_job_count{type="add index"} 1
+`))
+	})
+	defer svc.Close()
+
+	fakeClient := &fake.Clientset{}
+	informer := kubeinformers.NewSharedInformerFactory(fakeClient, 0)
+	control := NewDefaultTiDBControl(informer.Core().V1().Secrets().Lister())
+	control.testURL = svc.URL
+	count, err := control.GetDDLJobCount(getTidbCluster(), 0)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(count).To(Equal(2))
+}
+
 func getTidbCluster() *v1alpha1.TidbCluster {
 	return &v1alpha1.TidbCluster{
 		TypeMeta: metav1.TypeMeta{
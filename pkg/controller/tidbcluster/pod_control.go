@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -198,6 +199,11 @@ func (c *PodController) sync(key string) (result reconcile.Result, err error) {
 	if tcName == "" {
 		return reconcile.Result{}, nil
 	}
+	// the instance label of the pods of a TiDB group, TiKV pool or the TiFlash compute nodes is `<cluster>-<name>`
+	subClusterKey, subClusterName := subClusterLabelOfPod(pod)
+	if subClusterKey != "" {
+		tcName = strings.TrimSuffix(tcName, "-"+subClusterName)
+	}
 
 	tc, err := c.deps.TiDBClusterLister.TidbClusters(ns).Get(tcName)
 	if err != nil {
@@ -208,6 +214,9 @@ func (c *PodController) sync(key string) (result reconcile.Result, err error) {
 		return reconcile.Result{}, perrors.Annotatef(err, "failed to get TidbCluster %q", ns+"/"+tcName)
 	}
 	tc = tc.DeepCopy()
+	if subClusterKey != "" {
+		tc = subClusterOf(tc, subClusterKey, subClusterName)
+	}
 
 	startTime := time.Now()
 	defer func() {
@@ -242,6 +251,77 @@ func (c *PodController) sync(key string) (result reconcile.Result, err error) {
 	}
 }
 
+// subClusterLabelOfPod returns the label key and the name of the TiDB group, TiKV pool or TiFlash compute nodes
+// which the pod belongs to, the key is empty if the pod belongs to the cluster itself
+func subClusterLabelOfPod(pod *corev1.Pod) (string, string) {
+	for _, key := range []string{label.TiDBGroupLabelKey, label.TiKVPoolLabelKey} {
+		if name := pod.Labels[key]; name != "" {
+			return key, name
+		}
+	}
+	if pod.Labels[label.TiFlashRoleLabelKey] == label.TiFlashComputeRoleLabelVal {
+		return label.TiFlashRoleLabelKey, label.TiFlashComputeRoleLabelVal
+	}
+	return "", ""
+}
+
+// subClusterOf returns the in-memory cluster which syncs the TiDB group, TiKV pool or TiFlash compute nodes,
+// so the pods of them are synced in the same way as the pods of the cluster. The group or pool which is
+// removed from the spec is still returned, as its pods are being scaled in.
+func subClusterOf(tc *v1alpha1.TidbCluster, key, name string) *v1alpha1.TidbCluster {
+	switch key {
+	case label.TiDBGroupLabelKey:
+		for i := range tc.Spec.TiDBGroups {
+			if tc.Spec.TiDBGroups[i].Name == name {
+				return tc.TiDBGroupCluster(&tc.Spec.TiDBGroups[i])
+			}
+		}
+		return tc.TiDBGroupCluster(&v1alpha1.TiDBGroupSpec{Name: name})
+	case label.TiKVPoolLabelKey:
+		for i := range tc.Spec.TiKVPools {
+			if tc.Spec.TiKVPools[i].Name == name {
+				return tc.TiKVPoolCluster(&tc.Spec.TiKVPools[i])
+			}
+		}
+		return tc.TiKVPoolCluster(&v1alpha1.TiKVPoolSpec{Name: name})
+	case label.TiFlashRoleLabelKey:
+		if tc.TiFlashDisaggregated() {
+			return tc.TiFlashComputeCluster()
+		}
+	}
+	return tc
+}
+
+// updateEvictLeaderStatus sets the status of the leader eviction of the TiKV pod in the TidbCluster, or deletes
+// it if the status is nil. The status of a pod in a TiKV pool is set in the status of the pool in the owner.
+func (c *PodController) updateEvictLeaderStatus(ctx context.Context, tc *v1alpha1.TidbCluster, podName string, status *v1alpha1.EvictLeaderStatus) error {
+	pool := tc.Labels[label.TiKVPoolLabelKey]
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		owner, err := c.deps.TiDBClusterLister.TidbClusters(tc.Namespace).Get(tc.GetOwnerName())
+		if err != nil {
+			return err
+		}
+		// make a copy so we don't mutate the shared cache
+		owner = owner.DeepCopy()
+		tikvStatus := &owner.Status.TiKV
+		if pool != "" {
+			if tikvStatus = owner.Status.TiKVPools[pool]; tikvStatus == nil {
+				return fmt.Errorf("the status of TiKV pool %s is not found", pool)
+			}
+		}
+		if status == nil {
+			delete(tikvStatus.EvictLeader, podName)
+		} else {
+			if tikvStatus.EvictLeader == nil {
+				tikvStatus.EvictLeader = make(map[string]*v1alpha1.EvictLeaderStatus)
+			}
+			tikvStatus.EvictLeader[podName] = status
+		}
+		_, err = c.deps.Clientset.PingcapV1alpha1().TidbClusters(owner.Namespace).Update(ctx, owner, metav1.UpdateOptions{})
+		return err
+	})
+}
+
 func (c *PodController) getPDClient(tc *v1alpha1.TidbCluster) pdapi.PDClient {
 	if c.testPDClient != nil {
 		return c.testPDClient
@@ -419,11 +499,8 @@ func (c *PodController) syncTiKVPodForEviction(ctx context.Context, pod *corev1.
 				tc.Status.TiKV.EvictLeader = make(map[string]*v1alpha1.EvictLeaderStatus)
 			}
 			tc.Status.TiKV.EvictLeader[pod.Name] = evictStatus
-			var err error
-			key := fmt.Sprintf("%s/%s", tc.Namespace, tc.Name)
-			tc, err = c.deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Update(ctx, tc, metav1.UpdateOptions{})
-			if err != nil {
-				return reconcile.Result{}, perrors.Annotatef(err, "failed to update tc %q status", key)
+			if err := c.updateEvictLeaderStatus(ctx, tc, pod.Name, evictStatus); err != nil {
+				return reconcile.Result{}, perrors.Annotatef(err, "failed to update tc %q status", tc.Namespace+"/"+tc.GetOwnerName())
 			}
 
 			stat := c.getPodStat(pod)
@@ -495,24 +572,9 @@ func (c *PodController) syncTiKVPodForEviction(ctx context.Context, pod *corev1.
 				return perrors.Annotatef(err, "failed to remove evict leader scheduler for store %d, pod %s/%s", storeID, pod.Namespace, pod.Name)
 			}
 
-			err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
-				delete(tc.Status.TiKV.EvictLeader, pod.Name)
-				_, updateErr := c.deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Update(ctx, tc, metav1.UpdateOptions{})
-				if updateErr == nil {
-					return nil
-				}
-
-				if updated, err := c.deps.TiDBClusterLister.TidbClusters(tc.Namespace).Get(tc.Name); err == nil {
-					// // make a copy so we don't mutate the shared cache
-					tc = updated.DeepCopy()
-				} else {
-					utilruntime.HandleError(fmt.Errorf("error getting updated tc %s/%s from lister: %v", tc.Namespace, tc.Name, err))
-				}
-
-				return updateErr
-			})
-			if err != nil {
-				return perrors.Annotatef(err, "failed to update status for tc %s/%s", tc.Namespace, tc.Name)
+			delete(tc.Status.TiKV.EvictLeader, pod.Name)
+			if err := c.updateEvictLeaderStatus(ctx, tc, pod.Name, nil); err != nil {
+				return perrors.Annotatef(err, "failed to update status for tc %s/%s", tc.Namespace, tc.GetOwnerName())
 			}
 
 			return nil
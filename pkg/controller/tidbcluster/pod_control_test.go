@@ -47,12 +47,20 @@ func (c *kvClient) GetLeaderCount() (int, error) {
 	return int(count), nil
 }
 
+func (c *kvClient) GetMaxAllocatedID(ctx context.Context) (uint64, error) {
+	return 0, nil
+}
+
 // FlushLogBackupTasks implements tikvapi.TiKVClient.
 func (c *kvClient) FlushLogBackupTasks(ctx context.Context) error {
 	c.logBackupFlushed.Store(true)
 	return nil
 }
 
+func (c *kvClient) SetConfig(config map[string]string) error {
+	return nil
+}
+
 func TestTiKVPodSyncForEviction(t *testing.T) {
 	interval := time.Millisecond * 100
 	timeout := time.Minute * 1
@@ -176,6 +184,68 @@ func TestTiKVPodSyncForEviction(t *testing.T) {
 	g.Expect(kvClient.logBackupFlushed.Load()).To(BeTrue(), "should flushed log backup tasks")
 }
 
+func TestTiKVPoolPodSyncForEviction(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbCluster()
+	tc.Spec.TiKVPools = []v1alpha1.TiKVPoolSpec{{Name: "ssd", TiKVSpec: *tc.Spec.TiKV.DeepCopy()}}
+	pod := newTiKVPod(tc.TiKVPoolCluster(&tc.Spec.TiKVPools[0]))
+	pod.Labels[label.TiKVPoolLabelKey] = "ssd"
+	pod.Annotations = map[string]string{v1alpha1.EvictLeaderAnnKey: v1alpha1.EvictLeaderValueNone}
+	tc.Status.TiKVPools = map[string]*v1alpha1.TiKVStatus{
+		"ssd": {
+			Stores: map[string]v1alpha1.TiKVStore{
+				"1": {PodName: pod.Name, ID: "1"},
+			},
+		},
+	}
+
+	deps := controller.NewFakeDependencies()
+	c := NewPodController(deps)
+	pdClient := pdapi.NewFakePDClient()
+	c.testPDClient = pdClient
+	pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.StoresInfo{Stores: []*pdapi.StoreInfo{{Store: &pdapi.MetaStore{StateName: v1alpha1.TiKVStateUp}}}}, nil
+	})
+	var evicted, ended uint64
+	pdClient.AddReaction(pdapi.BeginEvictLeaderActionType, func(action *pdapi.Action) (interface{}, error) {
+		evicted = action.ID
+		return nil, nil
+	})
+	pdClient.AddReaction(pdapi.EndEvictLeaderActionType, func(action *pdapi.Action) (interface{}, error) {
+		ended = action.ID
+		return nil, nil
+	})
+
+	ctx := context.Background()
+	tcIndexer := deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer()
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	_, err := deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Create(ctx, tc, metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tcIndexer.Add(tc)).To(Succeed())
+	g.Expect(podIndexer.Add(pod)).To(Succeed())
+
+	// the pod of the pool is synced with the pool and the status is recorded in the status of the pool
+	_, err = c.sync(pod.Namespace + "/" + pod.Name)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(evicted).To(Equal(uint64(1)))
+	updated, err := deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Get(ctx, tc.Name, metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(updated.Status.TiKVPools["ssd"].EvictLeader).To(HaveKey(pod.Name))
+	g.Expect(updated.Status.TiKV.EvictLeader).To(BeEmpty())
+
+	// the eviction ends after the annotation is removed
+	g.Expect(tcIndexer.Update(updated)).To(Succeed())
+	pod.Annotations = nil
+	g.Expect(podIndexer.Update(pod)).To(Succeed())
+	_, err = c.sync(pod.Namespace + "/" + pod.Name)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ended).To(Equal(uint64(1)))
+	updated, err = deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Get(ctx, tc.Name, metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(updated.Status.TiKVPools["ssd"].EvictLeader).To(BeEmpty())
+}
+
 func TestTiKVPodSyncForReplaceVolume(t *testing.T) {
 	g := NewGomegaWithT(t)
 
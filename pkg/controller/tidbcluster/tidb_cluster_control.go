@@ -23,9 +23,11 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/manager/member"
 	"github.com/pingcap/tidb-operator/pkg/manager/volumes"
 	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"github.com/pingcap/tidb-operator/pkg/util/tracing"
 	v1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	errorutils "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 )
@@ -63,22 +65,22 @@ func NewDefaultTidbClusterControl(
 	recorder record.EventRecorder) ControlInterface {
 	return &defaultTidbClusterControl{
 		tcControl:                tcControl,
-		pdMemberManager:          pdMemberManager,
-		pdMSMemberManager:        pdMSMemberManager,
-		tikvMemberManager:        tikvMemberManager,
-		tidbMemberManager:        tidbMemberManager,
-		tiproxyMemberManager:     tiproxyMemberManager,
-		reclaimPolicyManager:     reclaimPolicyManager,
-		metaManager:              metaManager,
+		pdMemberManager:          manager.WithTracing("PD", pdMemberManager),
+		pdMSMemberManager:        manager.WithTracing("PDMS", pdMSMemberManager),
+		tikvMemberManager:        manager.WithTracing("TiKV", tikvMemberManager),
+		tidbMemberManager:        manager.WithTracing("TiDB", tidbMemberManager),
+		tiproxyMemberManager:     manager.WithTracing("TiProxy", tiproxyMemberManager),
+		reclaimPolicyManager:     manager.WithTracing("ReclaimPolicy", reclaimPolicyManager),
+		metaManager:              manager.WithTracing("Meta", metaManager),
 		orphanPodsCleaner:        orphanPodsCleaner,
 		pvcCleaner:               pvcCleaner,
 		pvcModifier:              pvcModifier,
 		pvcReplacer:              pvcReplacer,
-		pumpMemberManager:        pumpMemberManager,
-		tiflashMemberManager:     tiflashMemberManager,
-		ticdcMemberManager:       ticdcMemberManager,
+		pumpMemberManager:        manager.WithTracing("Pump", pumpMemberManager),
+		tiflashMemberManager:     manager.WithTracing("TiFlash", tiflashMemberManager),
+		ticdcMemberManager:       manager.WithTracing("TiCDC", ticdcMemberManager),
 		discoveryManager:         discoveryManager,
-		tidbClusterStatusManager: tidbClusterStatusManager,
+		tidbClusterStatusManager: manager.WithTracing("TidbClusterStatus", tidbClusterStatusManager),
 		conditionUpdater:         conditionUpdater,
 		recorder:                 recorder,
 	}
@@ -107,7 +109,11 @@ type defaultTidbClusterControl struct {
 }
 
 // UpdateTidbCluster executes the core logic loop for a tidbcluster.
-func (c *defaultTidbClusterControl) UpdateTidbCluster(tc *v1alpha1.TidbCluster) error {
+func (c *defaultTidbClusterControl) UpdateTidbCluster(tc *v1alpha1.TidbCluster) (err error) {
+	key := tracing.Key{Kind: v1alpha1.TiDBClusterKind, Namespace: tc.Namespace, Name: tc.Name}
+	span := tracing.StartSpan("UpdateTidbCluster", key)
+	defer func() { span.End(err) }()
+
 	c.defaulting(tc)
 	if !c.validate(tc) {
 		return nil // fatal error, no need to retry on invalid object
@@ -124,10 +130,15 @@ func (c *defaultTidbClusterControl) UpdateTidbCluster(tc *v1alpha1.TidbCluster)
 		errs = append(errs, err)
 	}
 
+	observeTidbClusterStatus(tc, oldStatus)
+
 	if apiequality.Semantic.DeepEqual(&tc.Status, oldStatus) {
 		return errorutils.NewAggregate(errs)
 	}
-	if _, err := c.tcControl.UpdateTidbCluster(tc.DeepCopy(), &tc.Status, oldStatus); err != nil {
+	statusSpan := tracing.StartSpan("UpdateTidbClusterStatus", key)
+	_, err = c.tcControl.UpdateTidbCluster(tc.DeepCopy(), &tc.Status, oldStatus)
+	statusSpan.End(err)
+	if err != nil {
 		errs = append(errs, err)
 	}
 
@@ -160,6 +171,13 @@ func (c *defaultTidbClusterControl) updateTidbCluster(tc *v1alpha1.TidbCluster)
 		metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "pv_reclaim_policy").Inc()
 		return err
 	}
+	// the PVs of the TiKV pools are labeled by the instance names of the pools
+	for _, ptc := range tikvPoolClusters(tc) {
+		if err := c.reclaimPolicyManager.Sync(ptc); err != nil {
+			metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "pv_reclaim_policy").Inc()
+			return err
+		}
+	}
 
 	// cleaning all orphan pods(pd, tikv or tiflash which don't have a related PVC) managed by operator
 	// this could be useful when failover run into an undesired situation as described in PD failover function
@@ -292,6 +310,12 @@ func (c *defaultTidbClusterControl) updateTidbCluster(tc *v1alpha1.TidbCluster)
 		metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "meta").Inc()
 		return err
 	}
+	for _, ptc := range tikvPoolClusters(tc) {
+		if err := c.metaManager.Sync(ptc); err != nil {
+			metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "meta").Inc()
+			return err
+		}
+	}
 
 	// cleaning the pod scheduling annotation for pd and tikv
 	pvcSkipReasons, err := c.pvcCleaner.Clean(tc)
@@ -304,6 +328,19 @@ func (c *defaultTidbClusterControl) updateTidbCluster(tc *v1alpha1.TidbCluster)
 			klog.Infof("pvc %s of cluster %s/%s is skipped, reason %q", pvcName, tc.Namespace, tc.Name, reason)
 		}
 	}
+	// the PVCs of the TiKV pools are labeled by the instance names of the pools
+	for _, ptc := range tikvPoolClusters(tc) {
+		pvcSkipReasons, err := c.pvcCleaner.Clean(ptc)
+		if err != nil {
+			metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "pvc_cleaner").Inc()
+			return err
+		}
+		if klog.V(10).Enabled() {
+			for pvcName, reason := range pvcSkipReasons {
+				klog.Infof("pvc %s of cluster %s/%s is skipped, reason %q", pvcName, ptc.Namespace, ptc.Name, reason)
+			}
+		}
+	}
 
 	// Replace volumes if necessary. Note: if enabled, takes precedence over pvcModifier.
 	if features.DefaultFeatureGate.Enabled(features.VolumeReplacing) || tc.IsPVCReplaceEnabled() {
@@ -328,6 +365,23 @@ func (c *defaultTidbClusterControl) updateTidbCluster(tc *v1alpha1.TidbCluster)
 	return err
 }
 
+// tikvPoolClusters returns the clusters to sync the TiKV pools, including the pools which are removed
+// from the spec but still recorded in the status.
+func tikvPoolClusters(tc *v1alpha1.TidbCluster) []*v1alpha1.TidbCluster {
+	var ptcs []*v1alpha1.TidbCluster
+	pools := sets.NewString()
+	for i := range tc.Spec.TiKVPools {
+		pools.Insert(tc.Spec.TiKVPools[i].Name)
+		ptcs = append(ptcs, tc.TiKVPoolCluster(&tc.Spec.TiKVPools[i]))
+	}
+	for name := range tc.Status.TiKVPools {
+		if !pools.Has(name) {
+			ptcs = append(ptcs, tc.TiKVPoolCluster(&v1alpha1.TiKVPoolSpec{Name: name}))
+		}
+	}
+	return ptcs
+}
+
 func (c *defaultTidbClusterControl) recordMetrics(tc *v1alpha1.TidbCluster) {
 	ns := tc.GetNamespace()
 	tcName := tc.GetName()
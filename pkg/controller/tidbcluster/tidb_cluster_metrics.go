@@ -0,0 +1,132 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbcluster
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var memberPhases = []v1alpha1.MemberPhase{
+	v1alpha1.NormalPhase,
+	v1alpha1.UpgradePhase,
+	v1alpha1.ScalePhase,
+	v1alpha1.SuspendPhase,
+}
+
+// upgradeBeginTimes records when the components began to upgrade, the key is namespace/name/component.
+// The upgrades in progress when the operator starts are not observed as their begin time is unknown.
+var upgradeBeginTimes sync.Map
+
+// observeTidbClusterStatus updates the metrics of the components of the tidbcluster from the status changed
+// by a reconciliation
+func observeTidbClusterStatus(tc *v1alpha1.TidbCluster, oldStatus *v1alpha1.TidbClusterStatus) {
+	ns, tcName := tc.GetNamespace(), tc.GetName()
+	oldTC := &v1alpha1.TidbCluster{Spec: tc.Spec, Status: *oldStatus}
+
+	for _, status := range tc.AllComponentStatus() {
+		component := status.MemberType().String()
+		phase := status.GetPhase()
+		for _, p := range memberPhases {
+			value := 0.0
+			if p == phase {
+				value = 1
+			}
+			metrics.ClusterComponentPhase.WithLabelValues(ns, tcName, component, string(p)).Set(value)
+		}
+
+		var oldPhase v1alpha1.MemberPhase
+		if oldComponentStatus := oldTC.ComponentStatus(status.MemberType()); oldComponentStatus != nil {
+			oldPhase = oldComponentStatus.GetPhase()
+		}
+		key := fmt.Sprintf("%s/%s/%s", ns, tcName, component)
+		if phase == v1alpha1.UpgradePhase && oldPhase != v1alpha1.UpgradePhase {
+			upgradeBeginTimes.Store(key, time.Now())
+		} else if phase != v1alpha1.UpgradePhase && oldPhase == v1alpha1.UpgradePhase {
+			if begin, ok := upgradeBeginTimes.LoadAndDelete(key); ok {
+				metrics.ClusterUpgradeDuration.WithLabelValues(ns, tcName, component).Observe(time.Since(begin.(time.Time)).Seconds())
+			}
+		}
+
+		if sts := status.GetStatefulSet(); sts != nil {
+			metrics.ClusterUpgradePods.WithLabelValues(ns, tcName, component, "updated").Set(float64(sts.UpdatedReplicas))
+			metrics.ClusterUpgradePods.WithLabelValues(ns, tcName, component, "total").Set(float64(sts.Replicas))
+		}
+
+		if failures := failureMembers(&tc.Status, status.MemberType()); failures != nil {
+			metrics.ClusterFailureMembers.WithLabelValues(ns, tcName, component).Set(float64(failures.Len()))
+			newFailures := failures.Difference(failureMembers(oldStatus, status.MemberType()))
+			metrics.ClusterFailoverTotal.WithLabelValues(ns, tcName, component).Add(float64(newFailures.Len()))
+		}
+	}
+
+	// the stores removed from the cluster are not observed any more
+	for id := range oldStatus.TiKV.Stores {
+		if _, ok := tc.Status.TiKV.Stores[id]; !ok {
+			metrics.DeleteClusterStoreMetrics(ns, tcName, v1alpha1.TiKVMemberType.String(), id)
+		}
+	}
+}
+
+// failureMembers returns the names of the failure members or stores of the component, or nil if the component
+// doesn't support failover
+func failureMembers(status *v1alpha1.TidbClusterStatus, mt v1alpha1.MemberType) sets.String {
+	names := sets.NewString()
+	switch mt {
+	case v1alpha1.PDMemberType:
+		for name := range status.PD.FailureMembers {
+			names.Insert(name)
+		}
+	case v1alpha1.TiDBMemberType:
+		for name := range status.TiDB.FailureMembers {
+			names.Insert(name)
+		}
+	case v1alpha1.TiKVMemberType:
+		for name := range status.TiKV.FailureStores {
+			names.Insert(name)
+		}
+	case v1alpha1.TiFlashMemberType:
+		for name := range status.TiFlash.FailureStores {
+			names.Insert(name)
+		}
+	case v1alpha1.TiCDCMemberType:
+		for name := range status.TiCDC.FailureMembers {
+			names.Insert(name)
+		}
+	case v1alpha1.TiProxyMemberType:
+		for name := range status.TiProxy.FailureMembers {
+			names.Insert(name)
+		}
+	default:
+		return nil
+	}
+	return names
+}
+
+// deleteTidbClusterMetrics deletes the metrics of a deleted tidbcluster
+func deleteTidbClusterMetrics(ns, tcName string) {
+	metrics.DeleteClusterMetrics(ns, tcName)
+	upgradeBeginTimes.Range(func(key, _ interface{}) bool {
+		if strings.HasPrefix(key.(string), ns+"/"+tcName+"/") {
+			upgradeBeginTimes.Delete(key)
+		}
+		return true
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbcluster
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestObserveTidbClusterStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "metrics"},
+		Spec: v1alpha1.TidbClusterSpec{
+			PD:   &v1alpha1.PDSpec{},
+			TiKV: &v1alpha1.TiKVSpec{},
+		},
+	}
+	defer deleteTidbClusterMetrics("ns", "metrics")
+
+	// tikv begins to upgrade and a store fails
+	oldStatus := tc.Status.DeepCopy()
+	tc.Status.PD.Phase = v1alpha1.NormalPhase
+	tc.Status.TiKV.Phase = v1alpha1.UpgradePhase
+	tc.Status.TiKV.StatefulSet = &apps.StatefulSetStatus{Replicas: 3, UpdatedReplicas: 1}
+	tc.Status.TiKV.FailureStores = map[string]v1alpha1.TiKVFailureStore{"1": {StoreID: "1"}}
+	observeTidbClusterStatus(tc, oldStatus)
+
+	g.Expect(testutil.ToFloat64(metrics.ClusterComponentPhase.WithLabelValues("ns", "metrics", "tikv", "Upgrade"))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(metrics.ClusterComponentPhase.WithLabelValues("ns", "metrics", "tikv", "Normal"))).To(Equal(0.0))
+	g.Expect(testutil.ToFloat64(metrics.ClusterComponentPhase.WithLabelValues("ns", "metrics", "pd", "Normal"))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(metrics.ClusterUpgradePods.WithLabelValues("ns", "metrics", "tikv", "updated"))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(metrics.ClusterUpgradePods.WithLabelValues("ns", "metrics", "tikv", "total"))).To(Equal(3.0))
+	g.Expect(testutil.ToFloat64(metrics.ClusterFailureMembers.WithLabelValues("ns", "metrics", "tikv"))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(metrics.ClusterFailoverTotal.WithLabelValues("ns", "metrics", "tikv"))).To(Equal(1.0))
+
+	// the failure store is counted only once
+	oldStatus = tc.Status.DeepCopy()
+	observeTidbClusterStatus(tc, oldStatus)
+	g.Expect(testutil.ToFloat64(metrics.ClusterFailoverTotal.WithLabelValues("ns", "metrics", "tikv"))).To(Equal(1.0))
+
+	// tikv finishes upgrading
+	oldStatus = tc.Status.DeepCopy()
+	tc.Status.TiKV.Phase = v1alpha1.NormalPhase
+	tc.Status.TiKV.FailureStores = nil
+	observeTidbClusterStatus(tc, oldStatus)
+	g.Expect(testutil.CollectAndCount(metrics.ClusterUpgradeDuration, "tidb_operator_cluster_upgrade_duration_seconds")).To(BeNumerically(">=", 1))
+	g.Expect(testutil.ToFloat64(metrics.ClusterFailureMembers.WithLabelValues("ns", "metrics", "tikv"))).To(Equal(0.0))
+
+	// the metrics of a removed store are deleted
+	tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{"1": {ID: "1"}, "2": {ID: "2"}}
+	metrics.ClusterEvictLeaderDuration.WithLabelValues("ns", "metrics", "tikv", "1").Set(10)
+	metrics.ClusterEvictLeaderDuration.WithLabelValues("ns", "metrics", "tikv", "2").Set(20)
+	oldStatus = tc.Status.DeepCopy()
+	delete(tc.Status.TiKV.Stores, "2")
+	observeTidbClusterStatus(tc, oldStatus)
+	g.Expect(testutil.CollectAndCount(metrics.ClusterEvictLeaderDuration)).To(Equal(1))
+	g.Expect(testutil.ToFloat64(metrics.ClusterEvictLeaderDuration.WithLabelValues("ns", "metrics", "tikv", "1"))).To(Equal(10.0))
+}
@@ -79,6 +79,11 @@ func (c *realTidbClusterControl) UpdateTidbCluster(tc *v1alpha1.TidbCluster, new
 			// TiKV.EvictLeader is controlled by pod leader evictor in pkg/controller/tidbcluster/pod_control.go
 			// So don't overwrite it
 			status.TiKV.EvictLeader = tc.Status.TiKV.EvictLeader
+			for name, pool := range status.TiKVPools {
+				if latest := tc.Status.TiKVPools[name]; latest != nil {
+					pool.EvictLeader = latest.EvictLeader
+				}
+			}
 			tc.Status = *status
 		} else {
 			utilruntime.HandleError(fmt.Errorf("error getting updated TidbCluster %s/%s from lister: %v", ns, tcName, err))
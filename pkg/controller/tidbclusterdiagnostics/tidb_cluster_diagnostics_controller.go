@@ -0,0 +1,338 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbclusterdiagnostics
+
+import (
+	"fmt"
+	"time"
+
+	perrors "github.com/pingcap/errors"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	backuputil "github.com/pingcap/tidb-operator/pkg/backup/util"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// defaultServiceAccountName is the ServiceAccount of the collection pod if it's not specified
+	defaultServiceAccountName = "tidb-diagnostics-collector"
+	defaultBackoffLimit       = int32(2)
+)
+
+// Controller creates the jobs collecting the diagnostics bundles of TidbClusterDiagnostics
+type Controller struct {
+	deps *controller.Dependencies
+	// diagnostics that need to be synced.
+	queue         workqueue.RateLimitingInterface
+	statusUpdater controller.DiagnosticsStatusUpdaterInterface
+}
+
+// NewController creates a diagnostics controller.
+func NewController(deps *controller.Dependencies) *Controller {
+	c := &Controller{
+		deps: deps,
+		queue: workqueue.NewNamedRateLimitingQueue(
+			controller.NewControllerRateLimiter(1*time.Second, 100*time.Second),
+			"tidbclusterdiagnostics",
+		),
+		statusUpdater: controller.NewRealDiagnosticsStatusUpdater(deps.Recorder, deps.DiagnosticsLister, deps.Clientset),
+	}
+
+	diagInformer := deps.InformerFactory.Pingcap().V1alpha1().TidbClusterDiagnostics()
+	jobInformer := deps.KubeInformerFactory.Batch().V1().Jobs()
+	diagInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueueDiagnostics,
+		UpdateFunc: func(old, cur interface{}) {
+			c.enqueueDiagnostics(cur)
+		},
+	})
+	jobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) {
+			c.enqueueJob(cur)
+		},
+		DeleteFunc: c.enqueueJob,
+	})
+
+	return c
+}
+
+// Name returns the diagnostics controller name.
+func (c *Controller) Name() string {
+	return "tidbclusterdiagnostics"
+}
+
+// Run runs the diagnostics controller.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	klog.Info("Starting diagnostics controller")
+	defer klog.Info("Shutting down diagnostics controller")
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker runs a worker goroutine that invokes processNextWorkItem until the the controller's queue is closed
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+// processNextWorkItem dequeues items, processes them, and marks them done. It enforces that the sync is never
+// invoked concurrently with the same key.
+func (c *Controller) processNextWorkItem() bool {
+	metrics.ActiveWorkers.WithLabelValues(c.Name()).Add(1)
+	defer metrics.ActiveWorkers.WithLabelValues(c.Name()).Add(-1)
+
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+	if err := c.sync(key.(string)); err != nil {
+		if perrors.Find(err, controller.IsRequeueError) != nil {
+			klog.Infof("TidbClusterDiagnostics: %v, still need sync: %v, requeuing", key.(string), err)
+			c.queue.AddRateLimited(key)
+		} else if perrors.Find(err, controller.IsIgnoreError) != nil {
+			klog.V(4).Infof("TidbClusterDiagnostics: %v, ignore err: %v", key.(string), err)
+		} else {
+			utilruntime.HandleError(fmt.Errorf("TidbClusterDiagnostics: %v, sync failed, err: %v, requeuing", key.(string), err))
+			c.queue.AddRateLimited(key)
+		}
+	} else {
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) sync(key string) (err error) {
+	startTime := time.Now()
+	defer func() {
+		duration := time.Since(startTime)
+		metrics.ReconcileTime.WithLabelValues(c.Name()).Observe(duration.Seconds())
+
+		if err == nil {
+			metrics.ReconcileTotal.WithLabelValues(c.Name(), metrics.LabelSuccess).Inc()
+		} else if perrors.Find(err, controller.IsRequeueError) != nil {
+			metrics.ReconcileTotal.WithLabelValues(c.Name(), metrics.LabelRequeue).Inc()
+		} else {
+			metrics.ReconcileTotal.WithLabelValues(c.Name(), metrics.LabelError).Inc()
+			metrics.ReconcileErrors.WithLabelValues(c.Name()).Inc()
+		}
+
+		klog.V(4).Infof("Finished syncing TidbClusterDiagnostics %q (%v)", key, duration)
+	}()
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	diag, err := c.deps.DiagnosticsLister.TidbClusterDiagnostics(ns).Get(name)
+	if errors.IsNotFound(err) {
+		klog.Infof("TidbClusterDiagnostics has been deleted %v", key)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.syncDiagnostics(diag.DeepCopy())
+}
+
+func (c *Controller) syncDiagnostics(diag *v1alpha1.TidbClusterDiagnostics) error {
+	ns := diag.GetNamespace()
+	name := diag.GetName()
+
+	if diag.IsFinished() {
+		return nil
+	}
+
+	if err := validate(diag); err != nil {
+		klog.Errorf("TidbClusterDiagnostics %s/%s is invalid: %v", ns, name, err)
+		return c.statusUpdater.Update(diag, &v1alpha1.TidbClusterDiagnosticsStatus{
+			Phase:   v1alpha1.DiagnosticsFailed,
+			Message: err.Error(),
+		})
+	}
+
+	job, err := c.deps.JobLister.Jobs(ns).Get(diag.GetJobName())
+	if errors.IsNotFound(err) {
+		job, err = c.makeDiagnosticsJob(diag)
+		if err != nil {
+			return err
+		}
+		if err := c.deps.JobControl.CreateJob(diag, job); err != nil {
+			return fmt.Errorf("create job %s/%s for TidbClusterDiagnostics failed: %v", ns, job.Name, err)
+		}
+		return c.statusUpdater.Update(diag, &v1alpha1.TidbClusterDiagnosticsStatus{Phase: v1alpha1.DiagnosticsPending})
+	}
+	if err != nil {
+		return err
+	}
+
+	// the job updates the status when it succeeds, only the failure after the retries is reported here
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			return c.statusUpdater.Update(diag, &v1alpha1.TidbClusterDiagnosticsStatus{
+				Phase:          v1alpha1.DiagnosticsFailed,
+				Message:        fmt.Sprintf("job %s failed: %s", job.Name, cond.Message),
+				CompletionTime: &cond.LastTransitionTime,
+			})
+		}
+	}
+	return nil
+}
+
+func validate(diag *v1alpha1.TidbClusterDiagnostics) error {
+	if diag.Spec.Cluster.Name == "" {
+		return fmt.Errorf("spec.cluster.name must be set")
+	}
+	if backuputil.GetStorageType(diag.Spec.StorageProvider) == v1alpha1.BackupStorageTypeUnknown {
+		return fmt.Errorf("one of the storage providers must be set")
+	}
+	if diag.Spec.Local != nil && diag.Spec.Local.VolumeMount.Name != diag.Spec.Local.Volume.Name {
+		return fmt.Errorf("the local volume mount %s does not match the volume %s", diag.Spec.Local.VolumeMount.Name, diag.Spec.Local.Volume.Name)
+	}
+	return nil
+}
+
+func (c *Controller) makeDiagnosticsJob(diag *v1alpha1.TidbClusterDiagnostics) (*batchv1.Job, error) {
+	ns := diag.GetNamespace()
+	name := diag.GetName()
+
+	envVars, reason, err := backuputil.GenerateStorageCertEnv(ns, diag.Spec.UseKMS, diag.Spec.StorageProvider, c.deps.SecretLister)
+	if err != nil {
+		return nil, fmt.Errorf("TidbClusterDiagnostics %s/%s, %s: %v", ns, name, reason, err)
+	}
+	envVars = util.AppendOverwriteEnv(envVars, diag.Spec.Env)
+
+	args := []string{
+		"diagnose",
+		fmt.Sprintf("--namespace=%s", ns),
+		fmt.Sprintf("--resourceName=%s", name),
+	}
+
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+	if diag.Spec.Local != nil {
+		volumes = append(volumes, diag.Spec.Local.Volume)
+		volumeMounts = append(volumeMounts, diag.Spec.Local.VolumeMount)
+	}
+
+	serviceAccount := defaultServiceAccountName
+	if diag.Spec.ServiceAccount != "" {
+		serviceAccount = diag.Spec.ServiceAccount
+	}
+	backoffLimit := defaultBackoffLimit
+	if diag.Spec.BackoffLimit != nil {
+		backoffLimit = *diag.Spec.BackoffLimit
+	}
+
+	jobLabels := util.CombineStringMap(label.NewDiagnostics().Instance(diag.Spec.Cluster.Name), diag.Labels)
+	podSpec := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      jobLabels,
+			Annotations: diag.Annotations,
+		},
+		Spec: corev1.PodSpec{
+			SecurityContext:    diag.Spec.PodSecurityContext,
+			ServiceAccountName: serviceAccount,
+			Containers: []corev1.Container{
+				{
+					Name:            label.DiagnosticsJobLabelVal,
+					Image:           c.deps.CLIConfig.TiDBBackupManagerImage,
+					Args:            args,
+					Env:             envVars,
+					VolumeMounts:    volumeMounts,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Resources:       diag.Spec.ResourceRequirements,
+				},
+			},
+			RestartPolicy:     corev1.RestartPolicyNever,
+			Tolerations:       diag.Spec.Tolerations,
+			ImagePullSecrets:  diag.Spec.ImagePullSecrets,
+			Affinity:          diag.Spec.Affinity,
+			Volumes:           volumes,
+			PriorityClassName: diag.Spec.PriorityClassName,
+		},
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        diag.GetJobName(),
+			Namespace:   ns,
+			Labels:      jobLabels,
+			Annotations: diag.Annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				controller.GetDiagnosticsOwnerRef(diag),
+			},
+		},
+		Spec: batchv1.JobSpec{
+			Template:     podSpec,
+			BackoffLimit: &backoffLimit,
+		},
+	}, nil
+}
+
+// enqueueDiagnostics enqueues the given diagnostics in the work queue.
+func (c *Controller) enqueueDiagnostics(obj interface{}) {
+	diag, ok := obj.(*v1alpha1.TidbClusterDiagnostics)
+	if !ok || diag.IsFinished() {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// enqueueJob enqueues the diagnostics owning the job
+func (c *Controller) enqueueJob(obj interface{}) {
+	job, ok := obj.(*batchv1.Job)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if job, ok = tombstone.Obj.(*batchv1.Job); !ok {
+			return
+		}
+	}
+	owner := metav1.GetControllerOf(job)
+	if owner == nil || owner.Kind != controller.DiagnosticsControllerKind.Kind {
+		return
+	}
+	diag, err := c.deps.DiagnosticsLister.TidbClusterDiagnostics(job.Namespace).Get(owner.Name)
+	if err != nil || diag.UID != owner.UID {
+		return
+	}
+	c.enqueueDiagnostics(diag)
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbclusterdiagnostics
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestControllerSync(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type testcase struct {
+		name     string
+		update   func(diag *v1alpha1.TidbClusterDiagnostics)
+		job      func(job *batchv1.Job)
+		expectFn func(diag *v1alpha1.TidbClusterDiagnostics, job *batchv1.Job)
+	}
+
+	cases := []testcase{
+		{
+			name: "create the job",
+			expectFn: func(diag *v1alpha1.TidbClusterDiagnostics, job *batchv1.Job) {
+				g.Expect(diag.Status.Phase).To(Equal(v1alpha1.DiagnosticsPending))
+				g.Expect(job).NotTo(BeNil())
+				g.Expect(job.Spec.Template.Spec.ServiceAccountName).To(Equal(defaultServiceAccountName))
+				g.Expect(*job.Spec.BackoffLimit).To(Equal(defaultBackoffLimit))
+				g.Expect(job.Spec.Template.Spec.Containers[0].Args).To(ContainElement("diagnose"))
+				g.Expect(job.Spec.Template.Spec.Volumes).To(HaveLen(1))
+				g.Expect(job.OwnerReferences[0].Kind).To(Equal(controller.DiagnosticsControllerKind.Kind))
+			},
+		},
+		{
+			name: "storage is not set",
+			update: func(diag *v1alpha1.TidbClusterDiagnostics) {
+				diag.Spec.Local = nil
+			},
+			expectFn: func(diag *v1alpha1.TidbClusterDiagnostics, job *batchv1.Job) {
+				g.Expect(diag.Status.Phase).To(Equal(v1alpha1.DiagnosticsFailed))
+				g.Expect(job).To(BeNil())
+			},
+		},
+		{
+			name: "job failed",
+			job: func(job *batchv1.Job) {
+				job.Status.Conditions = []batchv1.JobCondition{
+					{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"},
+				}
+			},
+			expectFn: func(diag *v1alpha1.TidbClusterDiagnostics, job *batchv1.Job) {
+				g.Expect(diag.Status.Phase).To(Equal(v1alpha1.DiagnosticsFailed))
+				g.Expect(diag.Status.Message).To(ContainSubstring("BackoffLimitExceeded"))
+			},
+		},
+		{
+			name: "finished diagnostics is skipped",
+			update: func(diag *v1alpha1.TidbClusterDiagnostics) {
+				diag.Status.Phase = v1alpha1.DiagnosticsComplete
+			},
+			expectFn: func(diag *v1alpha1.TidbClusterDiagnostics, job *batchv1.Job) {
+				g.Expect(diag.Status.Phase).To(Equal(v1alpha1.DiagnosticsComplete))
+				g.Expect(job).To(BeNil())
+			},
+		},
+	}
+
+	for _, testcase := range cases {
+		t.Logf("testcase: %s", testcase.name)
+
+		deps := controller.NewFakeDependencies()
+		diagInformer := deps.InformerFactory.Pingcap().V1alpha1().TidbClusterDiagnostics()
+		jobIndexer := deps.KubeInformerFactory.Batch().V1().Jobs().Informer().GetIndexer()
+		c := NewController(deps)
+		c.statusUpdater = controller.NewFakeDiagnosticsStatusUpdater(diagInformer)
+
+		diag := newDiagnosticsForTest()
+		if testcase.update != nil {
+			testcase.update(diag)
+		}
+		g.Expect(diagInformer.Informer().GetIndexer().Add(diag)).To(Succeed())
+		if testcase.job != nil {
+			job, err := c.makeDiagnosticsJob(diag)
+			g.Expect(err).NotTo(HaveOccurred())
+			testcase.job(job)
+			g.Expect(jobIndexer.Add(job)).To(Succeed())
+		}
+
+		key, err := cache.MetaNamespaceKeyFunc(diag)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(c.sync(key)).To(Succeed())
+
+		diag, err = deps.DiagnosticsLister.TidbClusterDiagnostics(diag.Namespace).Get(diag.Name)
+		g.Expect(err).NotTo(HaveOccurred())
+		job, err := deps.JobLister.Jobs(diag.Namespace).Get(diag.GetJobName())
+		if err != nil {
+			job = nil
+		}
+		testcase.expectFn(diag, job)
+	}
+}
+
+func newDiagnosticsForTest() *v1alpha1.TidbClusterDiagnostics {
+	return &v1alpha1.TidbClusterDiagnostics{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "diag",
+			Namespace: corev1.NamespaceDefault,
+			UID:       "test-uid",
+		},
+		Spec: v1alpha1.TidbClusterDiagnosticsSpec{
+			Cluster: v1alpha1.TidbClusterRef{Name: "basic"},
+			StorageProvider: v1alpha1.StorageProvider{
+				Local: &v1alpha1.LocalStorageProvider{
+					Volume: corev1.Volume{
+						Name: "diagnostics",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "diagnostics"},
+						},
+					},
+					VolumeMount: corev1.VolumeMount{Name: "diagnostics", MountPath: "/diagnostics"},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,747 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	informers "github.com/pingcap/tidb-operator/pkg/client/informers/externalversions"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeinformers "k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// watchedNamespaces is the set of namespaces managed by the operator when it watches multiple namespaces. The
+// namespaces are the ones listed in --watch-namespaces plus the ones selected by --watch-namespace-selector,
+// the latter is updated when the namespaces are created, deleted or relabeled.
+type watchedNamespaces struct {
+	lock       sync.RWMutex
+	static     sets.Set[string]
+	selector   labels.Selector
+	selected   sets.Set[string]
+	namespaces []string
+	// changed is closed and replaced when the namespaces change
+	changed chan struct{}
+}
+
+// newWatchedNamespaces returns the namespaces to watch, the namespaces selected by the label selector are
+// synced before it returns
+func newWatchedNamespaces(cliCfg *CLIConfig, kubeCli kubernetes.Interface, resync time.Duration) (*watchedNamespaces, error) {
+	w := &watchedNamespaces{
+		static:   sets.New[string](cliCfg.WatchNamespaceList()...),
+		selected: sets.New[string](),
+		changed:  make(chan struct{}),
+	}
+	w.namespaces = sets.List(w.static)
+	if cliCfg.WatchNamespaceSelector == "" {
+		return w, nil
+	}
+	selector, err := labels.Parse(cliCfg.WatchNamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace selector %q: %v", cliCfg.WatchNamespaceSelector, err)
+	}
+	w.selector = selector
+
+	informer := coreinformers.NewFilteredNamespaceInformer(kubeCli, resync, cache.Indexers{}, func(options *metav1.ListOptions) {
+		options.LabelSelector = cliCfg.WatchNamespaceSelector
+	})
+	_, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			w.update(obj, true)
+		},
+		UpdateFunc: func(_, cur interface{}) {
+			w.update(cur, true)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			w.update(obj, false)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	go informer.Run(wait.NeverStop)
+	if !cache.WaitForCacheSync(wait.NeverStop, informer.HasSynced) {
+		return nil, fmt.Errorf("failed to sync the namespaces selected by %q", cliCfg.WatchNamespaceSelector)
+	}
+	return w, nil
+}
+
+func (w *watchedNamespaces) update(obj interface{}, selected bool) {
+	ns, ok := obj.(*corev1.Namespace)
+	if !ok {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if selected && w.selector.Matches(labels.Set(ns.Labels)) {
+		w.selected.Insert(ns.Name)
+	} else {
+		w.selected.Delete(ns.Name)
+	}
+	namespaces := sets.List(w.static.Union(w.selected))
+	if strings.Join(namespaces, ",") == strings.Join(w.namespaces, ",") {
+		return
+	}
+	klog.Infof("the watched namespaces are changed from %v to %v", w.namespaces, namespaces)
+	w.namespaces = namespaces
+	close(w.changed)
+	w.changed = make(chan struct{})
+}
+
+// List returns the sorted namespaces watched now and the channel closed when they change
+func (w *watchedNamespaces) List() ([]string, <-chan struct{}) {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	return w.namespaces, w.changed
+}
+
+// namespaceInformerFactories is the informer factories of the watched namespaces, one factory per namespace.
+// The informers of a kind in the namespaces are merged by a multiNamespaceInformer. A factory is created and
+// started when its namespace is watched, and stopped when the namespace is not watched any more, so the objects
+// in the other namespaces are never listed again when the watched namespaces change.
+type namespaceInformerFactories struct {
+	namespaces *watchedNamespaces
+	kubeCli    kubernetes.Interface
+	resync     time.Duration
+
+	lock      sync.Mutex
+	factories map[string]*namespaceInformerFactory
+	// informers is the merged informers started
+	informers []*multiNamespaceInformer
+	runOnce   sync.Once
+}
+
+// namespaceInformerFactory is the informer factory of a namespace, the informers of the operator's own kinds
+// are registered to it as well, it's only used to share and start the informers of the namespace
+type namespaceInformerFactory struct {
+	kubeinformers.SharedInformerFactory
+	stopCh chan struct{}
+}
+
+func newNamespaceInformerFactories(namespaces *watchedNamespaces, kubeCli kubernetes.Interface, resync time.Duration) *namespaceInformerFactories {
+	return &namespaceInformerFactories{
+		namespaces: namespaces,
+		kubeCli:    kubeCli,
+		resync:     resync,
+		factories:  map[string]*namespaceInformerFactory{},
+	}
+}
+
+// informerFor returns the informer merging the informers of the kind created by newInformer in the watched namespaces
+func (f *namespaceInformerFactories) informerFor(obj runtime.Object, newInformer func(ns string) cache.SharedIndexInformer) *multiNamespaceInformer {
+	return &multiNamespaceInformer{
+		factories:   f,
+		obj:         obj,
+		newInformer: newInformer,
+		informers:   map[string]*namespaceInformer{},
+		indexers:    cache.Indexers{},
+	}
+}
+
+// run starts the informers of the kind in the watched namespaces, the informers are started and stopped with the
+// factories of the namespaces when the watched namespaces change until stopCh is closed
+func (f *namespaceInformerFactories) run(informer *multiNamespaceInformer, stopCh <-chan struct{}) {
+	f.lock.Lock()
+	f.informers = append(f.informers, informer)
+	f.lock.Unlock()
+
+	f.runOnce.Do(func() {
+		go func() {
+			for {
+				_, changed := f.namespaces.List()
+				select {
+				case <-changed:
+					f.sync()
+				case <-stopCh:
+					f.stop()
+					return
+				}
+			}
+		}()
+	})
+	f.sync()
+}
+
+// sync starts the factories of the namespaces watched now and stops the others
+func (f *namespaceInformerFactories) sync() {
+	namespaces, _ := f.namespaces.List()
+	watched := sets.New[string](namespaces...)
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for ns, factory := range f.factories {
+		if watched.Has(ns) {
+			continue
+		}
+		klog.Infof("stop the informers in namespace %s which is not watched any more", ns)
+		close(factory.stopCh)
+		delete(f.factories, ns)
+		for _, informer := range f.informers {
+			informer.removeNamespace(ns)
+		}
+	}
+	for _, ns := range namespaces {
+		factory, ok := f.factories[ns]
+		if !ok {
+			factory = &namespaceInformerFactory{
+				SharedInformerFactory: kubeinformers.NewSharedInformerFactoryWithOptions(f.kubeCli, f.resync, kubeinformers.WithNamespace(ns)),
+				stopCh:                make(chan struct{}),
+			}
+			f.factories[ns] = factory
+		}
+		for _, informer := range f.informers {
+			informer.addNamespace(ns, factory)
+		}
+		factory.Start(factory.stopCh)
+	}
+}
+
+func (f *namespaceInformerFactories) stop() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for ns, factory := range f.factories {
+		close(factory.stopCh)
+		delete(f.factories, ns)
+	}
+}
+
+// namespaceInformer is the informer of a kind in a namespace
+type namespaceInformer struct {
+	cache.SharedIndexInformer
+	// forbidden is set if the operator is forbidden to list the objects in the namespace, the namespace doesn't
+	// block the sync of the other namespaces, and the informer keeps retrying to list the objects
+	forbidden atomic.Bool
+}
+
+func (i *namespaceInformer) hasSynced(registration cache.ResourceEventHandlerRegistration) bool {
+	return registration.HasSynced() || i.forbidden.Load()
+}
+
+// multiNamespaceInformer merges the informers of a kind in the watched namespaces, the objects in a namespace
+// are read from the informer of the namespace. The event handlers are added to the informers of all the
+// namespaces, including the ones watched later. The objects in the namespaces not watched any more are removed
+// from the cache without the delete events, as they are not deleted.
+type multiNamespaceInformer struct {
+	factories   *namespaceInformerFactories
+	obj         runtime.Object
+	newInformer func(ns string) cache.SharedIndexInformer
+
+	lock              sync.RWMutex
+	informers         map[string]*namespaceInformer
+	registrations     []*multiNamespaceRegistration
+	indexers          cache.Indexers
+	transform         cache.TransformFunc
+	watchErrorHandler cache.WatchErrorHandler
+	started           bool
+	stopped           bool
+}
+
+var _ cache.SharedIndexInformer = &multiNamespaceInformer{}
+
+func (i *multiNamespaceInformer) addNamespace(ns string, factory kubeinformers.SharedInformerFactory) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if _, ok := i.informers[ns]; ok {
+		return
+	}
+
+	informer := &namespaceInformer{}
+	informer.SharedIndexInformer = factory.InformerFor(i.obj, func(kubernetes.Interface, time.Duration) cache.SharedIndexInformer {
+		return i.newInformer(ns)
+	})
+	if len(i.indexers) > 0 {
+		if err := informer.AddIndexers(i.indexers); err != nil {
+			klog.Errorf("failed to add the indexers to the informer of %T in namespace %s: %v", i.obj, ns, err)
+		}
+	}
+	if i.transform != nil {
+		if err := informer.SetTransform(i.transform); err != nil {
+			klog.Errorf("failed to set the transform of the informer of %T in namespace %s: %v", i.obj, ns, err)
+		}
+	}
+	watchErrorHandler := i.watchErrorHandler
+	if watchErrorHandler == nil {
+		watchErrorHandler = cache.DefaultWatchErrorHandler
+	}
+	err := informer.SetWatchErrorHandler(func(r *cache.Reflector, err error) {
+		if apierrors.IsForbidden(err) {
+			klog.Warningf("the operator is forbidden to list %T in namespace %s, skip it until it's permitted: %v", i.obj, ns, err)
+			informer.forbidden.Store(true)
+			return
+		}
+		watchErrorHandler(r, err)
+	})
+	if err != nil {
+		klog.Errorf("failed to set the watch error handler of the informer of %T in namespace %s: %v", i.obj, ns, err)
+	}
+
+	for _, r := range i.registrations {
+		registration, err := r.add(informer)
+		if err != nil {
+			klog.Errorf("failed to add the event handler to the informer of %T in namespace %s: %v", i.obj, ns, err)
+			continue
+		}
+		r.registrations[ns] = registration
+	}
+	i.informers[ns] = informer
+}
+
+func (i *multiNamespaceInformer) removeNamespace(ns string) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	delete(i.informers, ns)
+	for _, r := range i.registrations {
+		delete(r.registrations, ns)
+	}
+}
+
+func (i *multiNamespaceInformer) AddEventHandler(handler cache.ResourceEventHandler) (cache.ResourceEventHandlerRegistration, error) {
+	return i.addEventHandler(func(informer cache.SharedIndexInformer) (cache.ResourceEventHandlerRegistration, error) {
+		return informer.AddEventHandler(handler)
+	})
+}
+
+func (i *multiNamespaceInformer) AddEventHandlerWithResyncPeriod(handler cache.ResourceEventHandler, resyncPeriod time.Duration) (cache.ResourceEventHandlerRegistration, error) {
+	return i.addEventHandler(func(informer cache.SharedIndexInformer) (cache.ResourceEventHandlerRegistration, error) {
+		return informer.AddEventHandlerWithResyncPeriod(handler, resyncPeriod)
+	})
+}
+
+func (i *multiNamespaceInformer) addEventHandler(add func(cache.SharedIndexInformer) (cache.ResourceEventHandlerRegistration, error)) (cache.ResourceEventHandlerRegistration, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	r := &multiNamespaceRegistration{
+		informer:      i,
+		add:           add,
+		registrations: map[string]cache.ResourceEventHandlerRegistration{},
+	}
+	for ns, informer := range i.informers {
+		registration, err := add(informer)
+		if err != nil {
+			i.removeRegistrations(r)
+			return nil, err
+		}
+		r.registrations[ns] = registration
+	}
+	i.registrations = append(i.registrations, r)
+	return r, nil
+}
+
+func (i *multiNamespaceInformer) RemoveEventHandler(handle cache.ResourceEventHandlerRegistration) error {
+	r, ok := handle.(*multiNamespaceRegistration)
+	if !ok || r.informer != i {
+		return fmt.Errorf("the event handler is not added to the informer of %T", i.obj)
+	}
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if err := i.removeRegistrations(r); err != nil {
+		return err
+	}
+	for idx, registration := range i.registrations {
+		if registration == r {
+			i.registrations = append(i.registrations[:idx], i.registrations[idx+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// removeRegistrations removes the event handler from the informers of the namespaces
+func (i *multiNamespaceInformer) removeRegistrations(r *multiNamespaceRegistration) error {
+	for ns, registration := range r.registrations {
+		if err := i.informers[ns].RemoveEventHandler(registration); err != nil {
+			return err
+		}
+		delete(r.registrations, ns)
+	}
+	return nil
+}
+
+func (i *multiNamespaceInformer) GetStore() cache.Store {
+	return i.GetIndexer()
+}
+
+// GetController returns nil, the informers of the namespaces are run by their own controllers
+func (i *multiNamespaceInformer) GetController() cache.Controller {
+	return nil
+}
+
+func (i *multiNamespaceInformer) Run(stopCh <-chan struct{}) {
+	i.factories.run(i, stopCh)
+	i.lock.Lock()
+	i.started = true
+	i.lock.Unlock()
+
+	<-stopCh
+	i.lock.Lock()
+	i.stopped = true
+	i.lock.Unlock()
+}
+
+func (i *multiNamespaceInformer) HasSynced() bool {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	if !i.started {
+		return false
+	}
+	for _, informer := range i.informers {
+		if !informer.hasSynced(informer) {
+			return false
+		}
+	}
+	return true
+}
+
+// LastSyncResourceVersion returns an empty string, the resource versions of the namespaces can't be merged
+func (i *multiNamespaceInformer) LastSyncResourceVersion() string {
+	return ""
+}
+
+func (i *multiNamespaceInformer) SetWatchErrorHandler(handler cache.WatchErrorHandler) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.started {
+		return fmt.Errorf("informer has already started")
+	}
+	i.watchErrorHandler = handler
+	return nil
+}
+
+func (i *multiNamespaceInformer) SetTransform(handler cache.TransformFunc) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.started {
+		return fmt.Errorf("informer has already started")
+	}
+	i.transform = handler
+	return nil
+}
+
+func (i *multiNamespaceInformer) IsStopped() bool {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.stopped
+}
+
+func (i *multiNamespaceInformer) AddIndexers(indexers cache.Indexers) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.started {
+		return fmt.Errorf("informer has already started")
+	}
+	for name, indexFunc := range indexers {
+		i.indexers[name] = indexFunc
+	}
+	return nil
+}
+
+func (i *multiNamespaceInformer) GetIndexer() cache.Indexer {
+	return &multiNamespaceIndexer{informer: i}
+}
+
+// multiNamespaceRegistration is an event handler added to the informers of all the watched namespaces
+type multiNamespaceRegistration struct {
+	informer *multiNamespaceInformer
+	add      func(cache.SharedIndexInformer) (cache.ResourceEventHandlerRegistration, error)
+	// registrations is the registrations of the handler in the informers of the namespaces, it's guarded by
+	// the lock of the informer
+	registrations map[string]cache.ResourceEventHandlerRegistration
+}
+
+func (r *multiNamespaceRegistration) HasSynced() bool {
+	r.informer.lock.RLock()
+	defer r.informer.lock.RUnlock()
+	for ns, registration := range r.registrations {
+		if !r.informer.informers[ns].hasSynced(registration) {
+			return false
+		}
+	}
+	return true
+}
+
+// multiNamespaceIndexer reads the objects from the indexers of the informers in the watched namespaces, the
+// objects in a namespace are only read from the indexer of the namespace
+type multiNamespaceIndexer struct {
+	informer *multiNamespaceInformer
+}
+
+var _ cache.Indexer = &multiNamespaceIndexer{}
+
+// indexers returns the indexers of the informers in the watched namespaces
+func (idx *multiNamespaceIndexer) indexers() map[string]cache.Indexer {
+	idx.informer.lock.RLock()
+	defer idx.informer.lock.RUnlock()
+	indexers := make(map[string]cache.Indexer, len(idx.informer.informers))
+	for ns, informer := range idx.informer.informers {
+		indexers[ns] = informer.GetIndexer()
+	}
+	return indexers
+}
+
+// indexer returns the indexer of the informer in the namespace, it returns false if the namespace is not watched
+func (idx *multiNamespaceIndexer) indexer(ns string) (cache.Indexer, bool) {
+	idx.informer.lock.RLock()
+	defer idx.informer.lock.RUnlock()
+	informer, ok := idx.informer.informers[ns]
+	if !ok {
+		return nil, false
+	}
+	return informer.GetIndexer(), true
+}
+
+// indexerOf returns the indexer of the namespace of the object
+func (idx *multiNamespaceIndexer) indexerOf(obj interface{}) (cache.Indexer, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	indexer, ok := idx.indexer(accessor.GetNamespace())
+	if !ok {
+		return nil, fmt.Errorf("namespace %s is not watched", accessor.GetNamespace())
+	}
+	return indexer, nil
+}
+
+func (idx *multiNamespaceIndexer) Add(obj interface{}) error {
+	indexer, err := idx.indexerOf(obj)
+	if err != nil {
+		return err
+	}
+	return indexer.Add(obj)
+}
+
+func (idx *multiNamespaceIndexer) Update(obj interface{}) error {
+	indexer, err := idx.indexerOf(obj)
+	if err != nil {
+		return err
+	}
+	return indexer.Update(obj)
+}
+
+func (idx *multiNamespaceIndexer) Delete(obj interface{}) error {
+	indexer, err := idx.indexerOf(obj)
+	if err != nil {
+		return err
+	}
+	return indexer.Delete(obj)
+}
+
+func (idx *multiNamespaceIndexer) List() []interface{} {
+	var objs []interface{}
+	for _, indexer := range idx.indexers() {
+		objs = append(objs, indexer.List()...)
+	}
+	return objs
+}
+
+func (idx *multiNamespaceIndexer) ListKeys() []string {
+	var keys []string
+	for _, indexer := range idx.indexers() {
+		keys = append(keys, indexer.ListKeys()...)
+	}
+	return keys
+}
+
+func (idx *multiNamespaceIndexer) Get(obj interface{}) (interface{}, bool, error) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return nil, false, err
+	}
+	return idx.GetByKey(key)
+}
+
+func (idx *multiNamespaceIndexer) GetByKey(key string) (interface{}, bool, error) {
+	ns, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil, false, err
+	}
+	indexer, ok := idx.indexer(ns)
+	if !ok {
+		return nil, false, nil
+	}
+	return indexer.GetByKey(key)
+}
+
+// Replace is not supported, the objects are replaced by the informers of the namespaces
+func (idx *multiNamespaceIndexer) Replace([]interface{}, string) error {
+	return fmt.Errorf("replacing the objects in multiple namespaces is not supported")
+}
+
+func (idx *multiNamespaceIndexer) Resync() error {
+	return nil
+}
+
+func (idx *multiNamespaceIndexer) Index(indexName string, obj interface{}) ([]interface{}, error) {
+	if indexName == cache.NamespaceIndex {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		return idx.ByIndex(indexName, accessor.GetNamespace())
+	}
+	var objs []interface{}
+	for _, indexer := range idx.indexers() {
+		nsObjs, err := indexer.Index(indexName, obj)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, nsObjs...)
+	}
+	return objs, nil
+}
+
+func (idx *multiNamespaceIndexer) IndexKeys(indexName, indexedValue string) ([]string, error) {
+	if indexName == cache.NamespaceIndex {
+		indexer, ok := idx.indexer(indexedValue)
+		if !ok {
+			return nil, nil
+		}
+		return indexer.IndexKeys(indexName, indexedValue)
+	}
+	var keys []string
+	for _, indexer := range idx.indexers() {
+		nsKeys, err := indexer.IndexKeys(indexName, indexedValue)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, nsKeys...)
+	}
+	return keys, nil
+}
+
+func (idx *multiNamespaceIndexer) ListIndexFuncValues(indexName string) []string {
+	values := sets.New[string]()
+	for _, indexer := range idx.indexers() {
+		values.Insert(indexer.ListIndexFuncValues(indexName)...)
+	}
+	return sets.List(values)
+}
+
+func (idx *multiNamespaceIndexer) ByIndex(indexName, indexedValue string) ([]interface{}, error) {
+	if indexName == cache.NamespaceIndex {
+		indexer, ok := idx.indexer(indexedValue)
+		if !ok {
+			return nil, nil
+		}
+		return indexer.ByIndex(indexName, indexedValue)
+	}
+	var objs []interface{}
+	for _, indexer := range idx.indexers() {
+		nsObjs, err := indexer.ByIndex(indexName, indexedValue)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, nsObjs...)
+	}
+	return objs, nil
+}
+
+func (idx *multiNamespaceIndexer) GetIndexers() cache.Indexers {
+	idx.informer.lock.RLock()
+	defer idx.informer.lock.RUnlock()
+	indexers := namespaceIndexers()
+	for name, indexFunc := range idx.informer.indexers {
+		indexers[name] = indexFunc
+	}
+	return indexers
+}
+
+func (idx *multiNamespaceIndexer) AddIndexers(newIndexers cache.Indexers) error {
+	return idx.informer.AddIndexers(newIndexers)
+}
+
+// pingcapKinds returns the custom resources managed by tidb-operator
+func pingcapKinds(cli versioned.Interface, tweak func(*metav1.ListOptions)) []namespacedKind {
+	c := cli.PingcapV1alpha1()
+	return []namespacedKind{
+		{&v1alpha1.TidbCluster{}, newListWatchFunc(func(ns string) listWatchClient[*v1alpha1.TidbClusterList] { return c.TidbClusters(ns) }, tweak)},
+		{&v1alpha1.DMCluster{}, newListWatchFunc(func(ns string) listWatchClient[*v1alpha1.DMClusterList] { return c.DMClusters(ns) }, tweak)},
+		{&v1alpha1.Backup{}, newListWatchFunc(func(ns string) listWatchClient[*v1alpha1.BackupList] { return c.Backups(ns) }, tweak)},
+		{&v1alpha1.CompactBackup{}, newListWatchFunc(func(ns string) listWatchClient[*v1alpha1.CompactBackupList] { return c.CompactBackups(ns) }, tweak)},
+		{&v1alpha1.Restore{}, newListWatchFunc(func(ns string) listWatchClient[*v1alpha1.RestoreList] { return c.Restores(ns) }, tweak)},
+		{&v1alpha1.BackupSchedule{}, newListWatchFunc(func(ns string) listWatchClient[*v1alpha1.BackupScheduleList] { return c.BackupSchedules(ns) }, tweak)},
+		{&v1alpha1.TidbInitializer{}, newListWatchFunc(func(ns string) listWatchClient[*v1alpha1.TidbInitializerList] { return c.TidbInitializers(ns) }, tweak)},
+		{&v1alpha1.TidbMonitor{}, newListWatchFunc(func(ns string) listWatchClient[*v1alpha1.TidbMonitorList] { return c.TidbMonitors(ns) }, tweak)},
+		{&v1alpha1.TidbNGMonitoring{}, newListWatchFunc(func(ns string) listWatchClient[*v1alpha1.TidbNGMonitoringList] { return c.TidbNGMonitorings(ns) }, tweak)},
+		{&v1alpha1.TidbDashboard{}, newListWatchFunc(func(ns string) listWatchClient[*v1alpha1.TidbDashboardList] { return c.TidbDashboards(ns) }, tweak)},
+		{&v1alpha1.TidbClusterDiagnostics{}, newListWatchFunc(func(ns string) listWatchClient[*v1alpha1.TidbClusterDiagnosticsList] {
+			return c.TidbClusterDiagnostics(ns)
+		}, tweak)},
+	}
+}
+
+// registerMultiNamespaceInformers registers the informers of all the namespaced kinds read by the operator to
+// the factories, so they list and watch the objects in the watched namespaces only. The kubeScope is the scope of
+// the informers in kubeInformerFactory, the kinds filtered by registerFilteredInformers are already registered
+// and skipped.
+func registerMultiNamespaceInformers(
+	cliCfg *CLIConfig,
+	informerFactory informers.SharedInformerFactory,
+	kubeInformerFactory kubeinformers.SharedInformerFactory,
+	labelFilterKubeInformerFactory kubeinformers.SharedInformerFactory,
+	cli versioned.Interface,
+	kubeCli kubernetes.Interface,
+	kubeScope informerScope,
+	ingressV1 bool) {
+
+	namespaces := kubeScope.factories.namespaces
+	scope := informerScope{factories: newNamespaceInformerFactories(namespaces, kubeCli, cliCfg.ResyncDuration)}
+	tweakSelector := func(options *metav1.ListOptions) {
+		if len(cliCfg.Selector) > 0 {
+			options.LabelSelector = cliCfg.Selector
+		}
+	}
+	for _, kind := range pingcapKinds(cli, tweakSelector) {
+		informerFactory.InformerFor(kind.obj, func(_ versioned.Interface, resync time.Duration) cache.SharedIndexInformer {
+			return scope.newInformer(kind, resync, nil)
+		})
+	}
+
+	kinds := kubeKinds(kubeCli, nil, ingressV1)
+	if !cliCfg.FilterInformerCache {
+		kinds = append(kinds, namespacedKind{&corev1.Secret{}, newListWatchFunc(func(ns string) listWatchClient[*corev1.SecretList] {
+			return kubeCli.CoreV1().Secrets(ns)
+		}, nil)})
+	}
+	for _, kind := range kinds {
+		registerKubeInformer(kubeInformerFactory, kind, kubeScope, nil)
+	}
+	configMaps := namespacedKind{&corev1.ConfigMap{}, newListWatchFunc(func(ns string) listWatchClient[*corev1.ConfigMapList] {
+		return kubeCli.CoreV1().ConfigMaps(ns)
+	}, func(options *metav1.ListOptions) {
+		options.LabelSelector = fmt.Sprintf("%s=%s", label.ManagedByLabelKey, label.TiDBOperator)
+	})}
+	labelFilterScope := informerScope{factories: newNamespaceInformerFactories(namespaces, kubeCli, cliCfg.ResyncDuration)}
+	registerKubeInformer(labelFilterKubeInformerFactory, configMaps, labelFilterScope, nil)
+}
@@ -0,0 +1,188 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func createPodInNamespace(g *GomegaWithT, kubeCli kubernetes.Interface, ns, name string) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns, Labels: label.New().Instance("tc").TiKV()}}
+	_, err := kubeCli.CoreV1().Pods(ns).Create(context.TODO(), pod, metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func cachedPodNames(factory kubeinformers.SharedInformerFactory) func() []string {
+	return func() []string {
+		pods, err := factory.Core().V1().Pods().Lister().List(labels.Everything())
+		if err != nil {
+			return nil
+		}
+		names := make([]string, 0, len(pods))
+		for _, pod := range pods {
+			names = append(names, pod.Namespace+"/"+pod.Name)
+		}
+		return names
+	}
+}
+
+// podLists returns the number of the lists of the pods in the namespace
+func podLists(kubeCli *kubefake.Clientset, ns string) int {
+	count := 0
+	for _, action := range kubeCli.Actions() {
+		if action.Matches("list", "pods") && action.GetNamespace() == ns {
+			count++
+		}
+	}
+	return count
+}
+
+func startMultiNamespacePodInformer(kubeCli kubernetes.Interface, namespaces *watchedNamespaces, stop <-chan struct{}) kubeinformers.SharedInformerFactory {
+	factory := kubeinformers.NewSharedInformerFactory(kubeCli, 0)
+	registerFilteredInformers(factory, kubeinformers.NewSharedInformerFactory(kubeCli, 0), kubeCli, informerScope{factories: newNamespaceInformerFactories(namespaces, kubeCli, 0)}, true)
+	factory.Core().V1().Pods().Lister()
+	factory.Start(stop)
+	factory.WaitForCacheSync(stop)
+	return factory
+}
+
+func TestWatchNamespaceList(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cliCfg := DefaultCLIConfig()
+	g.Expect(cliCfg.WatchesMultipleNamespaces()).To(BeFalse())
+	cliCfg.WatchNamespaces = " tenant-a,,tenant-b "
+	g.Expect(cliCfg.WatchNamespaceList()).To(Equal([]string{"tenant-a", "tenant-b"}))
+	g.Expect(cliCfg.WatchesMultipleNamespaces()).To(BeTrue())
+
+	// the cluster wide permissions are not expected
+	_, err := NewDependencies("tidb-admin", cliCfg, nil, kubefake.NewSimpleClientset(), nil)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestMultiNamespaceInformers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	kubeCli := kubefake.NewSimpleClientset()
+	for _, ns := range []string{"a", "b", "c"} {
+		createPodInNamespace(g, kubeCli, ns, "pod-1")
+	}
+	cliCfg := DefaultCLIConfig()
+	cliCfg.WatchNamespaces = "a,b"
+	namespaces, err := newWatchedNamespaces(cliCfg, kubeCli, 0)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	stop := make(chan struct{})
+	defer close(stop)
+	factory := startMultiNamespacePodInformer(kubeCli, namespaces, stop)
+	g.Expect(cachedPodNames(factory)()).To(ConsistOf("a/pod-1", "b/pod-1"))
+
+	// the events of all the watched namespaces are received
+	createPodInNamespace(g, kubeCli, "b", "pod-2")
+	createPodInNamespace(g, kubeCli, "c", "pod-2")
+	g.Eventually(cachedPodNames(factory), 5*time.Second, 10*time.Millisecond).Should(ConsistOf("a/pod-1", "b/pod-1", "b/pod-2"))
+	err = kubeCli.CoreV1().Pods("a").Delete(context.TODO(), "pod-1", metav1.DeleteOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Eventually(cachedPodNames(factory), 5*time.Second, 10*time.Millisecond).Should(ConsistOf("b/pod-1", "b/pod-2"))
+}
+
+func TestMultiNamespaceInformersWithSelector(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tenant := map[string]string{"tidb-operator/tenant": "true"}
+	kubeCli := kubefake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "a", Labels: tenant}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+	)
+	for _, ns := range []string{"a", "b", "static"} {
+		createPodInNamespace(g, kubeCli, ns, "pod-1")
+	}
+	cliCfg := DefaultCLIConfig()
+	cliCfg.WatchNamespaces = "static"
+	cliCfg.WatchNamespaceSelector = "tidb-operator/tenant=true"
+	namespaces, err := newWatchedNamespaces(cliCfg, kubeCli, 0)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	stop := make(chan struct{})
+	defer close(stop)
+	factory := startMultiNamespacePodInformer(kubeCli, namespaces, stop)
+	g.Expect(cachedPodNames(factory)()).To(ConsistOf("a/pod-1", "static/pod-1"))
+
+	// the namespaces labeled later are watched
+	ns, err := kubeCli.CoreV1().Namespaces().Get(context.TODO(), "b", metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	ns.Labels = tenant
+	_, err = kubeCli.CoreV1().Namespaces().Update(context.TODO(), ns, metav1.UpdateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Eventually(cachedPodNames(factory), 5*time.Second, 10*time.Millisecond).Should(ConsistOf("a/pod-1", "b/pod-1", "static/pod-1"))
+	// the namespaces watched before are not listed again
+	g.Expect(podLists(kubeCli, "a")).To(Equal(1))
+	g.Expect(podLists(kubeCli, "static")).To(Equal(1))
+
+	// the objects in the namespaces not selected any more are removed from the cache
+	err = kubeCli.CoreV1().Namespaces().Delete(context.TODO(), "a", metav1.DeleteOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Eventually(cachedPodNames(factory), 5*time.Second, 10*time.Millisecond).Should(ConsistOf("b/pod-1", "static/pod-1"))
+	list, _ := namespaces.List()
+	g.Expect(list).To(Equal([]string{"b", "static"}))
+}
+
+func TestMultiNamespaceInformersWithForbiddenNamespace(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	kubeCli := kubefake.NewSimpleClientset()
+	for _, ns := range []string{"a", "b"} {
+		createPodInNamespace(g, kubeCli, ns, "pod-1")
+	}
+	var forbidden atomic.Bool
+	forbidden.Store(true)
+	kubeCli.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "b" && forbidden.Load() {
+			return true, nil, apierrors.NewForbidden(corev1.Resource("pods"), "", fmt.Errorf("no permission"))
+		}
+		return false, nil, nil
+	})
+	cliCfg := DefaultCLIConfig()
+	cliCfg.WatchNamespaces = "a,b"
+	namespaces, err := newWatchedNamespaces(cliCfg, kubeCli, 0)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// the forbidden namespace is skipped
+	stop := make(chan struct{})
+	defer close(stop)
+	factory := startMultiNamespacePodInformer(kubeCli, namespaces, stop)
+	g.Expect(cachedPodNames(factory)()).To(ConsistOf("a/pod-1"))
+	createPodInNamespace(g, kubeCli, "a", "pod-2")
+	g.Eventually(cachedPodNames(factory), 5*time.Second, 10*time.Millisecond).Should(ConsistOf("a/pod-1", "a/pod-2"))
+
+	// the namespace is listed again after it's permitted
+	forbidden.Store(false)
+	g.Eventually(cachedPodNames(factory), 10*time.Second, 10*time.Millisecond).Should(ConsistOf("a/pod-1", "a/pod-2", "b/pod-1"))
+}
@@ -18,8 +18,10 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
 	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/tracing"
 
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
@@ -58,15 +60,15 @@ func (mc *defaultMasterControl) GetMasterClient(namespace string, dcName string,
 		tlsConfig, err = pdapi.GetTLSConfig(mc.secretLister, pdapi.Namespace(namespace), util.DMClientTLSSecretName(dcName))
 		if err != nil {
 			klog.Errorf("Unable to get tls config for dm cluster %q, master client may not work: %v", dcName, err)
-			return NewMasterClient(MasterClientURL(namespace, dcName, scheme), DefaultTimeout, tlsConfig, true)
+			return withTracing(NewMasterClient(MasterClientURL(namespace, dcName, scheme), DefaultTimeout, tlsConfig, true), namespace, dcName)
 		}
 
-		return NewMasterClient(MasterClientURL(namespace, dcName, scheme), DefaultTimeout, tlsConfig, true)
+		return withTracing(NewMasterClient(MasterClientURL(namespace, dcName, scheme), DefaultTimeout, tlsConfig, true), namespace, dcName)
 	}
 
 	key := masterClientKey(scheme, namespace, dcName)
 	if _, ok := mc.masterClients[key]; !ok {
-		mc.masterClients[key] = NewMasterClient(MasterClientURL(namespace, dcName, scheme), DefaultTimeout, nil, false)
+		mc.masterClients[key] = withTracing(NewMasterClient(MasterClientURL(namespace, dcName, scheme), DefaultTimeout, nil, false), namespace, dcName)
 	}
 	return mc.masterClients[key]
 }
@@ -84,13 +86,22 @@ func (mc *defaultMasterControl) GetMasterPeerClient(namespace string, dcName str
 		tlsConfig, err = pdapi.GetTLSConfig(mc.secretLister, pdapi.Namespace(namespace), util.DMClientTLSSecretName(dcName))
 		if err != nil {
 			klog.Errorf("Unable to get tls config for dm cluster %q, master client may not work: %v", dcName, err)
-			return NewMasterClient(MasterPeerClientURL(namespace, dcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+			return withTracing(NewMasterClient(MasterPeerClientURL(namespace, dcName, podName, scheme), DefaultTimeout, tlsConfig, true), namespace, dcName)
 		}
 
-		return NewMasterClient(MasterPeerClientURL(namespace, dcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+		return withTracing(NewMasterClient(MasterPeerClientURL(namespace, dcName, podName, scheme), DefaultTimeout, tlsConfig, true), namespace, dcName)
 	}
 
-	return NewMasterClient(MasterPeerClientURL(namespace, dcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+	return withTracing(NewMasterClient(MasterPeerClientURL(namespace, dcName, podName, scheme), DefaultTimeout, tlsConfig, true), namespace, dcName)
+}
+
+// withTracing records the spans of the requests of the client to the dm-master of the cluster
+func withTracing(client MasterClient, namespace, dcName string) MasterClient {
+	if c, ok := client.(*masterClient); ok {
+		key := tracing.Key{Kind: v1alpha1.DMClusterKind, Namespace: namespace, Name: dcName}
+		c.httpClient.Transport = tracing.NewTransport(c.httpClient.Transport, "dm-master", key)
+	}
+	return client
 }
 
 // masterClientKey returns the master client key
@@ -32,6 +32,7 @@ var (
 		AdvancedStatefulSet: false,
 		VolumeModifying:     false,
 		VolumeReplacing:     false,
+		InPlacePodResize:    false,
 	}
 	// DefaultFeatureGate is a shared global FeatureGate.
 	DefaultFeatureGate FeatureGate = NewDefaultFeatureGate()
@@ -51,6 +52,13 @@ const (
 	// VolumeReplacing controls whether to replace whole volumes by deleting and recreating on changes.
 	// tidb, tikv & pd supported. If enabled takes precedence over resizing/modifying.
 	VolumeReplacing string = "VolumeReplacing"
+
+	// InPlacePodResize controls whether to resize the resources of the TiDB and TiKV pods in place
+	// without restarting them if only the resources are changed.
+	// Kubernetes v1.27 and later are supported. The InPlacePodVerticalScaling feature gate of Kubernetes
+	// must be enabled on v1.27 to v1.32, and the pods are resized by the resize subresource since v1.33.
+	// It falls back to the rolling upgrade on the older versions.
+	InPlacePodResize string = "InPlacePodResize"
 )
 
 type FeatureGate interface {
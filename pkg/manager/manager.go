@@ -15,6 +15,7 @@ package manager
 
 import (
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/util/tracing"
 )
 
 // Manager implements the logic for syncing tidbcluster.
@@ -23,6 +24,24 @@ type Manager interface {
 	Sync(*v1alpha1.TidbCluster) error
 }
 
+// tracedManager records a span for each sync of the manager
+type tracedManager struct {
+	name string
+	m    Manager
+}
+
+// WithTracing returns the Manager recording a span named by the manager for each sync, the span is a child of
+// the reconciliation of the tidbcluster, and the parent of the calls to the components of the tidbcluster.
+func WithTracing(name string, m Manager) Manager {
+	return &tracedManager{name: name, m: m}
+}
+
+func (tm *tracedManager) Sync(tc *v1alpha1.TidbCluster) (err error) {
+	span := tracing.StartSpan("Sync "+tm.name, tracing.Key{Kind: v1alpha1.TiDBClusterKind, Namespace: tc.Namespace, Name: tc.Name})
+	defer func() { span.End(err) }()
+	return tm.m.Sync(tc)
+}
+
 type DMManager interface {
 	// Sync implements the logic for syncing dmcluster.
 	SyncDM(*v1alpha1.DMCluster) error
@@ -0,0 +1,274 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+// statelessMember is the health status of a member of a component without persistent data
+type statelessMember struct {
+	Health             bool
+	LastTransitionTime metav1.Time
+}
+
+// StatelessMemberAccess contains the common set of functions to access the properties of TiProxy and TiCDC types
+type StatelessMemberAccess interface {
+	GetFailoverPeriod(cliConfig *controller.CLIConfig) time.Duration
+	GetMemberType() v1alpha1.MemberType
+	GetMaxFailoverCount(tc *v1alpha1.TidbCluster) *int32
+	// GetMembers returns the members of the component, the key is the pod name
+	GetMembers(tc *v1alpha1.TidbCluster) map[string]statelessMember
+	GetFailureMembers(tc *v1alpha1.TidbCluster) map[string]v1alpha1.StatelessFailureMember
+	SetFailureMembers(tc *v1alpha1.TidbCluster, failureMembers map[string]v1alpha1.StatelessFailureMember)
+	GetStsDesiredOrdinals(tc *v1alpha1.TidbCluster, excludeFailover bool) sets.Int32
+	GetPodName(tc *v1alpha1.TidbCluster, ordinal int32) string
+}
+
+// commonStatelessFailover has the common logic to handle the failover of TiProxy and TiCDC.
+// As there is no data in the failure pods, a new pod is added for each failure member
+// and the failure members are removed once they become healthy again.
+// It uses the commonStatefulFailureRecovery to restart the failure pods on the down hosts.
+type commonStatelessFailover struct {
+	deps            *controller.Dependencies
+	memberAccess    StatelessMemberAccess
+	failureRecovery commonStatefulFailureRecovery
+}
+
+var _ Failover = (*commonStatelessFailover)(nil)
+
+func newCommonStatelessFailover(deps *controller.Dependencies, memberAccess StatelessMemberAccess) *commonStatelessFailover {
+	failureRecovery := commonStatefulFailureRecovery{deps: deps, failureObjectAccess: &statelessFailureMemberAccess{memberAccess: memberAccess}}
+	return &commonStatelessFailover{deps: deps, memberAccess: memberAccess, failureRecovery: failureRecovery}
+}
+
+func (sf *commonStatelessFailover) Failover(tc *v1alpha1.TidbCluster) error {
+	memberType := sf.memberAccess.GetMemberType()
+	failureMembers := sf.memberAccess.GetFailureMembers(tc)
+	if failureMembers == nil {
+		failureMembers = map[string]v1alpha1.StatelessFailureMember{}
+		sf.memberAccess.SetFailureMembers(tc, failureMembers)
+	}
+
+	members := sf.memberAccess.GetMembers(tc)
+	for podName := range failureMembers {
+		if member, ok := members[podName]; ok && member.Health {
+			delete(failureMembers, podName)
+			klog.Infof("%s failover: delete %s from failureMembers, %s/%s", memberType, podName, tc.GetNamespace(), tc.GetName())
+		}
+	}
+
+	if err := sf.failureRecovery.RestartPodOnHostDown(tc); err != nil {
+		if controller.IsIgnoreError(err) {
+			return nil
+		}
+		return err
+	}
+
+	maxFailoverCount := sf.memberAccess.GetMaxFailoverCount(tc)
+	if maxFailoverCount == nil || *maxFailoverCount <= 0 {
+		klog.Infof("%s failover is disabled for %s/%s, skipped", memberType, tc.GetNamespace(), tc.GetName())
+		return nil
+	}
+
+	desiredOrdinals := sf.memberAccess.GetStsDesiredOrdinals(tc, false)
+	podNames := make([]string, 0, len(members))
+	for podName := range members {
+		podNames = append(podNames, podName)
+	}
+	sort.Strings(podNames)
+	for _, podName := range podNames {
+		member := members[podName]
+		if _, exist := failureMembers[podName]; exist || member.Health || member.LastTransitionTime.IsZero() {
+			continue
+		}
+		// the member of a deleted pod may be still in the status, ignore it
+		ordinal, err := util.GetOrdinalFromPodName(podName)
+		if err != nil || !desiredOrdinals.Has(ordinal) {
+			continue
+		}
+
+		deadline := member.LastTransitionTime.Add(sf.memberAccess.GetFailoverPeriod(sf.deps.CLIConfig))
+		if !time.Now().After(deadline) {
+			continue
+		}
+		if len(failureMembers) >= int(*maxFailoverCount) {
+			klog.Warningf("%s/%s %s failure members count reached the limit: %d", tc.GetNamespace(), tc.GetName(), memberType, *maxFailoverCount)
+			break
+		}
+
+		pod, err := sf.deps.PodLister.Pods(tc.GetNamespace()).Get(podName)
+		if err != nil {
+			return fmt.Errorf("%s failover: failed to get pod %s for cluster %s/%s, error: %s", memberType, podName, tc.GetNamespace(), tc.GetName(), err)
+		}
+		_, condition := k8s.GetPodCondition(&pod.Status, corev1.PodScheduled)
+		if condition == nil || condition.Status != corev1.ConditionTrue {
+			// if a member is unhealthy because it's not scheduled yet, we
+			// should not create failover pod for it
+			klog.Warningf("pod %s/%s is not scheduled yet, skipping failover", pod.Namespace, pod.Name)
+			continue
+		}
+
+		failureMembers[podName] = v1alpha1.StatelessFailureMember{
+			PodName:   podName,
+			CreatedAt: metav1.Now(),
+		}
+		msg := fmt.Sprintf("%s[%s] is unhealthy", memberType, podName)
+		sf.deps.Recorder.Event(tc, corev1.EventTypeWarning, unHealthEventReason, fmt.Sprintf(unHealthEventMsgPattern, memberType, podName, msg))
+		break
+	}
+	return nil
+}
+
+func (sf *commonStatelessFailover) Recover(tc *v1alpha1.TidbCluster) {
+	sf.memberAccess.SetFailureMembers(tc, nil)
+	klog.Infof("%s recover: clear FailureMembers, %s/%s", sf.memberAccess.GetMemberType(), tc.GetNamespace(), tc.GetName())
+}
+
+func (sf *commonStatelessFailover) RemoveUndesiredFailures(tc *v1alpha1.TidbCluster) {
+	ordinals := sf.memberAccess.GetStsDesiredOrdinals(tc, true)
+	failureMembers := sf.memberAccess.GetFailureMembers(tc)
+	for key, failureMember := range failureMembers {
+		ordinal, err := util.GetOrdinalFromPodName(failureMember.PodName)
+		if err != nil {
+			klog.Errorf("unexpected pod name %q: %v", failureMember.PodName, err)
+			continue
+		}
+		if !ordinals.Has(ordinal) {
+			// If we delete the pods, e.g. by using advanced statefulset delete
+			// slots feature. We should remove the record of undesired pods,
+			// otherwise an extra replacement pod will be created.
+			delete(failureMembers, key)
+		}
+	}
+}
+
+// shouldRecoverStatelessFailure returns true if all desired replicas (excluding failover pods)
+// are ready and healthy, the failover pods can be removed then.
+func shouldRecoverStatelessFailure(deps *controller.Dependencies, tc *v1alpha1.TidbCluster, memberAccess StatelessMemberAccess) bool {
+	if memberAccess.GetFailureMembers(tc) == nil {
+		return false
+	}
+	members := memberAccess.GetMembers(tc)
+	// Note that failover pods may fail (e.g. lack of resources) and we don't care
+	// about them because we're going to delete them.
+	for ordinal := range memberAccess.GetStsDesiredOrdinals(tc, true) {
+		name := memberAccess.GetPodName(tc, ordinal)
+		pod, err := deps.PodLister.Pods(tc.GetNamespace()).Get(name)
+		if err != nil {
+			klog.Errorf("pod %s/%s does not exist: %v", tc.GetNamespace(), name, err)
+			return false
+		}
+		if !k8s.IsPodReady(pod) {
+			return false
+		}
+		member, ok := members[name]
+		if !ok || !member.Health {
+			return false
+		}
+	}
+	return true
+}
+
+// statelessFailureMemberAccess implements the FailureObjectAccess interface for TiProxy and TiCDC member
+type statelessFailureMemberAccess struct {
+	memberAccess StatelessMemberAccess
+}
+
+var _ FailureObjectAccess = (*statelessFailureMemberAccess)(nil)
+
+func (fma *statelessFailureMemberAccess) GetMemberType() v1alpha1.MemberType {
+	return fma.memberAccess.GetMemberType()
+}
+
+// GetFailureObjects returns the set of pod names of the failure members
+func (fma *statelessFailureMemberAccess) GetFailureObjects(tc *v1alpha1.TidbCluster) map[string]v1alpha1.EmptyStruct {
+	failureMembers := make(map[string]v1alpha1.EmptyStruct, len(fma.memberAccess.GetFailureMembers(tc)))
+	for podName := range fma.memberAccess.GetFailureMembers(tc) {
+		failureMembers[podName] = v1alpha1.EmptyStruct{}
+	}
+	return failureMembers
+}
+
+// IsFailing returns if the member of the pod is unhealthy
+func (fma *statelessFailureMemberAccess) IsFailing(tc *v1alpha1.TidbCluster, podName string) bool {
+	member, exists := fma.memberAccess.GetMembers(tc)[podName]
+	return exists && !member.Health
+}
+
+func (fma *statelessFailureMemberAccess) GetPodName(tc *v1alpha1.TidbCluster, podName string) string {
+	return fma.memberAccess.GetFailureMembers(tc)[podName].PodName
+}
+
+// IsHostDownForFailedPod checks if HostDown is set for any failure member
+func (fma *statelessFailureMemberAccess) IsHostDownForFailedPod(tc *v1alpha1.TidbCluster) bool {
+	for _, failureMember := range fma.memberAccess.GetFailureMembers(tc) {
+		if failureMember.HostDown {
+			return true
+		}
+	}
+	return false
+}
+
+func (fma *statelessFailureMemberAccess) IsHostDown(tc *v1alpha1.TidbCluster, podName string) bool {
+	return fma.memberAccess.GetFailureMembers(tc)[podName].HostDown
+}
+
+func (fma *statelessFailureMemberAccess) SetHostDown(tc *v1alpha1.TidbCluster, podName string, hostDown bool) {
+	failureMembers := fma.memberAccess.GetFailureMembers(tc)
+	failureMember := failureMembers[podName]
+	failureMember.HostDown = hostDown
+	failureMembers[podName] = failureMember
+}
+
+func (fma *statelessFailureMemberAccess) GetCreatedAt(tc *v1alpha1.TidbCluster, podName string) metav1.Time {
+	return fma.memberAccess.GetFailureMembers(tc)[podName].CreatedAt
+}
+
+func (fma *statelessFailureMemberAccess) GetLastTransitionTime(tc *v1alpha1.TidbCluster, podName string) metav1.Time {
+	return fma.memberAccess.GetMembers(tc)[podName].LastTransitionTime
+}
+
+// GetPVCUIDSet returns nil as there is no persistent data for the failure member
+func (fma *statelessFailureMemberAccess) GetPVCUIDSet(_ *v1alpha1.TidbCluster, _ string) map[types.UID]v1alpha1.EmptyStruct {
+	return nil
+}
+
+func (fma *statelessFailureMemberAccess) CanMarkHostDown(_ *v1alpha1.TidbCluster, _ string) bool {
+	return true
+}
+
+type fakeStatelessFailover struct{}
+
+var _ Failover = (*fakeStatelessFailover)(nil)
+
+func (fsf *fakeStatelessFailover) Failover(_ *v1alpha1.TidbCluster) error {
+	return nil
+}
+
+func (fsf *fakeStatelessFailover) Recover(_ *v1alpha1.TidbCluster) {}
+
+func (fsf *fakeStatelessFailover) RemoveUndesiredFailures(_ *v1alpha1.TidbCluster) {}
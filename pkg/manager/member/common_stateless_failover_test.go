@@ -0,0 +1,222 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+)
+
+func TestTiProxyFailoverFailover(t *testing.T) {
+	longAgo := metav1.NewTime(time.Now().Add(-time.Hour))
+	tests := []struct {
+		name     string
+		update   func(*v1alpha1.TidbCluster)
+		expectFn func(*GomegaWithT, *v1alpha1.TidbCluster)
+	}{
+		{
+			name: "all tiproxy members are healthy",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.TiProxy.Members = map[string]v1alpha1.TiProxyMember{
+					"failover-tiproxy-0": {Name: "failover-tiproxy-0", Health: true, LastTransitionTime: longAgo},
+					"failover-tiproxy-1": {Name: "failover-tiproxy-1", Health: true, LastTransitionTime: longAgo},
+				}
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster) {
+				g.Expect(tc.Status.TiProxy.FailureMembers).To(BeEmpty())
+			},
+		},
+		{
+			name: "one tiproxy member is unhealthy",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.TiProxy.Members = map[string]v1alpha1.TiProxyMember{
+					"failover-tiproxy-0": {Name: "failover-tiproxy-0", Health: false, LastTransitionTime: longAgo},
+					"failover-tiproxy-1": {Name: "failover-tiproxy-1", Health: true, LastTransitionTime: longAgo},
+				}
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster) {
+				g.Expect(tc.Status.TiProxy.FailureMembers).To(HaveLen(1))
+				g.Expect(tc.Status.TiProxy.FailureMembers).To(HaveKey("failover-tiproxy-0"))
+				g.Expect(tc.TiProxyStsDesiredReplicas()).To(Equal(int32(3)))
+			},
+		},
+		{
+			name: "tiproxy member is unhealthy within failover period",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.TiProxy.Members = map[string]v1alpha1.TiProxyMember{
+					"failover-tiproxy-0": {Name: "failover-tiproxy-0", Health: false, LastTransitionTime: metav1.Now()},
+					"failover-tiproxy-1": {Name: "failover-tiproxy-1", Health: true, LastTransitionTime: longAgo},
+				}
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster) {
+				g.Expect(tc.Status.TiProxy.FailureMembers).To(BeEmpty())
+			},
+		},
+		{
+			name: "failover is disabled",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiProxy.MaxFailoverCount = pointer.Int32Ptr(0)
+				tc.Status.TiProxy.Members = map[string]v1alpha1.TiProxyMember{
+					"failover-tiproxy-0": {Name: "failover-tiproxy-0", Health: false, LastTransitionTime: longAgo},
+				}
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster) {
+				g.Expect(tc.Status.TiProxy.FailureMembers).To(BeEmpty())
+			},
+		},
+		{
+			name: "failure members count reached the limit",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiProxy.MaxFailoverCount = pointer.Int32Ptr(1)
+				tc.Status.TiProxy.Members = map[string]v1alpha1.TiProxyMember{
+					"failover-tiproxy-0": {Name: "failover-tiproxy-0", Health: false, LastTransitionTime: longAgo},
+					"failover-tiproxy-1": {Name: "failover-tiproxy-1", Health: false, LastTransitionTime: longAgo},
+				}
+				tc.Status.TiProxy.FailureMembers = map[string]v1alpha1.StatelessFailureMember{
+					"failover-tiproxy-0": {PodName: "failover-tiproxy-0", CreatedAt: longAgo},
+				}
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster) {
+				g.Expect(tc.Status.TiProxy.FailureMembers).To(HaveLen(1))
+				g.Expect(tc.Status.TiProxy.FailureMembers).To(HaveKey("failover-tiproxy-0"))
+			},
+		},
+		{
+			name: "failure member becomes healthy",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.TiProxy.Members = map[string]v1alpha1.TiProxyMember{
+					"failover-tiproxy-0": {Name: "failover-tiproxy-0", Health: true, LastTransitionTime: metav1.Now()},
+					"failover-tiproxy-1": {Name: "failover-tiproxy-1", Health: true, LastTransitionTime: longAgo},
+					"failover-tiproxy-2": {Name: "failover-tiproxy-2", Health: false, LastTransitionTime: metav1.Now()},
+				}
+				tc.Status.TiProxy.FailureMembers = map[string]v1alpha1.StatelessFailureMember{
+					"failover-tiproxy-0": {PodName: "failover-tiproxy-0", CreatedAt: longAgo},
+				}
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster) {
+				g.Expect(tc.Status.TiProxy.FailureMembers).To(BeEmpty())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			deps := controller.NewFakeDependencies()
+			podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+			for i := int32(0); i < 3; i++ {
+				g.Expect(podIndexer.Add(newScheduledPodForStatelessFailover(ordinalPodName(v1alpha1.TiProxyMemberType, "failover", i)))).To(Succeed())
+			}
+
+			tc := newTidbClusterForStatelessFailover()
+			test.update(tc)
+			g.Expect(NewTiProxyFailover(deps).Failover(tc)).To(Succeed())
+			test.expectFn(g, tc)
+		})
+	}
+}
+
+func TestTiCDCFailoverRecover(t *testing.T) {
+	g := NewGomegaWithT(t)
+	deps := controller.NewFakeDependencies()
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	for i := int32(0); i < 3; i++ {
+		pod := newScheduledPodForStatelessFailover(ordinalPodName(v1alpha1.TiCDCMemberType, "failover", i))
+		pod.Status.Conditions = append(pod.Status.Conditions, corev1.PodCondition{Type: corev1.PodReady, Status: corev1.ConditionTrue})
+		g.Expect(podIndexer.Add(pod)).To(Succeed())
+	}
+
+	tc := newTidbClusterForStatelessFailover()
+	tc.Status.TiCDC.Captures = map[string]v1alpha1.TiCDCCapture{
+		"failover-ticdc-0": {PodName: "failover-ticdc-0", Ready: true},
+		"failover-ticdc-1": {PodName: "failover-ticdc-1", Ready: false},
+		"failover-ticdc-2": {PodName: "failover-ticdc-2", Ready: true},
+	}
+	tc.Status.TiCDC.FailureMembers = map[string]v1alpha1.StatelessFailureMember{
+		"failover-ticdc-1": {PodName: "failover-ticdc-1"},
+	}
+	access := &ticdcMemberAccess{}
+	g.Expect(shouldRecoverStatelessFailure(deps, tc, access)).To(BeFalse())
+
+	capture := tc.Status.TiCDC.Captures["failover-ticdc-1"]
+	capture.Ready = true
+	tc.Status.TiCDC.Captures["failover-ticdc-1"] = capture
+	g.Expect(shouldRecoverStatelessFailure(deps, tc, access)).To(BeTrue())
+
+	failover := NewTiCDCFailover(deps)
+	failover.Recover(tc)
+	g.Expect(tc.Status.TiCDC.FailureMembers).To(BeNil())
+	g.Expect(tc.TiCDCDeployDesiredReplicas()).To(Equal(int32(2)))
+}
+
+func TestTiCDCFailoverRemoveUndesiredFailures(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tc := newTidbClusterForStatelessFailover()
+	tc.Status.TiCDC.FailureMembers = map[string]v1alpha1.StatelessFailureMember{
+		"failover-ticdc-1": {PodName: "failover-ticdc-1"},
+		"failover-ticdc-5": {PodName: "failover-ticdc-5"},
+	}
+	NewTiCDCFailover(controller.NewFakeDependencies()).RemoveUndesiredFailures(tc)
+	g.Expect(tc.Status.TiCDC.FailureMembers).To(HaveLen(1))
+	g.Expect(tc.Status.TiCDC.FailureMembers).To(HaveKey("failover-ticdc-1"))
+}
+
+func newScheduledPodForStatelessFailover(name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: corev1.NamespaceDefault,
+			Name:      name,
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{
+					Type:   corev1.PodScheduled,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
+	}
+}
+
+func newTidbClusterForStatelessFailover() *v1alpha1.TidbCluster {
+	return &v1alpha1.TidbCluster{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "TidbCluster",
+			APIVersion: "pingcap.com/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "failover",
+			Namespace: corev1.NamespaceDefault,
+			UID:       types.UID("failover"),
+		},
+		Spec: v1alpha1.TidbClusterSpec{
+			TiProxy: &v1alpha1.TiProxySpec{
+				Replicas:         2,
+				MaxFailoverCount: pointer.Int32Ptr(3),
+			},
+			TiCDC: &v1alpha1.TiCDCSpec{
+				Replicas:         2,
+				MaxFailoverCount: pointer.Int32Ptr(3),
+			},
+		},
+	}
+}
@@ -179,6 +179,8 @@ func (sf *commonStoreFailover) invokeDeleteFailureStore(tc *v1alpha1.TidbCluster
 			if deleteErr := pdCli.DeleteStore(storeUintId); deleteErr != nil {
 				return deleteErr
 			}
+			tc.StartOperation(storeOperation(v1alpha1.OperationDeleteStore, sf.storeAccess.GetMemberType(), operationReasonFailover,
+				failureStore.StoreID, failureStore.PodName))
 			msg := fmt.Sprintf("Invoked delete on %s store '%s' in cluster %s/%s", sf.storeAccess.GetMemberType(), failureStore.StoreID, ns, tcName)
 			sf.deps.Recorder.Event(tc, corev1.EventTypeWarning, recoveryEventReason, msg)
 			return controller.RequeueErrorf(msg)
@@ -192,6 +194,8 @@ func (sf *commonStoreFailover) invokeDeleteFailureStore(tc *v1alpha1.TidbCluster
 func (sf *commonStoreFailover) checkAndRemoveFailurePVC(tc *v1alpha1.TidbCluster, failureStore v1alpha1.TiKVFailureStore) error {
 	store, storeExists := sf.storeAccess.GetStore(tc, failureStore.StoreID)
 	if !storeExists || store.State == v1alpha1.TiKVStateTombstone {
+		tc.FinishOperation(storeOperation(v1alpha1.OperationDeleteStore, sf.storeAccess.GetMemberType(), operationReasonFailover,
+			failureStore.StoreID, failureStore.PodName), nil)
 		err := sf.failureRecovery.deletePodAndPvcs(tc, failureStore.StoreID)
 		if err != nil {
 			return err
@@ -298,6 +302,13 @@ func (fsa *failureStoreAccess) GetPVCUIDSet(tc *v1alpha1.TidbCluster, storeId st
 	return failureStore.PVCUIDSet
 }
 
+func (fsa *failureStoreAccess) CanMarkHostDown(tc *v1alpha1.TidbCluster, storeId string) bool {
+	// for backward compatibility, if there exists failure stores and user upgrades operator to newer version there
+	// will be failure store structures with empty PVCUIDSet set from api server, we should not handle those failure
+	// stores for failure recovery
+	return len(fsa.GetPVCUIDSet(tc, storeId)) > 0
+}
+
 type fakeStoreFailover struct{}
 
 var _ Failover = (*fakeStoreFailover)(nil)
@@ -53,6 +53,7 @@ const (
 // Failure object denotes the instance running on a pod of a component like a FailureStore or FailureMember. Thus,
 // In case of PD, the objectId is PD name (which is the key of FailureMember)
 // In case of TiKV/TiFlash, the objectId is storeID (which is the key of FailureStore)
+// In case of TiProxy/TiCDC, the objectId is pod name (which is the key of FailureMember)
 type FailureObjectAccess interface {
 	GetMemberType() v1alpha1.MemberType
 	GetFailureObjects(tc *v1alpha1.TidbCluster) map[string]v1alpha1.EmptyStruct
@@ -64,11 +65,14 @@ type FailureObjectAccess interface {
 	GetCreatedAt(tc *v1alpha1.TidbCluster, objectId string) metav1.Time
 	GetLastTransitionTime(tc *v1alpha1.TidbCluster, objectId string) metav1.Time
 	GetPVCUIDSet(tc *v1alpha1.TidbCluster, objectId string) map[types.UID]v1alpha1.EmptyStruct
+	// CanMarkHostDown returns whether the node failure of the failure object can be detected and marked
+	CanMarkHostDown(tc *v1alpha1.TidbCluster, objectId string) bool
 }
 
 // commonStatefulFailureRecovery has the common logic to handle the failure recovery of a stateful component like PD, TiKV/TiFlash
 // It uses the FailureObjectAccess interface thus enabling it to have the common logic for failure recovery for PD, and TiKV/TiFlash
-// It is currently used in pdFailover and commonStoreFailover.
+// It is currently used in pdFailover, commonStoreFailover and commonStatelessFailover, for the stateless components
+// like TiProxy and TiCDC only the restart of the pod on host down is used.
 type commonStatefulFailureRecovery struct {
 	deps                *controller.Dependencies
 	failureObjectAccess FailureObjectAccess
@@ -99,10 +103,7 @@ func (fr *commonStatefulFailureRecovery) checkAndMarkHostDown(tc *v1alpha1.TidbC
 
 	for objectId := range fr.failureObjectAccess.GetFailureObjects(tc) {
 		if fr.failureObjectAccess.IsFailing(tc, objectId) {
-			// for backward compatibility, if there exists failure stores and user upgrades operator to newer version there
-			// will be failure store structures with empty PVCUIDSet set from api server, we should not handle those failure
-			// stores for failure recovery (or failure member with empty PVCUIDSet)
-			if !fr.failureObjectAccess.IsHostDown(tc, objectId) && len(fr.failureObjectAccess.GetPVCUIDSet(tc, objectId)) > 0 {
+			if !fr.failureObjectAccess.IsHostDown(tc, objectId) && fr.failureObjectAccess.CanMarkHostDown(tc, objectId) {
 				pod, err := fr.deps.PodLister.Pods(ns).Get(fr.failureObjectAccess.GetPodName(tc, objectId))
 				if err != nil && !errors.IsNotFound(err) {
 					return fmt.Errorf("%s failover [checkAndMarkHostDown]: failed to get pod %s for tc %s/%s, error: %s", fr.failureObjectAccess.GetMemberType(), fr.failureObjectAccess.GetPodName(tc, objectId), ns, tcName, err)
@@ -189,6 +190,7 @@ func (fr *commonStatefulFailureRecovery) restartPodForHostDown(tc *v1alpha1.Tidb
 					if err = fr.deps.PodControl.ForceDeletePod(tc, pod); err != nil {
 						return err
 					}
+					tc.RecordOperation(podOperation(v1alpha1.OperationRestartPod, memberType, operationReasonHostDown, pod.Name), nil)
 					msg := fmt.Sprintf("Failed %s pod %s/%s is force deleted for recovery", memberType, ns, fr.failureObjectAccess.GetPodName(tc, objectId))
 					klog.Infof(msg)
 					return controller.IgnoreErrorf(msg)
@@ -250,6 +252,7 @@ func (fr *commonStatefulFailureRecovery) deletePodAndPvcs(tc *v1alpha1.TidbClust
 		if deleteErr := fr.deps.PodControl.DeletePod(tc, pod); deleteErr != nil {
 			return deleteErr
 		}
+		tc.RecordOperation(podOperation(v1alpha1.OperationDeletePod, memberType, operationReasonFailover, pod.Name), nil)
 	} else {
 		klog.Infof("pod %s/%s has DeletionTimestamp set to %s", ns, pod.Name, pod.DeletionTimestamp)
 	}
@@ -269,6 +272,9 @@ func (fr *commonStatefulFailureRecovery) deletePodAndPvcs(tc *v1alpha1.TidbClust
 					return deleteErr
 				}
 				klog.Infof("%s failover[deletePodAndPvcs]: delete PVC %s/%s successfully", memberType, ns, pvc.Name)
+				op := podOperation(v1alpha1.OperationDeletePVC, memberType, operationReasonFailover, failurePodName)
+				op.Target = pvc.Name
+				tc.RecordOperation(op, nil)
 			} else {
 				klog.Infof("pvc %s/%s has DeletionTimestamp set to %s", ns, pvc.Name, pvc.DeletionTimestamp)
 			}
@@ -0,0 +1,350 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	imagePrePullReason       = "ImagePrePull"
+	imagePrePullFailedReason = "ImagePrePullTimeout"
+)
+
+// imageNotPulledReasons are the reasons of a waiting container before its image is pulled, the kubelet
+// pulls the image before creating the container, so any other reason means the image is pulled.
+var imageNotPulledReasons = sets.NewString(
+	"ContainerCreating",
+	"PodInitializing",
+	"ErrImagePull",
+	"ImagePullBackOff",
+	"ErrImageNeverPull",
+	"InvalidImageName",
+	"RegistryUnavailable",
+)
+
+// imagePrePuller pulls the new images of TiKV or TiFlash on the nodes hosting the pods by a DaemonSet
+// before the upgrade begins, so that the pods don't wait for pulling the images when they are restarted.
+// Each new image is pulled by a container of the DaemonSet, the images are pulled on a node once all the
+// containers of the pod on it are created, even if they fail to start, e.g. the images don't have a shell.
+type imagePrePuller struct {
+	deps *controller.Dependencies
+}
+
+func newImagePrePuller(deps *controller.Dependencies) *imagePrePuller {
+	return &imagePrePuller{
+		deps: deps,
+	}
+}
+
+// Sync pulls the images changed from oldSet to newSet and returns true if the upgrade can begin,
+// i.e. the images are pulled on all the nodes, the timeout is hit, or no image needs to be pulled.
+func (p *imagePrePuller) Sync(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, oldSet, newSet *apps.StatefulSet) (bool, error) {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+
+	policy, status := p.getPolicyAndStatus(tc, memberType)
+	images := changedImages(oldSet, newSet)
+	if policy == nil || len(images) == 0 {
+		if *status != nil && (*status).Phase == v1alpha1.ImagePrePullPhasePulling {
+			// the images are reverted or the policy is removed in the middle of pulling
+			if err := p.deleteDaemonSet(tc, memberType); err != nil {
+				return false, err
+			}
+			*status = nil
+		}
+		return true, nil
+	}
+
+	if *status != nil && sets.NewString((*status).Images...).Equal(sets.NewString(images...)) {
+		if (*status).Phase != v1alpha1.ImagePrePullPhasePulling {
+			return true, nil
+		}
+	} else {
+		// the images are changed again in the middle of pulling, start over
+		if err := p.deleteDaemonSet(tc, memberType); err != nil {
+			return false, err
+		}
+		now := metav1.Now()
+		*status = &v1alpha1.ImagePrePullStatus{
+			Images:    images,
+			Phase:     v1alpha1.ImagePrePullPhasePulling,
+			StartTime: &now,
+		}
+	}
+	st := *status
+
+	nodes, err := p.getNodes(tc, memberType)
+	if err != nil {
+		return false, err
+	}
+	st.DesiredNodes = int32(len(nodes))
+	if len(nodes) == 0 {
+		return p.complete(tc, memberType, st, v1alpha1.ImagePrePullPhaseCompleted)
+	}
+
+	ds, err := p.syncDaemonSet(tc, memberType, images, nodes)
+	if err != nil {
+		return false, err
+	}
+	if st.PulledNodes, err = p.countPulledNodes(tc, ds); err != nil {
+		return false, err
+	}
+	if st.PulledNodes >= st.DesiredNodes {
+		return p.complete(tc, memberType, st, v1alpha1.ImagePrePullPhaseCompleted)
+	}
+	if st.StartTime != nil && time.Since(st.StartTime.Time) > policy.GetTimeout() {
+		return p.complete(tc, memberType, st, v1alpha1.ImagePrePullPhaseTimeout)
+	}
+
+	klog.Infof("TidbCluster: [%s/%s], pulling %s images %v, %d/%d nodes pulled", ns, tcName, memberType, images, st.PulledNodes, st.DesiredNodes)
+	return false, nil
+}
+
+func (p *imagePrePuller) getPolicyAndStatus(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType) (*v1alpha1.ImagePrePullPolicy, **v1alpha1.ImagePrePullStatus) {
+	var upgradePolicy *v1alpha1.UpgradePolicy
+	var status **v1alpha1.ImagePrePullStatus
+	if memberType == v1alpha1.TiFlashMemberType {
+		upgradePolicy = tc.Spec.TiFlash.UpgradePolicy
+		status = &tc.Status.TiFlash.ImagePrePull
+	} else {
+		upgradePolicy = tc.Spec.TiKV.UpgradePolicy
+		status = &tc.Status.TiKV.ImagePrePull
+	}
+	if upgradePolicy == nil {
+		return nil, status
+	}
+	return upgradePolicy.ImagePrePull, status
+}
+
+// complete stops pulling the images and lets the upgrade begin
+func (p *imagePrePuller) complete(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, st *v1alpha1.ImagePrePullStatus, phase v1alpha1.ImagePrePullPhase) (bool, error) {
+	if err := p.deleteDaemonSet(tc, memberType); err != nil {
+		return false, err
+	}
+	now := metav1.Now()
+	st.Phase = phase
+	st.CompletionTime = &now
+
+	if phase == v1alpha1.ImagePrePullPhaseTimeout {
+		msg := fmt.Sprintf("Timeout pulling %s images %v, %d/%d nodes pulled, begin upgrading", memberType, st.Images, st.PulledNodes, st.DesiredNodes)
+		klog.Warningf("TidbCluster: [%s/%s], %s", tc.GetNamespace(), tc.GetName(), msg)
+		p.deps.Recorder.Event(tc, corev1.EventTypeWarning, imagePrePullFailedReason, msg)
+		return true, nil
+	}
+	msg := fmt.Sprintf("Pulled %s images %v on %d nodes, begin upgrading", memberType, st.Images, st.DesiredNodes)
+	klog.Infof("TidbCluster: [%s/%s], %s", tc.GetNamespace(), tc.GetName(), msg)
+	p.deps.Recorder.Event(tc, corev1.EventTypeNormal, imagePrePullReason, msg)
+	return true, nil
+}
+
+// getNodes returns the sorted names of the nodes hosting the pods of the component
+func (p *imagePrePuller) getNodes(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType) ([]string, error) {
+	selector, err := label.New().Instance(tc.GetInstanceName()).Component(memberType.String()).Selector()
+	if err != nil {
+		return nil, err
+	}
+	pods, err := p.deps.PodLister.Pods(tc.GetNamespace()).List(selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s pods for cluster %s/%s, error: %v", memberType, tc.GetNamespace(), tc.GetName(), err)
+	}
+	nodes := sets.NewString()
+	for _, pod := range pods {
+		if pod.Spec.NodeName != "" {
+			nodes.Insert(pod.Spec.NodeName)
+		}
+	}
+	return nodes.List(), nil
+}
+
+// countPulledNodes returns the number of the nodes where all the images of the DaemonSet are pulled
+func (p *imagePrePuller) countPulledNodes(tc *v1alpha1.TidbCluster, ds *apps.DaemonSet) (int32, error) {
+	selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		return 0, err
+	}
+	pods, err := p.deps.PodLister.Pods(tc.GetNamespace()).List(selector)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list pods of DaemonSet %s/%s, error: %v", ds.Namespace, ds.Name, err)
+	}
+	images := containerImages(ds.Spec.Template.Spec.Containers)
+	nodes := sets.NewString()
+	for _, pod := range pods {
+		// skip the pods of the DaemonSet deleted for the previous images
+		if pod.DeletionTimestamp != nil || !containerImages(pod.Spec.Containers).Equal(images) {
+			continue
+		}
+		if pod.Spec.NodeName == "" || len(pod.Status.ContainerStatuses) < len(pod.Spec.Containers) {
+			continue
+		}
+		pulled := true
+		for _, status := range pod.Status.ContainerStatuses {
+			pulled = pulled && isImagePulled(status)
+		}
+		if pulled {
+			nodes.Insert(pod.Spec.NodeName)
+		}
+	}
+	return int32(nodes.Len()), nil
+}
+
+func containerImages(containers []corev1.Container) sets.String {
+	images := sets.NewString()
+	for _, c := range containers {
+		images.Insert(c.Image)
+	}
+	return images
+}
+
+// isImagePulled returns true if the image of the container is pulled, i.e. the container has been created
+func isImagePulled(status corev1.ContainerStatus) bool {
+	if status.State.Running != nil || status.State.Terminated != nil || status.LastTerminationState.Terminated != nil {
+		return true
+	}
+	return status.State.Waiting != nil && !imageNotPulledReasons.Has(status.State.Waiting.Reason)
+}
+
+func (p *imagePrePuller) syncDaemonSet(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, images, nodes []string) (*apps.DaemonSet, error) {
+	desired := newImagePrePullDaemonSet(tc, memberType, images, nodes)
+	client := p.deps.KubeClientset.AppsV1().DaemonSets(tc.GetNamespace())
+	ds, err := client.Get(context.TODO(), desired.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		ds, err = client.Create(context.TODO(), desired, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create DaemonSet %s/%s, error: %v", desired.Namespace, desired.Name, err)
+		}
+		klog.Infof("TidbCluster: [%s/%s], created DaemonSet %s to pull %s images %v on %d nodes",
+			tc.GetNamespace(), tc.GetName(), desired.Name, memberType, images, len(nodes))
+		return ds, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DaemonSet %s/%s, error: %v", desired.Namespace, desired.Name, err)
+	}
+	// the pods may be rescheduled to other nodes in the middle of pulling
+	if !equality.Semantic.DeepEqual(ds.Spec.Template.Spec.Affinity, desired.Spec.Template.Spec.Affinity) {
+		ds = ds.DeepCopy()
+		ds.Spec.Template.Spec.Affinity = desired.Spec.Template.Spec.Affinity
+		ds, err = client.Update(context.TODO(), ds, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update DaemonSet %s/%s, error: %v", desired.Namespace, desired.Name, err)
+		}
+	}
+	return ds, nil
+}
+
+func (p *imagePrePuller) deleteDaemonSet(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType) error {
+	name := imagePrePullDaemonSetName(tc.GetName(), memberType)
+	err := p.deps.KubeClientset.AppsV1().DaemonSets(tc.GetNamespace()).Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete DaemonSet %s/%s, error: %v", tc.GetNamespace(), name, err)
+	}
+	return nil
+}
+
+func imagePrePullDaemonSetName(tcName string, memberType v1alpha1.MemberType) string {
+	return fmt.Sprintf("%s-%s-image-prepull", tcName, memberType)
+}
+
+// newImagePrePullDaemonSet returns a DaemonSet which runs on the nodes only, the new images are pulled
+// by the containers with the ImagePullSecrets, NodeSelector and Tolerations of the component. The containers
+// keep running if the images have a shell, otherwise they fail to start after the images are pulled.
+func newImagePrePullDaemonSet(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, images, nodes []string) *apps.DaemonSet {
+	var spec v1alpha1.ComponentAccessor
+	if memberType == v1alpha1.TiFlashMemberType {
+		spec = tc.BaseTiFlashSpec()
+	} else {
+		spec = tc.BaseTiKVSpec()
+	}
+	name := imagePrePullDaemonSetName(tc.GetName(), memberType)
+	labels := label.New().Instance(tc.GetInstanceName()).Component(fmt.Sprintf("%s-image-prepull", memberType)).Labels()
+
+	containers := make([]corev1.Container, 0, len(images))
+	for i, image := range images {
+		containers = append(containers, corev1.Container{
+			Name:            fmt.Sprintf("prepull-%d", i),
+			Image:           image,
+			ImagePullPolicy: spec.ImagePullPolicy(),
+			Command:         []string{"sh", "-c", "tail -f /dev/null"},
+		})
+	}
+
+	return &apps.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       tc.GetNamespace(),
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
+		},
+		Spec: apps.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Affinity: &corev1.Affinity{
+						NodeAffinity: &corev1.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+								NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+									MatchFields: []corev1.NodeSelectorRequirement{{
+										Key:      metav1.ObjectNameField,
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   nodes,
+									}},
+								}},
+							},
+						},
+					},
+					NodeSelector:                  spec.NodeSelector(),
+					Tolerations:                   spec.Tolerations(),
+					ImagePullSecrets:              spec.ImagePullSecrets(),
+					PriorityClassName:             pointer.StringDeref(spec.PriorityClassName(), ""),
+					TerminationGracePeriodSeconds: pointer.Int64(0),
+					Containers:                    containers,
+				},
+			},
+		},
+	}
+}
+
+// changedImages returns the sorted new images of the containers whose images are changed
+func changedImages(oldSet, newSet *apps.StatefulSet) []string {
+	oldImages := map[string]string{}
+	for _, containers := range [][]corev1.Container{oldSet.Spec.Template.Spec.InitContainers, oldSet.Spec.Template.Spec.Containers} {
+		for _, c := range containers {
+			oldImages[c.Name] = c.Image
+		}
+	}
+	images := sets.NewString()
+	for _, containers := range [][]corev1.Container{newSet.Spec.Template.Spec.InitContainers, newSet.Spec.Template.Spec.Containers} {
+		for _, c := range containers {
+			if image, ok := oldImages[c.Name]; ok && image != c.Image {
+				images.Insert(c.Image)
+			}
+		}
+	}
+	return images.List()
+}
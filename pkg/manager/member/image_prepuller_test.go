@@ -0,0 +1,130 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestChangedImages(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	oldSet := newStatefulSetForTiKVUpgrader()
+	newSet := oldSet.DeepCopy()
+	g.Expect(changedImages(oldSet, newSet)).To(BeEmpty())
+
+	newSet.Spec.Template.Spec.Containers[0].Image = "tikv-new-image"
+	newSet.Spec.Template.Spec.Containers = append(newSet.Spec.Template.Spec.Containers, corev1.Container{Name: "new", Image: "new-image"})
+	g.Expect(changedImages(oldSet, newSet)).To(Equal([]string{"tikv-new-image"}))
+}
+
+func TestImagePrePullerSync(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	puller := newImagePrePuller(deps)
+	dsClient := deps.KubeClientset.AppsV1().DaemonSets(metav1.NamespaceDefault)
+	dsName := imagePrePullDaemonSetName(upgradeTcName, v1alpha1.TiKVMemberType)
+
+	tc := newTidbClusterForTiKVUpgrader()
+	tc.Spec.TiKV.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "secret"}}
+	tc.Spec.TiKV.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}}
+	oldSet := oldStatefulSetForTiKVUpgrader()
+	newSet := oldSet.DeepCopy()
+	newSet.Spec.Template.Spec.Containers[0].Image = "tikv-new-image"
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	for i, pod := range getTiKVPods(oldSet) {
+		pod.Spec.NodeName = []string{"node-a", "node-b", "node-a"}[i]
+		g.Expect(podIndexer.Add(pod)).To(Succeed())
+	}
+
+	// the images are not pulled if the policy is not set
+	pulled, err := puller.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pulled).To(BeTrue())
+	g.Expect(tc.Status.TiKV.ImagePrePull).To(BeNil())
+
+	// create the DaemonSet on the nodes hosting TiKV
+	tc.Spec.TiKV.UpgradePolicy = &v1alpha1.UpgradePolicy{ImagePrePull: &v1alpha1.ImagePrePullPolicy{}}
+	pulled, err = puller.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pulled).To(BeFalse())
+	status := tc.Status.TiKV.ImagePrePull
+	g.Expect(status.Phase).To(Equal(v1alpha1.ImagePrePullPhasePulling))
+	g.Expect(status.Images).To(Equal([]string{"tikv-new-image"}))
+	g.Expect(status.DesiredNodes).To(Equal(int32(2)))
+	ds, err := dsClient.Get(context.TODO(), dsName, metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	podSpec := ds.Spec.Template.Spec
+	g.Expect(podSpec.Containers).To(HaveLen(1))
+	g.Expect(podSpec.Containers[0].Image).To(Equal("tikv-new-image"))
+	g.Expect(podSpec.ImagePullSecrets).To(Equal(tc.Spec.TiKV.ImagePullSecrets))
+	g.Expect(podSpec.Tolerations).To(Equal(tc.Spec.TiKV.Tolerations))
+	nodeSelectorTerms := podSpec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	g.Expect(nodeSelectorTerms[0].MatchFields[0].Values).To(Equal([]string{"node-a", "node-b"}))
+
+	// report the progress, the image is pulled on node-a even though the container fails to start
+	addPrePullPod := func(node string, state corev1.ContainerState) {
+		g.Expect(podIndexer.Add(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: dsName + "-" + node, Namespace: metav1.NamespaceDefault, Labels: ds.Spec.Selector.MatchLabels},
+			Spec:       corev1.PodSpec{NodeName: node, Containers: podSpec.Containers},
+			Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{Name: podSpec.Containers[0].Name, State: state}}},
+		})).To(Succeed())
+	}
+	addPrePullPod("node-a", corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}})
+	addPrePullPod("node-b", corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}})
+	pulled, err = puller.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pulled).To(BeFalse())
+	g.Expect(tc.Status.TiKV.ImagePrePull.PulledNodes).To(Equal(int32(1)))
+
+	// complete after the images are pulled on all the nodes
+	addPrePullPod("node-b", corev1.ContainerState{Running: &corev1.ContainerStateRunning{}})
+	pulled, err = puller.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pulled).To(BeTrue())
+	g.Expect(tc.Status.TiKV.ImagePrePull.Phase).To(Equal(v1alpha1.ImagePrePullPhaseCompleted))
+	g.Expect(tc.Status.TiKV.ImagePrePull.CompletionTime).NotTo(BeNil())
+	_, err = dsClient.Get(context.TODO(), dsName, metav1.GetOptions{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+
+	// don't pull the same images again
+	pulled, err = puller.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pulled).To(BeTrue())
+	_, err = dsClient.Get(context.TODO(), dsName, metav1.GetOptions{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+
+	// begin the upgrade after the timeout
+	newSet.Spec.Template.Spec.Containers[0].Image = "tikv-newer-image"
+	pulled, err = puller.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pulled).To(BeFalse())
+	tc.Status.TiKV.ImagePrePull.StartTime = &metav1.Time{Time: time.Now().Add(-20 * time.Minute)}
+	pulled, err = puller.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pulled).To(BeTrue())
+	g.Expect(tc.Status.TiKV.ImagePrePull.Phase).To(Equal(v1alpha1.ImagePrePullPhaseTimeout))
+	_, err = dsClient.Get(context.TODO(), dsName, metav1.GetOptions{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
@@ -0,0 +1,526 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/features"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/klog/v2"
+)
+
+const (
+	inPlaceResizeReason         = "InPlaceResize"
+	inPlaceResizeFallbackReason = "InPlaceResizeFallback"
+
+	// inPlaceResizeTimeout is the max time to wait for the kubelets to resize the pods,
+	// the pods not resized are restarted by the rolling upgrade after the timeout.
+	inPlaceResizeTimeout = 10 * time.Minute
+
+	// tikvBlockCacheCapacityKey is the online config of the block cache capacity of tikv
+	tikvBlockCacheCapacityKey = "storage.block-cache.capacity"
+	// tikvBlockCacheRatio is the default ratio of the memory limit used by the block cache of tikv
+	tikvBlockCacheRatio = 0.45
+
+	// podResizePending and podResizeInProgress are the pod conditions which report the progress of the resize
+	// since Kubernetes v1.33, they replace status.resize of the pod
+	podResizePending    corev1.PodConditionType = "PodResizePending"
+	podResizeInProgress corev1.PodConditionType = "PodResizeInProgress"
+	// podResizeReasonInfeasible is the reason of the PodResizePending condition if the node can never hold the
+	// new resources, the other reason Deferred means that the node may hold them later
+	podResizeReasonInfeasible = "Infeasible"
+)
+
+// inPlaceResizeMode is how the pods are resized in place, which depends on the version of Kubernetes
+type inPlaceResizeMode int
+
+const (
+	// inPlaceResizeUnsupported is for Kubernetes before v1.27 which can't resize pods in place
+	inPlaceResizeUnsupported inPlaceResizeMode = iota
+	// inPlaceResizeByUpdate is for Kubernetes v1.27 to v1.32, where the resources in the spec of the pod are
+	// updated and the kubelet reports the progress in status.resize. The InPlacePodVerticalScaling feature
+	// gate of Kubernetes must be enabled as it's alpha in these versions.
+	inPlaceResizeByUpdate
+	// inPlaceResizeBySubresource is for Kubernetes v1.33 and later, where the resources are only updated by
+	// the resize subresource of the pod and the kubelet reports the progress by the PodResizePending and
+	// PodResizeInProgress conditions
+	inPlaceResizeBySubresource
+)
+
+var (
+	inPlaceResizeMinVersion         = utilversion.MajorMinor(1, 27)
+	inPlaceResizeSubresourceVersion = utilversion.MajorMinor(1, 33)
+)
+
+// inPlaceResizer resizes the resources of the TiDB or TiKV pods in place if only the resources
+// of the containers are changed, so that the pods are not restarted and the leaders of the stores
+// are not evicted.
+//
+// The new StatefulSet is applied with the partition unchanged, so the StatefulSet controller does
+// not recreate any pod. Then the resources of the pods are updated and the kubelets resize the
+// containers. After a pod is resized, it's equivalent to the pod created from the new template,
+// so it's labeled with the update revision of the StatefulSet and taken as updated.
+// If a pod can not be resized, e.g. the InPlacePodVerticalScaling feature gate of Kubernetes is
+// not enabled or the node has no enough resources, it falls back to the rolling upgrade.
+//
+// Kubernetes v1.27 and later are supported, the pods are resized by the resize subresource since
+// v1.33 and by updating the pods before, see inPlaceResizeMode. It falls back to the rolling upgrade
+// directly on the older versions.
+type inPlaceResizer struct {
+	deps *controller.Dependencies
+}
+
+func newInPlaceResizer(deps *controller.Dependencies) *inPlaceResizer {
+	return &inPlaceResizer{
+		deps: deps,
+	}
+}
+
+// Sync returns true if the pods are being resized in place, then newSet should be applied without upgrading.
+func (r *inPlaceResizer) Sync(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, oldSet, newSet *apps.StatefulSet) (bool, error) {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+
+	status := r.getStatus(tc, memberType)
+	resizing := *status != nil && (*status).Phase == v1alpha1.InPlaceResizePhaseResizing
+	if !features.DefaultFeatureGate.Enabled(features.InPlacePodResize) {
+		if resizing {
+			r.fallback(tc, memberType, *status, "the InPlacePodResize feature is disabled")
+		}
+		return false, nil
+	}
+
+	if !templateEqual(newSet, oldSet) {
+		resources, ok := resizedResources(oldSet, newSet)
+		if !ok {
+			if resizing {
+				r.fallback(tc, memberType, *status, "the pod template is changed in the middle of resizing")
+			}
+			return false, nil
+		}
+		if !resizing && mngerutils.StatefulSetIsUpgrading(oldSet) {
+			// don't interrupt the rolling upgrade in progress
+			return false, nil
+		}
+		now := metav1.Now()
+		*status = &v1alpha1.InPlaceResizeStatus{
+			Phase:     v1alpha1.InPlaceResizePhaseResizing,
+			Resources: resources,
+			StartTime: &now,
+		}
+		mode, version, err := r.resizeMode()
+		if err != nil {
+			return false, err
+		}
+		if mode == inPlaceResizeUnsupported {
+			r.fallback(tc, memberType, *status, fmt.Sprintf("Kubernetes %s can not resize pods in place, v%s or later is required", version, inPlaceResizeMinVersion))
+			return false, nil
+		}
+		klog.Infof("TidbCluster: [%s/%s], only the resources of %s are changed, begin resizing the pods in place", ns, tcName, memberType)
+		return true, nil
+	}
+	if !resizing {
+		return false, nil
+	}
+	st := *status
+
+	if oldSet.Status.ObservedGeneration < oldSet.Generation || oldSet.Status.UpdateRevision == "" {
+		return true, controller.RequeueErrorf("tidbcluster: [%s/%s]'s %s statefulset %s is not observed yet", ns, tcName, memberType, oldSet.Name)
+	}
+	updateRevision := oldSet.Status.UpdateRevision
+	mode, version, err := r.resizeMode()
+	if err != nil {
+		return true, err
+	}
+	if mode == inPlaceResizeUnsupported {
+		r.fallback(tc, memberType, st, fmt.Sprintf("Kubernetes %s can not resize pods in place, v%s or later is required", version, inPlaceResizeMinVersion))
+		return false, nil
+	}
+
+	pending := 0
+	for _, i := range helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet).List() {
+		podName := fmt.Sprintf("%s-%d", oldSet.Name, i)
+		pod, err := r.deps.PodLister.Pods(ns).Get(podName)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return true, controller.RequeueErrorf("tidbcluster: [%s/%s]'s %s pod %s is not created yet", ns, tcName, memberType, podName)
+			}
+			return true, fmt.Errorf("inPlaceResizer.Sync: failed to get pod %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
+		}
+		if pod.Labels[apps.ControllerRevisionHashLabelKey] == updateRevision {
+			continue
+		}
+
+		done, err := r.resizePod(tc, memberType, mode, st, pod)
+		if err != nil {
+			return true, err
+		}
+		if st.Phase != v1alpha1.InPlaceResizePhaseResizing {
+			return false, nil
+		}
+		if !done {
+			pending++
+			continue
+		}
+
+		// the pod is equivalent to the one created from the new template now
+		pod = pod.DeepCopy()
+		pod.Labels[apps.ControllerRevisionHashLabelKey] = updateRevision
+		if _, err := r.deps.PodControl.UpdatePod(tc, pod); err != nil {
+			return true, err
+		}
+		st.ResizedPods = append(st.ResizedPods, podName)
+	}
+
+	if pending > 0 {
+		if st.StartTime != nil && time.Since(st.StartTime.Time) > inPlaceResizeTimeout {
+			r.fallback(tc, memberType, st, fmt.Sprintf("timeout waiting for %d pods to be resized", pending))
+			return false, nil
+		}
+		return true, controller.RequeueErrorf("tidbcluster: [%s/%s], waiting for %d %s pods to be resized in place", ns, tcName, pending, memberType)
+	}
+
+	now := metav1.Now()
+	st.Phase = v1alpha1.InPlaceResizePhaseCompleted
+	st.CompletionTime = &now
+	msg := fmt.Sprintf("Resized %d %s pods in place", len(st.ResizedPods), memberType)
+	klog.Infof("TidbCluster: [%s/%s], %s", ns, tcName, msg)
+	r.deps.Recorder.Event(tc, corev1.EventTypeNormal, inPlaceResizeReason, msg)
+	return true, nil
+}
+
+// resizeMode returns how the pods are resized in place by the version of Kubernetes
+func (r *inPlaceResizer) resizeMode() (inPlaceResizeMode, string, error) {
+	info, err := r.deps.KubeClientset.Discovery().ServerVersion()
+	if err != nil {
+		return inPlaceResizeUnsupported, "", fmt.Errorf("failed to get the version of Kubernetes, error: %v", err)
+	}
+	version, err := utilversion.ParseGeneric(info.GitVersion)
+	if err != nil {
+		return inPlaceResizeUnsupported, "", fmt.Errorf("failed to parse the version %q of Kubernetes, error: %v", info.GitVersion, err)
+	}
+	switch {
+	case version.AtLeast(inPlaceResizeSubresourceVersion):
+		return inPlaceResizeBySubresource, info.GitVersion, nil
+	case version.AtLeast(inPlaceResizeMinVersion):
+		return inPlaceResizeByUpdate, info.GitVersion, nil
+	}
+	return inPlaceResizeUnsupported, info.GitVersion, nil
+}
+
+// resizePod updates the resources of the pod and returns true if the kubelet has resized the containers
+func (r *inPlaceResizer) resizePod(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, mode inPlaceResizeMode,
+	st *v1alpha1.InPlaceResizeStatus, pod *corev1.Pod) (bool, error) {
+	if !podResourcesEqual(pod, st.Resources) {
+		var err error
+		if mode == inPlaceResizeBySubresource {
+			err = r.patchPodResize(pod, st.Resources)
+		} else {
+			err = r.updatePodResources(pod, st.Resources)
+		}
+		if errors.IsInvalid(err) || errors.IsForbidden(err) {
+			r.fallback(tc, memberType, st, fmt.Sprintf("pod %s can not be resized: %v", pod.Name, err))
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to resize pod %s/%s, error: %v", pod.Namespace, pod.Name, err)
+		}
+		klog.Infof("TidbCluster: [%s/%s], resizing %s pod %s in place", tc.GetNamespace(), tc.GetName(), memberType, pod.Name)
+		return false, nil
+	}
+
+	if mode == inPlaceResizeBySubresource {
+		for _, cond := range pod.Status.Conditions {
+			if cond.Status != corev1.ConditionTrue {
+				continue
+			}
+			if cond.Type == podResizePending && cond.Reason == podResizeReasonInfeasible {
+				r.fallback(tc, memberType, st, fmt.Sprintf("the resize of pod %s is infeasible on node %s: %s", pod.Name, pod.Spec.NodeName, cond.Message))
+				return false, nil
+			}
+			if cond.Type == podResizePending || cond.Type == podResizeInProgress {
+				return false, nil
+			}
+		}
+	} else {
+		switch pod.Status.Resize {
+		case corev1.PodResizeStatusInfeasible:
+			r.fallback(tc, memberType, st, fmt.Sprintf("the resize of pod %s is infeasible on node %s", pod.Name, pod.Spec.NodeName))
+			return false, nil
+		case corev1.PodResizeStatusProposed, corev1.PodResizeStatusInProgress, corev1.PodResizeStatusDeferred:
+			return false, nil
+		}
+	}
+	if !podStatusResourcesEqual(pod, st.Resources) {
+		return false, nil
+	}
+
+	if memberType == v1alpha1.TiKVMemberType {
+		if err := r.setTiKVBlockCache(tc, pod, st); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// updatePodResources updates the resources in the spec of the pod, which is how the pods are resized before
+// Kubernetes v1.33. The pod is updated by the client directly as the resources should not be retried with a
+// stale pod.
+func (r *inPlaceResizer) updatePodResources(pod *corev1.Pod, resources map[string]corev1.ResourceRequirements) error {
+	pod = pod.DeepCopy()
+	for i := range pod.Spec.Containers {
+		if res, ok := resources[pod.Spec.Containers[i].Name]; ok {
+			pod.Spec.Containers[i].Resources = res
+		}
+	}
+	_, err := r.deps.KubeClientset.CoreV1().Pods(pod.Namespace).Update(context.TODO(), pod, metav1.UpdateOptions{})
+	return err
+}
+
+// patchPodResize patches the resources of the containers by the resize subresource of the pod, which is the
+// only way to resize the pods since Kubernetes v1.33. The resources removed are set to null in the patch.
+func (r *inPlaceResizer) patchPodResize(pod *corev1.Pod, resources map[string]corev1.ResourceRequirements) error {
+	var containers []map[string]interface{}
+	for _, c := range pod.Spec.Containers {
+		res, ok := resources[c.Name]
+		if !ok {
+			continue
+		}
+		containers = append(containers, map[string]interface{}{
+			"name": c.Name,
+			"resources": map[string]interface{}{
+				"requests": resourceListPatch(c.Resources.Requests, defaultedRequests(res)),
+				"limits":   resourceListPatch(c.Resources.Limits, res.Limits),
+			},
+		})
+	}
+	patch, err := json.Marshal(map[string]interface{}{"spec": map[string]interface{}{"containers": containers}})
+	if err != nil {
+		return err
+	}
+	_, err = r.deps.KubeClientset.CoreV1().Pods(pod.Namespace).Patch(context.TODO(), pod.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{}, "resize")
+	return err
+}
+
+// resourceListPatch returns the patch of the resource list from old to new
+func resourceListPatch(old, new corev1.ResourceList) map[corev1.ResourceName]interface{} {
+	patch := map[corev1.ResourceName]interface{}{}
+	for name := range old {
+		patch[name] = nil
+	}
+	for name, q := range new {
+		patch[name] = q.String()
+	}
+	return patch
+}
+
+// setTiKVBlockCache updates the block cache capacity of the tikv for the new memory limit, since tikv only
+// derives it from the memory limit on startup. It's skipped if the capacity is configured explicitly.
+// TiDB needs nothing to do as its memory limits are in percentage of the memory limit of the cgroup.
+func (r *inPlaceResizer) setTiKVBlockCache(tc *v1alpha1.TidbCluster, pod *corev1.Pod, st *v1alpha1.InPlaceResizeStatus) error {
+	res, ok := st.Resources[v1alpha1.TiKVMemberType.String()]
+	if !ok {
+		return nil
+	}
+	limit, ok := res.Limits[corev1.ResourceMemory]
+	if !ok {
+		return nil
+	}
+	if tc.Spec.TiKV.Config != nil && tc.Spec.TiKV.Config.Get(tikvBlockCacheCapacityKey) != nil {
+		return nil
+	}
+	capacity := fmt.Sprintf("%dMiB", int64(float64(limit.Value())*tikvBlockCacheRatio)>>20)
+	kvcli := r.deps.TiKVControl.GetTiKVPodClient(tc.GetNamespace(), tc.GetName(), pod.GetName(), tc.Spec.ClusterDomain, tc.IsTLSClusterEnabled())
+	if err := kvcli.SetConfig(map[string]string{tikvBlockCacheCapacityKey: capacity}); err != nil {
+		return fmt.Errorf("failed to set %s of tikv pod %s/%s to %s, error: %v", tikvBlockCacheCapacityKey, pod.Namespace, pod.Name, capacity, err)
+	}
+	klog.Infof("TidbCluster: [%s/%s], set %s of tikv pod %s to %s", tc.GetNamespace(), tc.GetName(), tikvBlockCacheCapacityKey, pod.Name, capacity)
+	return nil
+}
+
+// fallback stops resizing the pods in place and lets the rolling upgrade restart the pods not resized
+func (r *inPlaceResizer) fallback(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, st *v1alpha1.InPlaceResizeStatus, reason string) {
+	now := metav1.Now()
+	st.Phase = v1alpha1.InPlaceResizePhaseFallback
+	st.Message = reason
+	st.CompletionTime = &now
+
+	msg := fmt.Sprintf("Failed to resize %s pods in place, fall back to rolling upgrade: %s", memberType, reason)
+	klog.Warningf("TidbCluster: [%s/%s], %s", tc.GetNamespace(), tc.GetName(), msg)
+	r.deps.Recorder.Event(tc, corev1.EventTypeWarning, inPlaceResizeFallbackReason, msg)
+}
+
+func (r *inPlaceResizer) getStatus(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType) **v1alpha1.InPlaceResizeStatus {
+	if memberType == v1alpha1.TiDBMemberType {
+		return &tc.Status.TiDB.InPlaceResize
+	}
+	return &tc.Status.TiKV.InPlaceResize
+}
+
+// resizedResources returns the new resources of the containers if only the cpu and memory of the containers
+// are changed from oldSet to newSet. The resources of the init containers are ignored as they have exited.
+func resizedResources(oldSet, newSet *apps.StatefulSet) (map[string]corev1.ResourceRequirements, bool) {
+	_, oldPodSpec, err := GetLastAppliedConfig(oldSet)
+	if err != nil {
+		return nil, false
+	}
+	newPodSpec := newSet.Spec.Template.Spec.DeepCopy()
+
+	resources := map[string]corev1.ResourceRequirements{}
+	for i := range newPodSpec.Containers {
+		c := &newPodSpec.Containers[i]
+		old := findContainer(oldPodSpec.Containers, c.Name)
+		if old == nil {
+			return nil, false
+		}
+		if equality.Semantic.DeepEqual(old.Resources, c.Resources) {
+			continue
+		}
+		if !onlyCPUAndMemoryChanged(old.Resources.Requests, c.Resources.Requests) ||
+			!onlyCPUAndMemoryChanged(old.Resources.Limits, c.Resources.Limits) {
+			return nil, false
+		}
+		resources[c.Name] = c.Resources
+		c.Resources = old.Resources
+	}
+	for i := range newPodSpec.InitContainers {
+		c := &newPodSpec.InitContainers[i]
+		if old := findContainer(oldPodSpec.InitContainers, c.Name); old != nil {
+			c.Resources = old.Resources
+		}
+	}
+	if len(resources) == 0 || !equality.Semantic.DeepEqual(*oldPodSpec, *newPodSpec) {
+		return nil, false
+	}
+	return resources, true
+}
+
+func findContainer(containers []corev1.Container, name string) *corev1.Container {
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
+// onlyCPUAndMemoryChanged returns true if the resources other than cpu and memory are not changed,
+// only cpu and memory can be resized in place.
+func onlyCPUAndMemoryChanged(old, new corev1.ResourceList) bool {
+	for name, q := range old {
+		if name == corev1.ResourceCPU || name == corev1.ResourceMemory {
+			continue
+		}
+		if nq, ok := new[name]; !ok || nq.Cmp(q) != 0 {
+			return false
+		}
+	}
+	for name := range new {
+		if name == corev1.ResourceCPU || name == corev1.ResourceMemory {
+			continue
+		}
+		if _, ok := old[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// podResourcesEqual returns true if the resources in the spec of the pod are updated to the new resources
+func podResourcesEqual(pod *corev1.Pod, resources map[string]corev1.ResourceRequirements) bool {
+	for name, res := range resources {
+		c := findContainer(pod.Spec.Containers, name)
+		if c == nil {
+			continue
+		}
+		// the requests are defaulted to the limits by the apiserver if not specified
+		if !resourceListEqual(c.Resources.Requests, defaultedRequests(res)) || !resourceListEqual(c.Resources.Limits, res.Limits) {
+			return false
+		}
+	}
+	return true
+}
+
+// podStatusResourcesEqual returns true if the kubelet reports that the containers are resized to the new resources
+func podStatusResourcesEqual(pod *corev1.Pod, resources map[string]corev1.ResourceRequirements) bool {
+	for name, res := range resources {
+		if findContainer(pod.Spec.Containers, name) == nil {
+			continue
+		}
+		var actual *corev1.ResourceRequirements
+		for i := range pod.Status.ContainerStatuses {
+			if pod.Status.ContainerStatuses[i].Name == name {
+				actual = pod.Status.ContainerStatuses[i].Resources
+			}
+		}
+		if actual == nil {
+			return false
+		}
+		requests := defaultedRequests(res)
+		for _, rn := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			if !quantityEqual(actual.Requests, requests, rn) || !quantityEqual(actual.Limits, res.Limits, rn) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func defaultedRequests(res corev1.ResourceRequirements) corev1.ResourceList {
+	requests := res.Requests.DeepCopy()
+	for name, q := range res.Limits {
+		if _, ok := requests[name]; !ok {
+			if requests == nil {
+				requests = corev1.ResourceList{}
+			}
+			requests[name] = q.DeepCopy()
+		}
+	}
+	return requests
+}
+
+func resourceListEqual(a, b corev1.ResourceList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name := range a {
+		if !quantityEqual(a, b, name) {
+			return false
+		}
+	}
+	return true
+}
+
+func quantityEqual(a, b corev1.ResourceList, name corev1.ResourceName) bool {
+	qa, oka := a[name]
+	qb, okb := b[name]
+	if oka != okb {
+		return false
+	}
+	return qa.Cmp(qb) == 0
+}
@@ -0,0 +1,342 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/features"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	"github.com/pingcap/tidb-operator/pkg/tikvapi"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func newResourcesForResizer(cpu, memory string) corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(memory),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse(memory),
+		},
+	}
+}
+
+func newStatefulSetForResizer(g *GomegaWithT) *apps.StatefulSet {
+	set := newStatefulSetForTiKVUpgrader()
+	set.Spec.Template.Spec.Containers[0].Resources = newResourcesForResizer("1", "4Gi")
+	set.Spec.Template.Spec.InitContainers = []corev1.Container{{Name: "init", Resources: newResourcesForResizer("1", "4Gi")}}
+	g.Expect(mngerutils.SetStatefulSetLastAppliedConfigAnnotation(set)).To(Succeed())
+	set.Status = apps.StatefulSetStatus{
+		Replicas:        3,
+		CurrentReplicas: 3,
+		CurrentRevision: "1",
+		UpdateRevision:  "1",
+	}
+	return set
+}
+
+func TestResizedResources(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	oldSet := newStatefulSetForResizer(g)
+	_, ok := resizedResources(oldSet, oldSet.DeepCopy())
+	g.Expect(ok).To(BeFalse())
+
+	newSet := oldSet.DeepCopy()
+	newSet.Spec.Template.Spec.Containers[0].Resources = newResourcesForResizer("2", "8Gi")
+	newSet.Spec.Template.Spec.InitContainers[0].Resources = newResourcesForResizer("2", "8Gi")
+	resources, ok := resizedResources(oldSet, newSet)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(resources).To(Equal(map[string]corev1.ResourceRequirements{"tikv": newResourcesForResizer("2", "8Gi")}))
+
+	// other resources can not be resized in place
+	ephemeral := newSet.DeepCopy()
+	ephemeral.Spec.Template.Spec.Containers[0].Resources.Limits[corev1.ResourceEphemeralStorage] = resource.MustParse("10Gi")
+	_, ok = resizedResources(oldSet, ephemeral)
+	g.Expect(ok).To(BeFalse())
+
+	// not only the resources are changed
+	image := newSet.DeepCopy()
+	image.Spec.Template.Spec.Containers[0].Image = "tikv-new-image"
+	_, ok = resizedResources(oldSet, image)
+	g.Expect(ok).To(BeFalse())
+}
+
+// setKubeVersionForResizer sets the version of Kubernetes which decides how the pods are resized
+func setKubeVersionForResizer(deps *controller.Dependencies, gitVersion string) {
+	deps.KubeClientset.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: gitVersion}
+}
+
+func TestInPlaceResizerSync(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	setKubeVersionForResizer(deps, "v1.28.3")
+	resizer := newInPlaceResizer(deps)
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	podClient := deps.KubeClientset.CoreV1().Pods(metav1.NamespaceDefault)
+	tikvControl := deps.TiKVControl.(*tikvapi.FakeTiKVControl)
+
+	tc := newTidbClusterForTiKVUpgrader()
+	tc.Status.TiKV.Phase = v1alpha1.NormalPhase
+	oldSet := newStatefulSetForResizer(g)
+	newSet := oldSet.DeepCopy()
+	newSet.Spec.Template.Spec.Containers[0].Resources = newResourcesForResizer("2", "8Gi")
+	blockCache := map[string]string{}
+	for _, pod := range getTiKVPods(oldSet) {
+		pod.Spec.Containers = []corev1.Container{{Name: "tikv", Resources: newResourcesForResizer("1", "4Gi")}}
+		g.Expect(podIndexer.Add(pod)).To(Succeed())
+		_, err := podClient.Create(context.TODO(), pod, metav1.CreateOptions{})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		podName := pod.Name
+		kvcli := tikvapi.NewFakeTiKVClient()
+		kvcli.AddReaction(tikvapi.SetConfigActionType, func(action *tikvapi.Action) (interface{}, error) {
+			blockCache[podName] = action.Config[tikvBlockCacheCapacityKey]
+			return nil, nil
+		})
+		tikvControl.SetTiKVPodClient(tc.GetNamespace(), tc.GetName(), podName, kvcli)
+	}
+
+	// the pods are upgraded if the feature is disabled
+	resizing, err := resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resizing).To(BeFalse())
+	g.Expect(tc.Status.TiKV.InPlaceResize).To(BeNil())
+
+	features.DefaultFeatureGate.Set("InPlacePodResize=true")
+	defer features.DefaultFeatureGate.Set("InPlacePodResize=false")
+
+	// apply the new template without restarting the pods
+	resizing, err = resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resizing).To(BeTrue())
+	status := tc.Status.TiKV.InPlaceResize
+	g.Expect(status.Phase).To(Equal(v1alpha1.InPlaceResizePhaseResizing))
+	g.Expect(status.Resources).To(HaveKey("tikv"))
+
+	// resize the pods after the new template is applied
+	oldSet = newSet.DeepCopy()
+	g.Expect(mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)).To(Succeed())
+	oldSet.Status.UpdateRevision = "2"
+	newSet = oldSet.DeepCopy()
+	resizing, err = resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(resizing).To(BeTrue())
+	for _, pod := range getTiKVPods(oldSet) {
+		updated, err := podClient.Get(context.TODO(), pod.Name, metav1.GetOptions{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(updated.Spec.Containers[0].Resources).To(Equal(newResourcesForResizer("2", "8Gi")))
+
+		// the kubelet is resizing the pod
+		updated.Status.Resize = corev1.PodResizeStatusInProgress
+		g.Expect(podIndexer.Update(updated)).To(Succeed())
+	}
+	resizing, err = resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(resizing).To(BeTrue())
+	g.Expect(tc.Status.TiKV.InPlaceResize.ResizedPods).To(BeEmpty())
+
+	// complete after the kubelet reports the pods are resized
+	for _, pod := range getTiKVPods(oldSet) {
+		obj, _, err := podIndexer.GetByKey(pod.Namespace + "/" + pod.Name)
+		g.Expect(err).NotTo(HaveOccurred())
+		resized := obj.(*corev1.Pod).DeepCopy()
+		resized.Status.Resize = ""
+		resources := newResourcesForResizer("2", "8Gi")
+		resized.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: "tikv", Resources: &resources}}
+		g.Expect(podIndexer.Update(resized)).To(Succeed())
+	}
+	resizing, err = resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resizing).To(BeTrue())
+	status = tc.Status.TiKV.InPlaceResize
+	g.Expect(status.Phase).To(Equal(v1alpha1.InPlaceResizePhaseCompleted))
+	g.Expect(status.ResizedPods).To(HaveLen(3))
+	g.Expect(status.CompletionTime).NotTo(BeNil())
+	for _, pod := range getTiKVPods(oldSet) {
+		obj, _, err := podIndexer.GetByKey(pod.Namespace + "/" + pod.Name)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(obj.(*corev1.Pod).Labels[apps.ControllerRevisionHashLabelKey]).To(Equal("2"))
+		// 45% of 8Gi
+		g.Expect(blockCache[pod.Name]).To(Equal("3686MiB"))
+	}
+
+	// nothing to do after completed
+	resizing, err = resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resizing).To(BeFalse())
+}
+
+func TestInPlaceResizerSyncFallback(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	features.DefaultFeatureGate.Set("InPlacePodResize=true")
+	defer features.DefaultFeatureGate.Set("InPlacePodResize=false")
+
+	deps := controller.NewFakeDependencies()
+	setKubeVersionForResizer(deps, "v1.28.3")
+	resizer := newInPlaceResizer(deps)
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+
+	tc := newTidbClusterForTiKVUpgrader()
+	oldSet := newStatefulSetForResizer(g)
+	newSet := oldSet.DeepCopy()
+	newSet.Spec.Template.Spec.Containers[0].Resources = newResourcesForResizer("2", "8Gi")
+
+	// don't interrupt the rolling upgrade in progress
+	upgrading := oldSet.DeepCopy()
+	upgrading.Status.UpdateRevision = "2"
+	resizing, err := resizer.Sync(tc, v1alpha1.TiKVMemberType, upgrading, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resizing).To(BeFalse())
+
+	resizing, err = resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resizing).To(BeTrue())
+
+	// fall back to the rolling upgrade if the resize is infeasible
+	oldSet = newSet.DeepCopy()
+	g.Expect(mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)).To(Succeed())
+	oldSet.Status.UpdateRevision = "2"
+	for _, pod := range getTiKVPods(oldSet) {
+		pod.Spec.Containers = []corev1.Container{{Name: "tikv", Resources: newResourcesForResizer("2", "8Gi")}}
+		pod.Status.Resize = corev1.PodResizeStatusInfeasible
+		g.Expect(podIndexer.Add(pod)).To(Succeed())
+	}
+	resizing, err = resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, oldSet.DeepCopy())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resizing).To(BeFalse())
+	status := tc.Status.TiKV.InPlaceResize
+	g.Expect(status.Phase).To(Equal(v1alpha1.InPlaceResizePhaseFallback))
+	g.Expect(status.Message).To(ContainSubstring("infeasible"))
+}
+
+func TestInPlaceResizerSyncBySubresource(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	features.DefaultFeatureGate.Set("InPlacePodResize=true")
+	defer features.DefaultFeatureGate.Set("InPlacePodResize=false")
+
+	deps := controller.NewFakeDependencies()
+	setKubeVersionForResizer(deps, "v1.33.1")
+	resizer := newInPlaceResizer(deps)
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	podClient := deps.KubeClientset.CoreV1().Pods(metav1.NamespaceDefault)
+
+	tc := newTidbClusterForTiKVUpgrader()
+	tc.Spec.TiKV.Config = v1alpha1.NewTiKVConfig()
+	tc.Spec.TiKV.Config.Set(tikvBlockCacheCapacityKey, "1GiB")
+	oldSet := newStatefulSetForResizer(g)
+	g.Expect(mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)).To(Succeed())
+	newSet := oldSet.DeepCopy()
+	newSet.Spec.Template.Spec.Containers[0].Resources = newResourcesForResizer("2", "8Gi")
+	for _, pod := range getTiKVPods(oldSet) {
+		pod.Spec.Containers = []corev1.Container{{Name: "tikv", Resources: newResourcesForResizer("1", "4Gi")}}
+		g.Expect(podIndexer.Add(pod)).To(Succeed())
+		_, err := podClient.Create(context.TODO(), pod, metav1.CreateOptions{})
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+	resizing, err := resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resizing).To(BeTrue())
+
+	// the pods are resized by the resize subresource
+	oldSet = newSet.DeepCopy()
+	g.Expect(mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)).To(Succeed())
+	oldSet.Status.UpdateRevision = "2"
+	newSet = oldSet.DeepCopy()
+	resizing, err = resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(resizing).To(BeTrue())
+	resized := 0
+	for _, action := range deps.KubeClientset.(*kubefake.Clientset).Actions() {
+		g.Expect(action.GetVerb()).NotTo(Equal("update"))
+		if action.GetVerb() == "patch" && action.GetSubresource() == "resize" {
+			resized++
+		}
+	}
+	g.Expect(resized).To(Equal(3))
+	for _, pod := range getTiKVPods(oldSet) {
+		updated, err := podClient.Get(context.TODO(), pod.Name, metav1.GetOptions{})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(podResourcesEqual(updated, tc.Status.TiKV.InPlaceResize.Resources)).To(BeTrue())
+
+		// the kubelet is resizing the pod
+		updated.Status.Conditions = []corev1.PodCondition{{Type: podResizeInProgress, Status: corev1.ConditionTrue}}
+		g.Expect(podIndexer.Update(updated)).To(Succeed())
+	}
+	resizing, err = resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(resizing).To(BeTrue())
+
+	// complete after the conditions are removed and the pods are resized
+	for _, pod := range getTiKVPods(oldSet) {
+		obj, _, err := podIndexer.GetByKey(pod.Namespace + "/" + pod.Name)
+		g.Expect(err).NotTo(HaveOccurred())
+		resized := obj.(*corev1.Pod).DeepCopy()
+		resized.Status.Conditions = nil
+		resources := newResourcesForResizer("2", "8Gi")
+		resized.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: "tikv", Resources: &resources}}
+		g.Expect(podIndexer.Update(resized)).To(Succeed())
+	}
+	resizing, err = resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resizing).To(BeTrue())
+	g.Expect(tc.Status.TiKV.InPlaceResize.Phase).To(Equal(v1alpha1.InPlaceResizePhaseCompleted))
+
+	// fall back to the rolling upgrade if the resize is infeasible
+	st := &v1alpha1.InPlaceResizeStatus{Phase: v1alpha1.InPlaceResizePhaseResizing, Resources: tc.Status.TiKV.InPlaceResize.Resources}
+	pod := getTiKVPods(oldSet)[0]
+	pod.Spec.Containers = []corev1.Container{{Name: "tikv", Resources: newResourcesForResizer("2", "8Gi")}}
+	pod.Status.Conditions = []corev1.PodCondition{{Type: podResizePending, Status: corev1.ConditionTrue, Reason: podResizeReasonInfeasible}}
+	done, err := resizer.resizePod(tc, v1alpha1.TiKVMemberType, inPlaceResizeBySubresource, st, pod)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(done).To(BeFalse())
+	g.Expect(st.Phase).To(Equal(v1alpha1.InPlaceResizePhaseFallback))
+}
+
+func TestInPlaceResizerSyncUnsupported(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	features.DefaultFeatureGate.Set("InPlacePodResize=true")
+	defer features.DefaultFeatureGate.Set("InPlacePodResize=false")
+
+	deps := controller.NewFakeDependencies()
+	setKubeVersionForResizer(deps, "v1.26.5")
+	resizer := newInPlaceResizer(deps)
+
+	tc := newTidbClusterForTiKVUpgrader()
+	oldSet := newStatefulSetForResizer(g)
+	newSet := oldSet.DeepCopy()
+	newSet.Spec.Template.Spec.Containers[0].Resources = newResourcesForResizer("2", "8Gi")
+	resizing, err := resizer.Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(resizing).To(BeFalse())
+	status := tc.Status.TiKV.InPlaceResize
+	g.Expect(status.Phase).To(Equal(v1alpha1.InPlaceResizePhaseFallback))
+	g.Expect(status.Message).To(ContainSubstring("v1.27 or later is required"))
+}
@@ -0,0 +1,773 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+const (
+	instanceReplaceReason = "InstanceReplace"
+	instanceDeleteReason  = "InstanceDelete"
+)
+
+// instanceManager manages the pods and PVCs of TiKV or TiFlash directly without StatefulSet if the
+// management mode is Instance. The StatefulSet generated for the component is only taken as the
+// blueprint: the pod of an instance is created from its template, and the PVCs are created from its
+// volume claim templates, all named the same as a StatefulSet does, so the services, the PD stores
+// and the other controls of the pods and PVCs work the same as before.
+//
+// Each instance is recorded in the status with the revision of the template its pod is created from.
+// Unlike a StatefulSet, any instance can be drained, replaced or moved to another node by annotating
+// its pod, and the other instances are never renumbered.
+type instanceManager struct {
+	deps       *controller.Dependencies
+	memberType v1alpha1.MemberType
+}
+
+func newInstanceManager(deps *controller.Dependencies, memberType v1alpha1.MemberType) *instanceManager {
+	return &instanceManager{
+		deps:       deps,
+		memberType: memberType,
+	}
+}
+
+// Adopt records the pods of the StatefulSet as instances and deletes the StatefulSet with the pods and PVCs
+// orphaned, it's the migration from the StatefulSet mode. The pods not upgraded to the update revision of the
+// StatefulSet are recorded without revision, so they are upgraded after the adoption.
+func (im *instanceManager) Adopt(tc *v1alpha1.TidbCluster, set *apps.StatefulSet) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+
+	spec, _, err := GetLastAppliedConfig(set)
+	if err != nil {
+		return err
+	}
+	revision, err := instanceRevision(&spec.Template)
+	if err != nil {
+		return err
+	}
+
+	instances := im.getInstances(tc)
+	now := metav1.Now()
+	for _, ordinal := range helper.GetPodOrdinals(*set.Spec.Replicas, set).List() {
+		podName := ordinalPodName(im.memberType, tcName, ordinal)
+		if _, ok := instances[podName]; ok {
+			continue
+		}
+		inst := &v1alpha1.InstanceStatus{
+			Ordinal:            ordinal,
+			Phase:              v1alpha1.InstancePhaseRunning,
+			LastTransitionTime: now,
+		}
+		pod, err := im.deps.PodLister.Pods(ns).Get(podName)
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("instanceManager.Adopt: failed to get pod %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
+		}
+		if pod != nil && pod.Labels[apps.ControllerRevisionHashLabelKey] == set.Status.UpdateRevision {
+			inst.Revision = revision
+		}
+		instances[podName] = inst
+		klog.Infof("TidbCluster: [%s/%s], adopted %s pod %s of statefulset %s", ns, tcName, im.memberType, podName, set.Name)
+	}
+
+	if set.DeletionTimestamp == nil {
+		orphan := metav1.DeletePropagationOrphan
+		err := im.deps.StatefulSetControl.DeleteStatefulSet(tc, set, metav1.DeleteOptions{PropagationPolicy: &orphan})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return controller.RequeueErrorf("tidbcluster: [%s/%s], waiting for %s statefulset %s to be deleted with the pods orphaned", ns, tcName, im.memberType, set.Name)
+}
+
+// Sync creates, upgrades and deletes the instances to match the blueprint
+func (im *instanceManager) Sync(tc *v1alpha1.TidbCluster, blueprint *apps.StatefulSet) error {
+	revision, err := instanceRevision(&blueprint.Spec.Template)
+	if err != nil {
+		return err
+	}
+	instances := im.getInstances(tc)
+
+	if err := im.syncReplacements(tc, instances); err != nil {
+		return err
+	}
+	if err := im.scale(tc, instances); err != nil {
+		return err
+	}
+
+	for _, name := range sortedInstanceNames(instances) {
+		inst := instances[name]
+		if inst.Phase == v1alpha1.InstancePhaseDeleting {
+			if err := im.deleteInstance(tc, instances, name); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := im.createPodIfNotExist(tc, blueprint, name, inst, revision); err != nil {
+			return err
+		}
+	}
+
+	err = im.upgrade(tc, instances, revision)
+	im.syncStatus(tc, instances, revision)
+	return err
+}
+
+// syncReplacements creates the new instances for the instances to be replaced or moved, and deletes
+// the replaced instances after the new instances are up
+func (im *instanceManager) syncReplacements(tc *v1alpha1.TidbCluster, instances map[string]*v1alpha1.InstanceStatus) error {
+	ns := tc.GetNamespace()
+	for _, name := range sortedInstanceNames(instances) {
+		inst := instances[name]
+		if inst.Phase == v1alpha1.InstancePhaseDeleting {
+			continue
+		}
+
+		if inst.ReplacedBy == "" {
+			pod, err := im.deps.PodLister.Pods(ns).Get(name)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return fmt.Errorf("instanceManager.syncReplacements: failed to get pod %s/%s, error: %s", ns, name, err)
+			}
+			_, replace := pod.Annotations[label.AnnReplaceInstance]
+			_, move := pod.Annotations[label.AnnMoveInstance]
+			if !replace && !move {
+				continue
+			}
+			ordinal := nextInstanceOrdinal(instances)
+			newInst := &v1alpha1.InstanceStatus{
+				Ordinal:            ordinal,
+				Phase:              v1alpha1.InstancePhaseRunning,
+				LastTransitionTime: metav1.Now(),
+			}
+			if move {
+				newInst.ExcludedNode = pod.Spec.NodeName
+			}
+			inst.ReplacedBy = ordinalPodName(im.memberType, tc.GetName(), ordinal)
+			instances[inst.ReplacedBy] = newInst
+			im.recordEvent(tc, instanceReplaceReason, fmt.Sprintf("Create %s instance %s to replace instance %s", im.memberType, inst.ReplacedBy, name))
+			continue
+		}
+
+		newInst, ok := instances[inst.ReplacedBy]
+		if !ok || newInst.Phase == v1alpha1.InstancePhaseDeleting {
+			// the new instance is deleted by the user, replace again if the pod is still annotated
+			inst.ReplacedBy = ""
+			continue
+		}
+		up, err := im.instanceUp(tc, inst.ReplacedBy)
+		if err != nil {
+			return err
+		}
+		if up {
+			im.setPhase(inst, v1alpha1.InstancePhaseDeleting)
+			im.recordEvent(tc, instanceDeleteReason, fmt.Sprintf("Delete %s instance %s replaced by instance %s", im.memberType, name, inst.ReplacedBy))
+		}
+	}
+	return nil
+}
+
+// scale adds or deletes the records of the instances for the desired replicas, the new instances are
+// created later, and only one instance is deleted at a time. The instances annotated to be drained are
+// deleted first, then the instances chosen by the scale in strategy of the component, the same as
+// scaling in the StatefulSet. No instance is deleted if the remaining stores are not enough to hold it.
+func (im *instanceManager) scale(tc *v1alpha1.TidbCluster, instances map[string]*v1alpha1.InstanceStatus) error {
+	var active []string
+	desired := im.replicas(tc)
+	deleting := false
+	for _, name := range sortedInstanceNames(instances) {
+		inst := instances[name]
+		if inst.Phase == v1alpha1.InstancePhaseDeleting {
+			deleting = true
+			continue
+		}
+		active = append(active, name)
+		if inst.ReplacedBy != "" {
+			// the instance is kept until the new instance is up
+			desired++
+		}
+	}
+
+	for i := int32(len(active)); i < desired; i++ {
+		ordinal := nextInstanceOrdinal(instances)
+		instances[ordinalPodName(im.memberType, tc.GetName(), ordinal)] = &v1alpha1.InstanceStatus{
+			Ordinal:            ordinal,
+			Phase:              v1alpha1.InstancePhaseRunning,
+			LastTransitionTime: metav1.Now(),
+		}
+	}
+	if int32(len(active)) <= desired || deleting {
+		return nil
+	}
+
+	victim, err := im.pickScaleInVictim(tc, instances, active)
+	if err != nil || victim == "" {
+		return err
+	}
+	if err := im.checkScaleIn(tc, instances, victim); err != nil {
+		return err
+	}
+	im.setPhase(instances[victim], v1alpha1.InstancePhaseDeleting)
+	im.recordEvent(tc, instanceDeleteReason, fmt.Sprintf("Delete %s instance %s for scaling in", im.memberType, victim))
+	return nil
+}
+
+// pickScaleInVictim returns the instance annotated to be drained if any, otherwise the instance chosen
+// by the Balanced scale in strategy, or the instance with the largest ordinal
+func (im *instanceManager) pickScaleInVictim(tc *v1alpha1.TidbCluster, instances map[string]*v1alpha1.InstanceStatus, active []string) (string, error) {
+	var (
+		victim   string
+		ordinals = sets.NewInt32()
+	)
+	for _, name := range active {
+		inst := instances[name]
+		if inst.ReplacedBy != "" || im.isReplacement(instances, name) {
+			continue
+		}
+		pod, err := im.deps.PodLister.Pods(tc.GetNamespace()).Get(name)
+		if err == nil {
+			if _, drain := pod.Annotations[label.AnnDrainInstance]; drain {
+				return name, nil
+			}
+			ordinals.Insert(inst.Ordinal)
+		}
+		if victim == "" || inst.Ordinal > instances[victim].Ordinal {
+			victim = name
+		}
+	}
+
+	selector := newStoreScaleInSelector(im.deps, im.memberType)
+	if selector.scalePolicy(tc).GetScaleInStrategy() != v1alpha1.ScaleInStrategyBalanced || ordinals.Len() == 0 {
+		return victim, nil
+	}
+	candidates, err := selector.candidates(tc, ordinals)
+	if err != nil {
+		return "", err
+	}
+	ordinal := pickScaleInVictims(candidates, 1).List()[0]
+	return ordinalPodName(im.memberType, tc.GetName(), ordinal), nil
+}
+
+// checkScaleIn returns an error if the store of the instance is Up and removing it would leave
+// the Up TiKV stores fewer than max-replicas, or the remaining stores without enough capacity
+// to hold its data, the same checks as scaling in the StatefulSet. Otherwise PD would never
+// finish moving the data away, and the store would be Offline forever.
+func (im *instanceManager) checkScaleIn(tc *v1alpha1.TidbCluster, instances map[string]*v1alpha1.InstanceStatus, name string) error {
+	_, stores, _ := im.getStores(tc)
+	store := findStoreByPodName(stores, name)
+	if store == nil || store.State != v1alpha1.TiKVStateUp {
+		return nil
+	}
+
+	if im.memberType == v1alpha1.TiKVMemberType && tc.TiKVBootStrapped() {
+		upTikvStoreCount, maxReplicas, err := getUpTiKVStoreCountAndMaxReplicas(im.deps, tc)
+		if err != nil {
+			return err
+		}
+		scaler := &tikvScaler{generalScaler{deps: im.deps}}
+		if !scaler.preCheckUpStores(tc, name, upTikvStoreCount, 0, maxReplicas) {
+			return fmt.Errorf("instanceManager.checkScaleIn: failed to pass up stores check, pod %s, cluster %s/%s", name, tc.GetNamespace(), tc.GetName())
+		}
+	}
+	return newStoreCapacityChecker(im.deps, im.memberType).Check(tc, []int32{instances[name].Ordinal})
+}
+
+// deleteInstance removes the store of the instance, then deletes the pod and marks the PVCs to be deleted
+// by the PVC cleaner after the store becomes tombstone. The store is removed only if it passes the checks
+// of scaling in, and the leaders of the TiKV store are evicted before it is removed.
+func (im *instanceManager) deleteInstance(tc *v1alpha1.TidbCluster, instances map[string]*v1alpha1.InstanceStatus, name string) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	inst := instances[name]
+
+	pod, err := im.deps.PodLister.Pods(ns).Get(name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("instanceManager.deleteInstance: failed to get pod %s/%s, error: %s", ns, name, err)
+		}
+		pod = nil
+	}
+
+	synced, stores, tombstoneStores := im.getStores(tc)
+	if !synced {
+		return controller.RequeueErrorf("tidbcluster: [%s/%s]'s %s status is not synced, can not delete instance %s", ns, tcName, im.memberType, name)
+	}
+	if store := findStoreByPodName(stores, name); store != nil {
+		if store.State != v1alpha1.TiKVStateOffline {
+			if err := im.deleteStore(tc, instances, name, pod, store); err != nil {
+				return err
+			}
+		}
+		return controller.RequeueErrorf("tidbcluster: [%s/%s], waiting for store %s of %s instance %s to be tombstone", ns, tcName, store.ID, im.memberType, name)
+	}
+	if store := findStoreByPodName(tombstoneStores, name); store == nil {
+		klog.Infof("TidbCluster: [%s/%s], no store found for %s instance %s, delete it directly", ns, tcName, im.memberType, name)
+	} else {
+		tc.FinishOperation(storeOperation(v1alpha1.OperationDeleteStore, im.memberType, operationReasonScaleIn, store.ID, name), nil)
+		if im.memberType == v1alpha1.TiKVMemberType {
+			id, err := strconv.ParseUint(store.ID, 10, 64)
+			if err != nil {
+				return err
+			}
+			if err := endEvictLeaderbyStoreID(im.deps, tc, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	if pod != nil {
+		if pod.DeletionTimestamp != nil {
+			return controller.RequeueErrorf("tidbcluster: [%s/%s], waiting for %s pod %s to be deleted", ns, tcName, im.memberType, name)
+		}
+		scaler := &generalScaler{deps: im.deps}
+		if err := scaler.updateDeferDeletingPVC(tc, im.memberType, inst.Ordinal); err != nil {
+			return err
+		}
+		return im.deps.PodControl.DeletePod(tc, pod)
+	}
+
+	delete(instances, name)
+	klog.Infof("TidbCluster: [%s/%s], %s instance %s is deleted", ns, tcName, im.memberType, name)
+	return nil
+}
+
+// deleteStore checks the instance and evicts the leaders of the TiKV store before deleting the store in PD.
+// It returns a requeue error while the leaders are being evicted.
+func (im *instanceManager) deleteStore(tc *v1alpha1.TidbCluster, instances map[string]*v1alpha1.InstanceStatus, name string, pod *corev1.Pod, store *v1alpha1.TiKVStore) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	id, err := strconv.ParseUint(store.ID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	if err := im.checkScaleIn(tc, instances, name); err != nil {
+		return err
+	}
+	// the leaders can not be evicted by annotating the pod if the pod is gone
+	if im.memberType == v1alpha1.TiKVMemberType && pod != nil {
+		evicted, err := evictLeaderBeforeScaleIn(im.deps, tc, pod, *store, time.Now())
+		if err != nil {
+			return err
+		}
+		if !evicted {
+			return controller.RequeueErrorf("tidbcluster: [%s/%s], evicting leaders of store %s of %s instance %s", ns, tcName, store.ID, im.memberType, name)
+		}
+		tc.FinishOperation(storeOperation(v1alpha1.OperationEvictLeader, im.memberType, operationReasonScaleIn, store.ID, name), nil)
+	}
+
+	if err := controller.GetPDClient(im.deps.PDControl, tc).DeleteStore(id); err != nil {
+		return fmt.Errorf("failed to delete store %d of %s instance %s for cluster %s/%s, error: %v", id, im.memberType, name, ns, tcName, err)
+	}
+	klog.Infof("TidbCluster: [%s/%s], delete store %d of %s instance %s", ns, tcName, id, im.memberType, name)
+	tc.StartOperation(storeOperation(v1alpha1.OperationDeleteStore, im.memberType, operationReasonScaleIn, store.ID, name))
+	return nil
+}
+
+// createPodIfNotExist creates the PVCs and the pod of the instance from the blueprint if the pod does not exist
+func (im *instanceManager) createPodIfNotExist(tc *v1alpha1.TidbCluster, blueprint *apps.StatefulSet, name string, inst *v1alpha1.InstanceStatus, revision string) error {
+	ns := tc.GetNamespace()
+	_, err := im.deps.PodLister.Pods(ns).Get(name)
+	if err == nil {
+		return nil
+	}
+	if !errors.IsNotFound(err) {
+		return fmt.Errorf("instanceManager.createPodIfNotExist: failed to get pod %s/%s, error: %s", ns, name, err)
+	}
+
+	// the PVCs left by the instance deleted before at the same ordinal must not be reused
+	scaler := &generalScaler{deps: im.deps}
+	if _, err := scaler.deleteDeferDeletingPVC(tc, im.memberType, inst.Ordinal); err != nil {
+		return err
+	}
+	for i := range blueprint.Spec.VolumeClaimTemplates {
+		pvc := newInstancePVC(blueprint, &blueprint.Spec.VolumeClaimTemplates[i], name)
+		if _, err := im.deps.PVCLister.PersistentVolumeClaims(ns).Get(pvc.Name); err == nil {
+			continue
+		} else if !errors.IsNotFound(err) {
+			return fmt.Errorf("instanceManager.createPodIfNotExist: failed to get pvc %s/%s, error: %s", ns, pvc.Name, err)
+		}
+		if err := im.deps.PVCControl.CreatePVC(tc, pvc); err != nil {
+			return err
+		}
+	}
+
+	if err := im.deps.PodControl.CreatePod(tc, newInstancePod(blueprint, name, inst)); err != nil {
+		return err
+	}
+	inst.Revision = revision
+	klog.Infof("TidbCluster: [%s/%s], created %s pod %s of revision %s", ns, tc.GetName(), im.memberType, name, revision)
+	return nil
+}
+
+// upgrade recreates the pods of the instances not created from the revision one by one, in the descending
+// order of the ordinals. The same as upgrading the StatefulSet of TiKV, an upgraded TiKV instance must be
+// available for the min ready seconds before the next one is upgraded, and the cluster must be stable and
+// the regions healthy before the leaders of the next TiKV store are evicted and its pod is deleted.
+func (im *instanceManager) upgrade(tc *v1alpha1.TidbCluster, instances map[string]*v1alpha1.InstanceStatus, revision string) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	names := sortedInstanceNames(instances)
+
+	// finish the upgrade in progress first
+	for _, name := range names {
+		inst := instances[name]
+		if inst.Phase != v1alpha1.InstancePhaseUpgrading {
+			continue
+		}
+		up, err := im.instanceUp(tc, name)
+		if err != nil {
+			return err
+		}
+		if !up {
+			return controller.RequeueErrorf("tidbcluster: [%s/%s], waiting for upgraded %s instance %s to be up", ns, tcName, im.memberType, name)
+		}
+		if im.memberType == v1alpha1.TiKVMemberType {
+			pod, err := im.deps.PodLister.Pods(ns).Get(name)
+			if err != nil {
+				return fmt.Errorf("instanceManager.upgrade: failed to get pod %s/%s, error: %s", ns, name, err)
+			}
+			if err := isPodAvailable(pod, getMinReadySeconds(tc), tc); err != nil {
+				return err
+			}
+			if err := im.endEvictLeader(tc, name); err != nil {
+				return err
+			}
+		}
+		im.setPhase(inst, v1alpha1.InstancePhaseRunning)
+	}
+
+	for i := len(names) - 1; i >= 0; i-- {
+		name := names[i]
+		inst := instances[name]
+		if inst.Phase != v1alpha1.InstancePhaseRunning || inst.ReplacedBy != "" || inst.Revision == revision {
+			continue
+		}
+		pod, err := im.deps.PodLister.Pods(ns).Get(name)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("instanceManager.upgrade: failed to get pod %s/%s, error: %s", ns, name, err)
+		}
+		_, stores, _ := im.getStores(tc)
+		if im.memberType == v1alpha1.TiKVMemberType && findStoreByPodName(stores, name) != nil {
+			u := &tikvUpgrader{deps: im.deps}
+			if unstableReason := u.isClusterStable(tc); unstableReason != "" {
+				return controller.RequeueErrorf("cluster is unstable: %s", unstableReason)
+			}
+			if _, evicting := pod.Annotations[annoKeyEvictLeaderBeginTime]; !evicting {
+				healthy, err := checkRegionHealthBeforeUpgrade(im.deps, tc, v1alpha1.TiKVMemberType, name)
+				if err != nil {
+					return fmt.Errorf("instanceManager.upgrade: failed to check region health before upgrading pod %s/%s, error: %s", ns, name, err)
+				}
+				if !healthy {
+					return controller.RequeueErrorf("tidbcluster: [%s/%s], waiting for regions to be healthy before upgrading %s instance %s", ns, tcName, im.memberType, name)
+				}
+			}
+			done, err := u.evictLeaderBeforeUpgrade(tc, pod)
+			if err != nil {
+				return fmt.Errorf("instanceManager.upgrade: failed to evict leader of pod %s/%s, error: %s", ns, name, err)
+			}
+			if !done {
+				return controller.RequeueErrorf("tidbcluster: [%s/%s], evicting leader of %s instance %s", ns, tcName, im.memberType, name)
+			}
+		}
+		if err := im.deps.PodControl.DeletePod(tc, pod); err != nil {
+			return err
+		}
+		im.setPhase(inst, v1alpha1.InstancePhaseUpgrading)
+		klog.Infof("TidbCluster: [%s/%s], upgrading %s instance %s to revision %s", ns, tcName, im.memberType, name, revision)
+		return controller.RequeueErrorf("tidbcluster: [%s/%s], upgrading %s instance %s", ns, tcName, im.memberType, name)
+	}
+
+	if im.memberType == v1alpha1.TiKVMemberType {
+		// the next upgrade records its own baseline of the unhealthy regions
+		tc.Status.TiKV.RegionHealthBaseline = nil
+		meta.RemoveStatusCondition(&tc.Status.TiKV.Conditions, v1alpha1.ConditionTypeUpgradeBlocked)
+	}
+	return nil
+}
+
+func (im *instanceManager) endEvictLeader(tc *v1alpha1.TidbCluster, name string) error {
+	_, stores, _ := im.getStores(tc)
+	store := findStoreByPodName(stores, name)
+	if store == nil {
+		return nil
+	}
+	id, err := strconv.ParseUint(store.ID, 10, 64)
+	if err != nil {
+		return err
+	}
+	return endEvictLeaderbyStoreID(im.deps, tc, id)
+}
+
+// syncStatus summarizes the instances into the status of the StatefulSet and the phase of the component,
+// so that the other parts of the operator work the same as the StatefulSet mode
+func (im *instanceManager) syncStatus(tc *v1alpha1.TidbCluster, instances map[string]*v1alpha1.InstanceStatus, revision string) {
+	status := &apps.StatefulSetStatus{
+		CurrentRevision: revision,
+		UpdateRevision:  revision,
+	}
+	deleting, upgrading := false, false
+	active := int32(0)
+	for _, name := range sortedInstanceNames(instances) {
+		inst := instances[name]
+		if inst.Phase == v1alpha1.InstancePhaseDeleting {
+			deleting = true
+			continue
+		}
+		active++
+		if inst.Phase == v1alpha1.InstancePhaseUpgrading || inst.Revision != revision {
+			upgrading = true
+			status.CurrentRevision = inst.Revision
+		} else {
+			status.UpdatedReplicas++
+		}
+		pod, err := im.deps.PodLister.Pods(tc.GetNamespace()).Get(name)
+		if err != nil {
+			continue
+		}
+		status.Replicas++
+		if k8s.IsPodReady(pod) {
+			status.ReadyReplicas++
+			status.AvailableReplicas++
+		}
+	}
+	status.CurrentReplicas = status.Replicas
+	if status.CurrentRevision == revision {
+		status.CurrentReplicas = status.UpdatedReplicas
+	}
+
+	phase := v1alpha1.NormalPhase
+	if deleting || active != im.replicas(tc) {
+		phase = v1alpha1.ScalePhase
+	} else if upgrading {
+		phase = v1alpha1.UpgradePhase
+	}
+	if im.memberType == v1alpha1.TiFlashMemberType {
+		tc.Status.TiFlash.StatefulSet = status
+		tc.Status.TiFlash.Phase = phase
+	} else {
+		tc.Status.TiKV.StatefulSet = status
+		tc.Status.TiKV.Phase = phase
+	}
+}
+
+// instanceUp returns true if the pod of the instance is ready and the store is up
+func (im *instanceManager) instanceUp(tc *v1alpha1.TidbCluster, name string) (bool, error) {
+	pod, err := im.deps.PodLister.Pods(tc.GetNamespace()).Get(name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("instanceManager.instanceUp: failed to get pod %s/%s, error: %s", tc.GetNamespace(), name, err)
+	}
+	if pod.DeletionTimestamp != nil || !k8s.IsPodReady(pod) {
+		return false, nil
+	}
+	_, stores, _ := im.getStores(tc)
+	store := findStoreByPodName(stores, name)
+	return store != nil && store.State == v1alpha1.TiKVStateUp, nil
+}
+
+// isReplacement returns true if the instance is created to replace another instance
+func (im *instanceManager) isReplacement(instances map[string]*v1alpha1.InstanceStatus, name string) bool {
+	for _, inst := range instances {
+		if inst.ReplacedBy == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (im *instanceManager) setPhase(inst *v1alpha1.InstanceStatus, phase v1alpha1.InstancePhase) {
+	if inst.Phase != phase {
+		inst.Phase = phase
+		inst.LastTransitionTime = metav1.Now()
+	}
+}
+
+func (im *instanceManager) recordEvent(tc *v1alpha1.TidbCluster, reason, msg string) {
+	klog.Infof("TidbCluster: [%s/%s], %s", tc.GetNamespace(), tc.GetName(), msg)
+	im.deps.Recorder.Event(tc, corev1.EventTypeNormal, reason, msg)
+}
+
+func (im *instanceManager) getInstances(tc *v1alpha1.TidbCluster) map[string]*v1alpha1.InstanceStatus {
+	instances := &tc.Status.TiKV.Instances
+	if im.memberType == v1alpha1.TiFlashMemberType {
+		instances = &tc.Status.TiFlash.Instances
+	}
+	if *instances == nil {
+		*instances = map[string]*v1alpha1.InstanceStatus{}
+	}
+	return *instances
+}
+
+func (im *instanceManager) getStores(tc *v1alpha1.TidbCluster) (bool, map[string]v1alpha1.TiKVStore, map[string]v1alpha1.TiKVStore) {
+	if im.memberType == v1alpha1.TiFlashMemberType {
+		return tc.Status.TiFlash.Synced, tc.Status.TiFlash.Stores, tc.Status.TiFlash.TombstoneStores
+	}
+	return tc.Status.TiKV.Synced, tc.Status.TiKV.Stores, tc.Status.TiKV.TombstoneStores
+}
+
+func (im *instanceManager) replicas(tc *v1alpha1.TidbCluster) int32 {
+	if im.memberType == v1alpha1.TiFlashMemberType {
+		return tc.Spec.TiFlash.Replicas
+	}
+	return tc.Spec.TiKV.Replicas
+}
+
+func findStoreByPodName(stores map[string]v1alpha1.TiKVStore, podName string) *v1alpha1.TiKVStore {
+	for _, store := range stores {
+		if store.PodName == podName {
+			return &store
+		}
+	}
+	return nil
+}
+
+// sortedInstanceNames returns the names of the instances in the ascending order of the ordinals
+func sortedInstanceNames(instances map[string]*v1alpha1.InstanceStatus) []string {
+	names := make([]string, 0, len(instances))
+	for name := range instances {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return instances[names[i]].Ordinal < instances[names[j]].Ordinal
+	})
+	return names
+}
+
+// nextInstanceOrdinal returns the smallest ordinal not used by any instance
+func nextInstanceOrdinal(instances map[string]*v1alpha1.InstanceStatus) int32 {
+	used := map[int32]bool{}
+	for _, inst := range instances {
+		used[inst.Ordinal] = true
+	}
+	ordinal := int32(0)
+	for used[ordinal] {
+		ordinal++
+	}
+	return ordinal
+}
+
+// instanceRevision returns the hash of the pod template
+func instanceRevision(template *corev1.PodTemplateSpec) (string, error) {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+	return v1alpha1.HashContents(data), nil
+}
+
+// newInstancePod returns the pod of the instance created from the template of the blueprint, the same as
+// the pod created by the StatefulSet controller
+func newInstancePod(blueprint *apps.StatefulSet, name string, inst *v1alpha1.InstanceStatus) *corev1.Pod {
+	template := blueprint.Spec.Template.DeepCopy()
+	labels := map[string]string{}
+	for k, v := range template.Labels {
+		labels[k] = v
+	}
+	labels[apps.StatefulSetPodNameLabel] = name
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       blueprint.Namespace,
+			Labels:          labels,
+			Annotations:     template.Annotations,
+			OwnerReferences: blueprint.OwnerReferences,
+		},
+		Spec: template.Spec,
+	}
+	pod.Spec.Hostname = name
+	pod.Spec.Subdomain = blueprint.Spec.ServiceName
+	for _, claim := range blueprint.Spec.VolumeClaimTemplates {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: claim.Name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: instancePVCName(claim.Name, name),
+				},
+			},
+		})
+	}
+
+	if inst.ExcludedNode != "" {
+		requirement := corev1.NodeSelectorRequirement{
+			Key:      metav1.ObjectNameField,
+			Operator: corev1.NodeSelectorOpNotIn,
+			Values:   []string{inst.ExcludedNode},
+		}
+		if pod.Spec.Affinity == nil {
+			pod.Spec.Affinity = &corev1.Affinity{}
+		}
+		if pod.Spec.Affinity.NodeAffinity == nil {
+			pod.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+		}
+		nodeAffinity := pod.Spec.Affinity.NodeAffinity
+		if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+			nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{}},
+			}
+		}
+		terms := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+		for i := range terms {
+			terms[i].MatchFields = append(terms[i].MatchFields, requirement)
+		}
+	}
+	return pod
+}
+
+// newInstancePVC returns the PVC of the instance created from the volume claim template of the blueprint
+func newInstancePVC(blueprint *apps.StatefulSet, claim *corev1.PersistentVolumeClaim, podName string) *corev1.PersistentVolumeClaim {
+	pvc := claim.DeepCopy()
+	pvc.Name = instancePVCName(claim.Name, podName)
+	pvc.Namespace = blueprint.Namespace
+	if pvc.Labels == nil {
+		pvc.Labels = map[string]string{}
+	}
+	if blueprint.Spec.Selector != nil {
+		for k, v := range blueprint.Spec.Selector.MatchLabels {
+			pvc.Labels[k] = v
+		}
+	}
+	pvc.Labels[label.AnnPodNameKey] = podName
+	return pvc
+}
+
+func instancePVCName(claimName, podName string) string {
+	return fmt.Sprintf("%s-%s", claimName, podName)
+}
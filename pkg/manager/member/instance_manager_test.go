@@ -0,0 +1,462 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/tikvapi"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func newBlueprintForInstanceManager() *apps.StatefulSet {
+	set := newStatefulSetForTiKVUpgrader()
+	set.Spec.ServiceName = "upgrader-tikv-peer"
+	set.Spec.Selector = &metav1.LabelSelector{MatchLabels: set.Labels}
+	set.Spec.Template.Labels = set.Labels
+	set.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "tikv"}}}
+	return set
+}
+
+func newTidbClusterForInstanceManager() *v1alpha1.TidbCluster {
+	tc := newTidbClusterForTiKVUpgrader()
+	tc.Spec.TiKV.ManagementMode = v1alpha1.ManagementModeInstance
+	tc.Status.TiKV = v1alpha1.TiKVStatus{Synced: true, BootStrapped: true}
+	return tc
+}
+
+// setInstancesUp marks the pods of the instances ready and the stores up
+func setInstancesUp(g *GomegaWithT, deps *controller.Dependencies, tc *v1alpha1.TidbCluster) {
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{}
+	for name, inst := range tc.Status.TiKV.Instances {
+		obj, exist, err := podIndexer.GetByKey(tc.Namespace + "/" + name)
+		g.Expect(err).NotTo(HaveOccurred())
+		if !exist {
+			continue
+		}
+		pod := obj.(*corev1.Pod).DeepCopy()
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		g.Expect(podIndexer.Update(pod)).To(Succeed())
+		id := strconv.Itoa(int(inst.Ordinal) + 1)
+		tc.Status.TiKV.Stores[id] = v1alpha1.TiKVStore{ID: id, PodName: name, State: v1alpha1.TiKVStateUp}
+	}
+}
+
+// addInstanceStoreReactions lets the fake PD report the stores in the status of the TidbCluster
+func addInstanceStoreReactions(pdClient *pdapi.FakePDClient, tc *v1alpha1.TidbCluster, maxReplicas uint64, regions map[string]int) {
+	pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		storesInfo := &pdapi.StoresInfo{}
+		for _, store := range tc.Status.TiKV.Stores {
+			id, _ := strconv.ParseUint(store.ID, 10, 64)
+			storesInfo.Stores = append(storesInfo.Stores, &pdapi.StoreInfo{
+				Store: &pdapi.MetaStore{
+					Store: &metapb.Store{
+						Id:      id,
+						Address: store.PodName + ".upgrader-tikv-peer.default.svc:20160",
+						Labels:  []*metapb.StoreLabel{{Key: "engine", Value: label.TiKVLabelVal}},
+					},
+					StateName: store.State,
+				},
+				Status: &pdapi.StoreStatus{RegionCount: regions[store.PodName], LeaderCount: int(store.LeaderCount)},
+			})
+		}
+		return storesInfo, nil
+	})
+	pdClient.AddReaction(pdapi.GetConfigActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.PDConfigFromAPI{Replication: &pdapi.PDReplicationConfig{MaxReplicas: &maxReplicas}}, nil
+	})
+	pdClient.AddReaction(pdapi.GetEvictLeaderSchedulersForStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		return map[uint64]string{}, nil
+	})
+	pdClient.AddReaction(pdapi.EndEvictLeaderActionType, func(action *pdapi.Action) (interface{}, error) {
+		return nil, nil
+	})
+}
+
+func TestInstanceManagerSyncCreate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	im := newInstanceManager(deps, v1alpha1.TiKVMemberType)
+	tc := newTidbClusterForInstanceManager()
+	blueprint := newBlueprintForInstanceManager()
+
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	g.Expect(tc.Status.TiKV.Instances).To(HaveLen(3))
+	for i := int32(0); i < 3; i++ {
+		name := TikvPodName(upgradeTcName, i)
+		g.Expect(tc.Status.TiKV.Instances).To(HaveKey(name))
+		g.Expect(tc.Status.TiKV.Instances[name].Ordinal).To(Equal(i))
+
+		pod, err := deps.PodLister.Pods(tc.Namespace).Get(name)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(pod.Spec.Hostname).To(Equal(name))
+		g.Expect(pod.Spec.Subdomain).To(Equal("upgrader-tikv-peer"))
+		g.Expect(pod.Spec.Volumes).To(ContainElement(HaveField("VolumeSource.PersistentVolumeClaim.ClaimName", "tikv-"+name)))
+
+		pvc, err := deps.PVCLister.PersistentVolumeClaims(tc.Namespace).Get("tikv-" + name)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(pvc.Labels[label.AnnPodNameKey]).To(Equal(name))
+	}
+	g.Expect(tc.Status.TiKV.StatefulSet.Replicas).To(Equal(int32(3)))
+	g.Expect(tc.Status.TiKV.StatefulSet.ReadyReplicas).To(Equal(int32(0)))
+	g.Expect(tc.Status.TiKV.Phase).To(Equal(v1alpha1.NormalPhase))
+
+	// scale out without renumbering the instances
+	tc.Spec.TiKV.Replicas = 4
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	g.Expect(tc.Status.TiKV.Instances).To(HaveKey(TikvPodName(upgradeTcName, 3)))
+	g.Expect(tc.Status.TiKV.StatefulSet.Replicas).To(Equal(int32(4)))
+}
+
+func TestInstanceManagerSyncUpgrade(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	im := newInstanceManager(deps, v1alpha1.TiFlashMemberType)
+	tc := newTidbClusterForInstanceManager()
+	tc.Spec.TiFlash = &v1alpha1.TiFlashSpec{Replicas: 2, ManagementMode: v1alpha1.ManagementModeInstance}
+	tc.Status.TiFlash = v1alpha1.TiFlashStatus{Synced: true}
+	blueprint := newBlueprintForInstanceManager()
+
+	setUp := func() {
+		tc.Status.TiFlash.Stores = map[string]v1alpha1.TiKVStore{}
+		for name, inst := range tc.Status.TiFlash.Instances {
+			pod, err := deps.PodLister.Pods(tc.Namespace).Get(name)
+			if err != nil {
+				continue
+			}
+			pod = pod.DeepCopy()
+			pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+			g.Expect(deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer().Update(pod)).To(Succeed())
+			id := strconv.Itoa(int(inst.Ordinal) + 1)
+			tc.Status.TiFlash.Stores[id] = v1alpha1.TiKVStore{ID: id, PodName: name, State: v1alpha1.TiKVStateUp}
+		}
+	}
+
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	setUp()
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	g.Expect(tc.Status.TiFlash.Phase).To(Equal(v1alpha1.NormalPhase))
+	oldRevision := tc.Status.TiFlash.StatefulSet.UpdateRevision
+
+	// the instance with the largest ordinal is upgraded first
+	blueprint.Spec.Template.Spec.Containers[0].Image = "tiflash-new-image"
+	err := im.Sync(tc, blueprint)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	last := tc.Status.TiFlash.Instances["upgrader-tiflash-1"]
+	g.Expect(last.Phase).To(Equal(v1alpha1.InstancePhaseUpgrading))
+	g.Expect(tc.Status.TiFlash.Instances["upgrader-tiflash-0"].Phase).To(Equal(v1alpha1.InstancePhaseRunning))
+	g.Expect(tc.Status.TiFlash.Phase).To(Equal(v1alpha1.UpgradePhase))
+	g.Expect(tc.Status.TiFlash.StatefulSet.CurrentRevision).To(Equal(oldRevision))
+
+	// the pod is recreated from the new template, and the next instance waits until it is up
+	err = im.Sync(tc, blueprint)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	pod, err := deps.PodLister.Pods(tc.Namespace).Get("upgrader-tiflash-1")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pod.Spec.Containers[0].Image).To(Equal("tiflash-new-image"))
+	g.Expect(tc.Status.TiFlash.Instances["upgrader-tiflash-0"].Phase).To(Equal(v1alpha1.InstancePhaseRunning))
+
+	for i := 0; i < 3; i++ {
+		setUp()
+		err = im.Sync(tc, blueprint)
+	}
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.TiFlash.Phase).To(Equal(v1alpha1.NormalPhase))
+	g.Expect(tc.Status.TiFlash.StatefulSet.UpdatedReplicas).To(Equal(int32(2)))
+	for _, inst := range tc.Status.TiFlash.Instances {
+		g.Expect(inst.Phase).To(Equal(v1alpha1.InstancePhaseRunning))
+		g.Expect(inst.Revision).To(Equal(tc.Status.TiFlash.StatefulSet.UpdateRevision))
+	}
+}
+
+func TestInstanceManagerSyncUpgradeTiKV(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	im := newInstanceManager(deps, v1alpha1.TiKVMemberType)
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	tc := newTidbClusterForInstanceManager()
+	tc.Annotations = map[string]string{annoKeyTiKVMinReadySeconds: "60"}
+	blueprint := newBlueprintForInstanceManager()
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	addInstanceStoreReactions(pdClient, tc, 3, nil)
+	setFakePDLeader(deps.PDControl.(*pdapi.FakePDControl), tc, pdClient)
+	missPeers := 0
+	pdClient.AddReaction(pdapi.GetRegionsStatusActionType, func(action *pdapi.Action) (interface{}, error) {
+		return map[pdapi.RegionStatusType]int{pdapi.RegionStatusMissPeer: missPeers}, nil
+	})
+	pdClient.AddReaction(pdapi.BeginEvictLeaderActionType, func(action *pdapi.Action) (interface{}, error) {
+		return nil, nil
+	})
+	for i := int32(0); i < 3; i++ {
+		tikvClient := controller.NewFakeTiKVClient(deps.TiKVControl.(*tikvapi.FakeTiKVControl), tc, TikvPodName(upgradeTcName, i))
+		tikvClient.AddReaction(tikvapi.GetLeaderCountActionType, func(action *tikvapi.Action) (interface{}, error) {
+			return 0, nil
+		})
+	}
+	// setReadySince marks the pod of the instance ready since the given time
+	setReadySince := func(name string, since time.Time) {
+		obj, _, err := podIndexer.GetByKey(tc.Namespace + "/" + name)
+		g.Expect(err).NotTo(HaveOccurred())
+		pod := obj.(*corev1.Pod).DeepCopy()
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(since)}}
+		g.Expect(podIndexer.Update(pod)).To(Succeed())
+	}
+
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	setInstancesUp(g, deps, tc)
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	g.Expect(tc.Status.TiKV.Phase).To(Equal(v1alpha1.NormalPhase))
+
+	// the regions are healthy and the leaders are evicted before the instance is upgraded
+	blueprint.Spec.Template.Spec.Containers[0].Image = "tikv-new-image"
+	last := TikvPodName(upgradeTcName, 2)
+	for i := 0; i < 3 && tc.Status.TiKV.Instances[last].Phase != v1alpha1.InstancePhaseUpgrading; i++ {
+		g.Expect(controller.IsRequeueError(im.Sync(tc, blueprint))).To(BeTrue())
+	}
+	g.Expect(tc.Status.TiKV.Instances[last].Phase).To(Equal(v1alpha1.InstancePhaseUpgrading))
+	g.Expect(tc.Status.TiKV.RegionHealthBaseline).NotTo(BeNil())
+
+	// the upgraded instance must be available for the min ready seconds
+	err := im.Sync(tc, blueprint)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	setInstancesUp(g, deps, tc)
+	setReadySince(last, time.Now())
+	err = im.Sync(tc, blueprint)
+	g.Expect(err).To(MatchError(ContainSubstring("is not available")))
+	g.Expect(tc.Status.TiKV.Instances[last].Phase).To(Equal(v1alpha1.InstancePhaseUpgrading))
+
+	// the next instance waits for the regions to be healthy
+	setReadySince(last, time.Now().Add(-time.Hour))
+	missPeers = 1
+	next := TikvPodName(upgradeTcName, 1)
+	err = im.Sync(tc, blueprint)
+	g.Expect(err).To(MatchError(ContainSubstring("waiting for regions to be healthy")))
+	g.Expect(tc.Status.TiKV.Instances[last].Phase).To(Equal(v1alpha1.InstancePhaseRunning))
+	g.Expect(tc.Status.TiKV.Instances[next].Phase).To(Equal(v1alpha1.InstancePhaseRunning))
+	_, err = deps.PodLister.Pods(tc.Namespace).Get(next)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	missPeers = 0
+	for i := 0; i < 3 && tc.Status.TiKV.Instances[next].Phase != v1alpha1.InstancePhaseUpgrading; i++ {
+		g.Expect(controller.IsRequeueError(im.Sync(tc, blueprint))).To(BeTrue())
+	}
+	g.Expect(tc.Status.TiKV.Instances[next].Phase).To(Equal(v1alpha1.InstancePhaseUpgrading))
+}
+
+func TestInstanceManagerSyncReplaceAndDrain(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	im := newInstanceManager(deps, v1alpha1.TiKVMemberType)
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	tc := newTidbClusterForInstanceManager()
+	blueprint := newBlueprintForInstanceManager()
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	deletedStores := map[uint64]bool{}
+	pdClient.AddReaction(pdapi.DeleteStoreActionType, func(action *pdapi.Action) (interface{}, error) {
+		deletedStores[action.ID] = true
+		return nil, nil
+	})
+	addInstanceStoreReactions(pdClient, tc, 2, nil)
+
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	setInstancesUp(g, deps, tc)
+
+	// move instance 1 to another node
+	name := TikvPodName(upgradeTcName, 1)
+	pod, err := deps.PodLister.Pods(tc.Namespace).Get(name)
+	g.Expect(err).NotTo(HaveOccurred())
+	pod = pod.DeepCopy()
+	pod.Spec.NodeName = "node-1"
+	pod.Annotations = map[string]string{label.AnnMoveInstance: ""}
+	g.Expect(podIndexer.Update(pod)).To(Succeed())
+
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	newName := TikvPodName(upgradeTcName, 3)
+	g.Expect(tc.Status.TiKV.Instances[name].ReplacedBy).To(Equal(newName))
+	g.Expect(tc.Status.TiKV.Instances[newName].ExcludedNode).To(Equal("node-1"))
+	newPod, err := deps.PodLister.Pods(tc.Namespace).Get(newName)
+	g.Expect(err).NotTo(HaveOccurred())
+	terms := newPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	g.Expect(terms[0].MatchFields).To(ContainElement(corev1.NodeSelectorRequirement{
+		Key:      metav1.ObjectNameField,
+		Operator: corev1.NodeSelectorOpNotIn,
+		Values:   []string{"node-1"},
+	}))
+	// the replaced instance is kept until the new instance is up
+	g.Expect(tc.Status.TiKV.Instances[name].Phase).To(Equal(v1alpha1.InstancePhaseRunning))
+	g.Expect(tc.Status.TiKV.Phase).To(Equal(v1alpha1.ScalePhase))
+
+	// the store of the replaced instance is deleted after the new instance is up
+	setInstancesUp(g, deps, tc)
+	err = im.Sync(tc, blueprint)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(tc.Status.TiKV.Instances[name].Phase).To(Equal(v1alpha1.InstancePhaseDeleting))
+	g.Expect(deletedStores).To(HaveKey(uint64(2)))
+
+	// the pod is deleted after the store is tombstone
+	delete(tc.Status.TiKV.Stores, "2")
+	tc.Status.TiKV.TombstoneStores = map[string]v1alpha1.TiKVStore{"2": {ID: "2", PodName: name, State: v1alpha1.TiKVStateTombstone}}
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	_, err = deps.PodLister.Pods(tc.Namespace).Get(name)
+	g.Expect(err).To(HaveOccurred())
+	pvc, err := deps.PVCLister.PersistentVolumeClaims(tc.Namespace).Get("tikv-" + name)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pvc.Annotations).To(HaveKey(label.AnnPVCDeferDeleting))
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	g.Expect(tc.Status.TiKV.Instances).NotTo(HaveKey(name))
+	g.Expect(tc.Status.TiKV.Instances).To(HaveLen(3))
+
+	// scale in by draining instance 0 instead of the instance with the largest ordinal
+	tc.Spec.TiKV.Replicas = 2
+	drained := TikvPodName(upgradeTcName, 0)
+	pod, err = deps.PodLister.Pods(tc.Namespace).Get(drained)
+	g.Expect(err).NotTo(HaveOccurred())
+	pod = pod.DeepCopy()
+	pod.Annotations = map[string]string{label.AnnDrainInstance: ""}
+	g.Expect(podIndexer.Update(pod)).To(Succeed())
+	err = im.Sync(tc, blueprint)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(tc.Status.TiKV.Instances[drained].Phase).To(Equal(v1alpha1.InstancePhaseDeleting))
+	g.Expect(deletedStores).To(HaveKey(uint64(1)))
+	for _, other := range []string{TikvPodName(upgradeTcName, 2), newName} {
+		g.Expect(tc.Status.TiKV.Instances[other].Phase).To(Equal(v1alpha1.InstancePhaseRunning))
+	}
+}
+
+func TestInstanceManagerSyncScaleIn(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	im := newInstanceManager(deps, v1alpha1.TiKVMemberType)
+	tc := newTidbClusterForInstanceManager()
+	tc.Spec.TiKV.ScalePolicy.ScaleInStrategy = v1alpha1.ScaleInStrategyBalanced
+	blueprint := newBlueprintForInstanceManager()
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	deletedStores := map[uint64]bool{}
+	pdClient.AddReaction(pdapi.DeleteStoreActionType, func(action *pdapi.Action) (interface{}, error) {
+		deletedStores[action.ID] = true
+		return nil, nil
+	})
+	evictingStores := map[uint64]bool{}
+	pdClient.AddReaction(pdapi.BeginEvictLeaderActionType, func(action *pdapi.Action) (interface{}, error) {
+		evictingStores[action.ID] = true
+		return nil, nil
+	})
+	// instance 0 has the fewest regions
+	victim := TikvPodName(upgradeTcName, 0)
+	regions := map[string]int{
+		victim:                        10,
+		TikvPodName(upgradeTcName, 1): 100,
+		TikvPodName(upgradeTcName, 2): 100,
+	}
+	addInstanceStoreReactions(pdClient, tc, 3, regions)
+
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	setInstancesUp(g, deps, tc)
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+
+	// no instance is deleted if the up stores would be fewer than max-replicas
+	tc.Spec.TiKV.Replicas = 2
+	err := im.Sync(tc, blueprint)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(controller.IsRequeueError(err)).To(BeFalse())
+	for _, inst := range tc.Status.TiKV.Instances {
+		g.Expect(inst.Phase).To(Equal(v1alpha1.InstancePhaseRunning))
+	}
+	g.Expect(collectEvents(deps.Recorder.(*record.FakeRecorder).Events)).To(ContainElement(ContainSubstring("FailedScaleIn")))
+
+	// the instance chosen by the Balanced strategy evicts the leaders before the store is deleted
+	store := tc.Status.TiKV.Stores["1"]
+	store.LeaderCount = 10
+	tc.Status.TiKV.Stores["1"] = store
+	addInstanceStoreReactions(pdClient, tc, 2, regions)
+	err = im.Sync(tc, blueprint)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(tc.Status.TiKV.Instances[victim].Phase).To(Equal(v1alpha1.InstancePhaseDeleting))
+	g.Expect(evictingStores).To(HaveKey(uint64(1)))
+	g.Expect(deletedStores).To(BeEmpty())
+	pod, err := deps.PodLister.Pods(tc.Namespace).Get(victim)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pod.Annotations).To(HaveKey(label.AnnoScaleInTime))
+
+	store.LeaderCount = 0
+	tc.Status.TiKV.Stores["1"] = store
+	err = im.Sync(tc, blueprint)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(deletedStores).To(HaveKey(uint64(1)))
+
+	// the pod is deleted after the store is tombstone
+	delete(tc.Status.TiKV.Stores, "1")
+	tc.Status.TiKV.TombstoneStores = map[string]v1alpha1.TiKVStore{"1": {ID: "1", PodName: victim, State: v1alpha1.TiKVStateTombstone}}
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	g.Expect(im.Sync(tc, blueprint)).To(Succeed())
+	g.Expect(tc.Status.TiKV.Instances).NotTo(HaveKey(victim))
+	g.Expect(tc.Status.TiKV.Instances).To(HaveLen(2))
+}
+
+func TestInstanceManagerAdopt(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	im := newInstanceManager(deps, v1alpha1.TiKVMemberType)
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	tc := newTidbClusterForInstanceManager()
+
+	set := newBlueprintForInstanceManager()
+	g.Expect(mngerutils.SetStatefulSetLastAppliedConfigAnnotation(set)).To(Succeed())
+	// the first pod is not upgraded yet
+	set.Status = apps.StatefulSetStatus{
+		Replicas:        3,
+		CurrentReplicas: 1,
+		CurrentRevision: "1",
+		UpdateRevision:  "2",
+	}
+	for _, pod := range getTiKVPods(set) {
+		g.Expect(podIndexer.Add(pod)).To(Succeed())
+	}
+
+	err := im.Adopt(tc, set)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(tc.Status.TiKV.Instances).To(HaveLen(3))
+	revision, err := instanceRevision(&set.Spec.Template)
+	g.Expect(err).NotTo(HaveOccurred())
+	for i := int32(0); i < 3; i++ {
+		inst := tc.Status.TiKV.Instances[TikvPodName(upgradeTcName, i)]
+		g.Expect(inst.Ordinal).To(Equal(i))
+		g.Expect(inst.Phase).To(Equal(v1alpha1.InstancePhaseRunning))
+		expected := revision
+		if i == 0 {
+			expected = ""
+		}
+		g.Expect(inst.Revision).To(Equal(expected), fmt.Sprintf("instance %d", i))
+	}
+}
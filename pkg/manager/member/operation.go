@@ -0,0 +1,48 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+)
+
+// Reasons of the operations recorded in the status of TidbCluster
+const (
+	operationReasonUpgrade  = "Upgrade"
+	operationReasonScaleIn  = "ScaleIn"
+	operationReasonFailover = "Failover"
+	operationReasonHostDown = "HostDown"
+	operationReasonRecovery = "UnsafeRecovery"
+)
+
+// storeOperation returns the operation on the store of a TiKV or TiFlash pod
+func storeOperation(typ v1alpha1.TidbClusterOperationType, mt v1alpha1.MemberType, reason, storeID, podName string) v1alpha1.TidbClusterOperation {
+	return v1alpha1.TidbClusterOperation{
+		Type:      typ,
+		Component: mt,
+		Reason:    reason,
+		StoreID:   storeID,
+		PodName:   podName,
+	}
+}
+
+// podOperation returns the operation on a pod
+func podOperation(typ v1alpha1.TidbClusterOperationType, mt v1alpha1.MemberType, reason, podName string) v1alpha1.TidbClusterOperation {
+	return v1alpha1.TidbClusterOperation{
+		Type:      typ,
+		Component: mt,
+		Reason:    reason,
+		PodName:   podName,
+	}
+}
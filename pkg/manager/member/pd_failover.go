@@ -196,6 +196,9 @@ func (f *pdFailover) tryToDeleteAFailureMember(tc *v1alpha1.TidbCluster) error {
 		return err
 	}
 	klog.Infof("pd failover[tryToDeleteAFailureMember]: delete member %s/%s(%d) successfully", ns, failurePodName, memberID)
+	op := podOperation(v1alpha1.OperationDeleteMember, v1alpha1.PDMemberType, operationReasonFailover, failurePodName)
+	op.Target = failurePDName
+	tc.RecordOperation(op, nil)
 	f.deps.Recorder.Eventf(tc, apiv1.EventTypeWarning, "PDMemberDeleted", "failure member %s/%s(%d) deleted from PD cluster", ns, failurePodName, memberID)
 
 	err = f.failureRecovery.deletePodAndPvcs(tc, failurePDName)
@@ -322,6 +325,11 @@ func (fma *pdFailureMemberAccess) GetPVCUIDSet(tc *v1alpha1.TidbCluster, pdName
 	return tc.Status.PD.FailureMembers[pdName].PVCUIDSet
 }
 
+func (fma *pdFailureMemberAccess) CanMarkHostDown(tc *v1alpha1.TidbCluster, pdName string) bool {
+	// for backward compatibility, failure members with empty PVCUIDSet are not handled for failure recovery
+	return len(fma.GetPVCUIDSet(tc, pdName)) > 0
+}
+
 type fakePDFailover struct{}
 
 // NewFakePDFailover returns a fake Failover
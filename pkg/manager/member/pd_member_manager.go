@@ -61,6 +61,7 @@ type pdMemberManager struct {
 	failover          Failover
 	suspender         suspender.Suspender
 	podVolumeModifier volumes.PodVolumeModifier
+	recoveryManager   *pdRecoveryManager
 }
 
 // NewPDMemberManager returns a *pdMemberManager
@@ -72,6 +73,7 @@ func NewPDMemberManager(dependencies *controller.Dependencies, pdScaler Scaler,
 		failover:          pdFailover,
 		suspender:         spder,
 		podVolumeModifier: pvm,
+		recoveryManager:   newPDRecoveryManager(dependencies),
 	}
 }
 
@@ -197,6 +199,14 @@ func (m *pdMemberManager) syncPDStatefulSetForTidbCluster(tc *v1alpha1.TidbClust
 
 	oldPDSet := oldPDSetTmp.DeepCopy()
 
+	// the PD StatefulSet is taken over while recovering PD from the loss of quorum,
+	// the status is not synced as the cluster ID of the fresh PD member is not the real one
+	if !setNotExist && !tc.Spec.Paused {
+		if handled, err := m.recoveryManager.Sync(tc, oldPDSet); handled {
+			return err
+		}
+	}
+
 	if err := m.syncTidbClusterStatus(tc, oldPDSet); err != nil {
 		klog.Errorf("failed to sync TidbCluster: [%s/%s]'s status, error: %v", ns, tcName, err)
 	}
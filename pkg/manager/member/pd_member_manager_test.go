@@ -938,6 +938,7 @@ func newFakePDMemberManager() (*pdMemberManager, cache.Indexer, cache.Indexer) {
 		failover:          NewFakePDFailover(),
 		suspender:         suspender.NewFakeSuspender(),
 		podVolumeModifier: &volumes.FakePodVolumeModifier{},
+		recoveryManager:   newPDRecoveryManager(fakeDeps),
 	}
 	return pdManager, podIndexer, pvcIndexer
 }
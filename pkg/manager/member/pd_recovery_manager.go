@@ -0,0 +1,672 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	apps "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	pdRecoverComponent = "pd-recover"
+	pdRecoveryReason   = "PDRecovery"
+
+	// pdRecoveryAllocIDMargin is added to the max allocated ID found in TiKV for the IDs allocated by the lost
+	// PD but not used by TiKV yet, e.g. the IDs of the regions being split
+	pdRecoveryAllocIDMargin = 1000000
+	// pdRecoveryScanTimeout is the timeout to scan the max allocated ID in a store
+	pdRecoveryScanTimeout = 30 * time.Minute
+
+	// tiflashComputeEngine is the engine label of the stores of the TiFlash compute nodes
+	tiflashComputeEngine = "tiflash_compute"
+)
+
+// pdRecoveryManager recovers PD from the loss of quorum when annotation `tidb.pingcap.com/pd-recovery`
+// is set on the TidbCluster. It takes over the PD StatefulSet and:
+//  1. determines the cluster ID and the alloc ID (scanned from the TiKV and TiFlash stores in background, or set by
+//     annotation `tidb.pingcap.com/pd-recovery-alloc-id`), and checks that the quorum of PD is really lost
+//  2. scales PD down to zero and deletes the PVCs of PD
+//  3. starts a single fresh PD member and runs pd-recover against it
+//  4. restarts the PD member so that it starts as a new cluster with the recovered cluster ID
+//  5. scales PD back up, restarts TiKV, the TiKV pools and TiFlash, and waits for all their stores to be
+//     registered and Up
+//
+// Each finished step is recorded in the PD status.
+type pdRecoveryManager struct {
+	deps    *controller.Dependencies
+	scanner *allocatedIDScanner
+}
+
+func newPDRecoveryManager(deps *controller.Dependencies) *pdRecoveryManager {
+	return &pdRecoveryManager{
+		deps:    deps,
+		scanner: newAllocatedIDScanner(),
+	}
+}
+
+// Sync drives the recovery forward and returns true if the PD StatefulSet is taken
+// over by the recovery, in which case the normal sync of PD should be skipped.
+func (m *pdRecoveryManager) Sync(tc *v1alpha1.TidbCluster, set *apps.StatefulSet) (bool, error) {
+	id := tc.Annotations[label.AnnPDRecovery]
+	status := tc.Status.PD.Recovery
+	if id == "" || status != nil && status.ID == id && (status.Step == v1alpha1.PDRecoveryStepCompleted || status.Step == v1alpha1.PDRecoveryStepFailed) {
+		return false, nil
+	}
+	if status == nil || status.ID != id {
+		return true, m.start(tc, id)
+	}
+
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	switch status.Step {
+	case "":
+		return true, m.syncAllocID(tc)
+
+	case v1alpha1.PDRecoveryStepStarted:
+		if err := m.scaleStatefulSet(tc, set, 0); err != nil {
+			return true, err
+		}
+		pods, err := m.listPods(tc, label.PDLabelVal)
+		if err != nil {
+			return true, err
+		}
+		if set.Status.Replicas != 0 || len(pods) != 0 {
+			return true, controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for PD pods to be deleted for recovery", ns, tcName)
+		}
+		m.finishStep(tc, v1alpha1.PDRecoveryStepPDScaledDown, "all PD pods are deleted")
+		return true, nil
+
+	case v1alpha1.PDRecoveryStepPDScaledDown:
+		selector, err := label.New().Instance(tc.GetInstanceName()).PD().Selector()
+		if err != nil {
+			return true, err
+		}
+		pvcs, err := m.deps.PVCLister.PersistentVolumeClaims(ns).List(selector)
+		if err != nil {
+			return true, fmt.Errorf("failed to list PVCs of PD for cluster %s/%s, error: %v", ns, tcName, err)
+		}
+		if len(pvcs) != 0 {
+			for _, pvc := range pvcs {
+				if pvc.DeletionTimestamp != nil {
+					continue
+				}
+				if err := m.deps.PVCControl.DeletePVC(tc, pvc); err != nil && !errors.IsNotFound(err) {
+					return true, err
+				}
+			}
+			return true, controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for PVCs of PD to be deleted for recovery", ns, tcName)
+		}
+		status.Replicas = pointer.Int32Ptr(1)
+		m.finishStep(tc, v1alpha1.PDRecoveryStepPDDataCleaned, "all PVCs of PD are deleted")
+		return true, nil
+
+	case v1alpha1.PDRecoveryStepPDDataCleaned:
+		if err := m.scaleStatefulSet(tc, set, 1); err != nil {
+			return true, err
+		}
+		if _, err := controller.GetPDClient(m.deps.PDControl, tc).GetHealth(); err != nil {
+			return true, controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for the fresh PD member to be started for recovery: %v", ns, tcName, err)
+		}
+		m.finishStep(tc, v1alpha1.PDRecoveryStepPDStarted, fmt.Sprintf("PD member %s is started", m.recoverPodName(tc)))
+		return true, nil
+
+	case v1alpha1.PDRecoveryStepPDStarted:
+		return true, m.syncRecoverJob(tc)
+
+	case v1alpha1.PDRecoveryStepPDRecovered:
+		podName := m.recoverPodName(tc)
+		pod, err := m.deps.PodLister.Pods(ns).Get(podName)
+		if err != nil && !errors.IsNotFound(err) {
+			return true, fmt.Errorf("failed to get pod %s/%s, error: %v", ns, podName, err)
+		}
+		if pod != nil && pod.CreationTimestamp.Before(stepTime(status, v1alpha1.PDRecoveryStepPDRecovered)) {
+			if err := m.deps.PodControl.DeletePod(tc, pod); err != nil {
+				return true, err
+			}
+			return true, controller.RequeueErrorf("TidbCluster: [%s/%s], restarting PD member %s after pd-recover", ns, tcName, podName)
+		}
+		cluster, err := controller.GetPDClient(m.deps.PDControl, tc).GetCluster()
+		if err != nil {
+			return true, controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for PD member %s to be restarted: %v", ns, tcName, podName, err)
+		}
+		if clusterID := strconv.FormatUint(cluster.Id, 10); clusterID != status.ClusterID {
+			m.fail(tc, fmt.Sprintf("cluster ID of the restarted PD is %s, expected %s", clusterID, status.ClusterID))
+			return true, nil
+		}
+		// hand over the PD StatefulSet to scale back up
+		status.Replicas = nil
+		m.finishStep(tc, v1alpha1.PDRecoveryStepPDRestarted, fmt.Sprintf("PD member %s is restarted with cluster ID %s", podName, status.ClusterID))
+		return true, nil
+
+	case v1alpha1.PDRecoveryStepPDRestarted:
+		if set.Status.ReadyReplicas < tc.PDStsDesiredReplicas() || !tc.PDAllMembersReady() {
+			klog.Infof("TidbCluster: [%s/%s], waiting for PD to be scaled up after recovery", ns, tcName)
+			return false, nil
+		}
+		m.finishStep(tc, v1alpha1.PDRecoveryStepPDScaledUp, fmt.Sprintf("PD is scaled up to %d replicas", tc.PDStsDesiredReplicas()))
+		return false, nil
+
+	case v1alpha1.PDRecoveryStepPDScaledUp:
+		// all the stores are restarted at once to register to the recovered PD, the cluster is unavailable anyway
+		scaledUpAt := stepTime(status, v1alpha1.PDRecoveryStepPDScaledUp)
+		restarted := 0
+		for _, sc := range m.storeClusters(tc) {
+			pods, err := m.listPods(sc.tc, sc.component)
+			if err != nil {
+				return false, err
+			}
+			for _, pod := range pods {
+				if pod.DeletionTimestamp == nil && pod.CreationTimestamp.Before(scaledUpAt) {
+					if err := m.deps.PodControl.DeletePod(tc, pod); err != nil {
+						return false, err
+					}
+				}
+			}
+			restarted += len(pods)
+		}
+		m.finishStep(tc, v1alpha1.PDRecoveryStepTiKVRestarted, fmt.Sprintf("%d TiKV and TiFlash pods are restarted", restarted))
+		return false, nil
+
+	case v1alpha1.PDRecoveryStepTiKVRestarted:
+		return false, m.verifyStores(tc)
+	}
+	return false, nil
+}
+
+func (m *pdRecoveryManager) start(tc *v1alpha1.TidbCluster, id string) error {
+	tc.Status.PD.Recovery = &v1alpha1.PDRecoveryStatus{
+		ID:        id,
+		ClusterID: tc.Status.ClusterID,
+		StartTime: metav1.Now(),
+	}
+	if _, err := strconv.ParseUint(tc.Status.ClusterID, 10, 64); err != nil {
+		m.fail(tc, fmt.Sprintf("invalid cluster ID %q in status", tc.Status.ClusterID))
+		return nil
+	}
+	return m.syncAllocID(tc)
+}
+
+// syncAllocID determines the alloc ID passed to pd-recover. A too small alloc ID leads to duplicate IDs after
+// recovery, so it's the max ID allocated by the lost PD which is found in the stores plus a margin. The alloc ID
+// set by users overrides it, e.g. when some stores are lost too, and it's checked to be larger than the known
+// store IDs at least.
+func (m *pdRecoveryManager) syncAllocID(tc *v1alpha1.TidbCluster) error {
+	var maxStoreID uint64
+	stores := []map[string]v1alpha1.TiKVStore{
+		tc.Status.TiKV.Stores, tc.Status.TiKV.TombstoneStores, tc.Status.TiFlash.Stores, tc.Status.TiFlash.TombstoneStores,
+	}
+	for _, status := range tc.Status.TiKVPools {
+		stores = append(stores, status.Stores, status.TombstoneStores)
+	}
+	if status := tc.Status.TiFlashCompute; status != nil {
+		stores = append(stores, status.Stores, status.TombstoneStores)
+	}
+	for _, stores := range stores {
+		for id := range stores {
+			if storeID, err := strconv.ParseUint(id, 10, 64); err == nil && storeID > maxStoreID {
+				maxStoreID = storeID
+			}
+		}
+	}
+
+	val, ok := tc.Annotations[label.AnnPDRecoveryAllocID]
+	if !ok {
+		maxID, err := m.scanMaxAllocatedID(tc)
+		if err != nil {
+			return err
+		}
+		m.startRecovery(tc, max(maxID, maxStoreID)+pdRecoveryAllocIDMargin)
+		return nil
+	}
+	allocID, err := strconv.ParseUint(val, 10, 64)
+	if err != nil {
+		m.fail(tc, fmt.Sprintf("invalid alloc ID %q in annotation %s", val, label.AnnPDRecoveryAllocID))
+		return nil
+	}
+	if allocID <= maxStoreID {
+		m.fail(tc, fmt.Sprintf("alloc ID %d in annotation %s is not larger than the allocated store ID %d", allocID, label.AnnPDRecoveryAllocID, maxStoreID))
+		return nil
+	}
+	m.startRecovery(tc, allocID)
+	return nil
+}
+
+func (m *pdRecoveryManager) startRecovery(tc *v1alpha1.TidbCluster, allocID uint64) {
+	status := tc.Status.PD.Recovery
+	// PD is scaled down and its data is deleted after the recovery is started, make sure it's not done to a
+	// healthy PD, e.g. by a mistaken annotation
+	if lost, msg := m.quorumLost(tc); !lost {
+		m.fail(tc, fmt.Sprintf("the quorum of PD is not lost, %s", msg))
+		return
+	}
+	status.AllocID = allocID
+	status.Replicas = pointer.Int32Ptr(0)
+	m.finishStep(tc, v1alpha1.PDRecoveryStepStarted, fmt.Sprintf("recover PD with cluster ID %s and alloc ID %d", status.ClusterID, allocID))
+}
+
+// quorumLost returns whether the quorum of PD is lost, i.e. PD can't be accessed or fewer than a majority
+// of the members are healthy, with the message of the health of PD
+func (m *pdRecoveryManager) quorumLost(tc *v1alpha1.TidbCluster) (bool, string) {
+	pdClient := controller.GetPDClient(m.deps.PDControl, tc)
+	health, err := pdClient.GetHealth()
+	if err != nil {
+		return true, fmt.Sprintf("failed to get the health of PD: %v", err)
+	}
+	members, err := pdClient.GetMembers()
+	if err != nil {
+		return true, fmt.Sprintf("failed to get the members of PD: %v", err)
+	}
+	healthy := 0
+	for _, member := range health.Healths {
+		if member.Health {
+			healthy++
+		}
+	}
+	total := max(len(members.Members), len(health.Healths))
+	return healthy*2 <= total, fmt.Sprintf("%d of %d members are healthy", healthy, total)
+}
+
+// scanMaxAllocatedID returns the max one of the IDs found in all the TiKV and TiFlash stores. The stores are
+// scanned in background as the info of every region in a store is read, the finished scans are recorded in the
+// status, and a requeue error is returned until all the stores are scanned. A failed scan is retried.
+func (m *pdRecoveryManager) scanMaxAllocatedID(tc *v1alpha1.TidbCluster) (uint64, error) {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	status := tc.Status.PD.Recovery
+	scans, err := m.storeScans(tc)
+	if err != nil {
+		return 0, err
+	}
+	if status.MaxAllocatedIDs == nil {
+		status.MaxAllocatedIDs = map[string]uint64{}
+	}
+
+	var maxID uint64
+	var scanning, failed []string
+	for podName, scan := range scans {
+		if id, ok := status.MaxAllocatedIDs[podName]; ok {
+			maxID = max(maxID, id)
+			continue
+		}
+		done, id, err := m.scanner.scan(fmt.Sprintf("%s/%s/%s/%s", ns, tcName, status.ID, podName), scan)
+		switch {
+		case !done:
+			scanning = append(scanning, podName)
+		case err != nil:
+			klog.Warningf("TidbCluster: [%s/%s], failed to get the max allocated ID from pod %s for PD recovery, retry it: %v", ns, tcName, podName, err)
+			failed = append(failed, podName)
+		default:
+			status.MaxAllocatedIDs[podName] = id
+			maxID = max(maxID, id)
+		}
+	}
+	if len(scanning) != 0 || len(failed) != 0 {
+		sort.Strings(scanning)
+		sort.Strings(failed)
+		status.Message = fmt.Sprintf("%d of %d stores are scanned for the max allocated ID, scanning %v, failed %v, "+
+			"set annotation %s to recover PD without it", len(status.MaxAllocatedIDs), len(scans), scanning, failed, label.AnnPDRecoveryAllocID)
+		return 0, controller.RequeueErrorf("TidbCluster: [%s/%s], %s", ns, tcName, status.Message)
+	}
+	return maxID, nil
+}
+
+// storeScans returns the scans of the max allocated IDs keyed by pod name, for the TiKV pods of the cluster and
+// the TiKV pools and the TiFlash pods. The TiFlash compute nodes are skipped as they don't keep any regions.
+func (m *pdRecoveryManager) storeScans(tc *v1alpha1.TidbCluster) (map[string]func(ctx context.Context) (uint64, error), error) {
+	ns := tc.GetNamespace()
+	scans := map[string]func(ctx context.Context) (uint64, error){}
+	tikvClusters := []*v1alpha1.TidbCluster{tc}
+	for i := range tc.Spec.TiKVPools {
+		tikvClusters = append(tikvClusters, tc.TiKVPoolCluster(&tc.Spec.TiKVPools[i]))
+	}
+	for _, ktc := range tikvClusters {
+		pods, err := m.listPods(ktc, label.TiKVLabelVal)
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range pods {
+			client := m.deps.TiKVControl.GetTiKVPodClient(ns, ktc.GetName(), pod.GetName(), ktc.Spec.ClusterDomain, ktc.IsTLSClusterEnabled())
+			scans[pod.GetName()] = client.GetMaxAllocatedID
+		}
+	}
+
+	pods, err := m.listPods(tc, label.TiFlashLabelVal)
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods {
+		if pod.Labels[label.TiFlashRoleLabelKey] == label.TiFlashComputeRoleLabelVal {
+			continue
+		}
+		client := m.deps.TiFlashControl.GetTiFlashPodClient(ns, tc.GetName(), pod.GetName(), tc.IsTLSClusterEnabled())
+		scans[pod.GetName()] = client.GetMaxAllocatedID
+	}
+	return scans, nil
+}
+
+// allocatedIDScanner scans the max allocated IDs of the stores in background, so the reconciliation of the
+// cluster is not blocked by the scans, which take long for the stores with many regions
+type allocatedIDScanner struct {
+	lock  sync.Mutex
+	scans map[string]*allocatedIDScan
+}
+
+type allocatedIDScan struct {
+	done bool
+	id   uint64
+	err  error
+}
+
+func newAllocatedIDScanner() *allocatedIDScanner {
+	return &allocatedIDScanner{scans: map[string]*allocatedIDScan{}}
+}
+
+// scan starts the scan of the key if it's not started, and returns whether it's done and the result. The result
+// of a done scan is forgotten after it's returned, so the scan is started again by the next call if it's failed.
+func (s *allocatedIDScanner) scan(key string, scan func(ctx context.Context) (uint64, error)) (bool, uint64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if result, ok := s.scans[key]; ok {
+		if !result.done {
+			return false, 0, nil
+		}
+		delete(s.scans, key)
+		return true, result.id, result.err
+	}
+
+	result := &allocatedIDScan{}
+	s.scans[key] = result
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), pdRecoveryScanTimeout)
+		defer cancel()
+		id, err := scan(ctx)
+
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		result.done, result.id, result.err = true, id, err
+	}()
+	return false, 0, nil
+}
+
+func (m *pdRecoveryManager) syncRecoverJob(tc *v1alpha1.TidbCluster) error {
+	ns := tc.GetNamespace()
+	status := tc.Status.PD.Recovery
+	job, err := m.deps.JobLister.Jobs(ns).Get(m.recoverJobName(tc))
+	if errors.IsNotFound(err) {
+		if err := m.deps.JobControl.CreateJob(tc, m.newRecoverJob(tc)); err != nil {
+			return err
+		}
+		return controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for pd-recover to be finished", ns, tc.GetName())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get job %s/%s, error: %v", ns, m.recoverJobName(tc), err)
+	}
+
+	// the job of a previous recovery is left
+	if job.Annotations[label.AnnPDRecovery] != status.ID {
+		if job.DeletionTimestamp == nil {
+			if err := m.deps.JobControl.DeleteJob(tc, job); err != nil {
+				return err
+			}
+		}
+		return controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for job %s of the previous recovery to be deleted", ns, tc.GetName(), job.GetName())
+	}
+	if job.Status.Failed > 0 {
+		m.fail(tc, fmt.Sprintf("job %s of pd-recover is failed, check its logs for details", job.GetName()))
+		return nil
+	}
+	if job.Status.Succeeded == 0 {
+		return controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for pd-recover to be finished", ns, tc.GetName())
+	}
+	m.finishStep(tc, v1alpha1.PDRecoveryStepPDRecovered, fmt.Sprintf("pd-recover is finished by job %s", job.GetName()))
+	return nil
+}
+
+func (m *pdRecoveryManager) newRecoverJob(tc *v1alpha1.TidbCluster) *batchv1.Job {
+	status := tc.Status.PD.Recovery
+	podName := m.recoverPodName(tc)
+	endpoint := fmt.Sprintf("%s://%s.%s.%s:%d", tc.Scheme(), podName, controller.PDPeerMemberName(tc.GetName()), tc.GetNamespace(), v1alpha1.DefaultPDClientPort)
+	args := []string{
+		"-endpoints", endpoint,
+		"-cluster-id", status.ClusterID,
+		"-alloc-id", strconv.FormatUint(status.AllocID, 10),
+	}
+
+	var volumes []corev1.Volume
+	var mounts []corev1.VolumeMount
+	if tc.IsTLSClusterEnabled() {
+		args = append(args,
+			"-cacert", path.Join(util.ClusterClientTLSPath, tlsSecretRootCAKey),
+			"-cert", path.Join(util.ClusterClientTLSPath, corev1.TLSCertKey),
+			"-key", path.Join(util.ClusterClientTLSPath, corev1.TLSPrivateKeyKey),
+		)
+		volumes = append(volumes, corev1.Volume{
+			Name: util.ClusterClientVolName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: util.ClusterClientTLSSecretName(tc.GetName()),
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name: util.ClusterClientVolName, ReadOnly: true, MountPath: util.ClusterClientTLSPath,
+		})
+	}
+
+	jobLabels := label.New().Instance(tc.GetInstanceName()).Component(pdRecoverComponent)
+	baseSpec := tc.BasePDSpec()
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            m.recoverJobName(tc),
+			Namespace:       tc.GetNamespace(),
+			Labels:          jobLabels,
+			Annotations:     map[string]string{label.AnnPDRecovery: status.ID},
+			OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: pointer.Int32Ptr(0),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: jobLabels,
+				},
+				Spec: corev1.PodSpec{
+					ImagePullSecrets: baseSpec.ImagePullSecrets(),
+					Containers: []corev1.Container{
+						{
+							Name:            pdRecoverComponent,
+							Image:           tc.PDImage(),
+							ImagePullPolicy: baseSpec.ImagePullPolicy(),
+							Command:         []string{"/pd-recover"},
+							Args:            args,
+							VolumeMounts:    mounts,
+						},
+					},
+					RestartPolicy: corev1.RestartPolicyNever,
+					Volumes:       volumes,
+					Tolerations:   baseSpec.Tolerations(),
+					NodeSelector:  baseSpec.NodeSelector(),
+				},
+			},
+		},
+	}
+}
+
+// storeCluster is the cluster whose stores are restarted and verified after PD is recovered
+type storeCluster struct {
+	tc        *v1alpha1.TidbCluster
+	component string
+	replicas  int32
+}
+
+// storeClusters returns the TiKV of the cluster and its TiKV pools, the TiFlash and the TiFlash compute nodes
+func (m *pdRecoveryManager) storeClusters(tc *v1alpha1.TidbCluster) []storeCluster {
+	var clusters []storeCluster
+	if tc.Spec.TiKV != nil {
+		clusters = append(clusters, storeCluster{tc: tc, component: label.TiKVLabelVal, replicas: tc.Spec.TiKV.Replicas})
+	}
+	for i := range tc.Spec.TiKVPools {
+		pool := &tc.Spec.TiKVPools[i]
+		clusters = append(clusters, storeCluster{tc: tc.TiKVPoolCluster(pool), component: label.TiKVLabelVal, replicas: pool.Replicas})
+	}
+	if tc.Spec.TiFlash != nil {
+		clusters = append(clusters, storeCluster{tc: tc, component: label.TiFlashLabelVal, replicas: tc.Spec.TiFlash.Replicas})
+		if tc.TiFlashDisaggregated() && tc.Spec.TiFlash.Disaggregated.Compute != nil {
+			ctc := tc.TiFlashComputeCluster()
+			clusters = append(clusters, storeCluster{tc: ctc, component: label.TiFlashLabelVal, replicas: ctc.Spec.TiFlash.Replicas})
+		}
+	}
+	return clusters
+}
+
+// verifyStores completes the recovery when all the TiKV and TiFlash stores of the cluster, its TiKV pools and
+// TiFlash compute nodes are registered to the recovered PD and Up
+func (m *pdRecoveryManager) verifyStores(tc *v1alpha1.TidbCluster) error {
+	expected := map[string]int32{}
+	for _, sc := range m.storeClusters(tc) {
+		expected[sc.component] += sc.replicas
+	}
+	if len(expected) == 0 {
+		m.complete(tc, "PD is recovered")
+		return nil
+	}
+	storesInfo, err := controller.GetPDClient(m.deps.PDControl, tc).GetStores()
+	if err != nil {
+		return fmt.Errorf("failed to get stores info in TidbCluster %s/%s: %v", tc.GetNamespace(), tc.GetName(), err)
+	}
+	up := map[string]int32{}
+	for _, store := range storesInfo.Stores {
+		if store.Store == nil || store.Store.StateName != v1alpha1.TiKVStateUp {
+			continue
+		}
+		up[recoveryStoreComponent(store.Store.Labels)]++
+	}
+	msg := fmt.Sprintf("%d of %d TiKV stores and %d of %d TiFlash stores are registered and Up",
+		up[label.TiKVLabelVal], expected[label.TiKVLabelVal], up[label.TiFlashLabelVal], expected[label.TiFlashLabelVal])
+	for component, replicas := range expected {
+		if up[component] < replicas {
+			tc.Status.PD.Recovery.Message = msg
+			return controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for stores to be registered after PD recovery, %s", tc.GetNamespace(), tc.GetName(), msg)
+		}
+	}
+	m.complete(tc, fmt.Sprintf("PD is recovered and %s", msg))
+	return nil
+}
+
+// recoveryStoreComponent returns the component of the store by its engine, the TiFlash compute nodes are
+// registered with their own engine `tiflash_compute`
+func recoveryStoreComponent(labels []*metapb.StoreLabel) string {
+	for _, l := range labels {
+		if l.Key == "engine" && (l.Value == label.TiFlashLabelVal || l.Value == tiflashComputeEngine) {
+			return label.TiFlashLabelVal
+		}
+	}
+	return label.TiKVLabelVal
+}
+
+func (m *pdRecoveryManager) complete(tc *v1alpha1.TidbCluster, msg string) {
+	m.finishStep(tc, v1alpha1.PDRecoveryStepCompleted, msg)
+	now := metav1.Now()
+	tc.Status.PD.Recovery.CompletionTime = &now
+}
+
+func (m *pdRecoveryManager) scaleStatefulSet(tc *v1alpha1.TidbCluster, set *apps.StatefulSet, replicas int32) error {
+	if set.Spec.Replicas != nil && *set.Spec.Replicas == replicas {
+		return nil
+	}
+	newSet := set.DeepCopy()
+	newSet.Spec.Replicas = pointer.Int32Ptr(replicas)
+	klog.Infof("TidbCluster: [%s/%s], scale PD StatefulSet to %d replicas for recovery", tc.GetNamespace(), tc.GetName(), replicas)
+	_, err := m.deps.StatefulSetControl.UpdateStatefulSet(tc, newSet)
+	return err
+}
+
+func (m *pdRecoveryManager) listPods(tc *v1alpha1.TidbCluster, component string) ([]*corev1.Pod, error) {
+	selector, err := label.New().Instance(tc.GetInstanceName()).Component(component).Selector()
+	if err != nil {
+		return nil, err
+	}
+	pods, err := m.deps.PodLister.Pods(tc.GetNamespace()).List(selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s pods for cluster %s/%s, error: %v", component, tc.GetNamespace(), tc.GetName(), err)
+	}
+	return pods, nil
+}
+
+// recoverPodName returns the name of the single PD member started for recovery
+func (m *pdRecoveryManager) recoverPodName(tc *v1alpha1.TidbCluster) string {
+	ordinals := tc.PDStsDesiredOrdinals(false).List()
+	if len(ordinals) == 0 {
+		return PdPodName(tc.GetName(), 0)
+	}
+	return PdPodName(tc.GetName(), ordinals[0])
+}
+
+func (m *pdRecoveryManager) recoverJobName(tc *v1alpha1.TidbCluster) string {
+	return fmt.Sprintf("%s-%s", tc.GetName(), pdRecoverComponent)
+}
+
+func (m *pdRecoveryManager) finishStep(tc *v1alpha1.TidbCluster, step v1alpha1.PDRecoveryStep, msg string) {
+	status := tc.Status.PD.Recovery
+	status.Step = step
+	status.Message = msg
+	status.Steps = append(status.Steps, v1alpha1.PDRecoveryStepRecord{
+		Step:    step,
+		Time:    metav1.Now(),
+		Message: msg,
+	})
+	klog.Infof("TidbCluster: [%s/%s], PD recovery %s step %s: %s", tc.GetNamespace(), tc.GetName(), status.ID, step, msg)
+	m.deps.Recorder.Event(tc, corev1.EventTypeNormal, pdRecoveryReason, fmt.Sprintf("step %s: %s", step, msg))
+}
+
+func (m *pdRecoveryManager) fail(tc *v1alpha1.TidbCluster, msg string) {
+	status := tc.Status.PD.Recovery
+	status.Step = v1alpha1.PDRecoveryStepFailed
+	status.Message = msg
+	status.Replicas = nil
+	status.Steps = append(status.Steps, v1alpha1.PDRecoveryStepRecord{
+		Step:    v1alpha1.PDRecoveryStepFailed,
+		Time:    metav1.Now(),
+		Message: msg,
+	})
+	klog.Errorf("TidbCluster: [%s/%s], PD recovery %s is failed: %s", tc.GetNamespace(), tc.GetName(), status.ID, msg)
+	m.deps.Recorder.Event(tc, corev1.EventTypeWarning, pdRecoveryReason, fmt.Sprintf("PD recovery is failed: %s", msg))
+}
+
+// stepTime returns the time when the step is finished
+func stepTime(status *v1alpha1.PDRecoveryStatus, step v1alpha1.PDRecoveryStep) *metav1.Time {
+	for i := len(status.Steps) - 1; i >= 0; i-- {
+		if status.Steps[i].Step == step {
+			return &status.Steps[i].Time
+		}
+	}
+	return &metav1.Time{}
+}
@@ -0,0 +1,380 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/tiflashapi"
+	"github.com/pingcap/tidb-operator/pkg/tikvapi"
+	apps "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestPDRecoveryManagerAllocID(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		name          string
+		allocID       *string
+		tikvMaxIDs    []uint64
+		tiflashMaxIDs []uint64
+		tikvErr       bool
+		pdHealthy     bool
+		expectRequeue bool
+		expectFail    bool
+		expectAllocID uint64
+	}{
+		{
+			name:          "derived from TiKV",
+			tikvMaxIDs:    []uint64{5000, 8000},
+			expectAllocID: 8000 + pdRecoveryAllocIDMargin,
+		},
+		{
+			name:          "derived from TiFlash",
+			tikvMaxIDs:    []uint64{5000, 8000},
+			tiflashMaxIDs: []uint64{9000},
+			expectAllocID: 9000 + pdRecoveryAllocIDMargin,
+		},
+		{
+			name:          "derived from the store IDs",
+			tikvMaxIDs:    []uint64{500},
+			expectAllocID: 1001 + pdRecoveryAllocIDMargin,
+		},
+		{
+			name:          "failed to get the IDs from TiKV",
+			tikvMaxIDs:    []uint64{5000, 8000},
+			tikvErr:       true,
+			expectRequeue: true,
+		},
+		{
+			name:          "overridden by the annotation",
+			allocID:       pointer.StringPtr("200000000"),
+			tikvErr:       true,
+			expectAllocID: 200000000,
+		},
+		{
+			name:       "invalid",
+			allocID:    pointer.StringPtr("abc"),
+			expectFail: true,
+		},
+		{
+			name:       "quorum of PD is not lost",
+			allocID:    pointer.StringPtr("200000000"),
+			pdHealthy:  true,
+			expectFail: true,
+		},
+		{
+			name:       "not larger than the store IDs",
+			allocID:    pointer.StringPtr("1001"),
+			expectFail: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbClusterForPD()
+			tc.Status.ClusterID = "6994144128787347469"
+			tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{"1": {ID: "1"}, "4": {ID: "4"}}
+			tc.Status.TiKV.TombstoneStores = map[string]v1alpha1.TiKVStore{"1001": {ID: "1001"}}
+			tc.Annotations = map[string]string{label.AnnPDRecovery: "r1"}
+			if tt.allocID != nil {
+				tc.Annotations[label.AnnPDRecoveryAllocID] = *tt.allocID
+			}
+			deps := controller.NewFakeDependencies()
+			addTiKVPodsForPDRecovery(g, deps, tc, int32(len(tt.tikvMaxIDs)))
+			pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+			if tt.pdHealthy {
+				pdClient.AddReaction(pdapi.GetHealthActionType, func(action *pdapi.Action) (interface{}, error) {
+					return &pdapi.HealthInfo{Healths: []pdapi.MemberHealth{{Health: true}, {Health: true}, {Health: false}}}, nil
+				})
+				pdClient.AddReaction(pdapi.GetMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+					return &pdapi.MembersInfo{Members: []*pdpb.Member{{}, {}, {}}}, nil
+				})
+			}
+			for i, maxID := range tt.tikvMaxIDs {
+				tikvClient := controller.NewFakeTiKVClient(deps.TiKVControl.(*tikvapi.FakeTiKVControl), tc, TikvPodName(tc.Name, int32(i)))
+				tikvClient.AddReaction(tikvapi.GetMaxAllocatedIDActionType, func(action *tikvapi.Action) (interface{}, error) {
+					if tt.tikvErr && i > 0 {
+						return nil, fmt.Errorf("connection refused")
+					}
+					return maxID, nil
+				})
+			}
+			podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+			for i, maxID := range tt.tiflashMaxIDs {
+				podName := TiFlashPodName(tc.Name, int32(i))
+				g.Expect(podIndexer.Add(&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: tc.Namespace, Labels: label.New().Instance(tc.Name).TiFlash().Labels()},
+				})).To(Succeed())
+				tiflashClient := tiflashapi.NewFakeTiFlashClient()
+				tiflashClient.AddReaction(tiflashapi.GetMaxAllocatedIDActionType, func(action *tiflashapi.Action) (interface{}, error) {
+					return maxID, nil
+				})
+				deps.TiFlashControl.(*tiflashapi.FakeTiFlashControl).SetTiFlashPodClient(tc.Namespace, tc.Name, podName, tiflashClient)
+			}
+
+			m := newPDRecoveryManager(deps)
+			handled, err := m.Sync(tc, newStatefulSetForPDScale())
+			g.Expect(handled).To(BeTrue())
+			if tt.expectFail {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepFailed))
+				if tt.pdHealthy {
+					g.Expect(tc.Status.PD.Recovery.Message).To(ContainSubstring("the quorum of PD is not lost, 2 of 3 members are healthy"))
+					g.Expect(tc.PDStsDesiredReplicas()).To(Equal(int32(3)))
+				} else {
+					g.Expect(tc.Status.PD.Recovery.Message).To(ContainSubstring(label.AnnPDRecoveryAllocID))
+				}
+				return
+			}
+			if tt.allocID == nil {
+				// the stores are scanned in background
+				g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+				g.Expect(tc.Status.PD.Recovery.Step).To(BeEmpty())
+				g.Eventually(func() bool {
+					_, err = m.Sync(tc, newStatefulSetForPDScale())
+					return err == nil || (tt.expectRequeue && len(tc.Status.PD.Recovery.MaxAllocatedIDs) == 1)
+				}).Should(BeTrue())
+			}
+			if tt.expectRequeue {
+				g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+				g.Expect(err.Error()).To(ContainSubstring(label.AnnPDRecoveryAllocID))
+				g.Expect(tc.Status.PD.Recovery.Step).To(BeEmpty())
+				g.Expect(tc.Status.PD.Recovery.MaxAllocatedIDs).To(Equal(map[string]uint64{TikvPodName(tc.Name, 0): 5000}))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepStarted))
+			g.Expect(tc.Status.PD.Recovery.AllocID).To(Equal(tt.expectAllocID))
+		})
+	}
+}
+
+func TestPDRecoveryManagerSync(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPD()
+	tc.Status.ClusterID = "6994144128787347469"
+	tc.Spec.TiKVPools = []v1alpha1.TiKVPoolSpec{{Name: "hot", TiKVSpec: v1alpha1.TiKVSpec{Replicas: 1}}}
+	tc.Spec.TiFlash.Replicas = 1
+	deps := controller.NewFakeDependencies()
+	addTiKVPodsForPDRecovery(g, deps, tc, 3)
+	addTiKVPodsForPDRecovery(g, deps, tc.TiKVPoolCluster(&tc.Spec.TiKVPools[0]), 1)
+	m := newPDRecoveryManager(deps)
+
+	setIndexer := deps.KubeInformerFactory.Apps().V1().StatefulSets().Informer().GetIndexer()
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	pvcIndexer := deps.KubeInformerFactory.Core().V1().PersistentVolumeClaims().Informer().GetIndexer()
+	jobIndexer := deps.KubeInformerFactory.Batch().V1().Jobs().Informer().GetIndexer()
+	set := newStatefulSetForPDScale()
+	set.Name = controller.PDMemberName(tc.Name)
+	set.Status.Replicas = 3
+	g.Expect(setIndexer.Add(set)).To(Succeed())
+	pdPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: PdPodName(tc.Name, 0), Namespace: tc.Namespace, Labels: label.New().Instance(tc.Name).PD().Labels()},
+	}
+	g.Expect(podIndexer.Add(pdPod)).To(Succeed())
+	g.Expect(podIndexer.Add(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: TiFlashPodName(tc.Name, 0), Namespace: tc.Namespace, Labels: label.New().Instance(tc.Name).TiFlash().Labels()},
+	})).To(Succeed())
+	g.Expect(pvcIndexer.Add(&corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "pd-" + pdPod.Name, Namespace: tc.Namespace, Labels: label.New().Instance(tc.Name).PD().Labels()},
+	})).To(Succeed())
+
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	sync := func() (bool, error) {
+		obj, _, err := setIndexer.GetByKey(tc.Namespace + "/" + set.Name)
+		g.Expect(err).NotTo(HaveOccurred())
+		return m.Sync(tc, obj.(*apps.StatefulSet).DeepCopy())
+	}
+
+	// not triggered
+	handled, err := sync()
+	g.Expect(handled).To(BeFalse())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.PD.Recovery).To(BeNil())
+
+	// start
+	tc.Annotations = map[string]string{label.AnnPDRecovery: "r1", label.AnnPDRecoveryAllocID: "100000000"}
+	handled, err = sync()
+	g.Expect(handled).To(BeTrue())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepStarted))
+	g.Expect(tc.Status.PD.Recovery.ClusterID).To(Equal(tc.Status.ClusterID))
+	g.Expect(tc.Status.PD.Recovery.AllocID).To(Equal(uint64(100000000)))
+	g.Expect(tc.PDStsDesiredReplicas()).To(Equal(int32(0)))
+
+	// scale down PD
+	handled, err = sync()
+	g.Expect(handled).To(BeTrue())
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	obj, _, _ := setIndexer.GetByKey(tc.Namespace + "/" + set.Name)
+	g.Expect(*obj.(*apps.StatefulSet).Spec.Replicas).To(Equal(int32(0)))
+	scaledDown := obj.(*apps.StatefulSet).DeepCopy()
+	scaledDown.Status.Replicas = 0
+	g.Expect(setIndexer.Update(scaledDown)).To(Succeed())
+	g.Expect(podIndexer.Delete(pdPod)).To(Succeed())
+	_, err = sync()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepPDScaledDown))
+
+	// delete PVCs
+	_, err = sync()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(pvcIndexer.List()).To(BeEmpty())
+	_, err = sync()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepPDDataCleaned))
+	g.Expect(tc.PDStsDesiredReplicas()).To(Equal(int32(1)))
+
+	// start a fresh PD
+	pdClient.AddReaction(pdapi.GetHealthActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.HealthInfo{}, nil
+	})
+	_, err = sync()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepPDStarted))
+	obj, _, _ = setIndexer.GetByKey(tc.Namespace + "/" + set.Name)
+	g.Expect(*obj.(*apps.StatefulSet).Spec.Replicas).To(Equal(int32(1)))
+
+	// run pd-recover
+	_, err = sync()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	jobObj, exist, _ := jobIndexer.GetByKey(tc.Namespace + "/test-pd-recover")
+	g.Expect(exist).To(BeTrue())
+	job := jobObj.(*batchv1.Job)
+	g.Expect(strings.Join(job.Spec.Template.Spec.Containers[0].Args, " ")).To(Equal(
+		"-endpoints http://test-pd-0.test-pd-peer.default:2379 -cluster-id 6994144128787347469 -alloc-id 100000000"))
+	job = job.DeepCopy()
+	job.Status.Succeeded = 1
+	g.Expect(jobIndexer.Update(job)).To(Succeed())
+	_, err = sync()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepPDRecovered))
+
+	// restart PD
+	pdClient.AddReaction(pdapi.GetClusterActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &metapb.Cluster{Id: 6994144128787347469}, nil
+	})
+	handled, err = sync()
+	g.Expect(handled).To(BeTrue())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepPDRestarted))
+	g.Expect(tc.Status.PD.Recovery.Replicas).To(BeNil())
+	g.Expect(tc.PDStsDesiredReplicas()).To(Equal(int32(3)))
+
+	// scale up PD
+	handled, err = sync()
+	g.Expect(handled).To(BeFalse())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepPDRestarted))
+	scaledUp := scaledDown.DeepCopy()
+	scaledUp.Spec.Replicas = pointer.Int32Ptr(3)
+	scaledUp.Status.ReadyReplicas = 3
+	g.Expect(setIndexer.Update(scaledUp)).To(Succeed())
+	tc.Status.PD.StatefulSet = &scaledUp.Status
+	tc.Status.PD.Members = map[string]v1alpha1.PDMember{}
+	for i := int32(0); i < 3; i++ {
+		tc.Status.PD.Members[PdPodName(tc.Name, i)] = v1alpha1.PDMember{Name: PdPodName(tc.Name, i), Health: true}
+	}
+	_, err = sync()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepPDScaledUp))
+
+	// restart TiKV, the TiKV pool and TiFlash
+	_, err = sync()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepTiKVRestarted))
+	g.Expect(tc.Status.PD.Recovery.Message).To(Equal("5 TiKV and TiFlash pods are restarted"))
+	for _, l := range []label.Label{label.New().TiKV(), label.New().TiFlash()} {
+		selector, _ := l.Selector()
+		pods, _ := deps.PodLister.Pods(tc.Namespace).List(selector)
+		g.Expect(pods).To(BeEmpty())
+	}
+
+	// verify TiKV stores
+	stores := []*pdapi.StoreInfo{}
+	pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.StoresInfo{Stores: stores}, nil
+	})
+	_, err = sync()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	for i := uint64(1); i <= 3; i++ {
+		stores = append(stores, &pdapi.StoreInfo{
+			Store: &pdapi.MetaStore{Store: &metapb.Store{Id: i}, StateName: v1alpha1.TiKVStateUp},
+		})
+	}
+	stores = append(stores, &pdapi.StoreInfo{
+		Store: &pdapi.MetaStore{
+			Store:     &metapb.Store{Id: 4, Labels: []*metapb.StoreLabel{{Key: "engine", Value: label.TiFlashLabelVal}}},
+			StateName: v1alpha1.TiKVStateUp,
+		},
+	})
+	// the store of the TiKV pool is not registered yet
+	_, err = sync()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(tc.Status.PD.Recovery.Message).To(Equal("3 of 4 TiKV stores and 1 of 1 TiFlash stores are registered and Up"))
+	stores = append(stores, &pdapi.StoreInfo{
+		Store: &pdapi.MetaStore{Store: &metapb.Store{Id: 5}, StateName: v1alpha1.TiKVStateUp},
+	})
+	_, err = sync()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepCompleted))
+	g.Expect(tc.Status.PD.Recovery.CompletionTime).NotTo(BeNil())
+	g.Expect(tc.Status.PD.Recovery.Steps).To(HaveLen(9))
+
+	// completed
+	handled, err = sync()
+	g.Expect(handled).To(BeFalse())
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestPDRecoveryManagerInvalidClusterID(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPD()
+	tc.Annotations = map[string]string{label.AnnPDRecovery: "r1"}
+	m := newPDRecoveryManager(controller.NewFakeDependencies())
+	handled, err := m.Sync(tc, newStatefulSetForPDScale())
+	g.Expect(handled).To(BeTrue())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tc.Status.PD.Recovery.Step).To(Equal(v1alpha1.PDRecoveryStepFailed))
+
+	handled, err = m.Sync(tc, newStatefulSetForPDScale())
+	g.Expect(handled).To(BeFalse())
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+// addTiKVPodsForPDRecovery adds the TiKV pods to be restarted by the recovery
+func addTiKVPodsForPDRecovery(g *GomegaWithT, deps *controller.Dependencies, tc *v1alpha1.TidbCluster, replicas int32) {
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	for i := int32(0); i < replicas; i++ {
+		g.Expect(podIndexer.Add(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: TikvPodName(tc.Name, i), Namespace: tc.Namespace, Labels: label.New().Instance(tc.GetInstanceName()).TiKV().Labels()},
+		})).To(Succeed())
+	}
+}
@@ -142,6 +142,9 @@ func (s *pdScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, newSet
 		return err
 	}
 	klog.Infof("pdScaler.ScaleIn: delete member %s successfully", memberName)
+	op := podOperation(v1alpha1.OperationDeleteMember, v1alpha1.PDMemberType, operationReasonScaleIn, pdPodName)
+	op.Target = memberName
+	tc.RecordOperation(op, nil)
 
 	pod, err := s.deps.PodLister.Pods(ns).Get(pdPodName)
 	if err != nil {
@@ -0,0 +1,62 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// getStoreDataMigration returns the progress of moving data out of the store if it is Offline.
+// The initial region count and used size are taken from the previous progress so that
+// the moved bytes and the migration rate are counted from the time the store becomes Offline.
+func getStoreDataMigration(prev *v1alpha1.StoreDataMigrationStatus, store *pdapi.StoreInfo, now time.Time) *v1alpha1.StoreDataMigrationStatus {
+	if store.Store == nil || store.Status == nil || store.Store.StateName != v1alpha1.TiKVStateOffline {
+		return nil
+	}
+
+	regionCount := int64(store.Status.RegionCount)
+	usedSize := int64(storeUsedSize(store.Status))
+
+	var migration *v1alpha1.StoreDataMigrationStatus
+	if prev != nil {
+		migration = prev.DeepCopy()
+	} else {
+		migration = &v1alpha1.StoreDataMigrationStatus{
+			StartTime:          metav1.NewTime(now),
+			InitialRegionCount: regionCount,
+			InitialUsedSize:    usedSize,
+		}
+	}
+
+	migration.RemainingRegionCount = regionCount
+	migration.MovedBytes = 0
+	if moved := migration.InitialUsedSize - usedSize; moved > 0 {
+		migration.MovedBytes = moved
+	}
+
+	// estimate the completion time by the average rate of region migration
+	migration.EstimatedCompletionTime = nil
+	movedRegions := migration.InitialRegionCount - regionCount
+	elapsed := now.Sub(migration.StartTime.Time)
+	if movedRegions > 0 && elapsed > 0 {
+		remaining := time.Duration(float64(elapsed) * float64(regionCount) / float64(movedRegions))
+		eta := metav1.NewTime(now.Add(remaining).Truncate(time.Second))
+		migration.EstimatedCompletionTime = &eta
+	}
+	return migration
+}
@@ -0,0 +1,180 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// defaultLowSpaceRatio is the default value of `schedule.low-space-ratio` in PD
+	defaultLowSpaceRatio = 0.8
+
+	scaleInBlockedReasonInsufficientCapacity = "InsufficientCapacity"
+	scaleInBlockedReasonSufficientCapacity   = "SufficientCapacity"
+)
+
+// storeCapacityChecker checks whether the stores left after scaling in have enough
+// free space to hold the data migrated from the stores to be removed.
+type storeCapacityChecker struct {
+	deps       *controller.Dependencies
+	memberType v1alpha1.MemberType
+}
+
+func newStoreCapacityChecker(deps *controller.Dependencies, memberType v1alpha1.MemberType) *storeCapacityChecker {
+	return &storeCapacityChecker{
+		deps:       deps,
+		memberType: memberType,
+	}
+}
+
+// Check returns an error and sets the ScaleInBlocked condition if the data of the Up stores
+// of the given ordinals cannot be held by the remaining Up stores without exceeding
+// the `low-space-ratio` of PD. The data of the stores that are already Offline is still
+// being migrated to the remaining stores, so it's counted as departing too.
+func (c *storeCapacityChecker) Check(tc *v1alpha1.TidbCluster, ordinals []int32) error {
+	if !c.synced(tc) {
+		return nil
+	}
+
+	departing := sets.NewString()
+	for _, ordinal := range ordinals {
+		podName := ordinalPodName(c.memberType, tc.GetName(), ordinal)
+		for _, store := range c.stores(tc) {
+			if store.PodName == podName && store.State == v1alpha1.TiKVStateUp {
+				departing.Insert(store.ID)
+			}
+		}
+	}
+	if departing.Len() == 0 {
+		return nil
+	}
+
+	pdClient := controller.GetPDClient(c.deps.PDControl, tc)
+	storesInfo, err := pdClient.GetStores()
+	if err != nil {
+		return fmt.Errorf("failed to get stores info in TidbCluster %s/%s: %v", tc.GetNamespace(), tc.GetName(), err)
+	}
+	config, err := pdClient.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get config in TidbCluster %s/%s: %v", tc.GetNamespace(), tc.GetName(), err)
+	}
+	lowSpaceRatio := defaultLowSpaceRatio
+	if config.Schedule != nil && config.Schedule.LowSpaceRatio != nil && *config.Schedule.LowSpaceRatio > 0 {
+		lowSpaceRatio = *config.Schedule.LowSpaceRatio
+	}
+
+	var departingUsed, remainingUsed, remainingCapacity uint64
+	for _, store := range storesInfo.Stores {
+		if store.Store == nil || store.Status == nil {
+			continue
+		}
+		if !util.MatchLabelFromStoreLabels(store.Store.Labels, c.storeLabelVal()) {
+			continue
+		}
+		used := storeUsedSize(store.Status)
+		switch store.Store.StateName {
+		case v1alpha1.TiKVStateOffline:
+			departingUsed += used
+		case v1alpha1.TiKVStateUp:
+			if departing.Has(fmt.Sprintf("%d", store.Store.GetId())) {
+				departingUsed += used
+			} else {
+				remainingUsed += used
+				remainingCapacity += uint64(store.Status.Capacity)
+			}
+		}
+	}
+	// there is no store left, let the other checks decide whether it can be scaled in
+	if remainingCapacity == 0 {
+		return nil
+	}
+
+	limit := uint64(float64(remainingCapacity) * lowSpaceRatio)
+	if remainingUsed+departingUsed > limit {
+		msg := fmt.Sprintf("can't scale in %s of TidbCluster [%s/%s], the remaining stores would use %s after migrating %s from stores %v, exceeding %.0f%% (low-space-ratio) of their capacity %s",
+			c.memberType, tc.GetNamespace(), tc.GetName(), humanize.IBytes(remainingUsed+departingUsed), humanize.IBytes(departingUsed),
+			departing.List(), lowSpaceRatio*100, humanize.IBytes(remainingCapacity))
+		klog.Error(msg)
+		c.deps.Recorder.Event(tc, corev1.EventTypeWarning, "FailedScaleIn", msg)
+		c.setCondition(tc, metav1.ConditionTrue, scaleInBlockedReasonInsufficientCapacity, msg)
+		return errors.New(msg)
+	}
+
+	c.setCondition(tc, metav1.ConditionFalse, scaleInBlockedReasonSufficientCapacity, "The remaining stores have enough capacity")
+	return nil
+}
+
+func (c *storeCapacityChecker) setCondition(tc *v1alpha1.TidbCluster, status metav1.ConditionStatus, reason, message string) {
+	cond := metav1.Condition{
+		Type:    v1alpha1.ConditionTypeScaleInBlocked,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}
+	switch c.memberType {
+	case v1alpha1.TiKVMemberType:
+		meta.SetStatusCondition(&tc.Status.TiKV.Conditions, cond)
+	case v1alpha1.TiFlashMemberType:
+		meta.SetStatusCondition(&tc.Status.TiFlash.Conditions, cond)
+	}
+}
+
+// synced returns whether the stores of the member type have been read from PD,
+// TiFlash stores are reported after TiFlash joins even if TiKV is not bootstrapped yet.
+func (c *storeCapacityChecker) synced(tc *v1alpha1.TidbCluster) bool {
+	if c.memberType == v1alpha1.TiFlashMemberType {
+		return tc.Status.TiFlash.Synced
+	}
+	return tc.TiKVBootStrapped()
+}
+
+func (c *storeCapacityChecker) stores(tc *v1alpha1.TidbCluster) map[string]v1alpha1.TiKVStore {
+	if c.memberType == v1alpha1.TiFlashMemberType {
+		return tc.Status.TiFlash.Stores
+	}
+	return tc.Status.TiKV.Stores
+}
+
+func (c *storeCapacityChecker) storeLabelVal() string {
+	if c.memberType == v1alpha1.TiFlashMemberType {
+		return label.TiFlashLabelVal
+	}
+	return label.TiKVLabelVal
+}
+
+// storeUsedSize returns the used size of the store, it falls back to
+// capacity - available if PD does not report the used size.
+func storeUsedSize(status *pdapi.StoreStatus) uint64 {
+	if status.UsedSize > 0 {
+		return uint64(status.UsedSize)
+	}
+	if status.Capacity > status.Available {
+		return uint64(status.Capacity - status.Available)
+	}
+	return 0
+}
@@ -0,0 +1,242 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/tikv/pd/pkg/typeutil"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+func TestStoreCapacityCheckerCheck(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	const gb = uint64(1 << 30)
+	tests := []struct {
+		name          string
+		used          []uint64
+		lowSpaceRatio *float64
+		ordinals      []int32
+		offline       []int32
+		expectBlocked bool
+	}{
+		{
+			name:     "enough capacity",
+			used:     []uint64{30 * gb, 30 * gb, 30 * gb, 30 * gb},
+			ordinals: []int32{3},
+		},
+		{
+			name:          "not enough capacity",
+			used:          []uint64{70 * gb, 70 * gb, 70 * gb, 70 * gb},
+			ordinals:      []int32{3},
+			expectBlocked: true,
+		},
+		{
+			name:          "not enough capacity with low-space-ratio",
+			used:          []uint64{50 * gb, 50 * gb, 50 * gb, 50 * gb},
+			lowSpaceRatio: func() *float64 { r := 0.6; return &r }(),
+			ordinals:      []int32{3},
+			expectBlocked: true,
+		},
+		{
+			name:     "enough capacity without the offline store",
+			used:     []uint64{50 * gb, 50 * gb, 50 * gb, 50 * gb, 50 * gb},
+			ordinals: []int32{3},
+		},
+		{
+			name:          "not enough capacity with the offline store",
+			used:          []uint64{50 * gb, 50 * gb, 50 * gb, 50 * gb, 50 * gb},
+			ordinals:      []int32{3},
+			offline:       []int32{4},
+			expectBlocked: true,
+		},
+		{
+			name:     "no store to scale in",
+			used:     []uint64{70 * gb, 70 * gb, 70 * gb, 70 * gb},
+			ordinals: []int32{4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbClusterForPD()
+			tc.Status.TiKV.BootStrapped = true
+			tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{}
+			deps := controller.NewFakeDependencies()
+
+			stores := []*pdapi.StoreInfo{}
+			for i, used := range tt.used {
+				id := uint64(i + 1)
+				podName := TikvPodName(tc.Name, int32(i))
+				state := v1alpha1.TiKVStateUp
+				if slices.Contains(tt.offline, int32(i)) {
+					state = v1alpha1.TiKVStateOffline
+				}
+				tc.Status.TiKV.Stores[strconv.FormatUint(id, 10)] = v1alpha1.TiKVStore{
+					ID:      strconv.FormatUint(id, 10),
+					PodName: podName,
+					State:   state,
+				}
+				stores = append(stores, &pdapi.StoreInfo{
+					Store: &pdapi.MetaStore{
+						Store: &metapb.Store{
+							Id:      id,
+							Address: podName + ".tikv-peer.default.svc:20160",
+							Labels:  []*metapb.StoreLabel{{Key: "engine", Value: label.TiKVLabelVal}},
+						},
+						StateName: state,
+					},
+					Status: &pdapi.StoreStatus{
+						Capacity: typeutil.ByteSize(100 * gb),
+						UsedSize: typeutil.ByteSize(used),
+					},
+				})
+			}
+
+			pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+			pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+				return &pdapi.StoresInfo{Stores: stores}, nil
+			})
+			pdClient.AddReaction(pdapi.GetConfigActionType, func(action *pdapi.Action) (interface{}, error) {
+				return &pdapi.PDConfigFromAPI{
+					Schedule: &pdapi.PDScheduleConfig{LowSpaceRatio: tt.lowSpaceRatio},
+				}, nil
+			})
+
+			err := newStoreCapacityChecker(deps, v1alpha1.TiKVMemberType).Check(tc, tt.ordinals)
+			if tt.expectBlocked {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(meta.IsStatusConditionTrue(tc.Status.TiKV.Conditions, v1alpha1.ConditionTypeScaleInBlocked)).To(BeTrue())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(meta.IsStatusConditionTrue(tc.Status.TiKV.Conditions, v1alpha1.ConditionTypeScaleInBlocked)).To(BeFalse())
+			}
+		})
+	}
+}
+
+func TestStoreCapacityCheckerCheckTiFlash(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	const gb = uint64(1 << 30)
+	tests := []struct {
+		name          string
+		synced        bool
+		expectBlocked bool
+	}{
+		{
+			name:          "tiflash stores synced",
+			synced:        true,
+			expectBlocked: true,
+		},
+		{
+			name:   "tiflash stores not synced",
+			synced: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbClusterForPD()
+			// the check of TiFlash does not depend on whether TiKV is bootstrapped
+			tc.Status.TiKV.BootStrapped = false
+			tc.Status.TiFlash.Synced = tt.synced
+			tc.Status.TiFlash.Stores = map[string]v1alpha1.TiKVStore{}
+			deps := controller.NewFakeDependencies()
+
+			stores := []*pdapi.StoreInfo{}
+			for i := 0; i < 3; i++ {
+				id := uint64(i + 1)
+				podName := TiFlashPodName(tc.Name, int32(i))
+				tc.Status.TiFlash.Stores[strconv.FormatUint(id, 10)] = v1alpha1.TiKVStore{
+					ID:      strconv.FormatUint(id, 10),
+					PodName: podName,
+					State:   v1alpha1.TiKVStateUp,
+				}
+				stores = append(stores, &pdapi.StoreInfo{
+					Store: &pdapi.MetaStore{
+						Store: &metapb.Store{
+							Id:      id,
+							Address: podName + ".tiflash-peer.default.svc:3930",
+							Labels:  []*metapb.StoreLabel{{Key: "engine", Value: label.TiFlashLabelVal}},
+						},
+						StateName: v1alpha1.TiKVStateUp,
+					},
+					Status: &pdapi.StoreStatus{
+						Capacity: typeutil.ByteSize(100 * gb),
+						UsedSize: typeutil.ByteSize(60 * gb),
+					},
+				})
+			}
+
+			pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+			pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+				return &pdapi.StoresInfo{Stores: stores}, nil
+			})
+			pdClient.AddReaction(pdapi.GetConfigActionType, func(action *pdapi.Action) (interface{}, error) {
+				return &pdapi.PDConfigFromAPI{Schedule: &pdapi.PDScheduleConfig{}}, nil
+			})
+
+			err := newStoreCapacityChecker(deps, v1alpha1.TiFlashMemberType).Check(tc, []int32{2})
+			if tt.expectBlocked {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(meta.IsStatusConditionTrue(tc.Status.TiFlash.Conditions, v1alpha1.ConditionTypeScaleInBlocked)).To(BeTrue())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(tc.Status.TiFlash.Conditions).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestGetStoreDataMigration(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	newStore := func(state string, regionCount int, used uint64) *pdapi.StoreInfo {
+		return &pdapi.StoreInfo{
+			Store:  &pdapi.MetaStore{Store: &metapb.Store{Id: 1}, StateName: state},
+			Status: &pdapi.StoreStatus{RegionCount: regionCount, UsedSize: typeutil.ByteSize(used)},
+		}
+	}
+
+	now := time.Now().Truncate(time.Second)
+	g.Expect(getStoreDataMigration(nil, newStore(v1alpha1.TiKVStateUp, 100, 1000), now)).To(BeNil())
+
+	migration := getStoreDataMigration(nil, newStore(v1alpha1.TiKVStateOffline, 100, 1000), now)
+	g.Expect(migration).NotTo(BeNil())
+	g.Expect(migration.InitialRegionCount).To(Equal(int64(100)))
+	g.Expect(migration.InitialUsedSize).To(Equal(int64(1000)))
+	g.Expect(migration.RemainingRegionCount).To(Equal(int64(100)))
+	g.Expect(migration.MovedBytes).To(Equal(int64(0)))
+	g.Expect(migration.EstimatedCompletionTime).To(BeNil())
+
+	// 25 regions are moved in 1 minute, 75 regions remain, so it takes 3 minutes more
+	later := now.Add(time.Minute)
+	migration = getStoreDataMigration(migration, newStore(v1alpha1.TiKVStateOffline, 75, 700), later)
+	g.Expect(migration.StartTime.Time).To(Equal(now))
+	g.Expect(migration.RemainingRegionCount).To(Equal(int64(75)))
+	g.Expect(migration.MovedBytes).To(Equal(int64(300)))
+	g.Expect(migration.EstimatedCompletionTime).NotTo(BeNil())
+	g.Expect(migration.EstimatedCompletionTime.Time).To(Equal(later.Add(3 * time.Minute)))
+}
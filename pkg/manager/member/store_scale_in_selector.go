@@ -0,0 +1,324 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/features"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+// scaleInCandidate describes a TiKV or TiFlash pod which may be removed when scaling in
+type scaleInCandidate struct {
+	ordinal int32
+	// domain is the topology domain of the pod, empty if unknown
+	domain string
+	// draining is true if the pod has no store or the store is already Offline,
+	// removing it first does not move any more data
+	draining    bool
+	evicting    bool
+	regionCount int
+	leaderCount int
+}
+
+// storeScaleInSelector chooses the stores to be removed when scaling in TiKV or TiFlash
+// with the Balanced strategy, and records them in the delete slots annotation of the
+// TidbCluster just like a user does, so that the normal scaling process removes them.
+type storeScaleInSelector struct {
+	deps       *controller.Dependencies
+	memberType v1alpha1.MemberType
+}
+
+func newStoreScaleInSelector(deps *controller.Dependencies, memberType v1alpha1.MemberType) *storeScaleInSelector {
+	return &storeScaleInSelector{deps: deps, memberType: memberType}
+}
+
+// Select records the chosen ordinals in the delete slots annotation of the TidbCluster
+// and returns a requeue error if the stores to remove are not decided by the delete slots yet.
+// It does nothing if the scale in strategy is not Balanced.
+func (s *storeScaleInSelector) Select(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSet, newSet *apps.StatefulSet) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+
+	if s.scalePolicy(tc).GetScaleInStrategy() != v1alpha1.ScaleInStrategyBalanced {
+		return nil
+	}
+	if !features.DefaultFeatureGate.Enabled(features.AdvancedStatefulSet) {
+		klog.Warningf("%s of cluster %s/%s uses scale in strategy %s, but AdvancedStatefulSet is not enabled, fall back to %s",
+			s.memberType, ns, tcName, v1alpha1.ScaleInStrategyBalanced, v1alpha1.ScaleInStrategyOrdinal)
+		return nil
+	}
+
+	actualOrdinals := helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet)
+	desiredOrdinals := helper.GetPodOrdinals(*newSet.Spec.Replicas, newSet)
+	desiredDeleteSlots := helper.GetDeleteSlots(newSet)
+	// ordinals which are going to be deleted only because the replicas is decreased
+	count := actualOrdinals.Difference(desiredOrdinals).Difference(desiredDeleteSlots).Len()
+	if count == 0 {
+		return nil
+	}
+
+	candidates, err := s.candidates(tc, actualOrdinals.Difference(desiredDeleteSlots))
+	if err != nil {
+		return err
+	}
+	victims := pickScaleInVictims(candidates, count)
+	deleteSlots := desiredDeleteSlots.Union(victims)
+
+	// the chosen slots must not cause any pods to be created
+	newOrdinals := helper.GetPodOrdinalsFromReplicasAndDeleteSlots(*newSet.Spec.Replicas, deleteSlots)
+	if !actualOrdinals.IsSuperset(newOrdinals) {
+		klog.Warningf("%s of cluster %s/%s: delete slots %v chosen by scale in strategy %s would create new pods %v, fall back to %s",
+			s.memberType, ns, tcName, deleteSlots.List(), v1alpha1.ScaleInStrategyBalanced,
+			newOrdinals.Difference(actualOrdinals).List(), v1alpha1.ScaleInStrategyOrdinal)
+		return nil
+	}
+
+	if err := s.recordDeleteSlots(tc, deleteSlots); err != nil {
+		return err
+	}
+	msg := fmt.Sprintf("choose %s pods %v to scale in, delete slots: %v", s.memberType, victims.List(), deleteSlots.List())
+	klog.Infof("%s of cluster %s/%s: %s", s.memberType, ns, tcName, msg)
+	s.deps.Recorder.Event(tc, corev1.EventTypeNormal, "ScaleInStoresSelected", msg)
+	return controller.RequeueErrorf("%s of cluster %s/%s: delete slots %v are recorded, wait for next round", s.memberType, ns, tcName, deleteSlots.List())
+}
+
+func (s *storeScaleInSelector) candidates(tc *v1alpha1.TidbCluster, ordinals sets.Int32) ([]scaleInCandidate, error) {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+
+	storeByPod := map[string]v1alpha1.TiKVStore{}
+	for _, store := range s.stores(tc) {
+		storeByPod[store.PodName] = store
+	}
+
+	var storeInfos map[string]*storeScaleInInfo
+	if s.bootstrapped(tc) {
+		var err error
+		storeInfos, err = s.storeInfos(tc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	topologyKey := s.topologyKey(tc)
+	candidates := make([]scaleInCandidate, 0, ordinals.Len())
+	for _, ordinal := range ordinals.List() {
+		podName := ordinalPodName(s.memberType, tcName, ordinal)
+		candidate := scaleInCandidate{ordinal: ordinal}
+
+		pod, err := s.deps.PodLister.Pods(ns).Get(podName)
+		if err != nil {
+			return nil, fmt.Errorf("storeScaleInSelector: failed to get pod %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
+		}
+		for _, key := range v1alpha1.EvictLeaderAnnKeys {
+			if _, ok := pod.Annotations[key]; ok {
+				candidate.evicting = true
+			}
+		}
+
+		store, hasStore := storeByPod[podName]
+		if !hasStore || store.State == v1alpha1.TiKVStateOffline {
+			candidate.draining = true
+		}
+		if info, ok := storeInfos[podName]; ok {
+			candidate.regionCount = info.regionCount
+			candidate.leaderCount = info.leaderCount
+			candidate.evicting = candidate.evicting || info.evicting
+			candidate.domain = info.labels[topologyKey]
+		} else if hasStore {
+			candidate.leaderCount = int(store.LeaderCount)
+		}
+		if topologyKey != "" && s.deps.NodeLister != nil && pod.Spec.NodeName != "" {
+			if ls, err := getNodeLabels(s.deps.NodeLister, pod.Spec.NodeName, []string{topologyKey}); err == nil && ls[topologyKey] != "" {
+				candidate.domain = ls[topologyKey]
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
+type storeScaleInInfo struct {
+	labels      map[string]string
+	regionCount int
+	leaderCount int
+	evicting    bool
+}
+
+// storeInfos returns the region count, leader count, store labels and whether it is evicting leaders
+// of the stores in PD, keyed by pod name
+func (s *storeScaleInSelector) storeInfos(tc *v1alpha1.TidbCluster) (map[string]*storeScaleInInfo, error) {
+	pdClient := controller.GetPDClient(s.deps.PDControl, tc)
+	storesInfo, err := pdClient.GetStores()
+	if err != nil {
+		return nil, fmt.Errorf("storeScaleInSelector: failed to get stores info in TidbCluster %s/%s, error: %v", tc.GetNamespace(), tc.GetName(), err)
+	}
+
+	managed := map[string]bool{}
+	for id := range s.stores(tc) {
+		managed[id] = true
+	}
+
+	infos := map[string]*storeScaleInInfo{}
+	podByID := map[uint64]string{}
+	ids := []uint64{}
+	for _, storeInfo := range storesInfo.Stores {
+		store := getTiKVStore(storeInfo)
+		if store == nil || !managed[store.ID] {
+			continue
+		}
+		info := &storeScaleInInfo{
+			labels:      map[string]string{},
+			regionCount: storeInfo.Status.RegionCount,
+			leaderCount: storeInfo.Status.LeaderCount,
+		}
+		for _, l := range storeInfo.Store.Labels {
+			info.labels[l.GetKey()] = l.GetValue()
+		}
+		infos[store.PodName] = info
+		podByID[storeInfo.Store.GetId()] = store.PodName
+		ids = append(ids, storeInfo.Store.GetId())
+	}
+
+	// only TiKV stores have leaders to be evicted
+	if s.memberType == v1alpha1.TiKVMemberType && len(ids) > 0 {
+		schedulers, err := pdClient.GetEvictLeaderSchedulersForStores(ids...)
+		if err != nil {
+			return nil, fmt.Errorf("storeScaleInSelector: failed to get evict leader schedulers in TidbCluster %s/%s, error: %v", tc.GetNamespace(), tc.GetName(), err)
+		}
+		for id := range schedulers {
+			if podName, ok := podByID[id]; ok {
+				infos[podName].evicting = true
+			}
+		}
+	}
+	return infos, nil
+}
+
+func (s *storeScaleInSelector) recordDeleteSlots(tc *v1alpha1.TidbCluster, deleteSlots sets.Int32) error {
+	key := label.AnnTiKVDeleteSlots
+	if s.memberType == v1alpha1.TiFlashMemberType {
+		key = label.AnnTiFlashDeleteSlots
+	}
+	b, err := json.Marshal(deleteSlots.List())
+	if err != nil {
+		return err
+	}
+
+	// the delete slots of a TiKV pool or the TiFlash compute nodes are recorded by its own key in the owner
+	ownerName := tc.GetOwnerName()
+	ownerKey := tc.DeleteSlotsAnnotationKey(key)
+	latest, err := s.deps.TiDBClusterLister.TidbClusters(tc.GetNamespace()).Get(ownerName)
+	if err != nil {
+		return fmt.Errorf("storeScaleInSelector: failed to get TidbCluster %s/%s, error: %v", tc.GetNamespace(), ownerName, err)
+	}
+	latest = latest.DeepCopy()
+	if latest.Annotations == nil {
+		latest.Annotations = map[string]string{}
+	}
+	latest.Annotations[ownerKey] = string(b)
+	updated, err := s.deps.TiDBClusterControl.Update(latest)
+	if err != nil {
+		return fmt.Errorf("storeScaleInSelector: failed to set annotation %s of TidbCluster %s/%s, error: %v", ownerKey, tc.GetNamespace(), ownerName, err)
+	}
+
+	// keep the TidbCluster in this round consistent with the one in api server
+	if ownerName != tc.GetName() {
+		if tc.Annotations == nil {
+			tc.Annotations = map[string]string{}
+		}
+		tc.Annotations[key] = string(b)
+		return nil
+	}
+	tc.Annotations = updated.Annotations
+	tc.ResourceVersion = updated.ResourceVersion
+	return nil
+}
+
+func (s *storeScaleInSelector) scalePolicy(tc *v1alpha1.TidbCluster) *v1alpha1.ScalePolicy {
+	if s.memberType == v1alpha1.TiFlashMemberType {
+		return &tc.Spec.TiFlash.ScalePolicy
+	}
+	return &tc.Spec.TiKV.ScalePolicy
+}
+
+func (s *storeScaleInSelector) stores(tc *v1alpha1.TidbCluster) map[string]v1alpha1.TiKVStore {
+	if s.memberType == v1alpha1.TiFlashMemberType {
+		return tc.Status.TiFlash.Stores
+	}
+	return tc.Status.TiKV.Stores
+}
+
+func (s *storeScaleInSelector) bootstrapped(tc *v1alpha1.TidbCluster) bool {
+	if s.memberType == v1alpha1.TiFlashMemberType {
+		return len(tc.Status.TiFlash.Stores) > 0
+	}
+	return tc.TiKVBootStrapped()
+}
+
+// topologyKey returns ScaleInTopologyKey if set, otherwise the first topologyKey of the
+// TopologySpreadConstraints of the component
+func (s *storeScaleInSelector) topologyKey(tc *v1alpha1.TidbCluster) string {
+	return storeTopologyKey(tc, s.memberType, s.scalePolicy(tc).ScaleInTopologyKey)
+}
+
+// pickScaleInVictims picks count ordinals from candidates one by one, preferring in order:
+//   - the pods which have no store or whose store is already Offline
+//   - the pods in the topology domain which has the most candidates left
+//   - the pods whose store is not evicting leaders
+//   - the pods with fewer regions, then fewer leaders
+//   - the pods with higher ordinals
+func pickScaleInVictims(candidates []scaleInCandidate, count int) sets.Int32 {
+	victims := sets.NewInt32()
+	remaining := append([]scaleInCandidate{}, candidates...)
+	for i := 0; i < count && len(remaining) > 0; i++ {
+		domainCount := map[string]int{}
+		for _, c := range remaining {
+			domainCount[c.domain]++
+		}
+		sort.SliceStable(remaining, func(i, j int) bool {
+			a, b := remaining[i], remaining[j]
+			if a.draining != b.draining {
+				return a.draining
+			}
+			if domainCount[a.domain] != domainCount[b.domain] {
+				return domainCount[a.domain] > domainCount[b.domain]
+			}
+			if a.evicting != b.evicting {
+				return !a.evicting
+			}
+			if a.regionCount != b.regionCount {
+				return a.regionCount < b.regionCount
+			}
+			if a.leaderCount != b.leaderCount {
+				return a.leaderCount < b.leaderCount
+			}
+			return a.ordinal > b.ordinal
+		})
+		victims.Insert(remaining[0].ordinal)
+		remaining = remaining[1:]
+	}
+	return victims
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/features"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestPickScaleInVictims(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		name       string
+		candidates []scaleInCandidate
+		count      int
+		expect     []int32
+	}{
+		{
+			name: "highest ordinal when everything is equal",
+			candidates: []scaleInCandidate{
+				{ordinal: 0}, {ordinal: 1}, {ordinal: 2},
+			},
+			count:  1,
+			expect: []int32{2},
+		},
+		{
+			name: "fewest regions",
+			candidates: []scaleInCandidate{
+				{ordinal: 0, regionCount: 10}, {ordinal: 1, regionCount: 5}, {ordinal: 2, regionCount: 20},
+			},
+			count:  1,
+			expect: []int32{1},
+		},
+		{
+			name: "fewest leaders if regions are equal",
+			candidates: []scaleInCandidate{
+				{ordinal: 0, regionCount: 10, leaderCount: 1}, {ordinal: 1, regionCount: 10, leaderCount: 5},
+			},
+			count:  1,
+			expect: []int32{0},
+		},
+		{
+			name: "avoid evicting stores",
+			candidates: []scaleInCandidate{
+				{ordinal: 0, regionCount: 10}, {ordinal: 1, regionCount: 5, evicting: true},
+			},
+			count:  1,
+			expect: []int32{0},
+		},
+		{
+			name: "draining stores first",
+			candidates: []scaleInCandidate{
+				{ordinal: 0, regionCount: 1}, {ordinal: 1, regionCount: 50, draining: true},
+			},
+			count:  1,
+			expect: []int32{1},
+		},
+		{
+			name: "keep zones balanced",
+			candidates: []scaleInCandidate{
+				{ordinal: 0, domain: "a", regionCount: 10},
+				{ordinal: 1, domain: "b", regionCount: 1},
+				{ordinal: 2, domain: "c", regionCount: 1},
+				{ordinal: 3, domain: "a", regionCount: 20},
+				{ordinal: 4, domain: "b", regionCount: 2},
+			},
+			count:  2,
+			expect: []int32{0, 1},
+		},
+		{
+			name: "count larger than candidates",
+			candidates: []scaleInCandidate{
+				{ordinal: 0},
+			},
+			count:  2,
+			expect: []int32{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pickScaleInVictims(tt.candidates, tt.count)
+			g.Expect(got.List()).To(Equal(tt.expect))
+		})
+	}
+}
+
+func TestStoreScaleInSelectorSelect(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		name             string
+		strategy         v1alpha1.ScaleInStrategy
+		pool             bool
+		annDeleteSlots   string
+		expectRequeue    bool
+		expectAnnotation string
+	}{
+		{
+			name:     "ordinal strategy",
+			strategy: v1alpha1.ScaleInStrategyOrdinal,
+		},
+		{
+			name:             "balanced strategy",
+			strategy:         v1alpha1.ScaleInStrategyBalanced,
+			expectRequeue:    true,
+			expectAnnotation: "[1]",
+		},
+		{
+			name:             "balanced strategy in a pool",
+			strategy:         v1alpha1.ScaleInStrategyBalanced,
+			pool:             true,
+			expectRequeue:    true,
+			expectAnnotation: "[1]",
+		},
+		{
+			name:           "balanced strategy with delete slots chosen",
+			strategy:       v1alpha1.ScaleInStrategyBalanced,
+			annDeleteSlots: "[3]",
+		},
+	}
+
+	features.DefaultFeatureGate.Set("AdvancedStatefulSet=true")
+	defer features.DefaultFeatureGate.Set("AdvancedStatefulSet=false")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbClusterForPD()
+			tc.Spec.TiKV.ScalePolicy.ScaleInStrategy = tt.strategy
+			if tt.annDeleteSlots != "" {
+				tc.Annotations = map[string]string{label.AnnTiKVDeleteSlots: tt.annDeleteSlots}
+			}
+
+			deps := controller.NewFakeDependencies()
+			tcIndexer := deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer()
+			g.Expect(tcIndexer.Add(tc)).To(Succeed())
+			podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+
+			// the selector syncs the pool in the in-memory cluster of the pool
+			stc := tc
+			if tt.pool {
+				stc = tc.TiKVPoolCluster(&v1alpha1.TiKVPoolSpec{Name: "ssd", TiKVSpec: *tc.Spec.TiKV.DeepCopy()})
+			}
+
+			stc.Status.TiKV.BootStrapped = true
+			stc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{}
+			stores := []*pdapi.StoreInfo{}
+			regions := []int{30, 5, 20, 40}
+			for i := int32(0); i < 4; i++ {
+				podName := TikvPodName(stc.Name, i)
+				g.Expect(podIndexer.Add(&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: tc.Namespace},
+				})).To(Succeed())
+				id := uint64(i + 1)
+				stc.Status.TiKV.Stores[strconv.FormatUint(id, 10)] = v1alpha1.TiKVStore{
+					ID:      strconv.FormatUint(id, 10),
+					PodName: podName,
+					State:   v1alpha1.TiKVStateUp,
+				}
+				stores = append(stores, &pdapi.StoreInfo{
+					Store: &pdapi.MetaStore{
+						Store: &metapb.Store{
+							Id:      id,
+							Address: podName + ".tikv-peer.default.svc:20160",
+						},
+						StateName: v1alpha1.TiKVStateUp,
+					},
+					Status: &pdapi.StoreStatus{RegionCount: regions[i]},
+				})
+			}
+
+			pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+			pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+				return &pdapi.StoresInfo{Stores: stores}, nil
+			})
+			pdClient.AddReaction(pdapi.GetEvictLeaderSchedulersForStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+				return map[uint64]string{}, nil
+			})
+
+			oldSet := newStatefulSetForPDScale()
+			oldSet.Spec.Replicas = pointer.Int32Ptr(4)
+			newSet := oldSet.DeepCopy()
+			newSet.Spec.Replicas = pointer.Int32Ptr(3)
+			newSet.Annotations = getStsAnnotations(stc.Annotations, label.TiKVLabelVal)
+
+			err := newStoreScaleInSelector(deps, v1alpha1.TiKVMemberType).Select(stc, oldSet, newSet)
+			if tt.expectRequeue {
+				g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+
+			obj, _, err := tcIndexer.Get(tc)
+			g.Expect(err).NotTo(HaveOccurred())
+			if tt.expectAnnotation != "" {
+				g.Expect(obj.(*v1alpha1.TidbCluster).Annotations[stc.DeleteSlotsAnnotationKey(label.AnnTiKVDeleteSlots)]).To(Equal(tt.expectAnnotation))
+				g.Expect(stc.Annotations[label.AnnTiKVDeleteSlots]).To(Equal(tt.expectAnnotation))
+			} else {
+				g.Expect(obj.(*v1alpha1.TidbCluster).Annotations[label.AnnTiKVDeleteSlots]).To(Equal(tt.annDeleteSlots))
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// topologyUpgradePod is a TiKV or TiFlash pod which is not upgraded yet
+type topologyUpgradePod struct {
+	ordinal int32
+	name    string
+	// domain is the topology domain of the pod, empty if unknown
+	domain string
+	// upgrading is true if the upgrade of the pod has begun, e.g. its leaders are being evicted or it's being deleted
+	upgrading bool
+}
+
+// nextTopologyUpgradeBatch returns the pods to be upgraded together next. The batch is the pending pods in the
+// topology domain of the pods whose upgrade has begun, or else in the domain of the pending pod with the highest
+// ordinal, at most maxParallelism pods. The ordinals of the pods in a domain are not adjacent as the pods are spread
+// across the domains, so the batch is not limited by the ordinals. A pod whose domain is unknown is upgraded alone.
+func nextTopologyUpgradeBatch(pods []topologyUpgradePod, maxParallelism int) []topologyUpgradePod {
+	remaining := append([]topologyUpgradePod{}, pods...)
+	// the pods whose upgrade has begun go first so that they are always in the batch
+	sort.Slice(remaining, func(i, j int) bool {
+		if remaining[i].upgrading != remaining[j].upgrading {
+			return remaining[i].upgrading
+		}
+		return remaining[i].ordinal > remaining[j].ordinal
+	})
+	if len(remaining) == 0 || remaining[0].domain == "" {
+		return remaining[:min(len(remaining), 1)]
+	}
+	var batch []topologyUpgradePod
+	for _, pod := range remaining {
+		if len(batch) >= maxParallelism {
+			break
+		}
+		if pod.domain == remaining[0].domain {
+			batch = append(batch, pod)
+		}
+	}
+	return batch
+}
+
+// topologyUpgradePodNames returns the names of the pods in the batch
+func topologyUpgradePodNames(batch []topologyUpgradePod) []string {
+	names := make([]string, 0, len(batch))
+	for _, pod := range batch {
+		names = append(names, pod.name)
+	}
+	return names
+}
+
+// isTopologyUpgradeBegun returns true if the upgrade of any pod in the batch has begun
+func isTopologyUpgradeBegun(batch []topologyUpgradePod) bool {
+	for _, pod := range batch {
+		if pod.upgrading {
+			return true
+		}
+	}
+	return false
+}
+
+// upgradeTopologyBatch deletes the pods in the batch together once the OnDelete strategy is applied to the
+// StatefulSet, so that they are recreated with the update revision while the other pods are kept on the
+// current revision. The partition and the delete slots only select the pods by adjacent ordinals, so the
+// strategy applies to the whole StatefulSet, and a pod outside the batch deleted by others in the meantime is
+// recreated with the update revision too, it's then taken as upgraded.
+func upgradeTopologyBatch(deps *controller.Dependencies, tc *v1alpha1.TidbCluster, oldSet, newSet *apps.StatefulSet,
+	batch []topologyUpgradePod, pods []*corev1.Pod) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	podNames := topologyUpgradePodNames(batch)
+
+	beginOnDeleteUpgrade(newSet)
+	if !isOnDeleteUpgradeSet(oldSet) {
+		// the pods deleted before the strategy is applied would be recreated with the current revision
+		return nil
+	}
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if err := deps.PodControl.DeletePod(tc, pod); err != nil {
+			return fmt.Errorf("failed to delete pod %s for tc %s/%s, error: %s", pod.Name, ns, tcName, err)
+		}
+	}
+	return controller.RequeueErrorf("upgrading pods %v for tc %s/%s", podNames, ns, tcName)
+}
+
+// getTopologyUpgradeKey returns the location label of PD used to upgrade TiKV or TiFlash by topology. An empty
+// key is returned with a warning event if it's unsafe, then the pods should be upgraded one by one instead.
+func getTopologyUpgradeKey(deps *controller.Dependencies, tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, key string) (string, error) {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	config, err := controller.GetPDClient(deps.PDControl, tc).GetConfig()
+	if err != nil {
+		return "", fmt.Errorf("failed to get the config of PD in TidbCluster %s/%s, error: %v", ns, tcName, err)
+	}
+	locationLabel, err := topologyUpgradeLocationLabel(config, storeTopologyKey(tc, memberType, key))
+	if err != nil {
+		msg := fmt.Sprintf("refuse to upgrade %s by topology, upgrade the pods one by one instead: %v", memberType, err)
+		klog.Warningf("TidbCluster: [%s/%s], %s", ns, tcName, msg)
+		deps.Recorder.Event(tc, corev1.EventTypeWarning, "TopologyUpgradeRefused", msg)
+		return "", nil
+	}
+	return locationLabel, nil
+}
+
+// topologyUpgradeLocationLabel returns the location label of PD which the topology key refers to, the key is
+// either the location label or the node label mapped to it. The pods in a topology domain can only be
+// restarted together if no region has more than one replica in the domain, so the location label must be
+// the isolation-level of PD or a lower level than it, otherwise an error tells why it's refused.
+func topologyUpgradeLocationLabel(config *pdapi.PDConfigFromAPI, topologyKey string) (string, error) {
+	if topologyKey == "" {
+		return "", fmt.Errorf("the topology key is not set")
+	}
+	if config == nil || config.Replication == nil {
+		return "", fmt.Errorf("the replication config of PD is unknown")
+	}
+	locationLabels := config.Replication.LocationLabels
+	isolationLevel := ""
+	if config.Replication.IsolationLevel != nil {
+		isolationLevel = *config.Replication.IsolationLevel
+	}
+	if isolationLevel == "" {
+		return "", fmt.Errorf("the isolation-level of PD is not set")
+	}
+
+	isolationIndex, keyIndex := -1, -1
+	for i, l := range locationLabels {
+		if l == isolationLevel {
+			isolationIndex = i
+		}
+		if l == topologyKey || slices.Contains(shortLabelNameToK8sLabel[l], topologyKey) {
+			keyIndex = i
+		}
+	}
+	if keyIndex < 0 {
+		return "", fmt.Errorf("topology key %s is not in the location-labels %v of PD", topologyKey, []string(locationLabels))
+	}
+	if isolationIndex < 0 || keyIndex < isolationIndex {
+		return "", fmt.Errorf("topology key %s is above the isolation-level %s of PD in the location-labels %v",
+			topologyKey, isolationLevel, []string(locationLabels))
+	}
+	return locationLabels[keyIndex], nil
+}
+
+// storeTopologyKey returns the TopologyKey of the upgrade policy if set, otherwise the first
+// topologyKey of the TopologySpreadConstraints of the component
+func storeTopologyKey(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, key string) string {
+	if key != "" {
+		return key
+	}
+	var tscs []corev1.TopologySpreadConstraint
+	if memberType == v1alpha1.TiFlashMemberType {
+		tscs = tc.BaseTiFlashSpec().TopologySpreadConstraints()
+	} else {
+		tscs = tc.BaseTiKVSpec().TopologySpreadConstraints()
+	}
+	if len(tscs) == 0 {
+		return ""
+	}
+	return tscs[0].TopologyKey
+}
+
+// getStoreTopologyDomains returns the topology domains of the pods keyed by pod name. The domain is
+// the label of the node of the pod, or the label of the store in PD if the node doesn't have the label.
+func getStoreTopologyDomains(deps *controller.Dependencies, tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType,
+	topologyKey string, pods []*corev1.Pod) (map[string]string, error) {
+	domains := map[string]string{}
+	if topologyKey == "" {
+		return domains, nil
+	}
+
+	missing := false
+	for _, pod := range pods {
+		if deps.NodeLister != nil && pod.Spec.NodeName != "" {
+			if ls, err := getNodeLabels(deps.NodeLister, pod.Spec.NodeName, []string{topologyKey}); err == nil && ls[topologyKey] != "" {
+				domains[pod.Name] = ls[topologyKey]
+				continue
+			}
+		}
+		missing = true
+	}
+	if !missing {
+		return domains, nil
+	}
+
+	stores := tc.Status.TiKV.Stores
+	if memberType == v1alpha1.TiFlashMemberType {
+		stores = tc.Status.TiFlash.Stores
+	}
+	storesInfo, err := controller.GetPDClient(deps.PDControl, tc).GetStores()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stores info in TidbCluster %s/%s, error: %v", tc.GetNamespace(), tc.GetName(), err)
+	}
+	for _, storeInfo := range storesInfo.Stores {
+		store := getTiKVStore(storeInfo)
+		if store == nil || domains[store.PodName] != "" {
+			continue
+		}
+		if _, ok := stores[store.ID]; !ok {
+			continue
+		}
+		for _, l := range storeInfo.Store.Labels {
+			if l.GetKey() == topologyKey {
+				domains[store.PodName] = l.GetValue()
+			}
+		}
+	}
+	return domains, nil
+}
@@ -0,0 +1,512 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/tikvapi"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+)
+
+func TestNextTopologyUpgradeBatch(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		name           string
+		pods           []topologyUpgradePod
+		maxParallelism int
+		expect         []int32
+	}{
+		{
+			name: "upgrade the pods in the same domain from the highest ordinal",
+			pods: []topologyUpgradePod{
+				{ordinal: 0, domain: "b"},
+				{ordinal: 1, domain: "a"},
+				{ordinal: 2, domain: "a"},
+				{ordinal: 3, domain: "a"},
+			},
+			maxParallelism: 3,
+			expect:         []int32{3, 2, 1},
+		},
+		{
+			name: "upgrade the pods interleaved across the domains",
+			pods: []topologyUpgradePod{
+				{ordinal: 0, domain: "a"},
+				{ordinal: 1, domain: "b"},
+				{ordinal: 2, domain: "c"},
+				{ordinal: 3, domain: "a"},
+				{ordinal: 4, domain: "b"},
+				{ordinal: 5, domain: "c"},
+			},
+			maxParallelism: 3,
+			expect:         []int32{5, 2},
+		},
+		{
+			name: "continue the domain whose upgrade has begun",
+			pods: []topologyUpgradePod{
+				{ordinal: 0, domain: "a"},
+				{ordinal: 1, domain: "b", upgrading: true},
+				{ordinal: 2, domain: "c"},
+				{ordinal: 3, domain: "a"},
+				{ordinal: 4, domain: "b"},
+			},
+			maxParallelism: 3,
+			expect:         []int32{1, 4},
+		},
+		{
+			name: "limited by max parallelism",
+			pods: []topologyUpgradePod{
+				{ordinal: 0, domain: "a"},
+				{ordinal: 1, domain: "a"},
+				{ordinal: 2, domain: "a"},
+			},
+			maxParallelism: 2,
+			expect:         []int32{2, 1},
+		},
+		{
+			name: "upgrade the pods with unknown domain one by one",
+			pods: []topologyUpgradePod{
+				{ordinal: 0, domain: ""},
+				{ordinal: 1, domain: ""},
+			},
+			maxParallelism: 3,
+			expect:         []int32{1},
+		},
+		{
+			name: "skip the pods with unknown domain",
+			pods: []topologyUpgradePod{
+				{ordinal: 0, domain: "a"},
+				{ordinal: 1, domain: ""},
+				{ordinal: 2, domain: "a"},
+			},
+			maxParallelism: 3,
+			expect:         []int32{2, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ordinals []int32
+			for _, pod := range nextTopologyUpgradeBatch(tt.pods, tt.maxParallelism) {
+				ordinals = append(ordinals, pod.ordinal)
+			}
+			g.Expect(ordinals).To(Equal(tt.expect))
+		})
+	}
+}
+
+func TestTopologyUpgradeLocationLabel(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		name           string
+		locationLabels []string
+		isolationLevel string
+		topologyKey    string
+		expect         string
+		expectErr      string
+	}{
+		{
+			name:           "topology key is not set",
+			locationLabels: []string{"zone", "host"},
+			isolationLevel: "zone",
+			expectErr:      "not set",
+		},
+		{
+			name:           "isolation level is not set",
+			locationLabels: []string{"zone", "host"},
+			topologyKey:    "zone",
+			expectErr:      "isolation-level",
+		},
+		{
+			name:           "topology key is not a location label",
+			locationLabels: []string{"zone", "host"},
+			isolationLevel: "zone",
+			topologyKey:    "rack",
+			expectErr:      "not in the location-labels",
+		},
+		{
+			name:           "topology key is above the isolation level",
+			locationLabels: []string{"zone", "host"},
+			isolationLevel: "host",
+			topologyKey:    "zone",
+			expectErr:      "above the isolation-level",
+		},
+		{
+			name:           "topology key is the isolation level",
+			locationLabels: []string{"zone", "host"},
+			isolationLevel: "zone",
+			topologyKey:    "zone",
+			expect:         "zone",
+		},
+		{
+			name:           "topology key is under the isolation level",
+			locationLabels: []string{"zone", "host"},
+			isolationLevel: "zone",
+			topologyKey:    "host",
+			expect:         "host",
+		},
+		{
+			name:           "topology key is the node label of a location label",
+			locationLabels: []string{"zone", "host"},
+			isolationLevel: "zone",
+			topologyKey:    corev1.LabelTopologyZone,
+			expect:         "zone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &pdapi.PDConfigFromAPI{Replication: &pdapi.PDReplicationConfig{LocationLabels: tt.locationLabels}}
+			if tt.isolationLevel != "" {
+				config.Replication.IsolationLevel = pointer.StringPtr(tt.isolationLevel)
+			}
+			locationLabel, err := topologyUpgradeLocationLabel(config, tt.topologyKey)
+			if tt.expectErr != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tt.expectErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(locationLabel).To(Equal(tt.expect))
+		})
+	}
+}
+
+func TestGetTopologyUpgradeKeyRefused(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	tc := newTidbClusterForTiKVUpgrader()
+	tc.Spec.TiKV.TopologySpreadConstraints = []v1alpha1.TopologySpreadConstraint{{TopologyKey: "zone"}}
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	pdClient.AddReaction(pdapi.GetConfigActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.PDConfigFromAPI{Replication: &pdapi.PDReplicationConfig{
+			LocationLabels: []string{"zone", "host"},
+			IsolationLevel: pointer.StringPtr("host"),
+		}}, nil
+	})
+
+	// the key of the TopologySpreadConstraints is checked too
+	key, err := getTopologyUpgradeKey(deps, tc, v1alpha1.TiKVMemberType, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(key).To(BeEmpty())
+	events := collectEvents(deps.Recorder.(*record.FakeRecorder).Events)
+	g.Expect(events).To(HaveLen(1))
+	g.Expect(events[0]).To(ContainSubstring("TopologyUpgradeRefused"))
+
+	key, err = getTopologyUpgradeKey(deps, tc, v1alpha1.TiKVMemberType, "host")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(key).To(Equal("host"))
+}
+
+func TestTiKVUpgraderUpgradeByTopology(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	volumeModifier := &volumes.FakePodVolumeModifier{
+		GetDesiredVolumesFunc: func(_ *v1alpha1.TidbCluster, _ v1alpha1.MemberType) ([]volumes.DesiredVolume, error) {
+			return nil, nil
+		},
+		ShouldModifyFunc: func(_ []volumes.ActualVolume) bool { return false },
+	}
+	upgrader := &tikvUpgrader{deps: deps, volumeModifier: volumeModifier}
+
+	tc := newTidbClusterForTiKVUpgrader()
+	tc.Spec.TiKV.UpgradePolicy = &v1alpha1.UpgradePolicy{
+		Strategy:    v1alpha1.UpgradeStrategyTopology,
+		TopologyKey: "zone",
+	}
+
+	nodeIndexer := deps.KubeInformerFactory.Core().V1().Nodes().Informer().GetIndexer()
+	// the pods are spread across the zones, so the ordinals in a zone are not adjacent
+	for node, zone := range map[string]string{"node-a": "a", "node-b": "b"} {
+		g.Expect(nodeIndexer.Add(&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: node, Labels: map[string]string{"zone": zone}},
+		})).To(Succeed())
+	}
+	podNodes := map[string]string{
+		TikvPodName(upgradeTcName, 0): "node-a",
+		TikvPodName(upgradeTcName, 1): "node-b",
+		TikvPodName(upgradeTcName, 2): "node-a",
+	}
+	oldSet := oldStatefulSetForTiKVUpgrader()
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	addPod := func(pod *corev1.Pod) {
+		pod.Spec.NodeName = podNodes[pod.Name]
+		g.Expect(podIndexer.Add(pod)).To(Succeed())
+	}
+	pods := getTiKVPods(oldSet)
+	for _, pod := range pods {
+		addPod(pod)
+	}
+
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	var evicting, ended []uint64
+	pdClient.AddReaction(pdapi.BeginEvictLeaderActionType, func(action *pdapi.Action) (interface{}, error) {
+		evicting = append(evicting, action.ID)
+		return nil, nil
+	})
+	pdClient.AddReaction(pdapi.EndEvictLeaderActionType, func(action *pdapi.Action) (interface{}, error) {
+		ended = append(ended, action.ID)
+		return nil, nil
+	})
+	pendingPeerRegions := 0
+	pdClient.AddReaction(pdapi.GetRegionsStatusActionType, func(action *pdapi.Action) (interface{}, error) {
+		return map[pdapi.RegionStatusType]int{pdapi.RegionStatusPendingPeer: pendingPeerRegions}, nil
+	})
+	setFakePDLeader(deps.PDControl.(*pdapi.FakePDControl), tc, pdClient)
+	pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.StoresInfo{}, nil
+	})
+	for _, pod := range pods {
+		tikvClient := controller.NewFakeTiKVClient(deps.TiKVControl.(*tikvapi.FakeTiKVControl), tc, pod.Name)
+		tikvClient.AddReaction(tikvapi.GetLeaderCountActionType, func(action *tikvapi.Action) (interface{}, error) {
+			return 0, nil
+		})
+	}
+	exists := func(ordinal int32) bool {
+		_, exist, err := podIndexer.GetByKey(tc.Namespace + "/" + TikvPodName(upgradeTcName, ordinal))
+		g.Expect(err).NotTo(HaveOccurred())
+		return exist
+	}
+	recreate := func(ordinals ...int32) {
+		for _, pod := range getTiKVPods(oldSet) {
+			for _, ordinal := range ordinals {
+				if pod.Name == TikvPodName(upgradeTcName, ordinal) {
+					pod.Labels[apps.ControllerRevisionHashLabelKey] = tc.Status.TiKV.StatefulSet.UpdateRevision
+					addPod(pod)
+				}
+			}
+		}
+	}
+	partition := func(set *apps.StatefulSet) int32 {
+		return *set.Spec.UpdateStrategy.RollingUpdate.Partition
+	}
+	upgrade := func() (*apps.StatefulSet, error) {
+		newSet := oldSet.DeepCopy()
+		err := upgrader.upgradeByTopology(tc, oldSet, newSet, "zone")
+		return newSet, err
+	}
+
+	// evict the leaders of the stores of the pods in zone a concurrently
+	newSet, err := upgrade()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(evicting).To(ConsistOf(uint64(1), uint64(3)))
+	g.Expect(partition(newSet)).To(Equal(int32(3)))
+	g.Expect(tc.Status.TiKV.RegionHealthBaseline).NotTo(BeNil())
+
+	// set the OnDelete strategy after the leaders are evicted, the pods are not deleted until it's applied
+	newSet, err = upgrade()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(isOnDeleteUpgradeSet(newSet)).To(BeTrue())
+	g.Expect(exists(0)).To(BeTrue())
+	g.Expect(exists(2)).To(BeTrue())
+
+	// delete the pods in zone a together
+	oldSet = newSet
+	_, err = upgrade()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(exists(0)).To(BeFalse())
+	g.Expect(exists(1)).To(BeTrue())
+	g.Expect(exists(2)).To(BeFalse())
+
+	// wait for the pods to be recreated
+	_, err = upgrade()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+
+	// wait for the regions to be healthy before upgrading zone b
+	recreate(0, 2)
+	pendingPeerRegions = 3
+	newSet, err = upgrade()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(ended).To(ConsistOf(uint64(1), uint64(3)))
+	g.Expect(evicting).To(HaveLen(2))
+	g.Expect(isOnDeleteUpgradeSet(newSet)).To(BeTrue())
+	cond := meta.FindStatusCondition(tc.Status.TiKV.Conditions, v1alpha1.ConditionTypeUpgradeBlocked)
+	g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+
+	// upgrade zone b, the pod is deleted once the leaders are evicted as the strategy is applied
+	pendingPeerRegions = 0
+	_, err = upgrade()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(evicting).To(ConsistOf(uint64(1), uint64(3), uint64(2)))
+	_, err = upgrade()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(exists(1)).To(BeFalse())
+
+	// restore the RollingUpdate strategy after all the pods are upgraded
+	recreate(1)
+	newSet, err = upgrade()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(newSet.Spec.UpdateStrategy.Type).To(Equal(apps.RollingUpdateStatefulSetStrategyType))
+	g.Expect(partition(newSet)).To(Equal(int32(0)))
+	g.Expect(newSet.Annotations).NotTo(HaveKey(annoKeyOnDeleteUpgrade))
+}
+
+// TestTiKVUpgraderUpgradeByTopologyOutsideBatch covers the known gap of the OnDelete strategy which is applied to
+// the whole StatefulSet: a pod outside the batch which is deleted during the upgrade, e.g. by a node drain, is
+// recreated with the update revision by the StatefulSet controller. It's taken as upgraded, so its leaders are
+// not evicted and it's not deleted again.
+func TestTiKVUpgraderUpgradeByTopologyOutsideBatch(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	volumeModifier := &volumes.FakePodVolumeModifier{
+		GetDesiredVolumesFunc: func(_ *v1alpha1.TidbCluster, _ v1alpha1.MemberType) ([]volumes.DesiredVolume, error) {
+			return nil, nil
+		},
+		ShouldModifyFunc: func(_ []volumes.ActualVolume) bool { return false },
+	}
+	upgrader := &tikvUpgrader{deps: deps, volumeModifier: volumeModifier}
+
+	tc := newTidbClusterForTiKVUpgrader()
+	tc.Spec.TiKV.UpgradePolicy = &v1alpha1.UpgradePolicy{
+		Strategy:    v1alpha1.UpgradeStrategyTopology,
+		TopologyKey: "zone",
+	}
+	nodeIndexer := deps.KubeInformerFactory.Core().V1().Nodes().Informer().GetIndexer()
+	for node, zone := range map[string]string{"node-a": "a", "node-b": "b"} {
+		g.Expect(nodeIndexer.Add(&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: node, Labels: map[string]string{"zone": zone}},
+		})).To(Succeed())
+	}
+	podNodes := map[string]string{
+		TikvPodName(upgradeTcName, 0): "node-a",
+		TikvPodName(upgradeTcName, 1): "node-b",
+		TikvPodName(upgradeTcName, 2): "node-a",
+	}
+
+	// the leaders of the pods in zone a are evicted and the OnDelete strategy is applied, when the pod in
+	// zone b is recreated with the update revision
+	oldSet := oldStatefulSetForTiKVUpgrader()
+	beginOnDeleteUpgrade(oldSet)
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	for _, pod := range getTiKVPods(oldSet) {
+		pod.Spec.NodeName = podNodes[pod.Name]
+		if pod.Name == TikvPodName(upgradeTcName, 1) {
+			pod.Labels[apps.ControllerRevisionHashLabelKey] = tc.Status.TiKV.StatefulSet.UpdateRevision
+		} else {
+			pod.Annotations = map[string]string{annoKeyEvictLeaderBeginTime: time.Now().Add(-time.Minute).Format(time.RFC3339)}
+		}
+		g.Expect(podIndexer.Add(pod)).To(Succeed())
+	}
+
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	var evicting []uint64
+	pdClient.AddReaction(pdapi.BeginEvictLeaderActionType, func(action *pdapi.Action) (interface{}, error) {
+		evicting = append(evicting, action.ID)
+		return nil, nil
+	})
+	pdClient.AddReaction(pdapi.EndEvictLeaderActionType, func(action *pdapi.Action) (interface{}, error) {
+		return nil, nil
+	})
+	for _, pod := range getTiKVPods(oldSet) {
+		tikvClient := controller.NewFakeTiKVClient(deps.TiKVControl.(*tikvapi.FakeTiKVControl), tc, pod.Name)
+		tikvClient.AddReaction(tikvapi.GetLeaderCountActionType, func(action *tikvapi.Action) (interface{}, error) {
+			return 0, nil
+		})
+	}
+	exists := func(ordinal int32) bool {
+		_, exist, err := podIndexer.GetByKey(tc.Namespace + "/" + TikvPodName(upgradeTcName, ordinal))
+		g.Expect(err).NotTo(HaveOccurred())
+		return exist
+	}
+
+	newSet := oldSet.DeepCopy()
+	err := upgrader.upgradeByTopology(tc, oldSet, newSet, "zone")
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(isOnDeleteUpgradeSet(newSet)).To(BeTrue())
+	g.Expect(exists(0)).To(BeFalse())
+	g.Expect(exists(1)).To(BeTrue())
+	g.Expect(exists(2)).To(BeFalse())
+	g.Expect(evicting).To(BeEmpty())
+}
+
+func TestTiKVUpgraderUpgradeByTopologyFromStoreLabels(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	tc := newTidbClusterForTiKVUpgrader()
+	pods := getTiKVPods(oldStatefulSetForTiKVUpgrader())
+
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		storesInfo := &pdapi.StoresInfo{}
+		for i, zone := range []string{"a", "b", "a"} {
+			storesInfo.Stores = append(storesInfo.Stores, &pdapi.StoreInfo{
+				Store: &pdapi.MetaStore{
+					Store: &metapb.Store{
+						Id:      uint64(i + 1),
+						Address: pods[i].Name + ".upgrader-tikv-peer.default.svc:20160",
+						Labels:  []*metapb.StoreLabel{{Key: "zone", Value: zone}},
+					},
+				},
+				Status: &pdapi.StoreStatus{},
+			})
+		}
+		return storesInfo, nil
+	})
+
+	domains, err := getStoreTopologyDomains(deps, tc, v1alpha1.TiKVMemberType, "zone", pods)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(domains).To(Equal(map[string]string{
+		TikvPodName(upgradeTcName, 0): "a",
+		TikvPodName(upgradeTcName, 1): "b",
+		TikvPodName(upgradeTcName, 2): "a",
+	}))
+}
+
+func TestTopologyUpgradeStrategy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	oldSet := oldStatefulSetForTiKVUpgrader()
+	oldSet.Spec.UpdateStrategy = apps.StatefulSetUpdateStrategy{
+		Type:          apps.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &apps.RollingUpdateStatefulSetStrategy{Partition: pointer.Int32Ptr(2)},
+	}
+	newSet := oldSet.DeepCopy()
+	newSet.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.Int32Ptr(3)
+	g.Expect(isOnDeleteUpgradeSet(oldSet)).To(BeFalse())
+	g.Expect(upgradePartition(oldSet, newSet)).To(Equal(int32(2)))
+
+	// the OnDelete strategy set manually is not taken as the one of the upgrade by topology
+	oldSet.Spec.UpdateStrategy = apps.StatefulSetUpdateStrategy{Type: apps.OnDeleteStatefulSetStrategyType}
+	g.Expect(isOnDeleteUpgradeSet(oldSet)).To(BeFalse())
+
+	// the upgrade one by one begins with the partition of the new StatefulSet if the old one is left with the
+	// OnDelete strategy by the upgrade by topology
+	beginOnDeleteUpgrade(oldSet)
+	g.Expect(isOnDeleteUpgradeSet(oldSet)).To(BeTrue())
+	g.Expect(upgradePartition(oldSet, newSet)).To(Equal(int32(3)))
+
+	endOnDeleteUpgrade(oldSet)
+	g.Expect(isOnDeleteUpgradeSet(oldSet)).To(BeFalse())
+	g.Expect(oldSet.Spec.UpdateStrategy.Type).To(Equal(apps.RollingUpdateStatefulSetStrategyType))
+	g.Expect(*oldSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(int32(0)))
+
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	upgradeBlockedReasonRegionsUnhealthy  = "RegionsUnhealthy"
+	upgradeBlockedReasonRegionsHealthy    = "RegionsHealthy"
+	upgradeBlockedReasonWaitRegionTimeout = "WaitRegionHealthyTimeout"
+)
+
+// checkRegionHealthBeforeUpgrade checks whether the counts of the unhealthy regions have returned to the
+// baseline recorded before the upgrade, so that the next store is not restarted while the regions on the
+// upgraded stores are still catching up. The upgrade continues after the timeout even if they haven't.
+// The baseline and the UpgradeBlocked condition are kept in the status of the given member type.
+func checkRegionHealthBeforeUpgrade(deps *controller.Dependencies, tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, podName string) (bool, error) {
+	timeout := tc.TiKVWaitRegionHealthyTimeout()
+	if timeout <= 0 {
+		return true, nil
+	}
+
+	baselinePtr, conditions := &tc.Status.TiKV.RegionHealthBaseline, &tc.Status.TiKV.Conditions
+	if memberType == v1alpha1.TiFlashMemberType {
+		baselinePtr, conditions = &tc.Status.TiFlash.RegionHealthBaseline, &tc.Status.TiFlash.Conditions
+	}
+
+	stats, err := getRegionHealthStats(deps, tc)
+	if err != nil {
+		return false, err
+	}
+	baseline := *baselinePtr
+	if baseline == nil {
+		klog.Infof("TidbCluster: [%s/%s], record the unhealthy regions before upgrading %s: %+v", tc.Namespace, tc.Name, memberType, *stats)
+		*baselinePtr = stats
+		return true, nil
+	}
+
+	if stats.MissPeerRegionCount <= baseline.MissPeerRegionCount &&
+		stats.DownPeerRegionCount <= baseline.DownPeerRegionCount &&
+		stats.PendingPeerRegionCount <= baseline.PendingPeerRegionCount &&
+		stats.LearnerPeerRegionCount <= baseline.LearnerPeerRegionCount {
+		setUpgradeBlockedCondition(conditions, metav1.ConditionFalse, upgradeBlockedReasonRegionsHealthy, "The unhealthy regions have returned to the baseline")
+		return true, nil
+	}
+
+	msg := fmt.Sprintf("waiting for the unhealthy regions to return to the baseline before upgrading pod %s, miss-peer: %d/%d, down-peer: %d/%d, pending-peer: %d/%d, learner-peer: %d/%d",
+		podName, stats.MissPeerRegionCount, baseline.MissPeerRegionCount, stats.DownPeerRegionCount, baseline.DownPeerRegionCount,
+		stats.PendingPeerRegionCount, baseline.PendingPeerRegionCount, stats.LearnerPeerRegionCount, baseline.LearnerPeerRegionCount)
+	cond := meta.FindStatusCondition(*conditions, v1alpha1.ConditionTypeUpgradeBlocked)
+	if cond != nil && cond.Status == metav1.ConditionTrue && time.Now().After(cond.LastTransitionTime.Add(timeout)) {
+		msg = fmt.Sprintf("timeout with threshold %v %s, continue to upgrade", timeout, msg)
+		klog.Warningf("TidbCluster: [%s/%s], %s", tc.Namespace, tc.Name, msg)
+		deps.Recorder.Event(tc, corev1.EventTypeWarning, upgradeBlockedReasonWaitRegionTimeout, msg)
+		setUpgradeBlockedCondition(conditions, metav1.ConditionFalse, upgradeBlockedReasonWaitRegionTimeout, msg)
+		return true, nil
+	}
+
+	klog.Infof("TidbCluster: [%s/%s], %s", tc.Namespace, tc.Name, msg)
+	setUpgradeBlockedCondition(conditions, metav1.ConditionTrue, upgradeBlockedReasonRegionsUnhealthy, msg)
+	return false, nil
+}
+
+func setUpgradeBlockedCondition(conditions *[]metav1.Condition, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:    v1alpha1.ConditionTypeUpgradeBlocked,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+// getRegionHealthStats queries PD for the counts of the unhealthy regions. It's called once in a sync at most, as TiKV
+// and TiFlash are not upgraded at the same time, and it doesn't list the regions: the counts are read from one scrape
+// of the metrics of the PD leader, and the learners are counted by the stores. The replicas on TiFlash are always
+// learners, so only the learners on TiKV stores are counted.
+func getRegionHealthStats(deps *controller.Dependencies, tc *v1alpha1.TidbCluster) (*v1alpha1.RegionHealthStats, error) {
+	pdClient := controller.GetPDClient(deps.PDControl, tc)
+	leader, err := pdClient.GetPDLeader()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PD leader, error: %v", err)
+	}
+	if len(leader.GetClientUrls()) == 0 {
+		return nil, fmt.Errorf("no client URL of PD leader %s", leader.GetName())
+	}
+	leaderClient := deps.PDControl.GetPDClient(pdapi.Namespace(tc.GetNamespace()), tc.GetName(), tc.IsTLSClusterEnabled(),
+		pdapi.SpecifyClient(leader.GetClientUrls()[0], leader.GetName()))
+	counts, err := leaderClient.GetRegionsStatus()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get regions status, error: %v", err)
+	}
+	storesInfo, err := pdClient.GetStores()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stores, error: %v", err)
+	}
+	var learners int32
+	for _, store := range storesInfo.Stores {
+		if store.Store == nil || store.Status == nil {
+			continue
+		}
+		if util.MatchLabelFromStoreLabels(store.Store.Labels, label.TiKVLabelVal) {
+			learners += int32(store.Status.LearnerCount)
+		}
+	}
+	return &v1alpha1.RegionHealthStats{
+		MissPeerRegionCount:    int32(counts[pdapi.RegionStatusMissPeer]),
+		DownPeerRegionCount:    int32(counts[pdapi.RegionStatusDownPeer]),
+		PendingPeerRegionCount: int32(counts[pdapi.RegionStatusPendingPeer]),
+		LearnerPeerRegionCount: learners,
+	}, nil
+}
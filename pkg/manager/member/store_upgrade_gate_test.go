@@ -0,0 +1,78 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+)
+
+func TestGetRegionHealthStats(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPD()
+	deps := controller.NewFakeDependencies()
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	setFakePDLeader(deps.PDControl.(*pdapi.FakePDControl), tc, pdClient)
+	pdClient.AddReaction(pdapi.GetRegionsStatusActionType, func(action *pdapi.Action) (interface{}, error) {
+		return map[pdapi.RegionStatusType]int{
+			pdapi.RegionStatusMissPeer:    1,
+			pdapi.RegionStatusDownPeer:    2,
+			pdapi.RegionStatusPendingPeer: 3,
+		}, nil
+	})
+	pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.StoresInfo{
+			Stores: []*pdapi.StoreInfo{
+				{
+					Store:  &pdapi.MetaStore{Store: &metapb.Store{Id: 1}},
+					Status: &pdapi.StoreStatus{LearnerCount: 4},
+				},
+				{
+					Store:  &pdapi.MetaStore{Store: &metapb.Store{Id: 2}},
+					Status: &pdapi.StoreStatus{LearnerCount: 1},
+				},
+				{
+					// the replicas on TiFlash are always learners
+					Store:  &pdapi.MetaStore{Store: &metapb.Store{Id: 3, Labels: []*metapb.StoreLabel{{Key: "engine", Value: "tiflash"}}}},
+					Status: &pdapi.StoreStatus{LearnerCount: 1000},
+				},
+			},
+		}, nil
+	})
+
+	stats, err := getRegionHealthStats(deps, tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*stats).To(Equal(v1alpha1.RegionHealthStats{
+		MissPeerRegionCount:    1,
+		DownPeerRegionCount:    2,
+		PendingPeerRegionCount: 3,
+		LearnerPeerRegionCount: 5,
+	}))
+}
+
+// setFakePDLeader makes the fake PD client the client of the PD leader too
+func setFakePDLeader(pdControl *pdapi.FakePDControl, tc *v1alpha1.TidbCluster, pdClient *pdapi.FakePDClient) {
+	leaderName := PdPodName(tc.GetName(), 0)
+	pdClient.AddReaction(pdapi.GetPDLeaderActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdpb.Member{Name: leaderName, ClientUrls: []string{"http://" + leaderName + ":2379"}}, nil
+	})
+	pdControl.SetPDClientForKey(pdapi.Namespace(tc.GetNamespace()), tc.GetName(), leaderName, pdClient)
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// NewTiCDCFailover returns a ticdc Failover
+func NewTiCDCFailover(deps *controller.Dependencies) Failover {
+	return newCommonStatelessFailover(deps, &ticdcMemberAccess{})
+}
+
+// ticdcMemberAccess is a folder of access functions for TiCDC capture and implements StatelessMemberAccess
+type ticdcMemberAccess struct{}
+
+var _ StatelessMemberAccess = (*ticdcMemberAccess)(nil)
+
+func (ma *ticdcMemberAccess) GetFailoverPeriod(cliConfig *controller.CLIConfig) time.Duration {
+	return cliConfig.TiCDCFailoverPeriod
+}
+
+func (ma *ticdcMemberAccess) GetMemberType() v1alpha1.MemberType {
+	return v1alpha1.TiCDCMemberType
+}
+
+func (ma *ticdcMemberAccess) GetMaxFailoverCount(tc *v1alpha1.TidbCluster) *int32 {
+	return tc.Spec.TiCDC.MaxFailoverCount
+}
+
+func (ma *ticdcMemberAccess) GetMembers(tc *v1alpha1.TidbCluster) map[string]statelessMember {
+	members := make(map[string]statelessMember, len(tc.Status.TiCDC.Captures))
+	for podName, capture := range tc.Status.TiCDC.Captures {
+		members[podName] = statelessMember{Health: capture.Ready, LastTransitionTime: capture.LastTransitionTime}
+	}
+	return members
+}
+
+func (ma *ticdcMemberAccess) GetFailureMembers(tc *v1alpha1.TidbCluster) map[string]v1alpha1.StatelessFailureMember {
+	return tc.Status.TiCDC.FailureMembers
+}
+
+func (ma *ticdcMemberAccess) SetFailureMembers(tc *v1alpha1.TidbCluster, failureMembers map[string]v1alpha1.StatelessFailureMember) {
+	tc.Status.TiCDC.FailureMembers = failureMembers
+}
+
+func (ma *ticdcMemberAccess) GetStsDesiredOrdinals(tc *v1alpha1.TidbCluster, excludeFailover bool) sets.Int32 {
+	return tc.TiCDCStsDesiredOrdinals(excludeFailover)
+}
+
+func (ma *ticdcMemberAccess) GetPodName(tc *v1alpha1.TidbCluster, ordinal int32) string {
+	return fmt.Sprintf("%s-%d", controller.TiCDCMemberName(tc.GetName()), ordinal)
+}
+
+// NewFakeTiCDCFailover returns a fake Failover
+func NewFakeTiCDCFailover() Failover {
+	return &fakeStatelessFailover{}
+}
@@ -50,6 +50,7 @@ type ticdcMemberManager struct {
 	deps                     *controller.Dependencies
 	scaler                   Scaler
 	ticdcUpgrader            Upgrader
+	failover                 Failover
 	suspender                suspender.Suspender
 	podVolumeModifier        volumes.PodVolumeModifier
 	statefulSetIsUpgradingFn func(corelisters.PodLister, pdapi.PDControlInterface, *apps.StatefulSet, *v1alpha1.TidbCluster) (bool, error)
@@ -89,11 +90,12 @@ func getTiCDCConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 }
 
 // NewTiCDCMemberManager returns a *ticdcMemberManager
-func NewTiCDCMemberManager(deps *controller.Dependencies, scaler Scaler, ticdcUpgrader Upgrader, spder suspender.Suspender, pvm volumes.PodVolumeModifier) manager.Manager {
+func NewTiCDCMemberManager(deps *controller.Dependencies, scaler Scaler, ticdcUpgrader Upgrader, failover Failover, spder suspender.Suspender, pvm volumes.PodVolumeModifier) manager.Manager {
 	m := &ticdcMemberManager{
 		deps:              deps,
 		scaler:            scaler,
 		ticdcUpgrader:     ticdcUpgrader,
+		failover:          failover,
 		suspender:         spder,
 		podVolumeModifier: pvm,
 	}
@@ -218,6 +220,19 @@ func (m *ticdcMemberManager) syncStatefulSet(tc *v1alpha1.TidbCluster) error {
 		return err
 	}
 
+	if m.deps.CLIConfig.AutoFailover {
+		if len(tc.Status.TiCDC.FailureMembers) > 0 {
+			m.failover.RemoveUndesiredFailures(tc)
+		}
+		if shouldRecoverStatelessFailure(m.deps, tc, &ticdcMemberAccess{}) {
+			m.failover.Recover(tc)
+		} else if tc.TiCDCAllPodsStarted() && !tc.TiCDCAllCapturesReady() {
+			if err := m.failover.Failover(tc); err != nil {
+				return err
+			}
+		}
+	}
+
 	if !templateEqual(newSts, oldSts) || tc.Status.TiCDC.Phase == v1alpha1.UpgradePhase {
 		if err := m.ticdcUpgrader.Upgrade(tc, oldSts, newSts); err != nil {
 			return err
@@ -248,6 +263,7 @@ func (m *ticdcMemberManager) syncTiCDCStatus(tc *v1alpha1.TidbCluster, sts *apps
 		tc.Status.TiCDC.Phase = v1alpha1.NormalPhase
 	}
 
+	oldCaptures := tc.Status.TiCDC.Captures
 	ticdcCaptures := map[string]v1alpha1.TiCDCCapture{}
 	allCapturesReady := true
 	for id := range helper.GetPodOrdinals(tc.Status.TiCDC.StatefulSet.Replicas, sts) {
@@ -260,8 +276,9 @@ func (m *ticdcMemberManager) syncTiCDCStatus(tc *v1alpha1.TidbCluster, sts *apps
 		}
 
 		capture := v1alpha1.TiCDCCapture{
-			PodName: podName,
-			Ready:   false,
+			PodName:            podName,
+			Ready:              false,
+			LastTransitionTime: metav1.Now(),
 		}
 		status, err := m.deps.CDCControl.GetStatus(tc, int32(id))
 		if err != nil {
@@ -273,6 +290,9 @@ func (m *ticdcMemberManager) syncTiCDCStatus(tc *v1alpha1.TidbCluster, sts *apps
 			capture.IsOwner = status.IsOwner
 			capture.Ready = true
 		}
+		if oldCapture, exist := oldCaptures[podName]; exist && oldCapture.Ready == capture.Ready && !oldCapture.LastTransitionTime.IsZero() {
+			capture.LastTransitionTime = oldCapture.LastTransitionTime
+		}
 
 		ticdcCaptures[podName] = capture
 	}
@@ -597,6 +597,7 @@ func newFakeTiCDCMemberManager() (*ticdcMemberManager, *controller.FakeStatefulS
 	tmm := &ticdcMemberManager{
 		deps:              fakeDeps,
 		scaler:            NewTiCDCScaler(fakeDeps),
+		failover:          NewFakeTiCDCFailover(),
 		suspender:         suspender.NewFakeSuspender(),
 		podVolumeModifier: &volumes.FakePodVolumeModifier{},
 	}
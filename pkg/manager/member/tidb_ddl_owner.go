@@ -0,0 +1,152 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const tidbDDLOwnerReason = "TiDBDDLOwner"
+
+// getTiDBDDLOwnerOrdinal returns the ordinal of the TiDB pod which is the DDL owner. The owner is
+// queried from a healthy TiDB, false is returned if the owner is not found.
+func getTiDBDDLOwnerOrdinal(deps *controller.Dependencies, tc *v1alpha1.TidbCluster) (int32, bool, error) {
+	var ordinals []int32
+	for name, member := range tc.Status.TiDB.Members {
+		if !member.Health {
+			continue
+		}
+		if ordinal, err := util.GetOrdinalFromPodName(name); err == nil {
+			ordinals = append(ordinals, ordinal)
+		}
+	}
+	if len(ordinals) == 0 {
+		return 0, false, nil
+	}
+	sort.Slice(ordinals, func(i, j int) bool { return ordinals[i] < ordinals[j] })
+
+	var info *controller.ClusterServerInfo
+	var err error
+	for _, ordinal := range ordinals {
+		if info, err = deps.TiDBControl.GetAllServersInfo(tc, ordinal); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get the info of all TiDB servers for cluster %s/%s, error: %v", tc.GetNamespace(), tc.GetName(), err)
+	}
+	owner := info.Owner()
+	if owner == nil {
+		return 0, false, nil
+	}
+
+	// the advertise address of TiDB is `<pod>.<peer service>.<namespace>.svc`
+	if podName := strings.SplitN(owner.IP, ".", 2)[0]; strings.HasPrefix(podName, controller.TiDBMemberName(tc.GetName())+"-") {
+		if ordinal, err := util.GetOrdinalFromPodName(podName); err == nil {
+			return ordinal, true, nil
+		}
+	}
+	// the advertise address may be the IP of the pod
+	selector, err := label.New().Instance(tc.GetInstanceName()).TiDB().Selector()
+	if err != nil {
+		return 0, false, err
+	}
+	pods, err := deps.PodLister.Pods(tc.GetNamespace()).List(selector)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to list TiDB pods for cluster %s/%s, error: %v", tc.GetNamespace(), tc.GetName(), err)
+	}
+	for _, pod := range pods {
+		if pod.Status.PodIP == owner.IP {
+			ordinal, err := util.GetOrdinalFromPodName(pod.GetName())
+			if err != nil {
+				return 0, false, err
+			}
+			return ordinal, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// moveTiDBDDLOwnerAway makes sure the TiDB pod of the ordinal is not the DDL owner before it's
+// restarted or deleted. If it's the DDL owner, it's asked to resign the owner by resignTiDBDDLOwner.
+// The TiDB pod is not held if the DDL owner can't be determined, e.g. TiDB is too old to support the API.
+func moveTiDBDDLOwnerAway(deps *controller.Dependencies, tc *v1alpha1.TidbCluster, ordinal int32, reason string) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	podName := tidbPodName(tcName, ordinal)
+
+	ownerOrdinal, found, err := getTiDBDDLOwnerOrdinal(deps, tc)
+	if err != nil {
+		klog.Warningf("TidbCluster: [%s/%s], failed to get the DDL owner before changing pod %s, skip moving the DDL owner: %v", ns, tcName, podName, err)
+		return nil
+	}
+	if !found || ownerOrdinal != ordinal {
+		return nil
+	}
+	return resignTiDBDDLOwner(deps, tc, ordinal, reason, nil)
+}
+
+// resignTiDBDDLOwner asks the DDL owner of the ordinal to resign the owner, and a requeue error is returned
+// to wait for a new owner to be elected from the other TiDB instances. If `holdOnRunningDDL` is enabled,
+// the owner is not resigned until no DDL job is running.
+// Only the healthy TiDB instances accepted by canTakeOver, or all of them if it's nil, are expected to take
+// over the owner, the owner is not resigned if there is none of them.
+func resignTiDBDDLOwner(deps *controller.Dependencies, tc *v1alpha1.TidbCluster, ordinal int32, reason string,
+	canTakeOver func(podName string) bool) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	podName := tidbPodName(tcName, ordinal)
+
+	if tc.Spec.TiDB.ShouldHoldOnRunningDDL() {
+		count, err := deps.TiDBControl.GetDDLJobCount(tc, ordinal)
+		if err != nil {
+			return fmt.Errorf("failed to get DDL jobs from TiDB pod %s/%s, error: %v", ns, podName, err)
+		}
+		if count > 0 {
+			return controller.RequeueErrorf("TidbCluster: [%s/%s], TiDB pod %s is the DDL owner and %d DDL jobs are running, hold on", ns, tcName, podName, count)
+		}
+	}
+
+	// there is no other TiDB instance to take over the DDL owner
+	var others int
+	for name, member := range tc.Status.TiDB.Members {
+		if name != podName && member.Health && (canTakeOver == nil || canTakeOver(name)) {
+			others++
+		}
+	}
+	if others == 0 {
+		return nil
+	}
+
+	resigned, err := deps.TiDBControl.ResignDDLOwner(tc, ordinal)
+	if err != nil {
+		return fmt.Errorf("failed to resign the DDL owner of TiDB pod %s/%s, error: %v", ns, podName, err)
+	}
+	if resigned {
+		tc.RecordOperation(podOperation(v1alpha1.OperationResignDDLOwner, v1alpha1.TiDBMemberType, reason, podName), nil)
+		msg := fmt.Sprintf("TiDB pod %s resigned the DDL owner", podName)
+		klog.Infof("TidbCluster: [%s/%s], %s", ns, tcName, msg)
+		deps.Recorder.Event(tc, corev1.EventTypeNormal, tidbDDLOwnerReason, msg)
+	}
+	return controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for the DDL owner to be moved away from TiDB pod %s", ns, tcName, podName)
+}
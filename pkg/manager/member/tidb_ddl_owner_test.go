@@ -0,0 +1,161 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func newClusterServerInfoForTest(ownerIP string) *controller.ClusterServerInfo {
+	return &controller.ClusterServerInfo{
+		ServersNum: 2,
+		OwnerID:    "owner",
+		AllServersInfo: map[string]*controller.ServerInfo{
+			"owner": {ID: "owner", IP: ownerIP, Port: 4000},
+			"other": {ID: "other", IP: "10.0.0.2", Port: 4000},
+		},
+	}
+}
+
+func TestGetTiDBDDLOwnerOrdinal(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiDBUpgrader()
+	deps := controller.NewFakeDependencies()
+	tidbControl := deps.TiDBControl.(*controller.FakeTiDBControl)
+
+	// failed to get the info
+	_, _, err := getTiDBDDLOwnerOrdinal(deps, tc)
+	g.Expect(err).To(HaveOccurred())
+
+	// advertised by the DNS name
+	tidbControl.SetClusterServerInfo(newClusterServerInfoForTest("upgrader-tidb-1.upgrader-tidb-peer.default.svc"))
+	ordinal, found, err := getTiDBDDLOwnerOrdinal(deps, tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(found).To(BeTrue())
+	g.Expect(ordinal).To(Equal(int32(1)))
+
+	// advertised by the pod IP
+	tidbControl.SetClusterServerInfo(newClusterServerInfoForTest("10.0.0.1"))
+	_, found, err = getTiDBDDLOwnerOrdinal(deps, tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(found).To(BeFalse())
+	g.Expect(deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer().Add(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tidbPodName(tc.Name, 0),
+			Namespace: tc.Namespace,
+			Labels:    label.New().Instance(tc.Name).TiDB().Labels(),
+		},
+		Status: corev1.PodStatus{PodIP: "10.0.0.1"},
+	})).To(Succeed())
+	ordinal, found, err = getTiDBDDLOwnerOrdinal(deps, tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(found).To(BeTrue())
+	g.Expect(ordinal).To(Equal(int32(0)))
+}
+
+func TestMoveTiDBDDLOwnerAway(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		name             string
+		ownerIP          string
+		holdOnRunningDDL bool
+		ddlJobCount      int
+		singleMember     bool
+		expectRequeue    bool
+		expectResigned   []int32
+	}{
+		{
+			name:    "not the owner",
+			ownerIP: "upgrader-tidb-0.upgrader-tidb-peer.default.svc",
+		},
+		{
+			name:           "resign the owner",
+			ownerIP:        "upgrader-tidb-1.upgrader-tidb-peer.default.svc",
+			expectRequeue:  true,
+			expectResigned: []int32{1},
+		},
+		{
+			name:             "hold on running DDL",
+			ownerIP:          "upgrader-tidb-1.upgrader-tidb-peer.default.svc",
+			holdOnRunningDDL: true,
+			ddlJobCount:      2,
+			expectRequeue:    true,
+		},
+		{
+			name:             "no running DDL",
+			ownerIP:          "upgrader-tidb-1.upgrader-tidb-peer.default.svc",
+			holdOnRunningDDL: true,
+			expectRequeue:    true,
+			expectResigned:   []int32{1},
+		},
+		{
+			name:         "no other TiDB to take over the owner",
+			ownerIP:      "upgrader-tidb-1.upgrader-tidb-peer.default.svc",
+			singleMember: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbClusterForTiDBUpgrader()
+			if tt.holdOnRunningDDL {
+				tc.Spec.TiDB.HoldOnRunningDDL = pointer.BoolPtr(true)
+			}
+			if tt.singleMember {
+				delete(tc.Status.TiDB.Members, tidbPodName(tc.Name, 0))
+			}
+			deps := controller.NewFakeDependencies()
+			tidbControl := deps.TiDBControl.(*controller.FakeTiDBControl)
+			tidbControl.SetClusterServerInfo(newClusterServerInfoForTest(tt.ownerIP))
+			tidbControl.SetDDLJobCount(tt.ddlJobCount)
+
+			err := moveTiDBDDLOwnerAway(deps, tc, 1, operationReasonUpgrade)
+			if tt.expectRequeue {
+				g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(tidbControl.GetResignedOrdinals()).To(Equal(tt.expectResigned))
+		})
+	}
+}
+
+func TestResignTiDBDDLOwnerToUpgradedMembers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiDBUpgrader()
+	deps := controller.NewFakeDependencies()
+	tidbControl := deps.TiDBControl.(*controller.FakeTiDBControl)
+	upgraded := map[string]bool{}
+	canTakeOver := func(podName string) bool { return upgraded[podName] }
+
+	// no upgraded TiDB to take over the owner
+	err := resignTiDBDDLOwner(deps, tc, 1, operationReasonUpgrade, canTakeOver)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tidbControl.GetResignedOrdinals()).To(BeEmpty())
+
+	upgraded[tidbPodName(tc.Name, 0)] = true
+	err = resignTiDBDDLOwner(deps, tc, 1, operationReasonUpgrade, canTakeOver)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(tidbControl.GetResignedOrdinals()).To(Equal([]int32{1}))
+}
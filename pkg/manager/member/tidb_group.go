@@ -0,0 +1,104 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"k8s.io/apimachinery/pkg/api/errors"
+	errorutils "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+// syncTiDBGroups syncs the TiDB groups one by one in the same way as `spec.tidb`, a failure of a group
+// doesn't block the other groups. The TiDB pods of the groups removed from the spec are scaled in.
+func (m *tidbMemberManager) syncTiDBGroups(tc *v1alpha1.TidbCluster) error {
+	if len(tc.Spec.TiDBGroups) == 0 && len(tc.Status.TiDBGroups) == 0 {
+		return nil
+	}
+	if tc.Status.TiDBGroups == nil {
+		tc.Status.TiDBGroups = map[string]*v1alpha1.TiDBStatus{}
+	}
+
+	var errs []error
+	groups := sets.NewString()
+	for i := range tc.Spec.TiDBGroups {
+		group := &tc.Spec.TiDBGroups[i]
+		groups.Insert(group.Name)
+
+		gtc := tc.TiDBGroupCluster(group)
+		if err := checkSubClusterName(m.deps, gtc); err != nil {
+			errs = append(errs, fmt.Errorf("TiDB group %s: %w", group.Name, err))
+			continue
+		}
+		err := m.syncTiDB(gtc)
+		tc.Status.TiDBGroups[group.Name] = &gtc.Status.TiDB
+		tc.MergeOperations(gtc)
+		if err != nil {
+			metrics.ClusterUpdateErrors.WithLabelValues(tc.GetNamespace(), tc.GetName(), fmt.Sprintf("tidb-%s", group.Name)).Inc()
+			errs = append(errs, fmt.Errorf("TiDB group %s: %w", group.Name, err))
+		}
+	}
+
+	for name := range tc.Status.TiDBGroups {
+		if groups.Has(name) {
+			continue
+		}
+		if err := m.scaleInRemovedTiDBGroup(tc, name); err != nil {
+			errs = append(errs, fmt.Errorf("TiDB group %s: %w", name, err))
+		}
+	}
+	return errorutils.NewAggregate(errs)
+}
+
+// scaleInRemovedTiDBGroup scales in the TiDB pods of the group removed from the spec one by one,
+// the status of the group is removed after all the pods are deleted.
+func (m *tidbMemberManager) scaleInRemovedTiDBGroup(tc *v1alpha1.TidbCluster, name string) error {
+	gtc := tc.TiDBGroupCluster(&v1alpha1.TiDBGroupSpec{Name: name})
+	if err := checkSubClusterName(m.deps, gtc); err != nil {
+		return err
+	}
+	ns := gtc.GetNamespace()
+	setName := controller.TiDBMemberName(gtc.GetName())
+
+	oldSetTmp, err := m.deps.StatefulSetLister.StatefulSets(ns).Get(setName)
+	if errors.IsNotFound(err) {
+		delete(tc.Status.TiDBGroups, name)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("scaleInRemovedTiDBGroup: failed to get sts %s for cluster %s/%s, error: %s", setName, ns, tc.GetName(), err)
+	}
+	if oldSetTmp.Status.Replicas == 0 && *oldSetTmp.Spec.Replicas == 0 {
+		klog.Infof("TidbCluster: [%s/%s], all the TiDB pods of the removed group %s are deleted", ns, tc.GetName(), name)
+		delete(tc.Status.TiDBGroups, name)
+		return nil
+	}
+
+	oldSet := oldSetTmp.DeepCopy()
+	newSet := oldSetTmp.DeepCopy()
+	*newSet.Spec.Replicas = 0
+	err = m.scaler.Scale(gtc, oldSet, newSet)
+	tc.Status.TiDBGroups[name] = &gtc.Status.TiDB
+	tc.MergeOperations(gtc)
+	if err != nil {
+		return err
+	}
+	return mngerutils.UpdateStatefulSetWithPrecheck(m.deps, gtc, "FailedUpdateTiDBSTS", newSet, oldSet)
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTiDBMemberManagerSyncTiDBGroups(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiDB()
+	tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{
+		"tikv-0": {PodName: "tikv-0", State: v1alpha1.TiKVStateUp},
+	}
+	tc.Status.TiKV.StatefulSet = &apps.StatefulSetStatus{ReadyReplicas: 1}
+	tc.Spec.TiDBGroups = []v1alpha1.TiDBGroupSpec{{
+		Name: "olap",
+		TiDBSpec: v1alpha1.TiDBSpec{
+			ComponentSpec: v1alpha1.ComponentSpec{
+				Image: "tidb-olap",
+			},
+			ResourceRequirements: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("8"),
+				},
+			},
+			Replicas: 2,
+		},
+	}}
+	ns := tc.GetNamespace()
+
+	tmm, _, _, indexers := newFakeTiDBMemberManager()
+	pdClient := controller.NewFakePDClient(tmm.deps.PDControl.(*pdapi.FakePDControl), tc)
+	pdClient.AddReaction(pdapi.GetConfigActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.PDConfigFromAPI{Replication: &pdapi.PDReplicationConfig{}}, nil
+	})
+	g.Expect(tmm.Sync(tc)).To(Succeed())
+
+	// the StatefulSets of spec.tidb and the group are created
+	set, err := tmm.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiDBMemberName("test"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*set.Spec.Replicas).To(Equal(int32(3)))
+	groupSetName := controller.TiDBMemberName("test-olap")
+	set, err = tmm.deps.StatefulSetLister.StatefulSets(ns).Get(groupSetName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*set.Spec.Replicas).To(Equal(int32(2)))
+	g.Expect(set.Spec.Template.Spec.Containers).To(ContainElement(HaveField("Image", "tidb-olap")))
+	g.Expect(set.Spec.Template.Labels).To(HaveKeyWithValue(label.InstanceLabelKey, "test-olap"))
+	g.Expect(set.Spec.Template.Labels).To(HaveKeyWithValue(label.TiDBGroupLabelKey, "olap"))
+	g.Expect(metav1.GetControllerOf(set).Name).To(Equal("test"))
+	g.Expect(tc.Status.TiDBGroups).To(HaveKey("olap"))
+	g.Expect(tc.Status.TiDBGroups["olap"].StatefulSet).NotTo(BeNil())
+
+	// scale in the removed group
+	for i := int32(0); i < 2; i++ {
+		g.Expect(indexers.pod.Add(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tidbPodName("test-olap", i),
+				Namespace: ns,
+			},
+		})).To(Succeed())
+	}
+	tc.Spec.TiDBGroups = nil
+	for i := 0; i < 2; i++ {
+		g.Expect(tmm.Sync(tc)).To(Succeed())
+		g.Expect(tc.Status.TiDBGroups).To(HaveKey("olap"))
+	}
+	set, err = tmm.deps.StatefulSetLister.StatefulSets(ns).Get(groupSetName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*set.Spec.Replicas).To(Equal(int32(0)))
+
+	// the status of the group is removed after all the pods are deleted
+	set = set.DeepCopy()
+	set.Status.Replicas = 0
+	g.Expect(indexers.set.Update(set)).To(Succeed())
+	g.Expect(tmm.Sync(tc)).To(Succeed())
+	g.Expect(tc.Status.TiDBGroups).NotTo(HaveKey("olap"))
+}
+
+func TestTiDBMemberManagerSyncTiDBGroupsNameClash(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiDB()
+	tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{
+		"tikv-0": {PodName: "tikv-0", State: v1alpha1.TiKVStateUp},
+	}
+	tc.Status.TiKV.StatefulSet = &apps.StatefulSetStatus{ReadyReplicas: 1}
+	tc.Spec.TiDBGroups = []v1alpha1.TiDBGroupSpec{{Name: "olap", TiDBSpec: v1alpha1.TiDBSpec{Replicas: 2}}}
+	ns := tc.GetNamespace()
+
+	tmm, _, _, _ := newFakeTiDBMemberManager()
+	pdClient := controller.NewFakePDClient(tmm.deps.PDControl.(*pdapi.FakePDControl), tc)
+	pdClient.AddReaction(pdapi.GetConfigActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.PDConfigFromAPI{Replication: &pdapi.PDReplicationConfig{}}, nil
+	})
+	// the resources of the group would be named the same as the ones of the TidbCluster
+	other := &v1alpha1.TidbCluster{ObjectMeta: metav1.ObjectMeta{Name: "test-olap", Namespace: ns}}
+	g.Expect(tmm.deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(other)).To(Succeed())
+
+	err := tmm.Sync(tc)
+	g.Expect(err).To(MatchError(ContainSubstring("test-olap, which is the name of another TidbCluster")))
+	_, err = tmm.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiDBMemberName("test"))
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = tmm.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiDBMemberName("test-olap"))
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestTiDBGroupCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiDB()
+	tc.Spec.TiDB.Initializer = &v1alpha1.TiDBInitializer{CreatePassword: true}
+	group := &v1alpha1.TiDBGroupSpec{Name: "olap", TiDBSpec: *tc.Spec.TiDB.DeepCopy()}
+	tc.Status.TiDBGroups = map[string]*v1alpha1.TiDBStatus{
+		"olap": {Phase: v1alpha1.UpgradePhase},
+	}
+
+	gtc := tc.TiDBGroupCluster(group)
+	g.Expect(gtc.Name).To(Equal("test-olap"))
+	g.Expect(gtc.GetOwnerName()).To(Equal("test"))
+	g.Expect(tc.GetOwnerName()).To(Equal("test"))
+	g.Expect(gtc.Heterogeneous()).To(BeTrue())
+	g.Expect(gtc.WithoutLocalPD()).To(BeTrue())
+	g.Expect(gtc.Spec.Cluster.Name).To(Equal("test"))
+	g.Expect(gtc.Spec.TiDB.Initializer).To(BeNil())
+	g.Expect(gtc.Status.TiDB.Phase).To(Equal(v1alpha1.UpgradePhase))
+	g.Expect(controller.GetOwnerRef(gtc).Name).To(Equal("test"))
+	g.Expect(controller.GetOwnerRef(gtc).UID).To(Equal(tc.UID))
+	// the cluster is not changed
+	g.Expect(tc.Spec.PD).NotTo(BeNil())
+	g.Expect(tc.Labels).NotTo(HaveKey(label.TiDBGroupLabelKey))
+	g.Expect(tc.Spec.TiDB.Initializer).NotTo(BeNil())
+}
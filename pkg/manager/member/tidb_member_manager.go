@@ -111,6 +111,19 @@ func NewTiDBMemberManager(deps *controller.Dependencies, scaler Scaler, tidbUpgr
 }
 
 func (m *tidbMemberManager) Sync(tc *v1alpha1.TidbCluster) error {
+	err := m.syncTiDB(tc)
+	// the TiDB groups are synced even if `spec.tidb` is not synced, e.g. in the middle of upgrading
+	if groupErr := m.syncTiDBGroups(tc); groupErr != nil {
+		if err != nil {
+			klog.Errorf("TidbCluster: [%s/%s], failed to sync TiDB groups: %v", tc.GetNamespace(), tc.GetName(), groupErr)
+			return err
+		}
+		return groupErr
+	}
+	return err
+}
+
+func (m *tidbMemberManager) syncTiDB(tc *v1alpha1.TidbCluster) error {
 	// If tidb is not specified return
 	if tc.Spec.TiDB == nil {
 		return nil
@@ -318,6 +331,17 @@ func (m *tidbMemberManager) syncTiDBStatefulSetForTidbCluster(tc *v1alpha1.TidbC
 		newTiDBSet.Spec.Template.Spec = *podSpec
 	}
 
+	resizing, err := newInPlaceResizer(m.deps).Sync(tc, v1alpha1.TiDBMemberType, oldTiDBSet, newTiDBSet)
+	if resizing {
+		// The pods are being resized in place, apply the new template without restarting the pods.
+		if err := mngerutils.UpdateStatefulSetWithPrecheck(m.deps, tc, "FailedUpdateTiDBSTS", newTiDBSet, oldTiDBSet); err != nil {
+			return err
+		}
+	}
+	if resizing || err != nil {
+		return err
+	}
+
 	if !templateEqual(newTiDBSet, oldTiDBSet) || tc.Status.TiDB.Phase == v1alpha1.UpgradePhase {
 		if err := m.tidbUpgrader.Upgrade(tc, oldTiDBSet, newTiDBSet); err != nil {
 			return err
@@ -603,6 +627,10 @@ func getTiDBConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 	if tc.Spec.TiDB.IsBootstrapSQLEnabled() {
 		config.Set("initialize-sql-file", path.Join(bootstrapSQLFilePath, bootstrapSQLFileName))
 	}
+	// TiDB sends the queries to the compute nodes of the disaggregated TiFlash
+	if tc.TiFlashDisaggregated() {
+		config.SetIfNil("disaggregated-tiflash", true)
+	}
 
 	// `DefaultTiDBServerPort`/`DefaultTiDBStatusPort` may be changed when building the binary
 	if v1alpha1.DefaultTiDBServerPort != int32(4000) {
@@ -148,6 +148,10 @@ func (s *tidbScaler) scaleInOne(tc *v1alpha1.TidbCluster, ordinal int32, scaleIn
 		return fmt.Errorf("tidbScaler.ScaleIn: failed to get pods %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
 	}
 
+	if err := moveTiDBDDLOwnerAway(s.deps, tc, ordinal, operationReasonScaleIn); err != nil {
+		return err
+	}
+
 	pvcs, err := util.ResolvePVCFromPod(pod, s.deps.PVCLister)
 	if err != nil && !errors.IsNotFound(err) {
 		return fmt.Errorf("tidbScaler.ScaleIn: failed to get pvcs for pod %s/%s in tc %s/%s, error: %s", ns, pod.Name, ns, tcName, err)
@@ -21,10 +21,12 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
+	"github.com/pingcap/tidb-operator/pkg/util"
 
 	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
 	apps "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
@@ -80,7 +82,8 @@ func (u *tidbUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSe
 		return nil
 	}
 
-	if oldSet.Spec.UpdateStrategy.Type == apps.OnDeleteStatefulSetStrategyType || oldSet.Spec.UpdateStrategy.RollingUpdate == nil {
+	if (oldSet.Spec.UpdateStrategy.Type == apps.OnDeleteStatefulSetStrategyType || oldSet.Spec.UpdateStrategy.RollingUpdate == nil) &&
+		!isOnDeleteUpgradeSet(oldSet) {
 		// Manually bypass tidb-operator to modify statefulset directly, such as modify tidb statefulset's RollingUpdate strategy to OnDelete strategy,
 		// or set RollingUpdate to nil, skip tidb-operator's rolling update logic in order to speed up the upgrade in the test environment occasionally.
 		// If we encounter this situation, we will let the native statefulset controller do the upgrade completely, which may be unsafe for upgrading tidb.
@@ -101,12 +104,23 @@ func (u *tidbUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSe
 		}
 	}
 
-	mngerutils.SetUpgradePartition(newSet, *oldSet.Spec.UpdateStrategy.RollingUpdate.Partition)
+	if isOnDeleteUpgradeSet(oldSet) {
+		beginOnDeleteUpgrade(newSet)
+	} else {
+		mngerutils.SetUpgradePartition(newSet, upgradePartition(oldSet, newSet))
+	}
+
+	// the pods not upgraded yet, from the highest ordinal to the lowest one
+	var pending []*corev1.Pod
+	upgraded := map[string]bool{}
 	podOrdinals := helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet).List()
 	for _i := len(podOrdinals) - 1; _i >= 0; _i-- {
 		i := podOrdinals[_i]
 		podName := tidbPodName(tcName, i)
 		pod, err := u.deps.PodLister.Pods(ns).Get(podName)
+		if errors.IsNotFound(err) && isOnDeleteUpgradeSet(oldSet) {
+			return controller.RequeueErrorf("tidbcluster: [%s/%s]'s tidb pod: [%s] is being recreated", ns, tcName, podName)
+		}
 		if err != nil {
 			return fmt.Errorf("tidbUpgrader.Upgrade: failed to get pods %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
 		}
@@ -127,12 +141,44 @@ func (u *tidbUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSe
 			if member, exist := tc.Status.TiDB.Members[podName]; !exist || !member.Health {
 				return controller.RequeueErrorf("tidbcluster: [%s/%s]'s tidb upgraded pod: [%s] is not ready", ns, tcName, podName)
 			}
+			upgraded[podName] = true
 			continue
 		}
-		return u.upgradeTiDBPod(tc, i, newSet)
+		pending = append(pending, pod)
+	}
+	if len(pending) == 0 {
+		if isOnDeleteUpgradeSet(oldSet) {
+			endOnDeleteUpgrade(newSet)
+		}
+		return nil
 	}
 
-	return nil
+	// the DDL owner is upgraded last, so that it's moved only once and only to an upgraded TiDB
+	ownerOrdinal, ownerFound, err := getTiDBDDLOwnerOrdinal(u.deps, tc)
+	if err != nil {
+		klog.Warningf("tidbcluster: [%s/%s], failed to get the DDL owner, upgrade tidb pods by ordinals: %v", ns, tcName, err)
+		ownerFound = false
+	}
+	next := 0
+	if ownerFound && len(pending) > 1 && pending[0].Name == tidbPodName(tcName, ownerOrdinal) {
+		next = 1
+	}
+	pod := pending[next]
+	ordinal, err := util.GetOrdinalFromPodName(pod.Name)
+	if err != nil {
+		return err
+	}
+	if ownerFound && ordinal == ownerOrdinal {
+		canTakeOver := func(podName string) bool { return upgraded[podName] }
+		if err := resignTiDBDDLOwner(u.deps, tc, ordinal, operationReasonUpgrade, canTakeOver); err != nil {
+			return err
+		}
+	}
+	if next == 0 && !isOnDeleteUpgradeSet(oldSet) {
+		return u.upgradeTiDBPod(tc, ordinal, newSet)
+	}
+	// the partition can't upgrade a pod before the ones with higher ordinals, recreate it with the OnDelete strategy
+	return u.recreateTiDBPod(tc, oldSet, newSet, pod)
 }
 
 func (u *tidbUpgrader) upgradeTiDBPod(tc *v1alpha1.TidbCluster, ordinal int32, newSet *apps.StatefulSet) error {
@@ -140,6 +186,25 @@ func (u *tidbUpgrader) upgradeTiDBPod(tc *v1alpha1.TidbCluster, ordinal int32, n
 	return nil
 }
 
+// recreateTiDBPod deletes the pod once the OnDelete strategy is applied to the StatefulSet, so that it's
+// recreated with the update revision while the other pods are kept.
+func (u *tidbUpgrader) recreateTiDBPod(tc *v1alpha1.TidbCluster, oldSet, newSet *apps.StatefulSet, pod *corev1.Pod) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+
+	beginOnDeleteUpgrade(newSet)
+	if !isOnDeleteUpgradeSet(oldSet) {
+		// the pod deleted before the strategy is applied would be recreated with the current revision
+		return nil
+	}
+	if pod.DeletionTimestamp == nil {
+		if err := u.deps.PodControl.DeletePod(tc, pod); err != nil {
+			return fmt.Errorf("tidbUpgrader.Upgrade: failed to delete pod %s for cluster %s/%s, error: %s", pod.Name, ns, tcName, err)
+		}
+	}
+	return controller.RequeueErrorf("tidbcluster: [%s/%s]'s tidb pod: [%s] is being upgraded", ns, tcName, pod.Name)
+}
+
 type fakeTiDBUpgrader struct{}
 
 // NewFakeTiDBUpgrader returns a fake tidb upgrader
@@ -395,3 +395,88 @@ func getTiDBPods() []*corev1.Pod {
 	}
 	return pods
 }
+
+func TestTiDBUpgrader_UpgradeDDLOwnerLast(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	upgrader, tidbControl, podInformer := newTiDBUpgrader()
+	podIndexer := podInformer.Informer().GetIndexer()
+	tc := newTidbClusterForTiDBUpgrader()
+	tc.Spec.TiDB.Replicas = 3
+	tc.Status.TiDB.StatefulSet.CurrentReplicas = 3
+	tc.Status.TiDB.StatefulSet.UpdatedReplicas = 0
+	tc.Status.TiDB.Members[tidbPodName(upgradeTcName, 2)] = v1alpha1.TiDBMember{Name: tidbPodName(upgradeTcName, 2), Health: true}
+	// the DDL owner has the highest ordinal
+	tidbControl.SetClusterServerInfo(newClusterServerInfoForTest("upgrader-tidb-2.upgrader-tidb-peer.default.svc"))
+
+	newPod := func(ordinal int32, revision string) *corev1.Pod {
+		pod := getTiDBPods()[0].DeepCopy()
+		pod.Name = tidbPodName(upgradeTcName, ordinal)
+		pod.Labels[apps.ControllerRevisionHashLabelKey] = revision
+		return pod
+	}
+	for i := int32(0); i < 3; i++ {
+		g.Expect(podIndexer.Add(newPod(i, "1"))).To(Succeed())
+	}
+	exists := func(ordinal int32) bool {
+		_, exist, err := podIndexer.GetByKey(tc.Namespace + "/" + tidbPodName(upgradeTcName, ordinal))
+		g.Expect(err).NotTo(HaveOccurred())
+		return exist
+	}
+	recreate := func(ordinal int32) {
+		g.Expect(podIndexer.Add(newPod(ordinal, "2"))).To(Succeed())
+	}
+
+	oldSet := newStatefulSetForTiDBUpgrader()
+	oldSet.Spec.Replicas = pointer.Int32Ptr(3)
+	oldSet.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.Int32Ptr(3)
+	mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)
+	upgrade := func() (*apps.StatefulSet, error) {
+		newSet := oldSet.DeepCopy()
+		err := upgrader.Upgrade(tc, oldSet, newSet)
+		return newSet, err
+	}
+
+	// the partition can't skip the owner, set the OnDelete strategy first
+	newSet, err := upgrade()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(isOnDeleteUpgradeSet(newSet)).To(BeTrue())
+	g.Expect(exists(1)).To(BeTrue())
+
+	// upgrade the pods other than the owner from the highest ordinal
+	oldSet = newSet
+	_, err = upgrade()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(exists(1)).To(BeFalse())
+	g.Expect(exists(2)).To(BeTrue())
+
+	_, err = upgrade()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+
+	recreate(1)
+	_, err = upgrade()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(exists(0)).To(BeFalse())
+	g.Expect(exists(2)).To(BeTrue())
+	g.Expect(tidbControl.GetResignedOrdinals()).To(BeEmpty())
+
+	// resign the owner after the other pods are upgraded
+	recreate(0)
+	_, err = upgrade()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(tidbControl.GetResignedOrdinals()).To(Equal([]int32{2}))
+	g.Expect(exists(2)).To(BeTrue())
+
+	tidbControl.SetClusterServerInfo(newClusterServerInfoForTest("upgrader-tidb-0.upgrader-tidb-peer.default.svc"))
+	_, err = upgrade()
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(exists(2)).To(BeFalse())
+
+	// restore the RollingUpdate strategy after all the pods are upgraded
+	recreate(2)
+	newSet, err = upgrade()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(newSet.Spec.UpdateStrategy.Type).To(Equal(apps.RollingUpdateStatefulSetStrategyType))
+	g.Expect(*newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(int32(0)))
+	g.Expect(newSet.Annotations).NotTo(HaveKey(annoKeyOnDeleteUpgrade))
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+)
+
+// syncTiFlashCompute syncs the TiFlash compute nodes of the disaggregated TiFlash in the same way as the
+// write nodes. The compute nodes are scaled in if they are removed from the spec.
+func (m *tiflashMemberManager) syncTiFlashCompute(tc *v1alpha1.TidbCluster) error {
+	if !tc.TiFlashDisaggregated() || tc.Spec.TiFlash.Disaggregated.Compute == nil {
+		if tc.Status.TiFlashCompute == nil {
+			return nil
+		}
+		return m.scaleInRemovedTiFlashCompute(tc)
+	}
+	// the compute nodes join the PD of the cluster, wait for it in the same way as the write nodes
+	if tc.Spec.PD != nil && !tc.PDIsAvailable() {
+		return controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for PD cluster running", tc.GetNamespace(), tc.GetName())
+	}
+
+	ctc := tc.TiFlashComputeCluster()
+	if err := checkSubClusterName(m.deps, ctc); err != nil {
+		return fmt.Errorf("TiFlash compute nodes: %w", err)
+	}
+	err := m.syncTiFlash(ctc)
+	tc.Status.TiFlashCompute = &ctc.Status.TiFlash
+	tc.MergeOperations(ctc)
+	if err != nil {
+		metrics.ClusterUpdateErrors.WithLabelValues(tc.GetNamespace(), tc.GetName(), "tiflash-compute").Inc()
+		return fmt.Errorf("TiFlash compute nodes: %w", err)
+	}
+	return nil
+}
+
+// scaleInRemovedTiFlashCompute scales in all the TiFlash compute nodes, the status of the compute nodes
+// is removed after all the pods are deleted.
+func (m *tiflashMemberManager) scaleInRemovedTiFlashCompute(tc *v1alpha1.TidbCluster) error {
+	ctc := tc.DeepCopy()
+	ctc.Spec.TiFlash = &v1alpha1.TiFlashSpec{Disaggregated: &v1alpha1.TiFlashDisaggregatedSpec{}}
+	ctc = ctc.TiFlashComputeCluster()
+	if err := checkSubClusterName(m.deps, ctc); err != nil {
+		return err
+	}
+	ns := ctc.GetNamespace()
+	setName := controller.TiFlashMemberName(ctc.GetName())
+
+	oldSetTmp, err := m.deps.StatefulSetLister.StatefulSets(ns).Get(setName)
+	if errors.IsNotFound(err) {
+		tc.Status.TiFlashCompute = nil
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("scaleInRemovedTiFlashCompute: failed to get sts %s for cluster %s/%s, error: %s", setName, ns, tc.GetName(), err)
+	}
+	if oldSetTmp.Status.Replicas == 0 && *oldSetTmp.Spec.Replicas == 0 {
+		klog.Infof("TidbCluster: [%s/%s], all the TiFlash compute nodes are deleted", ns, tc.GetName())
+		tc.Status.TiFlashCompute = nil
+		return nil
+	}
+
+	oldSet := oldSetTmp.DeepCopy()
+	newSet := oldSetTmp.DeepCopy()
+	*newSet.Spec.Replicas = 0
+	err = m.scaler.Scale(ctc, oldSet, newSet)
+	tc.Status.TiFlashCompute = &ctc.Status.TiFlash
+	tc.MergeOperations(ctc)
+	if err != nil {
+		return err
+	}
+	return mngerutils.UpdateStatefulSetWithPrecheck(m.deps, ctc, "FailedUpdateTiFlashSTS", newSet, oldSet)
+}
@@ -0,0 +1,217 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func newTidbClusterForTiFlashCompute() *v1alpha1.TidbCluster {
+	tc := newTidbClusterForTiflash()
+	tc.Status.PD.Members = map[string]v1alpha1.PDMember{
+		"pd-0": {Name: "pd-0", Health: true},
+		"pd-1": {Name: "pd-1", Health: true},
+		"pd-2": {Name: "pd-2", Health: true},
+	}
+	tc.Status.PD.StatefulSet = &apps.StatefulSetStatus{ReadyReplicas: 3}
+	tc.Spec.TiFlash.Disaggregated = &v1alpha1.TiFlashDisaggregatedSpec{
+		S3: v1alpha1.TiFlashS3Storage{
+			Endpoint:   "http://minio:9000",
+			Bucket:     "tiflash",
+			Root:       "/test",
+			SecretName: "s3-secret",
+		},
+		Compute: &v1alpha1.TiFlashComputeSpec{
+			ComponentSpec: v1alpha1.ComponentSpec{
+				Image: "tiflash-compute-image",
+			},
+			ResourceRequirements: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("8"),
+				},
+			},
+			Replicas:      2,
+			CacheCapacity: resource.NewQuantity(100<<30, resource.BinarySI),
+		},
+	}
+	return tc
+}
+
+func TestTiFlashMemberManagerSyncTiFlashCompute(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiFlashCompute()
+	ns := tc.GetNamespace()
+	computeSetName := controller.TiFlashMemberName(tc.GetName() + "-compute")
+
+	tfmm, _, _, pdClient, _, _ := newFakeTiFlashMemberManager(tc)
+	pdClient.AddReaction(pdapi.GetConfigActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.PDConfigFromAPI{Replication: &pdapi.PDReplicationConfig{EnablePlacementRules: pointer.BoolPtr(true)}}, nil
+	})
+	pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.StoresInfo{}, nil
+	})
+	pdClient.AddReaction(pdapi.GetTombStoneStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.StoresInfo{}, nil
+	})
+	g.Expect(tfmm.Sync(tc)).To(Succeed())
+
+	// the write nodes keep the persistent volumes
+	set, err := tfmm.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiFlashMemberName(tc.GetName()))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(set.Spec.VolumeClaimTemplates).To(HaveLen(1))
+	g.Expect(set.Spec.Template.Spec.Containers[0].Env).To(ContainElement(HaveField("Name", "S3_ACCESS_KEY_ID")))
+
+	// the compute nodes have no persistent volumes
+	set, err = tfmm.deps.StatefulSetLister.StatefulSets(ns).Get(computeSetName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*set.Spec.Replicas).To(Equal(int32(2)))
+	g.Expect(set.Spec.VolumeClaimTemplates).To(BeEmpty())
+	g.Expect(set.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+		Name:         "data0",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}))
+	container := set.Spec.Template.Spec.Containers[0]
+	g.Expect(container.Image).To(Equal("tiflash-compute-image"))
+	g.Expect(container.Env).To(ContainElement(HaveField("Name", "S3_SECRET_ACCESS_KEY")))
+	g.Expect(set.Spec.Template.Labels).To(HaveKeyWithValue(label.InstanceLabelKey, "test-compute"))
+	g.Expect(set.Spec.Template.Labels).To(HaveKeyWithValue(label.TiFlashRoleLabelKey, label.TiFlashComputeRoleLabelVal))
+	g.Expect(metav1.GetControllerOf(set).Name).To(Equal("test"))
+	g.Expect(tc.Status.TiFlashCompute).NotTo(BeNil())
+	g.Expect(tc.Status.TiFlashCompute.StatefulSet).NotTo(BeNil())
+
+	// scale in the removed compute nodes
+	tc.Spec.TiFlash.Disaggregated.Compute = nil
+	for i := 0; i < 2; i++ {
+		g.Expect(tfmm.Sync(tc)).To(Succeed())
+		g.Expect(tc.Status.TiFlashCompute).NotTo(BeNil())
+	}
+	set, err = tfmm.deps.StatefulSetLister.StatefulSets(ns).Get(computeSetName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*set.Spec.Replicas).To(Equal(int32(0)))
+
+	// the status of the compute nodes is removed after all the pods are deleted
+	g.Expect(tfmm.Sync(tc)).To(Succeed())
+	g.Expect(tc.Status.TiFlashCompute).To(BeNil())
+}
+
+func TestTiFlashComputeCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiFlashCompute()
+	tc.Spec.TiFlash.BaseImage = "pingcap/tiflash"
+	tc.Status.TiFlashCompute = &v1alpha1.TiFlashStatus{Phase: v1alpha1.ScalePhase}
+
+	ctc := tc.TiFlashComputeCluster()
+	g.Expect(ctc.Name).To(Equal("test-compute"))
+	g.Expect(ctc.GetOwnerName()).To(Equal("test"))
+	g.Expect(ctc.IsTiFlashCompute()).To(BeTrue())
+	g.Expect(tc.IsTiFlashCompute()).To(BeFalse())
+	g.Expect(ctc.Spec.Cluster.Name).To(Equal("test"))
+	g.Expect(ctc.Spec.TiFlash.Replicas).To(Equal(int32(2)))
+	g.Expect(ctc.Spec.TiFlash.StorageClaims).To(BeEmpty())
+	g.Expect(ctc.TiFlashImage()).To(Equal("tiflash-compute-image"))
+	g.Expect(ctc.Status.TiFlash.Phase).To(Equal(v1alpha1.ScalePhase))
+	g.Expect(ctc.Status.TiFlashCompute).To(BeNil())
+
+	// the image of the write nodes is used by default
+	tc.Spec.TiFlash.Disaggregated.Compute.Image = ""
+	g.Expect(tc.TiFlashComputeCluster().TiFlashImage()).To(Equal(tc.TiFlashImage()))
+}
+
+func TestGetTiFlashConfigDisaggregated(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiFlashCompute()
+	for _, version := range []string{"v7.5.0", "latest"} {
+		tc.Spec.Version = version
+		tc.Spec.TiFlash.BaseImage = "pingcap/tiflash"
+		config := GetTiFlashConfig(tc)
+		g.Expect(config.Common.Get("flash.disaggregated_mode").MustString()).To(Equal("tiflash_write"))
+		g.Expect(config.Common.Get("storage.s3.bucket").MustString()).To(Equal("tiflash"))
+		g.Expect(config.Common.Get("storage.s3.root").MustString()).To(Equal("/test"))
+		g.Expect(config.Common.Get("storage.remote.cache.dir")).To(BeNil())
+
+		ctc := tc.TiFlashComputeCluster()
+		config = GetTiFlashConfig(ctc)
+		g.Expect(config.Common.Get("flash.disaggregated_mode").MustString()).To(Equal("tiflash_compute"))
+		g.Expect(config.Common.Get("flash.use_autoscaler").Interface()).To(Equal(false))
+		g.Expect(config.Common.Get("storage.s3.endpoint").MustString()).To(Equal("http://minio:9000"))
+		g.Expect(config.Common.Get("storage.remote.cache.dir").MustString()).To(Equal("/data0/cache"))
+		g.Expect(config.Common.Get("storage.remote.cache.capacity").MustInt()).To(Equal(int64(100 << 30)))
+	}
+}
+
+func TestTiFlashScalerScaleInCompute(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scaler, pdControl, _, podIndexer, _ := newFakeTiFlashScaler()
+	ctc := newTidbClusterForTiFlashCompute().TiFlashComputeCluster()
+	podName := ordinalPodName(v1alpha1.TiFlashMemberType, ctc.Name, 2)
+	// the store of the compute node is down
+	ctc.Status.TiFlash.Stores = map[string]v1alpha1.TiKVStore{
+		"12": {ID: "12", PodName: podName, State: v1alpha1.TiKVStateDown},
+	}
+	g.Expect(podIndexer.Add(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: ctc.Namespace, Labels: map[string]string{label.StoreIDLabelKey: "12"}},
+	})).To(Succeed())
+	oldSet := newStatefulSetForPDScale()
+	oldSet.Name = controller.TiFlashMemberName(ctc.Name)
+	oldSet.Spec.Replicas = pointer.Int32Ptr(3)
+	newSet := oldSet.DeepCopy()
+	newSet.Spec.Replicas = pointer.Int32Ptr(1)
+
+	// the store is deleted in PD before the pod is removed
+	var deleted []uint64
+	pdClient := controller.NewFakePDClient(pdControl, ctc)
+	pdClient.AddReaction(pdapi.DeleteStoreActionType, func(action *pdapi.Action) (interface{}, error) {
+		deleted = append(deleted, action.ID)
+		return nil, nil
+	})
+	err := scaler.Scale(ctc, oldSet, newSet)
+	g.Expect(err).To(MatchError(ContainSubstring("is still in cluster")))
+	g.Expect(deleted).To(Equal([]uint64{12}))
+	g.Expect(*newSet.Spec.Replicas).To(Equal(int32(3)))
+	g.Expect(ctc.Status.Operations).To(HaveLen(1))
+	g.Expect(ctc.Status.Operations[0].Result).To(Equal(v1alpha1.OperationRunning))
+
+	// the pod is removed once the store is tombstone
+	ctc.Status.TiFlash.TombstoneStores = map[string]v1alpha1.TiKVStore{
+		"12": {ID: "12", PodName: podName, State: v1alpha1.TiKVStateTombstone},
+	}
+	ctc.Status.TiFlash.Stores = nil
+	newSet.Spec.Replicas = pointer.Int32Ptr(1)
+	g.Expect(scaler.Scale(ctc, oldSet, newSet)).To(Succeed())
+	g.Expect(*newSet.Spec.Replicas).To(Equal(int32(2)))
+	g.Expect(ctc.Status.Operations[0].Result).To(Equal(v1alpha1.OperationSucceeded))
+
+	// the compute node which has never joined the cluster is removed directly
+	oldSet.Spec.Replicas = pointer.Int32Ptr(2)
+	newSet = oldSet.DeepCopy()
+	newSet.Spec.Replicas = pointer.Int32Ptr(1)
+	g.Expect(scaler.Scale(ctc, oldSet, newSet)).To(Succeed())
+	g.Expect(*newSet.Spec.Replicas).To(Equal(int32(1)))
+	g.Expect(deleted).To(HaveLen(1))
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes"
+	apps "k8s.io/api/apps/v1"
+	"k8s.io/klog/v2"
+)
+
+// syncTiFlashInstances syncs the TiFlash instances managed without StatefulSet in the same way as TiKV
+func (m *tiflashMemberManager) syncTiFlashInstances(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSet) error {
+	im := newInstanceManager(m.deps, v1alpha1.TiFlashMemberType)
+	if oldSet != nil {
+		return im.Adopt(tc, oldSet)
+	}
+
+	if tc.Status.TiFlash.StatefulSet != nil {
+		if err := m.syncTiFlashStores(tc); err != nil {
+			return err
+		}
+		if err := volumes.SyncVolumeStatus(m.podVolumeModifier, m.deps.PodLister, tc, v1alpha1.TiFlashMemberType); err != nil {
+			return fmt.Errorf("failed to sync volume status for tiflash: %v", err)
+		}
+	}
+
+	if tc.Spec.Paused {
+		klog.V(4).Infof("tiflash cluster %s/%s is paused, skip syncing for tiflash instances", tc.GetNamespace(), tc.GetName())
+		return nil
+	}
+	if !tc.PDIsAvailable() && tc.Status.TiFlash.StatefulSet == nil {
+		klog.Infof("TidbCluster: %s/%s, waiting for PD cluster running", tc.GetNamespace(), tc.GetName())
+		return nil
+	}
+
+	cm, err := m.syncConfigMap(tc, nil)
+	if err != nil {
+		return err
+	}
+	blueprint, err := getNewStatefulSet(tc, cm)
+	if err != nil {
+		return err
+	}
+	tc.Status.TiFlash.Image = ""
+	if c := findContainerByName(blueprint, "tiflash"); c != nil {
+		tc.Status.TiFlash.Image = c.Image
+	}
+
+	if tc.Status.TiFlash.StatefulSet != nil {
+		if _, err := m.setStoreLabelsForTiFlash(tc); err != nil {
+			return err
+		}
+	}
+	return im.Sync(tc, blueprint)
+}
@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
@@ -35,6 +36,7 @@ import (
 	apps "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/uuid"
@@ -48,6 +50,10 @@ const (
 	tiflashStoreLimitPattern = `%s-tiflash-\d+\.%s-tiflash-peer\.%s\.svc%s:\d+`
 	tiflashCertPath          = "/var/lib/tiflash-tls"
 	tiflashCertVolumeName    = "tiflash-tls"
+	// the keys of the S3 access key and secret key in the secret of the disaggregated TiFlash, which
+	// are the same as the keys of the secret of backups
+	tiflashS3AccessKey = "access_key"
+	tiflashS3SecretKey = "secret_key"
 )
 
 // tiflashMemberManager implements manager.Manager.
@@ -77,6 +83,19 @@ func NewTiFlashMemberManager(deps *controller.Dependencies, tiflashFailover Fail
 
 // Sync fulfills the manager.Manager interface
 func (m *tiflashMemberManager) Sync(tc *v1alpha1.TidbCluster) error {
+	err := m.syncTiFlash(tc)
+	// the compute nodes are synced even if the write nodes are not synced, e.g. in the middle of upgrading
+	if computeErr := m.syncTiFlashCompute(tc); computeErr != nil {
+		if err != nil {
+			klog.Errorf("TidbCluster: [%s/%s], failed to sync TiFlash compute nodes: %v", tc.GetNamespace(), tc.GetName(), computeErr)
+			return err
+		}
+		return computeErr
+	}
+	return err
+}
+
+func (m *tiflashMemberManager) syncTiFlash(tc *v1alpha1.TidbCluster) error {
 	if tc.Spec.TiFlash == nil {
 		return nil
 	}
@@ -219,6 +238,10 @@ func (m *tiflashMemberManager) syncStatefulSet(tc *v1alpha1.TidbCluster) error {
 
 	oldSet := oldSetTmp.DeepCopy()
 
+	if tc.Spec.TiFlash.IsInstanceManaged() {
+		return m.syncTiFlashInstances(tc, oldSet)
+	}
+
 	if err := m.syncTidbClusterStatus(tc, oldSet); err != nil {
 		return err
 	}
@@ -285,6 +308,22 @@ func (m *tiflashMemberManager) syncStatefulSet(tc *v1alpha1.TidbCluster) error {
 		}
 	}
 
+	if !templateEqual(newSet, oldSet) {
+		pulled, err := newImagePrePuller(m.deps).Sync(tc, v1alpha1.TiFlashMemberType, oldSet, newSet)
+		if err != nil {
+			return err
+		}
+		if !pulled {
+			// The new images are being pulled on the nodes, so do not make any changes to Sts spec,
+			// overwrite with old pod spec config as we are not ready to upgrade yet.
+			_, podSpec, err := GetLastAppliedConfig(oldSet)
+			if err != nil {
+				return err
+			}
+			newSet.Spec.Template.Spec = *podSpec
+		}
+	}
+
 	if !templateEqual(newSet, oldSet) || tc.Status.TiFlash.Phase == v1alpha1.UpgradePhase {
 		if err := m.upgrader.Upgrade(tc, oldSet, newSet); err != nil {
 			return err
@@ -386,8 +425,11 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 		tiflashConfigMap = cm.Name
 	}
 
+	// The compute nodes of the disaggregated TiFlash have no persistent volumes, the data read from S3 is
+	// cached in an emptyDir volume
+	isCompute := tc.IsTiFlashCompute()
 	// This should not happen as we have validaton for this field
-	if len(spec.StorageClaims) < 1 {
+	if len(spec.StorageClaims) < 1 && !isCompute {
 		return nil, fmt.Errorf("storageClaims should be configured at least one item for tiflash, tidbcluster %s/%s", tc.Namespace, tc.Name)
 	}
 	pvcs, err := flashVolumeClaimTemplate(tc.Spec.TiFlash.StorageClaims)
@@ -402,6 +444,9 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 		volMounts = append(volMounts, corev1.VolumeMount{
 			Name: fmt.Sprintf("data%d", k), MountPath: fmt.Sprintf("/data%d", k)})
 	}
+	if isCompute {
+		volMounts = append(volMounts, corev1.VolumeMount{Name: "data0", MountPath: "/data0"})
+	}
 	volMounts = append(volMounts, tc.Spec.TiFlash.AdditionalVolumeMounts...)
 
 	if tc.IsTLSClusterEnabled() {
@@ -428,6 +473,14 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 		},
 	}
 
+	if isCompute {
+		vols = append(vols, corev1.Volume{
+			Name: "data0", VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+	}
+
 	if tc.IsTLSClusterEnabled() {
 		vols = append(vols, corev1.Volume{
 			Name: tiflashCertVolumeName, VolumeSource: corev1.VolumeSource{
@@ -560,6 +613,10 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 		},
 	}
 
+	if spec.Disaggregated != nil && spec.Disaggregated.S3.SecretName != "" {
+		env = append(env, tiflashS3CredentialEnv(spec.Disaggregated.S3.SecretName)...)
+	}
+
 	startScript, err := startscript.RenderTiFlashStartScript(tc)
 	if err != nil {
 		return nil, fmt.Errorf("render start-script for tc %s/%s failed: %v", tc.Namespace, tc.Name, err)
@@ -677,6 +734,30 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 	return tiflashset, nil
 }
 
+// tiflashS3CredentialEnv returns the environment variables of the S3 credentials read by the disaggregated TiFlash
+func tiflashS3CredentialEnv(secretName string) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{
+			Name: "S3_ACCESS_KEY_ID",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  tiflashS3AccessKey,
+				},
+			},
+		},
+		{
+			Name: "S3_SECRET_ACCESS_KEY",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  tiflashS3SecretKey,
+				},
+			},
+		},
+	}
+}
+
 func flashVolumeClaimTemplate(storageClaims []v1alpha1.StorageClaim) ([]corev1.PersistentVolumeClaim, error) {
 	var pvcs []corev1.PersistentVolumeClaim
 	for k := range storageClaims {
@@ -743,6 +824,11 @@ func (m *tiflashMemberManager) syncTidbClusterStatus(tc *v1alpha1.TidbCluster, s
 	if err != nil {
 		return err
 	}
+	// the next upgrade records its own baseline of the unhealthy regions
+	if !upgrading && tc.Status.TiFlash.Phase == v1alpha1.UpgradePhase {
+		tc.Status.TiFlash.RegionHealthBaseline = nil
+		meta.RemoveStatusCondition(&tc.Status.TiFlash.Conditions, v1alpha1.ConditionTypeUpgradeBlocked)
+	}
 	if tc.TiFlashStsDesiredReplicas() != *set.Spec.Replicas {
 		tc.Status.TiFlash.Phase = v1alpha1.ScalePhase
 	} else if upgrading {
@@ -751,6 +837,25 @@ func (m *tiflashMemberManager) syncTidbClusterStatus(tc *v1alpha1.TidbCluster, s
 		tc.Status.TiFlash.Phase = v1alpha1.NormalPhase
 	}
 
+	if err := m.syncTiFlashStores(tc); err != nil {
+		return err
+	}
+	tc.Status.TiFlash.Image = ""
+	c := findContainerByName(set, "tiflash")
+	if c != nil {
+		tc.Status.TiFlash.Image = c.Image
+	}
+
+	err = volumes.SyncVolumeStatus(m.podVolumeModifier, m.deps.PodLister, tc, v1alpha1.TiFlashMemberType)
+	if err != nil {
+		return fmt.Errorf("failed to sync volume status for tiflash: %v", err)
+	}
+
+	return nil
+}
+
+// syncTiFlashStores syncs the stores of the TiFlash cluster from PD
+func (m *tiflashMemberManager) syncTiFlashStores(tc *v1alpha1.TidbCluster) error {
 	previousStores := tc.Status.TiFlash.Stores
 	previousPeerStores := tc.Status.TiFlash.PeerStores
 	previousTombstoneStores := tc.Status.TiFlash.TombstoneStores
@@ -786,6 +891,7 @@ func (m *tiflashMemberManager) syncTidbClusterStatus(tc *v1alpha1.TidbCluster, s
 		if exist && status.State == oldStore.State {
 			status.LastTransitionTime = oldStore.LastTransitionTime
 		}
+		status.DataMigration = getStoreDataMigration(oldStore.DataMigration, store, time.Now())
 
 		if store.Store != nil {
 			if pattern.Match([]byte(store.Store.Address)) {
@@ -824,17 +930,6 @@ func (m *tiflashMemberManager) syncTidbClusterStatus(tc *v1alpha1.TidbCluster, s
 	tc.Status.TiFlash.Stores = stores
 	tc.Status.TiFlash.PeerStores = peerStores
 	tc.Status.TiFlash.TombstoneStores = tombstoneStores
-	tc.Status.TiFlash.Image = ""
-	c := findContainerByName(set, "tiflash")
-	if c != nil {
-		tc.Status.TiFlash.Image = c.Image
-	}
-
-	err = volumes.SyncVolumeStatus(m.podVolumeModifier, m.deps.PodLister, tc, v1alpha1.TiFlashMemberType)
-	if err != nil {
-		return fmt.Errorf("failed to sync volume status for tiflash: %v", err)
-	}
-
 	return nil
 }
 
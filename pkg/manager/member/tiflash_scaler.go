@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
 	apps "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	errorutils "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -98,10 +99,26 @@ func (s *tiflashScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, ne
 		return nil
 	}
 
+	if tc.IsTiFlashCompute() {
+		return s.scaleInCompute(tc, oldSet, newSet)
+	}
+
+	// choose the stores to remove by delete slots before scaling in if needed
+	if err := newStoreScaleInSelector(s.deps, v1alpha1.TiFlashMemberType).Select(tc, oldSet, newSet); err != nil {
+		resetReplicas(newSet, oldSet)
+		return err
+	}
+
 	scaleInParallelism := tc.Spec.TiFlash.GetScaleInParallelism()
 	_, ordinals, replicas, deleteSlots := scaleMulti(oldSet, newSet, scaleInParallelism)
 	klog.Infof("scaling in tiflash statefulset %s/%s, ordinal: %v (replicas: %d, delete slots: %v), scaleInParallelism: %v", oldSet.Namespace, oldSet.Name, ordinals, replicas, deleteSlots.List(), scaleInParallelism)
 
+	// make sure the remaining stores can hold the data of the stores to be removed
+	if err := newStoreCapacityChecker(s.deps, v1alpha1.TiFlashMemberType).Check(tc, ordinals); err != nil {
+		resetReplicas(newSet, oldSet)
+		return err
+	}
+
 	var (
 		errs                         []error
 		finishedOrdinals             = sets.NewInt32()
@@ -128,6 +145,81 @@ func (s *tiflashScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, ne
 	return errorutils.NewAggregate(errs)
 }
 
+// scaleInCompute scales in the compute nodes of the disaggregated TiFlash. The compute nodes hold no data, so
+// their stores are deleted in PD without checking the capacity, and the pods are removed once the stores
+// become tombstone, so that no Down store is left behind in PD.
+func (s *tiflashScaler) scaleInCompute(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSet, newSet *apps.StatefulSet) error {
+	scaleInParallelism := tc.Spec.TiFlash.GetScaleInParallelism()
+	_, ordinals, replicas, deleteSlots := scaleMulti(oldSet, newSet, scaleInParallelism)
+	klog.Infof("scaling in tiflash compute statefulset %s/%s, ordinal: %v (replicas: %d, delete slots: %v), scaleInParallelism: %v", oldSet.Namespace, oldSet.Name, ordinals, replicas, deleteSlots.List(), scaleInParallelism)
+
+	var errs []error
+	finishedOrdinals := sets.NewInt32()
+	for _, ordinal := range ordinals {
+		if err := s.scaleInComputeOne(tc, ordinal); err != nil {
+			errs = append(errs, err)
+		} else {
+			finishedOrdinals.Insert(ordinal)
+		}
+	}
+
+	if finishedOrdinals.Len() != 0 {
+		setReplicasAndDeleteSlotsByFinished(scalingInFlag, newSet, oldSet, ordinals, finishedOrdinals)
+	} else {
+		resetReplicas(newSet, oldSet)
+	}
+	return errorutils.NewAggregate(errs)
+}
+
+// scaleInComputeOne deletes the store of the compute node in PD, it returns nil once the store is tombstone or
+// the compute node has never joined the cluster. The compute nodes have no PVCs to be deferred deleting.
+func (s *tiflashScaler) scaleInComputeOne(tc *v1alpha1.TidbCluster, ordinal int32) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	podName := ordinalPodName(v1alpha1.TiFlashMemberType, tcName, ordinal)
+	pod, err := s.deps.PodLister.Pods(ns).Get(podName)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("tiflashScaler.ScaleIn: failed to get pods %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
+	}
+
+	for _, store := range tc.Status.TiFlash.Stores {
+		if store.PodName == podName {
+			id, err := strconv.ParseUint(store.ID, 10, 64)
+			if err != nil {
+				return err
+			}
+			if store.State != v1alpha1.TiKVStateOffline {
+				if err := controller.GetPDClient(s.deps.PDControl, tc).DeleteStore(id); err != nil {
+					klog.Errorf("tiflash compute scale in: failed to delete store %d, %v", id, err)
+					return err
+				}
+				klog.Infof("tiflash compute scale in: delete store %d for tiflash %s/%s successfully", id, ns, podName)
+				tc.StartOperation(storeOperation(v1alpha1.OperationDeleteStore, v1alpha1.TiFlashMemberType, operationReasonScaleIn, store.ID, podName))
+			}
+			return controller.RequeueErrorf("TiFlash compute %s/%s store %d is still in cluster, state: %s", ns, podName, id, store.State)
+		}
+	}
+	for id, store := range tc.Status.TiFlash.TombstoneStores {
+		if store.PodName == podName && (pod == nil || pod.Labels[label.StoreIDLabelKey] == id) {
+			klog.Infof("TiFlash compute %s/%s store %s becomes tombstone", ns, podName, id)
+			tc.FinishOperation(storeOperation(v1alpha1.OperationDeleteStore, v1alpha1.TiFlashMemberType, operationReasonScaleIn, store.ID, podName), nil)
+			return nil
+		}
+	}
+
+	// the compute node has never joined the cluster if the pod is not found or not ready for long without a
+	// store, see scaleInOne
+	if pod == nil {
+		return nil
+	}
+	if !k8s.IsPodReady(pod) && time.Now().After(pod.CreationTimestamp.Add(5*s.deps.CLIConfig.ResyncDuration)) {
+		klog.Infof("Pod %s/%s not ready for more than %v and no store for it, scale in it",
+			ns, podName, 5*s.deps.CLIConfig.ResyncDuration)
+		return nil
+	}
+	return controller.RequeueErrorf("tiflash compute %s/%s no store found in cluster", ns, podName)
+}
+
 func (s *tiflashScaler) scaleInOne(tc *v1alpha1.TidbCluster, ordinal int32) error {
 	ns := tc.GetNamespace()
 	tcName := tc.GetName()
@@ -150,6 +242,7 @@ func (s *tiflashScaler) scaleInOne(tc *v1alpha1.TidbCluster, ordinal int32) erro
 					return err
 				}
 				klog.Infof("tiflash scale in: delete store %d for tiflash %s/%s successfully", id, ns, podName)
+				tc.StartOperation(storeOperation(v1alpha1.OperationDeleteStore, v1alpha1.TiFlashMemberType, operationReasonScaleIn, store.ID, podName))
 			}
 			return controller.RequeueErrorf("TiFlash %s/%s store %d is still in cluster, state: %s", ns, podName, id, state)
 		}
@@ -163,6 +256,7 @@ func (s *tiflashScaler) scaleInOne(tc *v1alpha1.TidbCluster, ordinal int32) erro
 
 			// TODO: double check if store is really not in Up/Offline/Down state
 			klog.Infof("TiFlash %s/%s store %d becomes tombstone", ns, podName, id)
+			tc.FinishOperation(storeOperation(v1alpha1.OperationDeleteStore, v1alpha1.TiFlashMemberType, operationReasonScaleIn, store.ID, podName), nil)
 
 			err = s.updateDeferDeletingPVC(tc, v1alpha1.TiFlashMemberType, ordinal)
 			if err != nil {
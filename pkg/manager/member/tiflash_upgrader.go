@@ -16,6 +16,7 @@ package member
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
@@ -27,6 +28,7 @@ import (
 	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
 	apps "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
@@ -80,7 +82,8 @@ func (u *tiflashUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Statefu
 		return nil
 	}
 
-	if oldSet.Spec.UpdateStrategy.Type == apps.OnDeleteStatefulSetStrategyType || oldSet.Spec.UpdateStrategy.RollingUpdate == nil {
+	if (oldSet.Spec.UpdateStrategy.Type == apps.OnDeleteStatefulSetStrategyType || oldSet.Spec.UpdateStrategy.RollingUpdate == nil) &&
+		!isOnDeleteUpgradeSet(oldSet) {
 		// Manually bypass tidb-operator to modify statefulset directly, such as modify tikv statefulset's RollingUpdate strategy to OnDelete strategy,
 		// or set RollingUpdate to nil, skip tidb-operator's rolling update logic in order to speed up the upgrade in the test environment occasionally.
 		// If we encounter this situation, we will let the native statefulset controller do the upgrade completely, which may be unsafe for upgrading tikv.
@@ -90,18 +93,19 @@ func (u *tiflashUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Statefu
 		return nil
 	}
 
-	minReadySeconds := 0
-	s, ok := tc.Annotations[annoKeyTiFlashMinReadySeconds]
-	if ok {
-		i, err := strconv.Atoi(s)
+	if tc.Spec.TiFlash.UpgradePolicy.GetStrategy() == v1alpha1.UpgradeStrategyTopology {
+		topologyKey, err := getTopologyUpgradeKey(u.deps, tc, v1alpha1.TiFlashMemberType, tc.Spec.TiFlash.UpgradePolicy.TopologyKey)
 		if err != nil {
-			klog.Warningf("tidbcluster: [%s/%s] annotation %s should be an integer: %v", ns, tcName, annoKeyTiFlashMinReadySeconds, err)
-		} else {
-			minReadySeconds = i
+			return err
+		}
+		if topologyKey != "" {
+			return u.upgradeByTopology(tc, oldSet, newSet, topologyKey)
 		}
 	}
 
-	mngerutils.SetUpgradePartition(newSet, *oldSet.Spec.UpdateStrategy.RollingUpdate.Partition)
+	minReadySeconds := getTiFlashMinReadySeconds(tc)
+
+	mngerutils.SetUpgradePartition(newSet, upgradePartition(oldSet, newSet))
 	podOrdinals := helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet).List()
 	for _i := len(podOrdinals) - 1; _i >= 0; _i-- {
 		i := podOrdinals[_i]
@@ -121,39 +125,145 @@ func (u *tiflashUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Statefu
 		}
 
 		if revision == tc.Status.TiFlash.StatefulSet.UpdateRevision {
-			if !k8s.IsPodAvailable(pod, int32(minReadySeconds), metav1.Now()) {
-				readyCond := k8s.GetPodReadyCondition(pod.Status)
-				if readyCond == nil || readyCond.Status != corev1.ConditionTrue {
-					return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded tiflash pod: [%s] is not ready", ns, tcName, podName)
-
-				}
-				return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded TiFlash pod: [%s] is not available, last transition time is %v", ns, tcName, podName, readyCond.LastTransitionTime)
-			}
-			if store.State != v1alpha1.TiKVStateUp {
-				return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded TiFlash pod: [%s], store state is not UP", ns, tcName, podName)
+			if err := u.checkUpgradedPod(tc, pod, store, minReadySeconds); err != nil {
+				return err
 			}
+			continue
+		}
 
-			if larger, err := tiflashEqualOrGreaterThanV512.Check(tc.TiFlashVersion()); err == nil && larger {
-				status, err := u.deps.TiFlashControl.GetTiFlashPodClient(tc.Namespace, tc.Name, podName, tc.IsTLSClusterEnabled()).GetStoreStatus()
-				if err != nil {
-					return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded TiFlash pod: [%s], get store status failed: %s", ns, tcName, podName, err)
-				}
+		mngerutils.SetUpgradePartition(newSet, i)
+		return nil
+	}
 
-				if status != tiflashapi.Running {
-					return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded TiFlash pod: [%s], store status is %s instead of Running", ns, tcName, podName, status)
-				}
+	return nil
+}
+
+// upgradeByTopology upgrades the pods in the same topology domain together. The pods in a batch are deleted
+// together to be recreated with the update revision by the OnDelete strategy of the StatefulSet. The next
+// batch is upgraded after the upgraded stores are running and the regions are healthy again.
+func (u *tiflashUpgrader) upgradeByTopology(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSet, newSet *apps.StatefulSet, topologyKey string) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	minReadySeconds := getTiFlashMinReadySeconds(tc)
+
+	if isOnDeleteUpgradeSet(oldSet) {
+		beginOnDeleteUpgrade(newSet)
+	} else {
+		mngerutils.SetUpgradePartition(newSet, upgradePartition(oldSet, newSet))
+	}
+	podOrdinals := helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet).List()
+	pods := make([]*corev1.Pod, 0, len(podOrdinals))
+	podByName := map[string]*corev1.Pod{}
+	for _, i := range podOrdinals {
+		podName := TiFlashPodName(tcName, i)
+		pod, err := u.deps.PodLister.Pods(ns).Get(podName)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return controller.RequeueErrorf("tidbcluster: [%s/%s]'s TiFlash pod: [%s] is not created yet", ns, tcName, podName)
 			}
+			return fmt.Errorf("TiFlashUpgrader.upgradeByTopology: failed to get pods %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
+		}
+		pods = append(pods, pod)
+		podByName[podName] = pod
+	}
+	domains, err := getStoreTopologyDomains(u.deps, tc, v1alpha1.TiFlashMemberType, topologyKey, pods)
+	if err != nil {
+		return err
+	}
 
+	var pending []topologyUpgradePod
+	for idx, pod := range pods {
+		revision, exist := pod.Labels[apps.ControllerRevisionHashLabelKey]
+		if !exist {
+			return controller.RequeueErrorf("tidbcluster: [%s/%s]'s TiFlash pod: [%s] has no label: %s", ns, tcName, pod.Name, apps.ControllerRevisionHashLabelKey)
+		}
+		if revision == tc.Status.TiFlash.StatefulSet.UpdateRevision {
+			if store := getTiFlashStoreByOrdinal(tcName, tc.Status.TiFlash, podOrdinals[idx]); store != nil {
+				if err := u.checkUpgradedPod(tc, pod, store, minReadySeconds); err != nil {
+					return err
+				}
+			}
 			continue
 		}
+		pending = append(pending, topologyUpgradePod{
+			ordinal:   podOrdinals[idx],
+			name:      pod.Name,
+			domain:    domains[pod.Name],
+			upgrading: pod.DeletionTimestamp != nil,
+		})
+	}
 
-		mngerutils.SetUpgradePartition(newSet, i)
+	if len(pending) == 0 {
+		endOnDeleteUpgrade(newSet)
 		return nil
 	}
 
+	batch := nextTopologyUpgradeBatch(pending, tc.Spec.TiFlash.UpgradePolicy.GetMaxParallelism())
+	podNames := topologyUpgradePodNames(batch)
+	if !isTopologyUpgradeBegun(batch) {
+		healthy, err := checkRegionHealthBeforeUpgrade(u.deps, tc, v1alpha1.TiFlashMemberType, strings.Join(podNames, ","))
+		if err != nil {
+			return fmt.Errorf("TiFlashUpgrader.upgradeByTopology: failed to check region health before upgrading pods %v for tc %s/%s, error: %s", podNames, ns, tcName, err)
+		}
+		if !healthy {
+			return controller.RequeueErrorf("TiFlashUpgrader.upgradeByTopology: waiting for regions to be healthy before upgrading pods %v for tc %s/%s", podNames, ns, tcName)
+		}
+		msg := fmt.Sprintf("upgrade TiFlash pods %v in topology domain %s=%q", podNames, topologyKey, batch[0].domain)
+		klog.Infof("TidbCluster: [%s/%s], %s", ns, tcName, msg)
+		u.deps.Recorder.Event(tc, corev1.EventTypeNormal, "TopologyUpgrade", msg)
+	}
+
+	batchPods := make([]*corev1.Pod, 0, len(batch))
+	for _, p := range batch {
+		batchPods = append(batchPods, podByName[p.name])
+	}
+	return upgradeTopologyBatch(u.deps, tc, oldSet, newSet, batch, batchPods)
+}
+
+// checkUpgradedPod returns a requeue error if the upgraded TiFlash pod is not available or its store is not running
+func (u *tiflashUpgrader) checkUpgradedPod(tc *v1alpha1.TidbCluster, pod *corev1.Pod, store *v1alpha1.TiKVStore, minReadySeconds int) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	podName := pod.GetName()
+
+	if !k8s.IsPodAvailable(pod, int32(minReadySeconds), metav1.Now()) {
+		readyCond := k8s.GetPodReadyCondition(pod.Status)
+		if readyCond == nil || readyCond.Status != corev1.ConditionTrue {
+			return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded tiflash pod: [%s] is not ready", ns, tcName, podName)
+
+		}
+		return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded TiFlash pod: [%s] is not available, last transition time is %v", ns, tcName, podName, readyCond.LastTransitionTime)
+	}
+	if store.State != v1alpha1.TiKVStateUp {
+		return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded TiFlash pod: [%s], store state is not UP", ns, tcName, podName)
+	}
+
+	if larger, err := tiflashEqualOrGreaterThanV512.Check(tc.TiFlashVersion()); err == nil && larger {
+		status, err := u.deps.TiFlashControl.GetTiFlashPodClient(tc.Namespace, tc.Name, podName, tc.IsTLSClusterEnabled()).GetStoreStatus()
+		if err != nil {
+			return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded TiFlash pod: [%s], get store status failed: %s", ns, tcName, podName, err)
+		}
+
+		if status != tiflashapi.Running {
+			return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded TiFlash pod: [%s], store status is %s instead of Running", ns, tcName, podName, status)
+		}
+	}
 	return nil
 }
 
+func getTiFlashMinReadySeconds(tc *v1alpha1.TidbCluster) int {
+	s, ok := tc.Annotations[annoKeyTiFlashMinReadySeconds]
+	if !ok {
+		return 0
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		klog.Warningf("tidbcluster: [%s/%s] annotation %s should be an integer: %v", tc.Namespace, tc.Name, annoKeyTiFlashMinReadySeconds, err)
+		return 0
+	}
+	return i
+}
+
 func getTiFlashStoreByOrdinal(name string, status v1alpha1.TiFlashStatus, ordinal int32) *v1alpha1.TiKVStore {
 	podName := TiFlashPodName(name, ordinal)
 	for _, store := range status.Stores {
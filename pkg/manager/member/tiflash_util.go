@@ -112,11 +112,40 @@ func buildSidecarContainer(name, path, image string,
 }
 
 func GetTiFlashConfig(tc *v1alpha1.TidbCluster) *v1alpha1.TiFlashConfigWraper {
+	var config *v1alpha1.TiFlashConfigWraper
 	version := tc.TiFlashVersion()
 	if ok, err := tiflashEqualOrGreaterThanV540.Check(version); err == nil && ok {
-		return getTiFlashConfigV2(tc)
+		config = getTiFlashConfigV2(tc)
+	} else {
+		config = getTiFlashConfig(tc)
+	}
+	if tc.TiFlashDisaggregated() {
+		setTiFlashDisaggregatedConfigDefault(config.Common, tc)
+	}
+	return config
+}
+
+// setTiFlashDisaggregatedConfigDefault sets the S3 storage and the mode of the write or compute nodes
+// of the disaggregated TiFlash
+func setTiFlashDisaggregatedConfigDefault(config *v1alpha1.TiFlashCommonConfigWraper, tc *v1alpha1.TidbCluster) {
+	disaggregated := tc.Spec.TiFlash.Disaggregated
+	config.SetIfNil("storage.s3.endpoint", disaggregated.S3.Endpoint)
+	config.SetIfNil("storage.s3.bucket", disaggregated.S3.Bucket)
+	if disaggregated.S3.Root != "" {
+		config.SetIfNil("storage.s3.root", disaggregated.S3.Root)
+	}
+
+	if !tc.IsTiFlashCompute() {
+		config.SetIfNil("flash.disaggregated_mode", "tiflash_write")
+		return
+	}
+	config.SetIfNil("flash.disaggregated_mode", "tiflash_compute")
+	// the compute nodes are scaled by the operator instead of the autoscaler
+	config.SetIfNil("flash.use_autoscaler", false)
+	config.SetIfNil("storage.remote.cache.dir", "/data0/cache")
+	if disaggregated.Compute != nil && disaggregated.Compute.CacheCapacity != nil {
+		config.SetIfNil("storage.remote.cache.capacity", disaggregated.Compute.CacheCapacity.Value())
 	}
-	return getTiFlashConfig(tc)
 }
 
 func getTiFlashConfigV2(tc *v1alpha1.TidbCluster) *v1alpha1.TiFlashConfigWraper {
@@ -0,0 +1,67 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes"
+	apps "k8s.io/api/apps/v1"
+	"k8s.io/klog/v2"
+)
+
+// syncTiKVInstances syncs the TiKV instances managed without StatefulSet. The StatefulSet of TiKV created in
+// the StatefulSet mode is adopted first. The auto failover is not supported in this mode, the failed instances
+// can be replaced by annotating the pods instead.
+func (m *tikvMemberManager) syncTiKVInstances(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSet) error {
+	im := newInstanceManager(m.deps, v1alpha1.TiKVMemberType)
+	if oldSet != nil {
+		return im.Adopt(tc, oldSet)
+	}
+
+	if tc.Status.TiKV.StatefulSet != nil {
+		if _, err := m.syncTiKVStores(tc); err != nil {
+			return err
+		}
+		if err := volumes.SyncVolumeStatus(m.podVolumeModifier, m.deps.PodLister, tc, v1alpha1.TiKVMemberType); err != nil {
+			return fmt.Errorf("failed to sync volume status for tikv: %v", err)
+		}
+	}
+
+	if tc.Spec.Paused {
+		klog.V(4).Infof("tikv cluster %s/%s is paused, skip syncing for tikv instances", tc.GetNamespace(), tc.GetName())
+		return nil
+	}
+
+	cm, err := m.syncTiKVConfigMap(tc, nil)
+	if err != nil {
+		return err
+	}
+	blueprint, err := getNewTiKVSetForTidbCluster(tc, cm)
+	if err != nil {
+		return err
+	}
+	tc.Status.TiKV.Image = ""
+	if c := findContainerByName(blueprint, "tikv"); c != nil {
+		tc.Status.TiKV.Image = c.Image
+	}
+
+	if tc.Status.TiKV.StatefulSet != nil {
+		if _, err := m.setStoreLabelsForTiKV(tc); err != nil {
+			return err
+		}
+	}
+	return im.Sync(tc, blueprint)
+}
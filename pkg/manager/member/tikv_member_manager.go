@@ -21,6 +21,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
@@ -38,6 +39,7 @@ import (
 	apps "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -91,6 +93,31 @@ type SvcConfig struct {
 
 // Sync fulfills the manager.Manager interface
 func (m *tikvMemberManager) Sync(tc *v1alpha1.TidbCluster) error {
+	err := m.syncTiKV(tc)
+	// the TiKV pools are synced even if `spec.tikv` is not synced, e.g. in the middle of upgrading
+	if poolErr := m.syncTiKVPools(tc); poolErr != nil {
+		if err != nil {
+			klog.Errorf("TidbCluster: [%s/%s], failed to sync TiKV pools: %v", tc.GetNamespace(), tc.GetName(), poolErr)
+		} else {
+			err = poolErr
+		}
+	}
+	if tc.Spec.Paused {
+		return err
+	}
+	// the failed stores of `spec.tikv` and the TiKV pools are removed by one unsafe recovery after the
+	// stores of all of them are synced
+	if recoveryErr := newTiKVUnsafeRecoverer(m.deps).Sync(tc); recoveryErr != nil {
+		if err != nil {
+			klog.Errorf("TidbCluster: [%s/%s], failed to sync TiKV unsafe recovery: %v", tc.GetNamespace(), tc.GetName(), recoveryErr)
+		} else {
+			err = recoveryErr
+		}
+	}
+	return err
+}
+
+func (m *tikvMemberManager) syncTiKV(tc *v1alpha1.TidbCluster) error {
 	// If tikv is not specified return
 	if tc.Spec.TiKV == nil {
 		return nil
@@ -212,6 +239,10 @@ func (m *tikvMemberManager) syncStatefulSetForTidbCluster(tc *v1alpha1.TidbClust
 
 	oldSet := oldSetTmp.DeepCopy()
 
+	if tc.Spec.TiKV.IsInstanceManaged() {
+		return m.syncTiKVInstances(tc, oldSet)
+	}
+
 	if err := m.syncTiKVClusterStatus(tc, oldSet); err != nil {
 		return err
 	}
@@ -257,6 +288,10 @@ func (m *tikvMemberManager) syncStatefulSetForTidbCluster(tc *v1alpha1.TidbClust
 		return err
 	}
 
+	if err := newTiKVScaleOutRebalancer(m.deps).Sync(tc); err != nil {
+		return err
+	}
+
 	// Scaling takes precedence over upgrading because:
 	// - if a store fails in the upgrading, users may want to delete it or add
 	//   new replicas
@@ -287,6 +322,33 @@ func (m *tikvMemberManager) syncStatefulSetForTidbCluster(tc *v1alpha1.TidbClust
 		newSet.Spec.Template.Spec = *podSpec
 	}
 
+	resizing, err := newInPlaceResizer(m.deps).Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+	if resizing {
+		// The pods are being resized in place, apply the new template without restarting the pods.
+		if err := mngerutils.UpdateStatefulSetWithPrecheck(m.deps, tc, "FailedUpdateTiKVSTS", newSet, oldSet); err != nil {
+			return err
+		}
+	}
+	if resizing || err != nil {
+		return err
+	}
+
+	if !templateEqual(newSet, oldSet) {
+		pulled, err := newImagePrePuller(m.deps).Sync(tc, v1alpha1.TiKVMemberType, oldSet, newSet)
+		if err != nil {
+			return err
+		}
+		if !pulled {
+			// The new images are being pulled on the nodes, so do not make any changes to Sts spec,
+			// overwrite with old pod spec config as we are not ready to upgrade yet.
+			_, podSpec, err := GetLastAppliedConfig(oldSet)
+			if err != nil {
+				return err
+			}
+			newSet.Spec.Template.Spec = *podSpec
+		}
+	}
+
 	if !templateEqual(newSet, oldSet) || tc.Status.TiKV.Phase == v1alpha1.UpgradePhase {
 		if err := m.upgrader.Upgrade(tc, oldSet, newSet); err != nil {
 			return err
@@ -838,6 +900,10 @@ func (m *tikvMemberManager) syncTiKVClusterStatus(tc *v1alpha1.TidbCluster, set
 		if err = endEvictLeaderForAllStore(m.deps, tc); err != nil {
 			return err
 		}
+
+		// the next upgrade records its own baseline of the unhealthy regions
+		tc.Status.TiKV.RegionHealthBaseline = nil
+		meta.RemoveStatusCondition(&tc.Status.TiKV.Conditions, v1alpha1.ConditionTypeUpgradeBlocked)
 	}
 
 	// Scaling takes precedence over upgrading.
@@ -851,6 +917,26 @@ func (m *tikvMemberManager) syncTiKVClusterStatus(tc *v1alpha1.TidbCluster, set
 		tc.Status.TiKV.Phase = v1alpha1.NormalPhase
 	}
 
+	bootstrapped, err := m.syncTiKVStores(tc)
+	if err != nil || !bootstrapped {
+		return err
+	}
+	tc.Status.TiKV.Image = ""
+	c := findContainerByName(set, "tikv")
+	if c != nil {
+		tc.Status.TiKV.Image = c.Image
+	}
+
+	err = volumes.SyncVolumeStatus(m.podVolumeModifier, m.deps.PodLister, tc, v1alpha1.TiKVMemberType)
+	if err != nil {
+		return fmt.Errorf("failed to sync volume status for tikv: %v", err)
+	}
+
+	return nil
+}
+
+// syncTiKVStores syncs the stores of the TiKV cluster from PD, it returns false if the cluster is not bootstrapped yet
+func (m *tikvMemberManager) syncTiKVStores(tc *v1alpha1.TidbCluster) (bool, error) {
 	previousStores := tc.Status.TiKV.Stores
 	previousPeerStores := tc.Status.TiKV.PeerStores
 	previousTombstoneStores := tc.Status.TiKV.TombstoneStores
@@ -866,15 +952,15 @@ func (m *tikvMemberManager) syncTiKVClusterStatus(tc *v1alpha1.TidbCluster, set
 			klog.Infof("TiKV of Cluster %s/%s not bootstrapped yet", tc.Namespace, tc.Name)
 			tc.Status.TiKV.Synced = true
 			tc.Status.TiKV.BootStrapped = false
-			return nil
+			return false, nil
 		}
 		tc.Status.TiKV.Synced = false
-		return err
+		return false, err
 	}
 
 	pattern, err := regexp.Compile(fmt.Sprintf(tikvStoreLimitPattern, tc.Name, tc.Name, tc.Namespace, controller.FormatClusterDomainForRegex(tc.Spec.ClusterDomain)))
 	if err != nil {
-		return err
+		return false, err
 	}
 	for _, store := range storesInfo.Stores {
 		status := getTiKVStore(store)
@@ -895,6 +981,7 @@ func (m *tikvMemberManager) syncTiKVClusterStatus(tc *v1alpha1.TidbCluster, set
 		if oldStore.LeaderCountBeforeUpgrade != nil {
 			status.LeaderCountBeforeUpgrade = oldStore.LeaderCountBeforeUpgrade
 		}
+		status.DataMigration = getStoreDataMigration(oldStore.DataMigration, store, time.Now())
 
 		// In theory, the external tikv can join the cluster, and the operator would only manage the internal tikv.
 		// So we check the store owner to make sure it.
@@ -911,7 +998,7 @@ func (m *tikvMemberManager) syncTiKVClusterStatus(tc *v1alpha1.TidbCluster, set
 	tombstoneStoresInfo, err := pdCli.GetTombStoneStores()
 	if err != nil {
 		tc.Status.TiKV.Synced = false
-		return err
+		return false, err
 	}
 	for _, store := range tombstoneStoresInfo.Stores {
 		if store.Store != nil && !pattern.Match([]byte(store.Store.Address)) {
@@ -935,18 +1022,7 @@ func (m *tikvMemberManager) syncTiKVClusterStatus(tc *v1alpha1.TidbCluster, set
 	tc.Status.TiKV.PeerStores = peerStores
 	tc.Status.TiKV.TombstoneStores = tombstoneStores
 	tc.Status.TiKV.BootStrapped = true
-	tc.Status.TiKV.Image = ""
-	c := findContainerByName(set, "tikv")
-	if c != nil {
-		tc.Status.TiKV.Image = c.Image
-	}
-
-	err = volumes.SyncVolumeStatus(m.podVolumeModifier, m.deps.PodLister, tc, v1alpha1.TiKVMemberType)
-	if err != nil {
-		return fmt.Errorf("failed to sync volume status for tikv: %v", err)
-	}
-
-	return nil
+	return true, nil
 }
 
 func getTiKVStore(store *pdapi.StoreInfo) *v1alpha1.TiKVStore {
@@ -0,0 +1,116 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"k8s.io/apimachinery/pkg/api/errors"
+	errorutils "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+// syncTiKVPools syncs the TiKV pools one by one in the same way as `spec.tikv`, a failure of a pool
+// doesn't block the other pools. The stores of the pools removed from the spec are scaled in.
+func (m *tikvMemberManager) syncTiKVPools(tc *v1alpha1.TidbCluster) error {
+	if len(tc.Spec.TiKVPools) == 0 && len(tc.Status.TiKVPools) == 0 {
+		return nil
+	}
+	// the pools join the PD of the cluster, wait for it in the same way as `spec.tikv`
+	if tc.Spec.PD != nil && !tc.PDIsAvailable() {
+		return controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for PD cluster running", tc.GetNamespace(), tc.GetName())
+	}
+	if tc.Status.TiKVPools == nil {
+		tc.Status.TiKVPools = map[string]*v1alpha1.TiKVStatus{}
+	}
+
+	var errs []error
+	pools := sets.NewString()
+	for i := range tc.Spec.TiKVPools {
+		pool := &tc.Spec.TiKVPools[i]
+		pools.Insert(pool.Name)
+
+		ptc := tc.TiKVPoolCluster(pool)
+		if err := checkSubClusterName(m.deps, ptc); err != nil {
+			errs = append(errs, fmt.Errorf("TiKV pool %s: %w", pool.Name, err))
+			continue
+		}
+		err := m.syncTiKV(ptc)
+		tc.Status.TiKVPools[pool.Name] = &ptc.Status.TiKV
+		tc.MergeOperations(ptc)
+		if err != nil {
+			metrics.ClusterUpdateErrors.WithLabelValues(tc.GetNamespace(), tc.GetName(), fmt.Sprintf("tikv-%s", pool.Name)).Inc()
+			errs = append(errs, fmt.Errorf("TiKV pool %s: %w", pool.Name, err))
+		}
+	}
+
+	for name := range tc.Status.TiKVPools {
+		if pools.Has(name) {
+			continue
+		}
+		if err := m.scaleInRemovedTiKVPool(tc, name); err != nil {
+			errs = append(errs, fmt.Errorf("TiKV pool %s: %w", name, err))
+		}
+	}
+	return errorutils.NewAggregate(errs)
+}
+
+// scaleInRemovedTiKVPool scales in the stores of the pool removed from the spec by the scale-in policy
+// of the TiKV scaler, the status of the pool is removed after all the pods are deleted. The stores of
+// the pool are synced from PD before scaling in.
+func (m *tikvMemberManager) scaleInRemovedTiKVPool(tc *v1alpha1.TidbCluster, name string) error {
+	ptc := tc.TiKVPoolCluster(&v1alpha1.TiKVPoolSpec{Name: name})
+	if err := checkSubClusterName(m.deps, ptc); err != nil {
+		return err
+	}
+	ns := ptc.GetNamespace()
+	setName := controller.TiKVMemberName(ptc.GetName())
+
+	oldSetTmp, err := m.deps.StatefulSetLister.StatefulSets(ns).Get(setName)
+	if errors.IsNotFound(err) {
+		delete(tc.Status.TiKVPools, name)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("scaleInRemovedTiKVPool: failed to get sts %s for cluster %s/%s, error: %s", setName, ns, tc.GetName(), err)
+	}
+	if oldSetTmp.Status.Replicas == 0 && *oldSetTmp.Spec.Replicas == 0 {
+		klog.Infof("TidbCluster: [%s/%s], all the TiKV pods of the removed pool %s are deleted", ns, tc.GetName(), name)
+		delete(tc.Status.TiKVPools, name)
+		return nil
+	}
+
+	// the pool is not synced by syncTiKV any more, sync its stores here so that the scaler sees them
+	// becoming Offline and Tombstone
+	if _, err := m.syncTiKVStores(ptc); err != nil {
+		tc.Status.TiKVPools[name] = &ptc.Status.TiKV
+		return fmt.Errorf("scaleInRemovedTiKVPool: failed to sync stores for cluster %s/%s, error: %s", ns, tc.GetName(), err)
+	}
+
+	oldSet := oldSetTmp.DeepCopy()
+	newSet := oldSetTmp.DeepCopy()
+	*newSet.Spec.Replicas = 0
+	err = m.scaler.Scale(ptc, oldSet, newSet)
+	tc.Status.TiKVPools[name] = &ptc.Status.TiKV
+	tc.MergeOperations(ptc)
+	if err != nil {
+		return err
+	}
+	return mngerutils.UpdateStatefulSetWithPrecheck(m.deps, ptc, "FailedUpdateTiKVSTS", newSet, oldSet)
+}
@@ -0,0 +1,286 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+)
+
+func TestTiKVMemberManagerSyncTiKVPools(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPD()
+	tc.Status.PD.Members = map[string]v1alpha1.PDMember{
+		"pd-0": {Name: "pd-0", Health: true},
+		"pd-1": {Name: "pd-1", Health: true},
+		"pd-2": {Name: "pd-2", Health: true},
+	}
+	tc.Status.PD.StatefulSet = &apps.StatefulSetStatus{ReadyReplicas: 3}
+	tc.Spec.TiKVPools = []v1alpha1.TiKVPoolSpec{{
+		Name: "ssd",
+		TiKVSpec: v1alpha1.TiKVSpec{
+			ComponentSpec: v1alpha1.ComponentSpec{
+				Image: "tikv-ssd",
+			},
+			ResourceRequirements: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse("1Ti"),
+				},
+			},
+			Replicas:         2,
+			StorageClassName: pointer.StringPtr("ssd"),
+		},
+	}}
+	tc.Annotations = map[string]string{
+		label.AnnTiKVDeleteSlots:          "[5]",
+		label.AnnTiKVDeleteSlots + "-ssd": "[6]",
+	}
+	ns := tc.GetNamespace()
+	poolName := "test-ssd"
+
+	tkmm, _, _, pdClient, _, _ := newFakeTiKVMemberManager(tc)
+	pdClient.AddReaction(pdapi.GetConfigActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.PDConfigFromAPI{Replication: &pdapi.PDReplicationConfig{}}, nil
+	})
+	pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.StoresInfo{Stores: []*pdapi.StoreInfo{
+			{
+				Store: &pdapi.MetaStore{
+					Store: &metapb.Store{
+						Id:      1,
+						Address: "test-tikv-0.test-tikv-peer.default.svc:20160",
+						Labels:  []*metapb.StoreLabel{{Key: "engine", Value: "tikv"}},
+					},
+					StateName: v1alpha1.TiKVStateUp,
+				},
+				Status: &pdapi.StoreStatus{},
+			},
+			{
+				Store: &pdapi.MetaStore{
+					Store: &metapb.Store{
+						Id:      2,
+						Address: "test-ssd-tikv-0.test-ssd-tikv-peer.default.svc:20160",
+						Labels:  []*metapb.StoreLabel{{Key: "engine", Value: "tikv"}},
+					},
+					StateName: v1alpha1.TiKVStateUp,
+				},
+				Status: &pdapi.StoreStatus{},
+			},
+		}}, nil
+	})
+	pdClient.AddReaction(pdapi.GetTombStoneStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.StoresInfo{}, nil
+	})
+	g.Expect(tkmm.Sync(tc)).To(Succeed())
+
+	// the StatefulSets of spec.tikv and the pool are created
+	set, err := tkmm.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiKVMemberName("test"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*set.Spec.Replicas).To(Equal(int32(3)))
+	g.Expect(set.Annotations).To(HaveKeyWithValue(helper.DeleteSlotsAnn, "[5]"))
+	set, err = tkmm.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiKVMemberName(poolName))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*set.Spec.Replicas).To(Equal(int32(2)))
+	// the delete slots of the cluster are not the ones of the pool
+	g.Expect(set.Annotations).To(HaveKeyWithValue(helper.DeleteSlotsAnn, "[6]"))
+	g.Expect(set.Spec.Template.Spec.Containers).To(ContainElement(HaveField("Image", "tikv-ssd")))
+	g.Expect(set.Spec.Template.Labels).To(HaveKeyWithValue(label.InstanceLabelKey, "test-ssd"))
+	g.Expect(set.Spec.Template.Labels).To(HaveKeyWithValue(label.TiKVPoolLabelKey, "ssd"))
+	g.Expect(*set.Spec.VolumeClaimTemplates[0].Spec.StorageClassName).To(Equal("ssd"))
+	g.Expect(metav1.GetControllerOf(set).Name).To(Equal("test"))
+	g.Expect(tc.Status.TiKVPools).To(HaveKey("ssd"))
+	g.Expect(tc.Status.TiKVPools["ssd"].StatefulSet).NotTo(BeNil())
+
+	// the stores are split by pool
+	g.Expect(tkmm.Sync(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKV.Stores).To(HaveKey("1"))
+	g.Expect(tc.Status.TiKV.PeerStores).To(HaveKey("2"))
+	g.Expect(tc.Status.TiKVPools["ssd"].Stores).To(HaveKey("2"))
+	g.Expect(tc.Status.TiKVPools["ssd"].PeerStores).To(HaveKey("1"))
+
+	// scale in the removed pool
+	tc.Spec.TiKVPools = nil
+	for i := 0; i < 2; i++ {
+		g.Expect(tkmm.Sync(tc)).To(Succeed())
+		g.Expect(tc.Status.TiKVPools).To(HaveKey("ssd"))
+	}
+	set, err = tkmm.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiKVMemberName(poolName))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*set.Spec.Replicas).To(Equal(int32(0)))
+
+	// the status of the pool is removed after all the pods are deleted
+	g.Expect(tkmm.Sync(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKVPools).NotTo(HaveKey("ssd"))
+}
+
+func TestTiKVPoolCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiKV()
+	pool := &v1alpha1.TiKVPoolSpec{Name: "hdd", TiKVSpec: *tc.Spec.TiKV.DeepCopy()}
+	tc.Status.TiKVPools = map[string]*v1alpha1.TiKVStatus{
+		"hdd": {Phase: v1alpha1.ScalePhase},
+	}
+	tc.Annotations = map[string]string{label.AnnTiKVUnsafeRecovery: "r1"}
+
+	ptc := tc.TiKVPoolCluster(pool)
+	g.Expect(ptc.Name).To(Equal("test-hdd"))
+	g.Expect(ptc.GetOwnerName()).To(Equal("test"))
+	g.Expect(ptc.Heterogeneous()).To(BeTrue())
+	g.Expect(ptc.WithoutLocalPD()).To(BeTrue())
+	g.Expect(ptc.Spec.Cluster.Name).To(Equal("test"))
+	g.Expect(ptc.Spec.TiKV.Labels).To(HaveKeyWithValue(label.TiKVPoolLabelKey, "hdd"))
+	g.Expect(ptc.Spec.TiKVPools).To(BeNil())
+	g.Expect(ptc.Status.TiKV.Phase).To(Equal(v1alpha1.ScalePhase))
+	g.Expect(ptc.Status.TiKVPools).To(BeNil())
+	g.Expect(ptc.DeleteSlotsAnnotationKey(label.AnnTiKVDeleteSlots)).To(Equal(label.AnnTiKVDeleteSlots + "-hdd"))
+	// the unsafe recovery is run for the whole cluster
+	g.Expect(ptc.Annotations).NotTo(HaveKey(label.AnnTiKVUnsafeRecovery))
+	g.Expect(controller.GetOwnerRef(ptc).Name).To(Equal("test"))
+	// the cluster is not changed
+	g.Expect(tc.Spec.PD).NotTo(BeNil())
+	g.Expect(tc.Labels).NotTo(HaveKey(label.TiKVPoolLabelKey))
+	g.Expect(tc.Spec.TiKV.Labels).NotTo(HaveKey(label.TiKVPoolLabelKey))
+	g.Expect(tc.Annotations).To(HaveKey(label.AnnTiKVUnsafeRecovery))
+}
+
+func TestTiKVMemberManagerScaleInRemovedTiKVPool(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPD()
+	tc.Status.TiKV.BootStrapped = true
+	// the status of the pool is not synced since it's removed from the spec
+	tc.Status.TiKVPools = map[string]*v1alpha1.TiKVStatus{"ssd": {}}
+	ns := tc.GetNamespace()
+	setName := controller.TiKVMemberName("test-ssd")
+
+	tkmm, _, _, pdClient, podIndexer, _ := newFakeTiKVMemberManager(tc)
+	tkmm.deps.PodControl = &podCtlMock{
+		updatePod: func(_ runtime.Object, pod *corev1.Pod) (*corev1.Pod, error) {
+			return pod, nil
+		},
+	}
+	tkmm.scaler = NewTiKVScaler(tkmm.deps)
+
+	set := &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: setName, Namespace: ns},
+		Spec:       apps.StatefulSetSpec{Replicas: pointer.Int32Ptr(1)},
+	}
+	g.Expect(tkmm.deps.KubeInformerFactory.Apps().V1().StatefulSets().Informer().GetIndexer().Add(set)).To(Succeed())
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TikvPodName("test-ssd", 0),
+			Namespace: ns,
+			Labels:    map[string]string{label.StoreIDLabelKey: "2"},
+		},
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{{
+				Name: "tikv",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "tikv-test-ssd-tikv-0"},
+				},
+			}},
+		},
+	}
+	readyPodFunc(pod)
+	g.Expect(podIndexer.Add(pod)).To(Succeed())
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "tikv-test-ssd-tikv-0", Namespace: ns},
+	}
+	pvcIndexer := tkmm.deps.KubeInformerFactory.Core().V1().PersistentVolumeClaims().Informer().GetIndexer()
+	g.Expect(pvcIndexer.Add(pvc)).To(Succeed())
+
+	poolStore := func(state string) *pdapi.StoreInfo {
+		return &pdapi.StoreInfo{
+			Store: &pdapi.MetaStore{
+				Store: &metapb.Store{
+					Id:      2,
+					Address: "test-ssd-tikv-0.test-ssd-tikv-peer.default.svc:20160",
+					Labels:  []*metapb.StoreLabel{{Key: "engine", Value: "tikv"}},
+				},
+				StateName: state,
+			},
+			Status: &pdapi.StoreStatus{},
+		}
+	}
+	state := v1alpha1.TiKVStateUp
+	pdClient.AddReaction(pdapi.GetConfigActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.PDConfigFromAPI{Replication: &pdapi.PDReplicationConfig{MaxReplicas: pointer.Uint64Ptr(1)}}, nil
+	})
+	pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		stores := []*pdapi.StoreInfo{{
+			Store: &pdapi.MetaStore{
+				Store: &metapb.Store{
+					Id:      1,
+					Address: "test-tikv-0.test-tikv-peer.default.svc:20160",
+					Labels:  []*metapb.StoreLabel{{Key: "engine", Value: "tikv"}},
+				},
+				StateName: v1alpha1.TiKVStateUp,
+			},
+			Status: &pdapi.StoreStatus{},
+		}}
+		if state != v1alpha1.TiKVStateTombstone {
+			stores = append(stores, poolStore(state))
+		}
+		return &pdapi.StoresInfo{Stores: stores}, nil
+	})
+	pdClient.AddReaction(pdapi.GetTombStoneStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		if state != v1alpha1.TiKVStateTombstone {
+			return &pdapi.StoresInfo{}, nil
+		}
+		return &pdapi.StoresInfo{Stores: []*pdapi.StoreInfo{poolStore(state)}}, nil
+	})
+	pdClient.AddReaction(pdapi.DeleteStoreActionType, func(action *pdapi.Action) (interface{}, error) {
+		g.Expect(action.ID).To(Equal(uint64(2)))
+		state = v1alpha1.TiKVStateOffline
+		return nil, nil
+	})
+
+	// the store of the pool is deleted and then waited to become Tombstone
+	for i := 0; i < 2; i++ {
+		err := tkmm.scaleInRemovedTiKVPool(tc, "ssd")
+		g.Expect(err).To(MatchError(ContainSubstring("store 2 is still in cluster")))
+		g.Expect(state).To(Equal(v1alpha1.TiKVStateOffline))
+		g.Expect(tc.Status.TiKVPools["ssd"].Stores).To(HaveKey("2"))
+		set, err := tkmm.deps.StatefulSetLister.StatefulSets(ns).Get(setName)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(*set.Spec.Replicas).To(Equal(int32(1)))
+	}
+	g.Expect(tc.Status.TiKVPools["ssd"].Stores["2"].State).To(Equal(v1alpha1.TiKVStateOffline))
+
+	// the pod is removed after the store becomes Tombstone
+	state = v1alpha1.TiKVStateTombstone
+	g.Expect(tkmm.scaleInRemovedTiKVPool(tc, "ssd")).To(Succeed())
+	g.Expect(tc.Status.TiKVPools["ssd"].TombstoneStores).To(HaveKey("2"))
+	set, err := tkmm.deps.StatefulSetLister.StatefulSets(ns).Get(setName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*set.Spec.Replicas).To(Equal(int32(0)))
+
+	g.Expect(tkmm.scaleInRemovedTiKVPool(tc, "ssd")).To(Succeed())
+	g.Expect(tc.Status.TiKVPools).NotTo(HaveKey("ssd"))
+}
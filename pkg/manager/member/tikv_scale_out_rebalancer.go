@@ -0,0 +1,267 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	errorutils "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+// tikvScaleOutRebalancer raises the PD limits to speed up rebalancing data to the new
+// TiKV stores after scaling out, and restores the original limits when the new stores
+// are balanced or the timeout is hit.
+type tikvScaleOutRebalancer struct {
+	deps *controller.Dependencies
+}
+
+func newTiKVScaleOutRebalancer(deps *controller.Dependencies) *tikvScaleOutRebalancer {
+	return &tikvScaleOutRebalancer{
+		deps: deps,
+	}
+}
+
+// Track records the pods added by scaling out, the limits are raised for their stores in Sync.
+func (r *tikvScaleOutRebalancer) Track(tc *v1alpha1.TidbCluster, ordinals sets.Int32) {
+	if tc.Spec.TiKV.GetScaleOutRebalancePolicy() == nil || ordinals.Len() == 0 {
+		return
+	}
+	status := tc.Status.TiKV.ScaleOutRebalance
+	if status == nil {
+		status = &v1alpha1.ScaleOutRebalanceStatus{}
+		tc.Status.TiKV.ScaleOutRebalance = status
+	}
+	// the timeout is counted from the latest scale out
+	status.StartTime = metav1.Now()
+	status.Progress = 0
+	podNames := sets.NewString(status.PodNames...)
+	for _, ordinal := range ordinals.List() {
+		podNames.Insert(ordinalPodName(v1alpha1.TiKVMemberType, tc.GetName(), ordinal))
+	}
+	status.PodNames = podNames.List()
+}
+
+// Sync raises the limits for the new stores, updates the balance progress and
+// restores the limits when the new stores are balanced or the timeout is hit.
+func (r *tikvScaleOutRebalancer) Sync(tc *v1alpha1.TidbCluster) error {
+	status := tc.Status.TiKV.ScaleOutRebalance
+	if status == nil {
+		return nil
+	}
+	policy := tc.Spec.TiKV.GetScaleOutRebalancePolicy()
+	if policy == nil {
+		return r.restore(tc, "scale out rebalance policy is removed")
+	}
+	if !tc.TiKVBootStrapped() {
+		return nil
+	}
+
+	pdClient := controller.GetPDClient(r.deps.PDControl, tc)
+	if err := r.raiseScheduleLimits(tc, pdClient, policy); err != nil {
+		return err
+	}
+
+	podNames := sets.NewString(status.PodNames...)
+	newStoreIDs := sets.NewString()
+	for id, store := range tc.Status.TiKV.Stores {
+		if podNames.Has(store.PodName) && store.State == v1alpha1.TiKVStateUp {
+			newStoreIDs.Insert(id)
+		}
+	}
+	if err := r.raiseStoreLimits(tc, pdClient, policy, newStoreIDs); err != nil {
+		return err
+	}
+
+	storesInfo, err := pdClient.GetStores()
+	if err != nil {
+		return fmt.Errorf("failed to get stores info in TidbCluster %s/%s: %v", tc.GetNamespace(), tc.GetName(), err)
+	}
+	var totalRegions, upStores int
+	newStoreRegions := map[string]int{}
+	for _, store := range storesInfo.Stores {
+		if store.Store == nil || store.Status == nil || store.Store.StateName != v1alpha1.TiKVStateUp {
+			continue
+		}
+		if !util.MatchLabelFromStoreLabels(store.Store.Labels, label.TiKVLabelVal) {
+			continue
+		}
+		totalRegions += store.Status.RegionCount
+		upStores++
+		id := strconv.FormatUint(store.Store.GetId(), 10)
+		if newStoreIDs.Has(id) {
+			newStoreRegions[id] = store.Status.RegionCount
+		}
+	}
+	status.Progress = balanceProgress(newStoreRegions, totalRegions, upStores)
+
+	balanced := newStoreIDs.Len() == podNames.Len() && len(newStoreRegions) == newStoreIDs.Len() &&
+		status.Progress >= 100-policy.GetBalanceTolerancePercent()
+	if balanced {
+		return r.restore(tc, fmt.Sprintf("new stores %v are balanced", newStoreIDs.List()))
+	}
+	if time.Since(status.StartTime.Time) > policy.GetTimeout() {
+		return r.restore(tc, fmt.Sprintf("new stores %v are not balanced in %v, progress %d%%", newStoreIDs.List(), policy.GetTimeout(), status.Progress))
+	}
+	klog.V(4).Infof("rebalancing data to new TiKV stores %v of TidbCluster %s/%s, progress %d%%", newStoreIDs.List(), tc.GetNamespace(), tc.GetName(), status.Progress)
+	return nil
+}
+
+// balanceProgress returns the percentage of the region count of the least balanced new store
+// to the average region count of all stores.
+func balanceProgress(newStoreRegions map[string]int, totalRegions, upStores int) int32 {
+	if len(newStoreRegions) == 0 {
+		return 0
+	}
+	if upStores == 0 || totalRegions == 0 {
+		return 100
+	}
+	avg := float64(totalRegions) / float64(upStores)
+	progress := int32(100)
+	for _, regions := range newStoreRegions {
+		p := int32(float64(regions) * 100 / avg)
+		if p < progress {
+			progress = p
+		}
+	}
+	return progress
+}
+
+func (r *tikvScaleOutRebalancer) raiseScheduleLimits(tc *v1alpha1.TidbCluster, pdClient pdapi.PDClient, policy *v1alpha1.ScaleOutRebalancePolicy) error {
+	status := tc.Status.TiKV.ScaleOutRebalance
+	if status.OriginalRegionScheduleLimit != nil || status.OriginalLeaderScheduleLimit != nil {
+		return nil
+	}
+	config, err := pdClient.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get config in TidbCluster %s/%s: %v", tc.GetNamespace(), tc.GetName(), err)
+	}
+	if config.Schedule == nil || config.Schedule.RegionScheduleLimit == nil || config.Schedule.LeaderScheduleLimit == nil {
+		return fmt.Errorf("schedule limits are not found in PD config of TidbCluster %s/%s", tc.GetNamespace(), tc.GetName())
+	}
+
+	origRegion, origLeader := *config.Schedule.RegionScheduleLimit, *config.Schedule.LeaderScheduleLimit
+	update := pdapi.PDScheduleConfig{}
+	if limit := policy.GetRegionScheduleLimit(); limit > origRegion {
+		update.RegionScheduleLimit = &limit
+	}
+	if limit := policy.GetLeaderScheduleLimit(); limit > origLeader {
+		update.LeaderScheduleLimit = &limit
+	}
+	if update.RegionScheduleLimit != nil || update.LeaderScheduleLimit != nil {
+		if err := pdClient.UpdateScheduleConfig(update); err != nil {
+			return fmt.Errorf("failed to raise schedule limits in TidbCluster %s/%s: %v", tc.GetNamespace(), tc.GetName(), err)
+		}
+		klog.Infof("raise schedule limits of TidbCluster %s/%s for scaling out TiKV, region-schedule-limit: %d -> %d, leader-schedule-limit: %d -> %d",
+			tc.GetNamespace(), tc.GetName(), origRegion, policy.GetRegionScheduleLimit(), origLeader, policy.GetLeaderScheduleLimit())
+	}
+
+	region, leader := int64(origRegion), int64(origLeader)
+	status.OriginalRegionScheduleLimit = &region
+	status.OriginalLeaderScheduleLimit = &leader
+	return nil
+}
+
+func (r *tikvScaleOutRebalancer) raiseStoreLimits(tc *v1alpha1.TidbCluster, pdClient pdapi.PDClient, policy *v1alpha1.ScaleOutRebalancePolicy, storeIDs sets.String) error {
+	status := tc.Status.TiKV.ScaleOutRebalance
+	var limits map[uint64]*pdapi.StoreLimit
+	for _, id := range storeIDs.List() {
+		if _, ok := status.OriginalStoreLimits[id]; ok {
+			continue
+		}
+		storeID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return err
+		}
+		if limits == nil {
+			limits, err = pdClient.GetStoreLimits()
+			if err != nil {
+				return fmt.Errorf("failed to get store limits in TidbCluster %s/%s: %v", tc.GetNamespace(), tc.GetName(), err)
+			}
+		}
+		limit, ok := limits[storeID]
+		if !ok {
+			klog.Infof("store limit of TiKV store %d in TidbCluster %s/%s is not found, wait for next round", storeID, tc.GetNamespace(), tc.GetName())
+			continue
+		}
+
+		if rate := policy.GetStoreLimit(); rate > limit.AddPeer {
+			if err := pdClient.SetStoreLimit(storeID, pdapi.StoreLimitTypeAddPeer, rate); err != nil {
+				return fmt.Errorf("failed to raise store limit of TiKV store %d in TidbCluster %s/%s: %v", storeID, tc.GetNamespace(), tc.GetName(), err)
+			}
+			klog.Infof("raise add-peer limit of TiKV store %d in TidbCluster %s/%s: %v -> %v", storeID, tc.GetNamespace(), tc.GetName(), limit.AddPeer, rate)
+		}
+		if status.OriginalStoreLimits == nil {
+			status.OriginalStoreLimits = map[string]float64{}
+		}
+		status.OriginalStoreLimits[id] = limit.AddPeer
+	}
+	return nil
+}
+
+// restore restores the original limits and clears the status
+func (r *tikvScaleOutRebalancer) restore(tc *v1alpha1.TidbCluster, reason string) error {
+	status := tc.Status.TiKV.ScaleOutRebalance
+	pdClient := controller.GetPDClient(r.deps.PDControl, tc)
+
+	var errs []error
+	for id, limit := range status.OriginalStoreLimits {
+		// the store may be removed by scaling in
+		if _, ok := tc.Status.TiKV.Stores[id]; !ok {
+			continue
+		}
+		storeID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := pdClient.SetStoreLimit(storeID, pdapi.StoreLimitTypeAddPeer, limit); err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore store limit of TiKV store %d: %v", storeID, err))
+		}
+	}
+
+	update := pdapi.PDScheduleConfig{}
+	if status.OriginalRegionScheduleLimit != nil {
+		limit := uint64(*status.OriginalRegionScheduleLimit)
+		update.RegionScheduleLimit = &limit
+	}
+	if status.OriginalLeaderScheduleLimit != nil {
+		limit := uint64(*status.OriginalLeaderScheduleLimit)
+		update.LeaderScheduleLimit = &limit
+	}
+	if update.RegionScheduleLimit != nil || update.LeaderScheduleLimit != nil {
+		if err := pdClient.UpdateScheduleConfig(update); err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore schedule limits: %v", err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to restore limits after scaling out TiKV in TidbCluster %s/%s: %v", tc.GetNamespace(), tc.GetName(), errorutils.NewAggregate(errs))
+	}
+
+	msg := fmt.Sprintf("restore limits after scaling out TiKV: %s", reason)
+	klog.Infof("TidbCluster %s/%s %s", tc.GetNamespace(), tc.GetName(), msg)
+	r.deps.Recorder.Event(tc, corev1.EventTypeNormal, "ScaleOutRebalanceFinished", msg)
+	tc.Status.TiKV.ScaleOutRebalance = nil
+	return nil
+}
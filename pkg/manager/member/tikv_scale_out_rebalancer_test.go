@@ -0,0 +1,135 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestTiKVScaleOutRebalancer(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		name          string
+		regions       []int
+		startedBefore time.Duration
+		expectDone    bool
+	}{
+		{
+			name:    "new store is not balanced",
+			regions: []int{100, 100, 100, 10},
+		},
+		{
+			name:       "new store is balanced",
+			regions:    []int{100, 100, 100, 95},
+			expectDone: true,
+		},
+		{
+			name:          "timeout",
+			regions:       []int{100, 100, 100, 10},
+			startedBefore: 3 * time.Hour,
+			expectDone:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbClusterForPD()
+			tc.Spec.TiKV.ScalePolicy.ScaleOutRebalance = &v1alpha1.ScaleOutRebalancePolicy{}
+			tc.Status.TiKV.BootStrapped = true
+			tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{}
+			deps := controller.NewFakeDependencies()
+
+			stores := []*pdapi.StoreInfo{}
+			for i, regions := range tt.regions {
+				id := uint64(i + 1)
+				podName := TikvPodName(tc.Name, int32(i))
+				tc.Status.TiKV.Stores[strconv.FormatUint(id, 10)] = v1alpha1.TiKVStore{
+					ID:      strconv.FormatUint(id, 10),
+					PodName: podName,
+					State:   v1alpha1.TiKVStateUp,
+				}
+				stores = append(stores, &pdapi.StoreInfo{
+					Store: &pdapi.MetaStore{
+						Store: &metapb.Store{
+							Id:     id,
+							Labels: []*metapb.StoreLabel{{Key: "engine", Value: label.TiKVLabelVal}},
+						},
+						StateName: v1alpha1.TiKVStateUp,
+					},
+					Status: &pdapi.StoreStatus{RegionCount: regions},
+				})
+			}
+
+			storeLimits := map[uint64]float64{}
+			var scheduleConfigs []pdapi.PDScheduleConfig
+			pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+			pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+				return &pdapi.StoresInfo{Stores: stores}, nil
+			})
+			pdClient.AddReaction(pdapi.GetConfigActionType, func(action *pdapi.Action) (interface{}, error) {
+				region, leader := uint64(4), uint64(4)
+				return &pdapi.PDConfigFromAPI{
+					Schedule: &pdapi.PDScheduleConfig{RegionScheduleLimit: &region, LeaderScheduleLimit: &leader},
+				}, nil
+			})
+			pdClient.AddReaction(pdapi.UpdateScheduleConfigActionType, func(action *pdapi.Action) (interface{}, error) {
+				scheduleConfigs = append(scheduleConfigs, action.Schedule)
+				return nil, nil
+			})
+			pdClient.AddReaction(pdapi.GetStoreLimitsActionType, func(action *pdapi.Action) (interface{}, error) {
+				return map[uint64]*pdapi.StoreLimit{4: {AddPeer: 15, RemovePeer: 15}}, nil
+			})
+			pdClient.AddReaction(pdapi.SetStoreLimitActionType, func(action *pdapi.Action) (interface{}, error) {
+				g.Expect(action.LimitType).To(Equal(pdapi.StoreLimitTypeAddPeer))
+				storeLimits[action.ID] = action.Rate
+				return nil, nil
+			})
+
+			r := newTiKVScaleOutRebalancer(deps)
+			r.Track(tc, sets.NewInt32(3))
+			g.Expect(tc.Status.TiKV.ScaleOutRebalance).NotTo(BeNil())
+			g.Expect(tc.Status.TiKV.ScaleOutRebalance.PodNames).To(Equal([]string{TikvPodName(tc.Name, 3)}))
+			tc.Status.TiKV.ScaleOutRebalance.StartTime = metav1.NewTime(time.Now().Add(-tt.startedBefore))
+
+			g.Expect(r.Sync(tc)).To(Succeed())
+			g.Expect(scheduleConfigs).NotTo(BeEmpty())
+			g.Expect(*scheduleConfigs[0].RegionScheduleLimit).To(Equal(uint64(64)))
+			g.Expect(*scheduleConfigs[0].LeaderScheduleLimit).To(Equal(uint64(16)))
+			if tt.expectDone {
+				g.Expect(tc.Status.TiKV.ScaleOutRebalance).To(BeNil())
+				g.Expect(storeLimits[4]).To(Equal(float64(15)))
+				g.Expect(scheduleConfigs).To(HaveLen(2))
+				g.Expect(*scheduleConfigs[1].RegionScheduleLimit).To(Equal(uint64(4)))
+				g.Expect(*scheduleConfigs[1].LeaderScheduleLimit).To(Equal(uint64(4)))
+			} else {
+				g.Expect(tc.Status.TiKV.ScaleOutRebalance).NotTo(BeNil())
+				g.Expect(storeLimits[4]).To(Equal(float64(200)))
+				g.Expect(tc.Status.TiKV.ScaleOutRebalance.OriginalStoreLimits).To(Equal(map[string]float64{"4": 15}))
+				g.Expect(tc.Status.TiKV.ScaleOutRebalance.Progress).To(Equal(int32(12)))
+			}
+		})
+	}
+}
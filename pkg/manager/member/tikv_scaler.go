@@ -84,6 +84,8 @@ func (s *tikvScaler) ScaleOut(meta metav1.Object, oldSet *apps.StatefulSet, newS
 	}
 	if updateReplicasAndDeleteSlots {
 		setReplicasAndDeleteSlotsByFinished(scalingOutFlag, newSet, oldSet, ordinals, finishedOrdinals)
+		// raise the limits for the new stores to rebalance faster if needed
+		newTiKVScaleOutRebalancer(s.deps).Track(tc, finishedOrdinals)
 	} else {
 		resetReplicas(newSet, oldSet)
 	}
@@ -113,6 +115,12 @@ func (s *tikvScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, newSe
 		return nil
 	}
 
+	// choose the stores to remove by delete slots before scaling in if needed
+	if err := newStoreScaleInSelector(s.deps, v1alpha1.TiKVMemberType).Select(tc, oldSet, newSet); err != nil {
+		resetReplicas(newSet, oldSet)
+		return err
+	}
+
 	scaleInParallelism := tc.Spec.TiKV.GetScaleInParallelism()
 
 	_, ordinals, replicas, deleteSlots := scaleMulti(oldSet, newSet, scaleInParallelism)
@@ -120,6 +128,12 @@ func (s *tikvScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, newSe
 	klog.Infof("scaling in tikv statefulset %s/%s, ordinals: %v (replicas: %d, delete slots: %v), scaleInParallelism: %v, scaleInTime: %v",
 		oldSet.Namespace, oldSet.Name, ordinals, replicas, deleteSlots.List(), scaleInParallelism, scaleInTime.Format(time.RFC3339))
 
+	// make sure the remaining stores can hold the data of the stores to be removed
+	if err := newStoreCapacityChecker(s.deps, v1alpha1.TiKVMemberType).Check(tc, ordinals); err != nil {
+		resetReplicas(newSet, oldSet)
+		return err
+	}
+
 	var (
 		upTikvStoreCount    int
 		deletedUpStoreTotal int
@@ -131,21 +145,9 @@ func (s *tikvScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, newSe
 		skipPreCheck = true
 	} else {
 		var err error
-		pdClient := controller.GetPDClient(s.deps.PDControl, tc)
-		storesInfo, err := pdClient.GetStores()
+		upTikvStoreCount, maxReplicas, err = getUpTiKVStoreCountAndMaxReplicas(s.deps, tc)
 		if err != nil {
-			return fmt.Errorf("failed to get stores info in TidbCluster %s/%s", tc.GetNamespace(), tc.GetName())
-		}
-		config, err := pdClient.GetConfig()
-		if err != nil {
-			return fmt.Errorf("failed to get config in TidbCluster %s/%s", tc.GetNamespace(), tc.GetName())
-		}
-		maxReplicas = int(*(config.Replication.MaxReplicas))
-		// filter out TiFlash
-		for _, store := range storesInfo.Stores {
-			if store.Store != nil && store.Store.StateName == v1alpha1.TiKVStateUp && util.MatchLabelFromStoreLabels(store.Store.Labels, label.TiKVLabelVal) {
-				upTikvStoreCount++
-			}
+			return err
 		}
 	}
 
@@ -212,41 +214,9 @@ func (s *tikvScaler) scaleInOne(tc *v1alpha1.TidbCluster, skipPreCheck bool, upT
 			}
 			pdc := controller.GetPDClient(s.deps.PDControl, tc)
 
-			var startTime *time.Time
-			startStr, ok := pod.Annotations[label.AnnoScaleInTime]
-			if ok {
-				t, err := time.Parse(time.RFC3339, startStr)
-				if err != nil {
-					klog.Warningf("tikvScaler.ScaleIn: cannot parse annotation %s in pod %s, cluster %s/%s: %v", label.AnnoScaleInTime, podName, ns, tcName, err)
-					// use current time as startInTime
-					startTime = &currentTime
-				} else {
-					startTime = &t
-				}
-			} else {
-				startTime = &currentTime
-			}
-			if err := ensureScaleInTimeAnnoInPod(tc, pod, s.deps.PodControl, startTime.Format(time.RFC3339)); err != nil {
-				return deletedUpStore, fmt.Errorf("cannot add annotation to pod: %w", err)
-			}
-
-			var leaderEvictedOrTimeout bool
-			if startTime.Add(defaultEvictLeaderTimeoutWhenScaleIn).Before(currentTime) {
-				leaderEvictedOrTimeout = true
-			}
-			if store.LeaderCount == 0 {
-				leaderEvictedOrTimeout = true
-			}
-			if !leaderEvictedOrTimeout {
-				schedulerMap, err := pdc.GetEvictLeaderSchedulersForStores(id)
-				if err != nil {
-					return deletedUpStore, fmt.Errorf("cannot get scheduler of store %v: %w", id, err)
-				}
-				if _, ok := schedulerMap[id]; !ok {
-					if err := pdc.BeginEvictLeader(id); err != nil {
-						return deletedUpStore, fmt.Errorf("cannot evict leaders of store %v: %w", id, err)
-					}
-				}
+			leaderEvictedOrTimeout, err := evictLeaderBeforeScaleIn(s.deps, tc, pod, store, currentTime)
+			if err != nil {
+				return deletedUpStore, err
 			}
 
 			if state != v1alpha1.TiKVStateOffline && leaderEvictedOrTimeout {
@@ -255,6 +225,8 @@ func (s *tikvScaler) scaleInOne(tc *v1alpha1.TidbCluster, skipPreCheck bool, upT
 					return deletedUpStore, err
 				}
 				klog.Infof("tikvScaler.ScaleIn: delete store %d for tikv %s/%s successfully", id, ns, podName)
+				tc.FinishOperation(storeOperation(v1alpha1.OperationEvictLeader, v1alpha1.TiKVMemberType, operationReasonScaleIn, store.ID, podName), nil)
+				tc.StartOperation(storeOperation(v1alpha1.OperationDeleteStore, v1alpha1.TiKVMemberType, operationReasonScaleIn, store.ID, podName))
 				if state == v1alpha1.TiKVStateUp {
 					deletedUpStore++
 				}
@@ -281,6 +253,7 @@ func (s *tikvScaler) scaleInOne(tc *v1alpha1.TidbCluster, skipPreCheck bool, upT
 
 		// TODO: double check if store is really not in Up/Offline/Down state
 		klog.Infof("TiKV %s/%s store %d becomes tombstone", ns, podName, id)
+		tc.FinishOperation(storeOperation(v1alpha1.OperationDeleteStore, v1alpha1.TiKVMemberType, operationReasonScaleIn, store.ID, podName), nil)
 
 		pvcs, err := util.ResolvePVCFromPod(pod, s.deps.PVCLister)
 		if err != nil {
@@ -392,6 +365,74 @@ func (s *tikvScaler) preCheckUpStores(tc *v1alpha1.TidbCluster, podName string,
 	return true
 }
 
+// getUpTiKVStoreCountAndMaxReplicas returns the number of TiKV stores in Up state and the max-replicas in PD
+func getUpTiKVStoreCountAndMaxReplicas(deps *controller.Dependencies, tc *v1alpha1.TidbCluster) (int, int, error) {
+	pdClient := controller.GetPDClient(deps.PDControl, tc)
+	storesInfo, err := pdClient.GetStores()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get stores info in TidbCluster %s/%s", tc.GetNamespace(), tc.GetName())
+	}
+	config, err := pdClient.GetConfig()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get config in TidbCluster %s/%s", tc.GetNamespace(), tc.GetName())
+	}
+	upTikvStoreCount := 0
+	// filter out TiFlash
+	for _, store := range storesInfo.Stores {
+		if store.Store != nil && store.Store.StateName == v1alpha1.TiKVStateUp && util.MatchLabelFromStoreLabels(store.Store.Labels, label.TiKVLabelVal) {
+			upTikvStoreCount++
+		}
+	}
+	return upTikvStoreCount, int(*(config.Replication.MaxReplicas)), nil
+}
+
+// evictLeaderBeforeScaleIn begins evicting the leaders of the TiKV store to be scaled in, and returns true
+// if the leaders are evicted or defaultEvictLeaderTimeoutWhenScaleIn has passed since the scale in time
+// recorded in the annotation of the pod
+func evictLeaderBeforeScaleIn(deps *controller.Dependencies, tc *v1alpha1.TidbCluster, pod *v1.Pod, store v1alpha1.TiKVStore, currentTime time.Time) (bool, error) {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	podName := pod.GetName()
+	id, err := strconv.ParseUint(store.ID, 10, 64)
+	if err != nil {
+		return false, err
+	}
+	pdc := controller.GetPDClient(deps.PDControl, tc)
+
+	var startTime *time.Time
+	startStr, ok := pod.Annotations[label.AnnoScaleInTime]
+	if ok {
+		t, err := time.Parse(time.RFC3339, startStr)
+		if err != nil {
+			klog.Warningf("tikvScaler.ScaleIn: cannot parse annotation %s in pod %s, cluster %s/%s: %v", label.AnnoScaleInTime, podName, ns, tcName, err)
+			// use current time as startInTime
+			startTime = &currentTime
+		} else {
+			startTime = &t
+		}
+	} else {
+		startTime = &currentTime
+	}
+	if err := ensureScaleInTimeAnnoInPod(tc, pod, deps.PodControl, startTime.Format(time.RFC3339)); err != nil {
+		return false, fmt.Errorf("cannot add annotation to pod: %w", err)
+	}
+
+	if startTime.Add(defaultEvictLeaderTimeoutWhenScaleIn).Before(currentTime) || store.LeaderCount == 0 {
+		return true, nil
+	}
+	schedulerMap, err := pdc.GetEvictLeaderSchedulersForStores(id)
+	if err != nil {
+		return false, fmt.Errorf("cannot get scheduler of store %v: %w", id, err)
+	}
+	if _, ok := schedulerMap[id]; !ok {
+		if err := pdc.BeginEvictLeader(id); err != nil {
+			return false, fmt.Errorf("cannot evict leaders of store %v: %w", id, err)
+		}
+		tc.StartOperation(storeOperation(v1alpha1.OperationEvictLeader, v1alpha1.TiKVMemberType, operationReasonScaleIn, store.ID, podName))
+	}
+	return false, nil
+}
+
 type fakeTiKVScaler struct{}
 
 // NewFakeTiKVScaler returns a fake tikv Scaler
@@ -0,0 +1,333 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// tikvUnsafeRecoveryTimeout is the timeout passed to PD for the online unsafe recovery
+	tikvUnsafeRecoveryTimeout = 10 * time.Minute
+	tikvUnsafeRecoveryReason  = "TiKVUnsafeRecovery"
+
+	// the prefixes of the outputs of the online unsafe recovery in PD
+	unsafeRecoveryFinishedPrefix      = "unsafe recovery finished"
+	unsafeRecoveryFailedPrefix        = "unsafe recovery failed"
+	unsafeRecoveryForceLeaderPrefix   = "force leader on regions:"
+	unsafeRecoveryEmptyRegionsPrefix  = "newly created empty regions:"
+	unsafeRecoveryDemoteRegionPrefix  = "region "
+	unsafeRecoveryCreateRegionsPrefix = "create region "
+)
+
+// tikvUnsafeRecoverer removes the permanently failed TiKV stores by the online unsafe recovery of PD
+// when annotation `tidb.pingcap.com/tikv-unsafe-recovery` is set on the TidbCluster. It's used when
+// some regions lost the majority of replicas, e.g. a whole zone is lost in a 3-replica cluster, as
+// adding new stores by failover can't help the regions which can't elect leaders. The stores of
+// `spec.tikv` and all the TiKV pools are in the same PD cluster, so they are recovered at once. It:
+//  1. takes the failure stores which are not Up as the failed stores and starts the recovery in PD
+//  2. polls the progress of the recovery and records the affected regions and the risk of data loss
+//  3. deletes the failed stores from PD and recreates their pods and PVCs, then the normal failover
+//     restores the count of replicas
+type tikvUnsafeRecoverer struct {
+	deps            *controller.Dependencies
+	failureRecovery commonStatefulFailureRecovery
+}
+
+func newTiKVUnsafeRecoverer(deps *controller.Dependencies) *tikvUnsafeRecoverer {
+	return &tikvUnsafeRecoverer{
+		deps:            deps,
+		failureRecovery: commonStatefulFailureRecovery{deps: deps, failureObjectAccess: &failureStoreAccess{storeAccess: &tikvStoreAccess{}}},
+	}
+}
+
+func (r *tikvUnsafeRecoverer) Sync(tc *v1alpha1.TidbCluster) error {
+	id := tc.Annotations[label.AnnTiKVUnsafeRecovery]
+	status := tc.Status.TiKV.UnsafeRecovery
+	if id == "" || status != nil && status.ID == id &&
+		(status.Phase == v1alpha1.TiKVUnsafeRecoveryPhaseCompleted || status.Phase == v1alpha1.TiKVUnsafeRecoveryPhaseFailed) {
+		return nil
+	}
+	if status == nil || status.ID != id {
+		return r.start(tc, id)
+	}
+
+	switch status.Phase {
+	case v1alpha1.TiKVUnsafeRecoveryPhaseRunning:
+		return r.syncProgress(tc)
+	case v1alpha1.TiKVUnsafeRecoveryPhaseCleaningUp:
+		return r.cleanUp(tc)
+	}
+	return nil
+}
+
+func (r *tikvUnsafeRecoverer) start(tc *v1alpha1.TidbCluster, id string) error {
+	status := &v1alpha1.TiKVUnsafeRecoveryStatus{
+		ID:        id,
+		StartTime: metav1.Now(),
+	}
+	tc.Status.TiKV.UnsafeRecovery = status
+
+	var storeIDs []uint64
+	for _, tikv := range unsafeRecoveryStatuses(tc) {
+		for storeID, failureStore := range tikv.FailureStores {
+			store, exist := tikv.Stores[storeID]
+			// the store is back or already removed
+			if failureStore.StoreDeleted || !exist || store.State == v1alpha1.TiKVStateUp || store.State == v1alpha1.TiKVStateTombstone {
+				continue
+			}
+			sid, err := strconv.ParseUint(storeID, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid store id %q in failure stores of TiKV: %v", storeID, err)
+			}
+			storeIDs = append(storeIDs, sid)
+		}
+	}
+	if len(storeIDs) == 0 {
+		r.fail(tc, "no failed store is found in the failure stores of TiKV")
+		return nil
+	}
+	sort.Slice(storeIDs, func(i, j int) bool { return storeIDs[i] < storeIDs[j] })
+
+	if err := controller.GetPDClient(r.deps.PDControl, tc).RemoveFailedStores(storeIDs, tikvUnsafeRecoveryTimeout); err != nil {
+		// retry in the next round
+		tc.Status.TiKV.UnsafeRecovery = nil
+		return fmt.Errorf("failed to start unsafe recovery for cluster %s/%s, error: %v", tc.GetNamespace(), tc.GetName(), err)
+	}
+	for _, sid := range storeIDs {
+		status.FailedStores = append(status.FailedStores, strconv.FormatUint(sid, 10))
+	}
+	status.Phase = v1alpha1.TiKVUnsafeRecoveryPhaseRunning
+	status.Message = fmt.Sprintf("removing failed stores %s", strings.Join(status.FailedStores, ", "))
+	op := unsafeRecoveryOperation(id)
+	op.Message = status.Message
+	tc.StartOperation(op)
+	klog.Infof("TidbCluster: [%s/%s], TiKV unsafe recovery %s is started, %s", tc.GetNamespace(), tc.GetName(), id, status.Message)
+	r.deps.Recorder.Event(tc, corev1.EventTypeWarning, tikvUnsafeRecoveryReason, fmt.Sprintf("unsafe recovery is started, %s", status.Message))
+	return nil
+}
+
+func (r *tikvUnsafeRecoverer) syncProgress(tc *v1alpha1.TidbCluster) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	status := tc.Status.TiKV.UnsafeRecovery
+
+	stages, err := controller.GetPDClient(r.deps.PDControl, tc).GetUnsafeRecoveryProgress()
+	if err != nil {
+		return fmt.Errorf("failed to get unsafe recovery progress for cluster %s/%s, error: %v", ns, tcName, err)
+	}
+	if len(stages) == 0 {
+		return controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for unsafe recovery to be started", ns, tcName)
+	}
+	summarizeUnsafeRecovery(status, stages)
+
+	last := stages[len(stages)-1]
+	info := strings.ToLower(last.Info)
+	switch {
+	case strings.HasPrefix(info, unsafeRecoveryFailedPrefix):
+		status.Details = last.Details
+		r.fail(tc, last.Info)
+		return nil
+	case strings.HasPrefix(info, unsafeRecoveryFinishedPrefix):
+		status.Details = last.Details
+		status.Phase = v1alpha1.TiKVUnsafeRecoveryPhaseCleaningUp
+		status.Message = fmt.Sprintf("%d regions are recovered with %s risk of data loss, cleaning up failed stores", status.AffectedRegionCount, status.DataLossRisk)
+		r.deps.Recorder.Event(tc, corev1.EventTypeWarning, tikvUnsafeRecoveryReason, status.Message)
+		return nil
+	}
+	return controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for unsafe recovery to be finished, stage: %s", ns, tcName, last.Info)
+}
+
+// cleanUp deletes the failed stores from PD, and deletes the pods and PVCs of them after they become Tombstone
+func (r *tikvUnsafeRecoverer) cleanUp(tc *v1alpha1.TidbCluster) error {
+	status := tc.Status.TiKV.UnsafeRecovery
+	pdClient := controller.GetPDClient(r.deps.PDControl, tc)
+	statuses := unsafeRecoveryStatuses(tc)
+	var removed int
+	for _, storeID := range status.FailedStores {
+		pool, tikv := failedStoreStatus(statuses, storeID)
+		if tikv == nil {
+			// the store is removed together with its pool
+			removed++
+			continue
+		}
+		store, exist := tikv.Stores[storeID]
+		if exist && store.State != v1alpha1.TiKVStateTombstone {
+			if store.State != v1alpha1.TiKVStateOffline {
+				id, err := strconv.ParseUint(storeID, 10, 64)
+				if err != nil {
+					return err
+				}
+				if err := pdClient.DeleteStore(id); err != nil {
+					return err
+				}
+				klog.Infof("TidbCluster: [%s/%s], delete failed store %s after unsafe recovery", tc.GetNamespace(), tc.GetName(), storeID)
+			}
+			continue
+		}
+		if failureStore, ok := tikv.FailureStores[storeID]; ok && !failureStore.StoreDeleted {
+			if err := r.deletePodAndPvcs(tc, pool, storeID); err != nil {
+				return err
+			}
+			failureStore.StoreDeleted = true
+			tikv.FailureStores[storeID] = failureStore
+		}
+		removed++
+	}
+	if removed < len(status.FailedStores) {
+		status.Message = fmt.Sprintf("%d of %d failed stores are removed", removed, len(status.FailedStores))
+		return controller.RequeueErrorf("TidbCluster: [%s/%s], waiting for failed stores to be removed after unsafe recovery, %s", tc.GetNamespace(), tc.GetName(), status.Message)
+	}
+
+	now := metav1.Now()
+	status.Phase = v1alpha1.TiKVUnsafeRecoveryPhaseCompleted
+	status.CompletionTime = &now
+	status.Message = fmt.Sprintf("failed stores %s are removed", strings.Join(status.FailedStores, ", "))
+	op := unsafeRecoveryOperation(status.ID)
+	op.Message = status.Message
+	tc.FinishOperation(op, nil)
+	klog.Infof("TidbCluster: [%s/%s], TiKV unsafe recovery %s is completed", tc.GetNamespace(), tc.GetName(), status.ID)
+	r.deps.Recorder.Event(tc, corev1.EventTypeNormal, tikvUnsafeRecoveryReason, fmt.Sprintf("unsafe recovery is completed, %s", status.Message))
+	return nil
+}
+
+// deletePodAndPvcs deletes the pod and PVCs of the failed store of `spec.tikv` if pool is empty, or of the TiKV pool
+func (r *tikvUnsafeRecoverer) deletePodAndPvcs(tc *v1alpha1.TidbCluster, pool, storeID string) error {
+	if pool == "" {
+		return r.failureRecovery.deletePodAndPvcs(tc, storeID)
+	}
+	ptc := tc.TiKVPoolCluster(&v1alpha1.TiKVPoolSpec{Name: pool})
+	err := r.failureRecovery.deletePodAndPvcs(ptc, storeID)
+	tc.MergeOperations(ptc)
+	return err
+}
+
+func (r *tikvUnsafeRecoverer) fail(tc *v1alpha1.TidbCluster, msg string) {
+	status := tc.Status.TiKV.UnsafeRecovery
+	now := metav1.Now()
+	status.Phase = v1alpha1.TiKVUnsafeRecoveryPhaseFailed
+	status.CompletionTime = &now
+	status.Message = msg
+	tc.FinishOperation(unsafeRecoveryOperation(status.ID), errors.New(msg))
+	klog.Errorf("TidbCluster: [%s/%s], TiKV unsafe recovery %s is failed: %s", tc.GetNamespace(), tc.GetName(), status.ID, msg)
+	r.deps.Recorder.Event(tc, corev1.EventTypeWarning, tikvUnsafeRecoveryReason, fmt.Sprintf("unsafe recovery is failed: %s", msg))
+}
+
+// unsafeRecoveryStatuses returns the status of `spec.tikv` keyed by the empty string and the status of the TiKV
+// pools keyed by their names
+func unsafeRecoveryStatuses(tc *v1alpha1.TidbCluster) map[string]*v1alpha1.TiKVStatus {
+	statuses := map[string]*v1alpha1.TiKVStatus{"": &tc.Status.TiKV}
+	for name, status := range tc.Status.TiKVPools {
+		if status != nil {
+			statuses[name] = status
+		}
+	}
+	return statuses
+}
+
+// failedStoreStatus returns the pool and the status which the failed store belongs to, the status is nil if
+// the store is not found
+func failedStoreStatus(statuses map[string]*v1alpha1.TiKVStatus, storeID string) (string, *v1alpha1.TiKVStatus) {
+	for pool, status := range statuses {
+		if _, ok := status.FailureStores[storeID]; ok {
+			return pool, status
+		}
+		if _, ok := status.Stores[storeID]; ok {
+			return pool, status
+		}
+	}
+	return "", nil
+}
+
+// unsafeRecoveryOperation returns the operation of the unsafe recovery, its target is the id of the recovery
+func unsafeRecoveryOperation(id string) v1alpha1.TidbClusterOperation {
+	return v1alpha1.TidbClusterOperation{
+		Type:      v1alpha1.OperationUnsafeRecovery,
+		Component: v1alpha1.TiKVMemberType,
+		Reason:    operationReasonRecovery,
+		Target:    id,
+	}
+}
+
+// summarizeUnsafeRecovery records the latest stage, the affected regions and the risk of data loss from
+// the plans and the details in the stages of the online unsafe recovery
+func summarizeUnsafeRecovery(status *v1alpha1.TiKVUnsafeRecoveryStatus, stages []pdapi.UnsafeRecoveryStage) {
+	affected := sets.NewString()
+	empty := sets.NewString()
+	var creates int
+	for _, stage := range stages {
+		for _, actions := range stage.Actions {
+			for _, action := range actions {
+				switch {
+				case strings.HasPrefix(action, unsafeRecoveryForceLeaderPrefix):
+					affected.Insert(parseRegionIDs(strings.TrimPrefix(action, unsafeRecoveryForceLeaderPrefix))...)
+				case strings.HasPrefix(action, unsafeRecoveryCreateRegionsPrefix):
+					creates++
+				case strings.HasPrefix(action, unsafeRecoveryDemoteRegionPrefix):
+					// e.g. "region 2 demotes peers { id:5 store_id:4 }"
+					if fields := strings.Fields(action); len(fields) > 1 {
+						affected.Insert(parseRegionIDs(fields[1])...)
+					}
+				}
+			}
+		}
+		for _, detail := range stage.Details {
+			if strings.HasPrefix(detail, unsafeRecoveryEmptyRegionsPrefix) {
+				empty.Insert(parseRegionIDs(strings.TrimPrefix(detail, unsafeRecoveryEmptyRegionsPrefix))...)
+			}
+		}
+	}
+	affected = affected.Union(empty)
+
+	status.Stage = stages[len(stages)-1].Info
+	status.AffectedRegionCount = int32(affected.Len())
+	status.EmptyRegionCount = int32(empty.Len())
+	if creates > empty.Len() {
+		status.EmptyRegionCount = int32(creates)
+	}
+	switch {
+	case status.EmptyRegionCount > 0:
+		status.DataLossRisk = v1alpha1.DataLossRiskHigh
+	case status.AffectedRegionCount > 0:
+		status.DataLossRisk = v1alpha1.DataLossRiskLow
+	default:
+		status.DataLossRisk = v1alpha1.DataLossRiskNone
+	}
+}
+
+// parseRegionIDs parses the region IDs separated by commas, invalid IDs are ignored
+func parseRegionIDs(s string) []string {
+	var ids []string
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if _, err := strconv.ParseUint(field, 10, 64); err == nil {
+			ids = append(ids, field)
+		}
+	}
+	return ids
+}
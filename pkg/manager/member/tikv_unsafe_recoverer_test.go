@@ -0,0 +1,228 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestTiKVUnsafeRecovererSync(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPD()
+	tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{
+		"1": {ID: "1", PodName: TikvPodName(tc.Name, 0), State: v1alpha1.TiKVStateUp},
+		"4": {ID: "4", PodName: TikvPodName(tc.Name, 1), State: v1alpha1.TiKVStateDown},
+	}
+	tc.Status.TiKV.FailureStores = map[string]v1alpha1.TiKVFailureStore{}
+	// the failed store of the TiKV pool is recovered together with the one of spec.tikv
+	pool := &v1alpha1.TiKVStatus{
+		Stores: map[string]v1alpha1.TiKVStore{
+			"5": {ID: "5", PodName: TikvPodName("test-ssd", 0), State: v1alpha1.TiKVStateDown},
+		},
+		FailureStores: map[string]v1alpha1.TiKVFailureStore{},
+	}
+	tc.Status.TiKVPools = map[string]*v1alpha1.TiKVStatus{"ssd": pool}
+	deps := controller.NewFakeDependencies()
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	pvcIndexer := deps.KubeInformerFactory.Core().V1().PersistentVolumeClaims().Informer().GetIndexer()
+	for _, s := range []struct {
+		instance string
+		status   *v1alpha1.TiKVStatus
+		storeID  string
+	}{
+		{instance: tc.Name, status: &tc.Status.TiKV, storeID: "4"},
+		{instance: "test-ssd", status: pool, storeID: "5"},
+	} {
+		podName := s.status.Stores[s.storeID].PodName
+		pvcUID := types.UID("pvc-" + s.storeID)
+		s.status.FailureStores[s.storeID] = v1alpha1.TiKVFailureStore{
+			PodName:   podName,
+			StoreID:   s.storeID,
+			PVCUIDSet: map[types.UID]v1alpha1.EmptyStruct{pvcUID: {}},
+		}
+		pvcName := fmt.Sprintf("tikv-%s", podName)
+		g.Expect(podIndexer.Add(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: tc.Namespace, Labels: label.New().Instance(s.instance).TiKV().Labels()},
+		})).To(Succeed())
+		pvcLabels := label.New().Instance(s.instance).TiKV()
+		pvcLabels[label.AnnPodNameKey] = podName
+		g.Expect(pvcIndexer.Add(&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: pvcName, Namespace: tc.Namespace, UID: pvcUID, Labels: pvcLabels},
+		})).To(Succeed())
+	}
+	r := newTiKVUnsafeRecoverer(deps)
+
+	// not triggered
+	g.Expect(r.Sync(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKV.UnsafeRecovery).To(BeNil())
+
+	// start
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	var removed []uint64
+	pdClient.AddReaction(pdapi.RemoveFailedStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		g.Expect(action.Timeout).To(Equal(10 * time.Minute))
+		removed = action.IDs
+		return nil, nil
+	})
+	tc.Annotations = map[string]string{label.AnnTiKVUnsafeRecovery: "r1"}
+	g.Expect(r.Sync(tc)).To(Succeed())
+	g.Expect(removed).To(Equal([]uint64{4, 5}))
+	status := tc.Status.TiKV.UnsafeRecovery
+	g.Expect(status.Phase).To(Equal(v1alpha1.TiKVUnsafeRecoveryPhaseRunning))
+	g.Expect(status.FailedStores).To(Equal([]string{"4", "5"}))
+
+	// running
+	stages := []pdapi.UnsafeRecoveryStage{
+		{Info: "Unsafe recovery enters collect report stage: failed stores 4, 5"},
+		{
+			Info: "Unsafe recovery enters force leader stage",
+			Actions: map[string][]string{
+				"store 1": {"force leader on regions: 2, 3, 10"},
+			},
+		},
+	}
+	pdClient.AddReaction(pdapi.GetUnsafeRecoveryProgressActionType, func(action *pdapi.Action) (interface{}, error) {
+		return stages, nil
+	})
+	err := r.Sync(tc)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(status.Phase).To(Equal(v1alpha1.TiKVUnsafeRecoveryPhaseRunning))
+	g.Expect(status.Stage).To(Equal("Unsafe recovery enters force leader stage"))
+	g.Expect(status.AffectedRegionCount).To(Equal(int32(3)))
+	g.Expect(status.DataLossRisk).To(Equal(v1alpha1.DataLossRiskLow))
+
+	// finished
+	stages = append(stages,
+		pdapi.UnsafeRecoveryStage{
+			Info: "Unsafe recovery enters demote failed voter stage",
+			Actions: map[string][]string{
+				"store 1": {"region 2 demotes peers { id:5 store_id:4 }", "region 11 demotes peers { id:7 store_id:5 }"},
+			},
+		},
+		pdapi.UnsafeRecoveryStage{
+			Info:    "Unsafe recovery finished",
+			Details: []string{"affected table ids: 72", "newly created empty regions: 12"},
+		},
+	)
+	g.Expect(r.Sync(tc)).To(Succeed())
+	g.Expect(status.Phase).To(Equal(v1alpha1.TiKVUnsafeRecoveryPhaseCleaningUp))
+	g.Expect(status.AffectedRegionCount).To(Equal(int32(5)))
+	g.Expect(status.EmptyRegionCount).To(Equal(int32(1)))
+	g.Expect(status.DataLossRisk).To(Equal(v1alpha1.DataLossRiskHigh))
+	g.Expect(status.Details).To(Equal([]string{"affected table ids: 72", "newly created empty regions: 12"}))
+
+	// clean up
+	var deleted []uint64
+	pdClient.AddReaction(pdapi.DeleteStoreActionType, func(action *pdapi.Action) (interface{}, error) {
+		deleted = append(deleted, action.ID)
+		return nil, nil
+	})
+	err = r.Sync(tc)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(deleted).To(Equal([]uint64{4, 5}))
+
+	store := tc.Status.TiKV.Stores["4"]
+	store.State = v1alpha1.TiKVStateTombstone
+	tc.Status.TiKV.Stores["4"] = store
+	store = pool.Stores["5"]
+	store.State = v1alpha1.TiKVStateOffline
+	pool.Stores["5"] = store
+	err = r.Sync(tc)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(deleted).To(HaveLen(2))
+	g.Expect(tc.Status.TiKV.FailureStores["4"].StoreDeleted).To(BeTrue())
+	g.Expect(pool.FailureStores["5"].StoreDeleted).To(BeFalse())
+	_, exist, _ := podIndexer.GetByKey(tc.Namespace + "/" + TikvPodName(tc.Name, 1))
+	g.Expect(exist).To(BeFalse())
+	_, exist, _ = pvcIndexer.GetByKey(tc.Namespace + "/tikv-" + TikvPodName(tc.Name, 1))
+	g.Expect(exist).To(BeFalse())
+
+	delete(pool.Stores, "5")
+	g.Expect(r.Sync(tc)).To(Succeed())
+	g.Expect(status.Phase).To(Equal(v1alpha1.TiKVUnsafeRecoveryPhaseCompleted))
+	g.Expect(status.CompletionTime).NotTo(BeNil())
+	g.Expect(pool.FailureStores["5"].StoreDeleted).To(BeTrue())
+	_, exist, _ = podIndexer.GetByKey(tc.Namespace + "/" + TikvPodName("test-ssd", 0))
+	g.Expect(exist).To(BeFalse())
+	_, exist, _ = pvcIndexer.GetByKey(tc.Namespace + "/tikv-" + TikvPodName("test-ssd", 0))
+	g.Expect(exist).To(BeFalse())
+
+	// completed
+	g.Expect(r.Sync(tc)).To(Succeed())
+	g.Expect(status.Phase).To(Equal(v1alpha1.TiKVUnsafeRecoveryPhaseCompleted))
+}
+
+func TestTiKVUnsafeRecovererFailed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		name   string
+		stores map[string]v1alpha1.TiKVStore
+		stages []pdapi.UnsafeRecoveryStage
+	}{
+		{
+			name: "no failed store",
+			stores: map[string]v1alpha1.TiKVStore{
+				"4": {ID: "4", State: v1alpha1.TiKVStateUp},
+			},
+		},
+		{
+			name: "recovery is failed in PD",
+			stores: map[string]v1alpha1.TiKVStore{
+				"4": {ID: "4", State: v1alpha1.TiKVStateDown},
+			},
+			stages: []pdapi.UnsafeRecoveryStage{
+				{Info: "Unsafe recovery enters collect report stage: failed stores 4"},
+				{Info: "Unsafe recovery failed: exceeds timeout 10m0s"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbClusterForPD()
+			tc.Annotations = map[string]string{label.AnnTiKVUnsafeRecovery: "r1"}
+			tc.Status.TiKV.Stores = tt.stores
+			tc.Status.TiKV.FailureStores = map[string]v1alpha1.TiKVFailureStore{"4": {StoreID: "4"}}
+			deps := controller.NewFakeDependencies()
+			pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+			pdClient.AddReaction(pdapi.RemoveFailedStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+				return nil, nil
+			})
+			pdClient.AddReaction(pdapi.GetUnsafeRecoveryProgressActionType, func(action *pdapi.Action) (interface{}, error) {
+				return tt.stages, nil
+			})
+			r := newTiKVUnsafeRecoverer(deps)
+
+			g.Expect(r.Sync(tc)).To(Succeed())
+			if tc.Status.TiKV.UnsafeRecovery.Phase == v1alpha1.TiKVUnsafeRecoveryPhaseRunning {
+				g.Expect(r.Sync(tc)).To(Succeed())
+			}
+			g.Expect(tc.Status.TiKV.UnsafeRecovery.Phase).To(Equal(v1alpha1.TiKVUnsafeRecoveryPhaseFailed))
+			g.Expect(tc.Status.TiKV.UnsafeRecovery.CompletionTime).NotTo(BeNil())
+		})
+	}
+}
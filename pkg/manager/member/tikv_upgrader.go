@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
@@ -25,11 +26,13 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	"github.com/pingcap/tidb-operator/pkg/manager/volumes"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
 	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
 
 	apps "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	errorutils "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
@@ -106,7 +109,8 @@ func (u *tikvUpgrader) Upgrade(meta metav1.Object, oldSet *apps.StatefulSet, new
 		return nil
 	}
 
-	if oldSet.Spec.UpdateStrategy.Type == apps.OnDeleteStatefulSetStrategyType || oldSet.Spec.UpdateStrategy.RollingUpdate == nil {
+	if (oldSet.Spec.UpdateStrategy.Type == apps.OnDeleteStatefulSetStrategyType || oldSet.Spec.UpdateStrategy.RollingUpdate == nil) &&
+		!isOnDeleteUpgradeSet(oldSet) {
 		// Manually bypass tidb-operator to modify statefulset directly, such as modify tikv statefulset's RollingUpdate strategy to OnDelete strategy,
 		// or set RollingUpdate to nil, skip tidb-operator's rolling update logic in order to speed up the upgrade in the test environment occasionally.
 		// If we encounter this situation, we will let the native statefulset controller do the upgrade completely, which may be unsafe for upgrading tikv.
@@ -116,9 +120,19 @@ func (u *tikvUpgrader) Upgrade(meta metav1.Object, oldSet *apps.StatefulSet, new
 		return nil
 	}
 
+	if tc.Spec.TiKV.UpgradePolicy.GetStrategy() == v1alpha1.UpgradeStrategyTopology {
+		topologyKey, err := getTopologyUpgradeKey(u.deps, tc, v1alpha1.TiKVMemberType, tc.Spec.TiKV.UpgradePolicy.TopologyKey)
+		if err != nil {
+			return err
+		}
+		if topologyKey != "" {
+			return u.upgradeByTopology(tc, oldSet, newSet, topologyKey)
+		}
+	}
+
 	minReadySeconds := getMinReadySeconds(tc)
 
-	mngerutils.SetUpgradePartition(newSet, *oldSet.Spec.UpdateStrategy.RollingUpdate.Partition)
+	mngerutils.SetUpgradePartition(newSet, upgradePartition(oldSet, newSet))
 	podOrdinals := helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet).List()
 	for _i := len(podOrdinals) - 1; _i >= 0; _i-- {
 		i := podOrdinals[_i]
@@ -169,6 +183,132 @@ func (u *tikvUpgrader) Upgrade(meta metav1.Object, oldSet *apps.StatefulSet, new
 	return nil
 }
 
+// upgradeByTopology upgrades the pods in the same topology domain together. The leaders of the stores
+// in a batch are evicted concurrently, then the pods are deleted together to be recreated with the update
+// revision by the OnDelete strategy of the StatefulSet. The next batch is upgraded after the upgraded stores
+// are up and the regions are healthy again.
+func (u *tikvUpgrader) upgradeByTopology(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSet, newSet *apps.StatefulSet, topologyKey string) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	minReadySeconds := getMinReadySeconds(tc)
+
+	if isOnDeleteUpgradeSet(oldSet) {
+		beginOnDeleteUpgrade(newSet)
+	} else {
+		mngerutils.SetUpgradePartition(newSet, upgradePartition(oldSet, newSet))
+	}
+	podOrdinals := helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet).List()
+	pods := make([]*corev1.Pod, 0, len(podOrdinals))
+	podByName := map[string]*corev1.Pod{}
+	for _, i := range podOrdinals {
+		podName := TikvPodName(tcName, i)
+		pod, err := u.deps.PodLister.Pods(ns).Get(podName)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return controller.RequeueErrorf("tidbcluster: [%s/%s]'s tikv pod: [%s] is not created yet", ns, tcName, podName)
+			}
+			return fmt.Errorf("tikvUpgrader.upgradeByTopology: failed to get pods %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
+		}
+		pods = append(pods, pod)
+		podByName[podName] = pod
+	}
+	domains, err := getStoreTopologyDomains(u.deps, tc, v1alpha1.TiKVMemberType, topologyKey, pods)
+	if err != nil {
+		return err
+	}
+
+	var pending []topologyUpgradePod
+	for idx, pod := range pods {
+		revision, exist := pod.Labels[apps.ControllerRevisionHashLabelKey]
+		if !exist {
+			return controller.RequeueErrorf("tidbcluster: [%s/%s]'s tikv pod: [%s] has no label: %s", ns, tcName, pod.Name, apps.ControllerRevisionHashLabelKey)
+		}
+		store := getStoreByOrdinal(tcName, tc.Status.TiKV, podOrdinals[idx])
+
+		if revision == tc.Status.TiKV.StatefulSet.UpdateRevision {
+			if store == nil {
+				continue
+			}
+			if err := isPodAvailable(pod, minReadySeconds, tc); err != nil {
+				return err
+			}
+			if store.State != v1alpha1.TiKVStateUp {
+				return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded tikv pod: [%s] is not all ready", ns, tcName, pod.Name)
+			}
+			done, err := u.endEvictLeaderAfterUpgrade(tc, pod)
+			if err != nil {
+				return err
+			}
+			if !done {
+				return controller.RequeueErrorf("waiting to end evict leader of pod %s for tc %s/%s", pod.Name, ns, tcName)
+			}
+			continue
+		}
+
+		_, evicting := pod.Annotations[annoKeyEvictLeaderBeginTime]
+		pending = append(pending, topologyUpgradePod{
+			ordinal:   podOrdinals[idx],
+			name:      pod.Name,
+			domain:    domains[pod.Name],
+			upgrading: evicting || pod.DeletionTimestamp != nil,
+		})
+	}
+
+	if len(pending) == 0 {
+		endOnDeleteUpgrade(newSet)
+		return nil
+	}
+
+	batch := nextTopologyUpgradeBatch(pending, tc.Spec.TiKV.UpgradePolicy.GetMaxParallelism())
+	podNames := topologyUpgradePodNames(batch)
+	if !isTopologyUpgradeBegun(batch) {
+		if unstableReason := u.isClusterStable(tc); unstableReason != "" {
+			return controller.RequeueErrorf("cluster is unstable: %s", unstableReason)
+		}
+		healthy, err := checkRegionHealthBeforeUpgrade(u.deps, tc, v1alpha1.TiKVMemberType, strings.Join(podNames, ","))
+		if err != nil {
+			return fmt.Errorf("tikvUpgrader.upgradeByTopology: failed to check region health before upgrading pods %v for tc %s/%s, error: %s", podNames, ns, tcName, err)
+		}
+		if !healthy {
+			return controller.RequeueErrorf("tikvUpgrader.upgradeByTopology: waiting for regions to be healthy before upgrading pods %v for tc %s/%s", podNames, ns, tcName)
+		}
+		msg := fmt.Sprintf("upgrade tikv pods %v in topology domain %s=%q", podNames, topologyKey, batch[0].domain)
+		klog.Infof("TidbCluster: [%s/%s], %s", ns, tcName, msg)
+		u.deps.Recorder.Event(tc, corev1.EventTypeNormal, "TopologyUpgrade", msg)
+	}
+
+	// evict the leaders of all the stores in the batch concurrently
+	allDone := true
+	batchPods := make([]*corev1.Pod, 0, len(batch))
+	for _, p := range batch {
+		pod := podByName[p.name]
+		batchPods = append(batchPods, pod)
+		if getStoreByOrdinal(tcName, tc.Status.TiKV, p.ordinal) == nil {
+			continue
+		}
+		done, err := u.evictLeaderBeforeUpgrade(tc, pod)
+		if err != nil {
+			return fmt.Errorf("tikvUpgrader.upgradeByTopology: failed to evict leader of pod %s for tc %s/%s, error: %s", pod.Name, ns, tcName, err)
+		}
+		allDone = allDone && done
+	}
+	if !allDone {
+		return controller.RequeueErrorf("tikvUpgrader.upgradeByTopology: evicting leaders of pods %v for tc %s/%s", podNames, ns, tcName)
+	}
+
+	for _, pod := range batchPods {
+		done, err := u.modifyVolumesBeforeUpgrade(tc, pod)
+		if err != nil {
+			return fmt.Errorf("tikvUpgrader.upgradeByTopology: failed to modify volumes of pod %s for tc %s/%s, error: %s", pod.Name, ns, tcName, err)
+		}
+		if !done {
+			return controller.RequeueErrorf("tikvUpgrader.upgradeByTopology: modifying volumes of pod %s for tc %s/%s", pod.Name, ns, tcName)
+		}
+	}
+
+	return upgradeTopologyBatch(u.deps, tc, oldSet, newSet, batch, batchPods)
+}
+
 func (u *tikvUpgrader) isClusterStable(tc *v1alpha1.TidbCluster) string {
 	if check, ok := tc.Annotations[annoKeyTiKVStoreStateCheck]; ok && check == "true" {
 		return pdapi.IsTiKVStable(controller.GetPDClient(u.deps.PDControl, tc))
@@ -185,6 +325,17 @@ func (u *tikvUpgrader) upgradeTiKVPod(tc *v1alpha1.TidbCluster, ordinal int32, n
 		return fmt.Errorf("upgradeTiKVPod: failed to get pod %s for tc %s/%s, error: %s", upgradePodName, ns, tcName, err)
 	}
 
+	// wait for the regions to be healthy before evicting leaders on the next store
+	if _, evicting := upgradePod.Annotations[annoKeyEvictLeaderBeginTime]; !evicting {
+		healthy, err := checkRegionHealthBeforeUpgrade(u.deps, tc, v1alpha1.TiKVMemberType, upgradePodName)
+		if err != nil {
+			return fmt.Errorf("upgradeTiKVPod: failed to check region health before upgrading pod %s for tc %s/%s, error: %s", upgradePodName, ns, tcName, err)
+		}
+		if !healthy {
+			return controller.RequeueErrorf("upgradeTiKVPod: waiting for regions to be healthy before upgrading pod %s for tc %s/%s", upgradePodName, ns, tcName)
+		}
+	}
+
 	done, err := u.evictLeaderBeforeUpgrade(tc, upgradePod)
 	if err != nil {
 		return fmt.Errorf("upgradeTiKVPod: failed to evict leader of pod %s for tc %s/%s, error: %s", upgradePodName, ns, tcName, err)
@@ -227,8 +378,12 @@ func (u *tikvUpgrader) evictLeaderBeforeUpgrade(tc *v1alpha1.TidbCluster, upgrad
 			klog.Errorf("%s: parse annotation %q to time failed", logPrefix, annoKeyEvictLeaderBeginTime)
 			return false, nil
 		}
+		// update the duration on every sync so that a slow eviction is alerted while it's still running
+		observeEvictLeaderDuration(tc, storeID, evictLeaderBeginTime)
 		if time.Now().After(evictLeaderBeginTime.Add(evictLeaderTimeout)) {
 			klog.Infof("%s: evict leader timeout with threshold %v, so ready to upgrade", logPrefix, evictLeaderTimeout)
+			tc.FinishOperation(storeOperation(v1alpha1.OperationEvictLeader, v1alpha1.TiKVMemberType, operationReasonUpgrade,
+				strconv.FormatUint(storeID, 10), upgradePod.Name), fmt.Errorf("evict leader timeout with threshold %v", evictLeaderTimeout))
 			return true, nil
 		}
 
@@ -255,6 +410,11 @@ func (u *tikvUpgrader) evictLeaderBeforeUpgrade(tc *v1alpha1.TidbCluster, upgrad
 		if err != nil {
 			klog.Warningf("%s: failed to trigger force flush, continuing: %s", logPrefix, err)
 		}
+		if evictLeaderBeginTime, err := time.Parse(time.RFC3339, upgradePod.Annotations[annoKeyEvictLeaderBeginTime]); err == nil {
+			observeEvictLeaderDuration(tc, storeID, evictLeaderBeginTime)
+		}
+		tc.FinishOperation(storeOperation(v1alpha1.OperationEvictLeader, v1alpha1.TiKVMemberType, operationReasonUpgrade,
+			strconv.FormatUint(storeID, 10), upgradePod.Name), nil)
 		return true, nil
 	}
 
@@ -262,6 +422,13 @@ func (u *tikvUpgrader) evictLeaderBeforeUpgrade(tc *v1alpha1.TidbCluster, upgrad
 	return false, nil
 }
 
+// observeEvictLeaderDuration records the duration of the leader eviction of the store before its pod is upgraded,
+// it's updated while the eviction is running and deleted after the eviction ends
+func observeEvictLeaderDuration(tc *v1alpha1.TidbCluster, storeID uint64, beginTime time.Time) {
+	metrics.ClusterEvictLeaderDuration.WithLabelValues(tc.GetNamespace(), tc.GetName(), v1alpha1.TiKVMemberType.String(),
+		strconv.FormatUint(storeID, 10)).Set(time.Since(beginTime).Seconds())
+}
+
 func (u *tikvUpgrader) modifyVolumesBeforeUpgrade(tc *v1alpha1.TidbCluster, upgradePod *corev1.Pod) (bool, error) {
 	desiredVolumes, err := u.volumeModifier.GetDesiredVolumes(tc, v1alpha1.TiKVMemberType)
 	if err != nil {
@@ -388,6 +555,8 @@ func (u *tikvUpgrader) beginEvictLeader(tc *v1alpha1.TidbCluster, storeID uint64
 		return err
 	}
 	klog.Infof("beginEvictLeader: begin evict leader: %d, %s/%s successfully", storeID, ns, podName)
+	tc.StartOperation(storeOperation(v1alpha1.OperationEvictLeader, v1alpha1.TiKVMemberType, operationReasonUpgrade,
+		strconv.FormatUint(storeID, 10), podName))
 	annosToRecordInfo[annoKeyEvictLeaderBeginTime] = time.Now().Format(time.RFC3339)
 
 	if pod.Annotations == nil {
@@ -562,6 +731,7 @@ func endEvictLeaderbyStoreID(deps *controller.Dependencies, tc *v1alpha1.TidbClu
 		return err
 	}
 	klog.Infof("endEvictLeaderbyStoreID: end evict leader for store: %d of %s/%s successfully", storeID, tc.Namespace, tc.Name)
+	metrics.DeleteClusterStoreMetrics(tc.GetNamespace(), tc.GetName(), v1alpha1.TiKVMemberType.String(), strconv.FormatUint(storeID, 10))
 
 	return nil
 }
@@ -25,12 +25,14 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/features"
 	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	"github.com/pingcap/tidb-operator/pkg/manager/volumes"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
 	"github.com/pingcap/tidb-operator/pkg/tikvapi"
 
 	. "github.com/onsi/gomega"
 	apps "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	podinformers "k8s.io/client-go/informers/core/v1"
@@ -57,6 +59,7 @@ func TestTiKVUpgraderUpgrade(t *testing.T) {
 		podName             string
 		updatePodErr        bool
 		tikvIsUnstable      bool
+		pendingPeerRegions  int
 		modifyVolumesResult func() (bool /*should modify*/, error /*result of modify*/) // default to (true, nil)
 		errExpectFn         func(*GomegaWithT, error)
 		expectFn            func(*GomegaWithT, *v1alpha1.TidbCluster, *apps.StatefulSet, map[string]*corev1.Pod)
@@ -111,6 +114,11 @@ func TestTiKVUpgraderUpgrade(t *testing.T) {
 			})
 		}
 
+		pdClient.AddReaction(pdapi.GetRegionsStatusActionType, func(action *pdapi.Action) (interface{}, error) {
+			return map[pdapi.RegionStatusType]int{pdapi.RegionStatusPendingPeer: test.pendingPeerRegions}, nil
+		})
+		setFakePDLeader(pdControl, tc, pdClient)
+
 		var tikvState string
 		if test.tikvIsUnstable {
 			tikvState = v1alpha1.TiKVStateDown
@@ -284,6 +292,75 @@ func TestTiKVUpgraderUpgrade(t *testing.T) {
 				g.Expect(*newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(int32(1)))
 			},
 		},
+		{
+			name: "wait for the regions to be healthy before upgrading the pod which ordinal is 1",
+			changeFn: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PD.Phase = v1alpha1.NormalPhase
+				tc.Status.TiKV.Phase = v1alpha1.UpgradePhase
+				tc.Status.TiKV.Synced = true
+				tc.Status.TiKV.StatefulSet.CurrentReplicas = 2
+				tc.Status.TiKV.StatefulSet.UpdatedReplicas = 1
+				tc.Status.TiKV.RegionHealthBaseline = &v1alpha1.RegionHealthStats{PendingPeerRegionCount: 1}
+			},
+			changeOldSet: func(oldSet *apps.StatefulSet) {
+				mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)
+				oldSet.Status.CurrentReplicas = 2
+				oldSet.Status.UpdatedReplicas = 1
+				oldSet.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.Int32Ptr(2)
+			},
+			pendingPeerRegions: 10,
+			podName:            "upgrader-tikv-1",
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring("waiting for regions to be healthy before upgrading pod upgrader-tikv-1"))
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet, pods map[string]*corev1.Pod) {
+				g.Expect(*newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(int32(2)))
+				_, evicting := pods[TikvPodName(upgradeTcName, 1)].Annotations[annoKeyEvictLeaderBeginTime]
+				g.Expect(evicting).To(BeFalse())
+				cond := meta.FindStatusCondition(tc.Status.TiKV.Conditions, v1alpha1.ConditionTypeUpgradeBlocked)
+				g.Expect(cond).NotTo(BeNil())
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(cond.Reason).To(Equal(upgradeBlockedReasonRegionsUnhealthy))
+				g.Expect(cond.Message).To(ContainSubstring("pending-peer: 10/1"))
+			},
+		},
+		{
+			name: "begin to evict leader of the pod which ordinal is 1 after waiting for the regions timeout",
+			changeFn: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PD.Phase = v1alpha1.NormalPhase
+				tc.Status.TiKV.Phase = v1alpha1.UpgradePhase
+				tc.Status.TiKV.Synced = true
+				tc.Status.TiKV.StatefulSet.CurrentReplicas = 2
+				tc.Status.TiKV.StatefulSet.UpdatedReplicas = 1
+				tc.Status.TiKV.RegionHealthBaseline = &v1alpha1.RegionHealthStats{}
+				tc.Status.TiKV.Conditions = []metav1.Condition{{
+					Type:               v1alpha1.ConditionTypeUpgradeBlocked,
+					Status:             metav1.ConditionTrue,
+					Reason:             upgradeBlockedReasonRegionsUnhealthy,
+					LastTransitionTime: metav1.NewTime(time.Now().Add(-20 * time.Minute)),
+				}}
+			},
+			changeOldSet: func(oldSet *apps.StatefulSet) {
+				mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)
+				oldSet.Status.CurrentReplicas = 2
+				oldSet.Status.UpdatedReplicas = 1
+				oldSet.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.Int32Ptr(2)
+			},
+			pendingPeerRegions: 10,
+			podName:            "upgrader-tikv-1",
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring("upgradeTiKVPod: evicting leader of pod upgrader-tikv-1"))
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet, pods map[string]*corev1.Pod) {
+				_, evicting := pods[TikvPodName(upgradeTcName, 1)].Annotations[annoKeyEvictLeaderBeginTime]
+				g.Expect(evicting).To(BeTrue())
+				cond := meta.FindStatusCondition(tc.Status.TiKV.Conditions, v1alpha1.ConditionTypeUpgradeBlocked)
+				g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(cond.Reason).To(Equal(upgradeBlockedReasonWaitRegionTimeout))
+			},
+		},
 		{
 			name: "newSet template changed",
 			changeFn: func(tc *v1alpha1.TidbCluster) {
@@ -505,6 +582,7 @@ func TestTiKVUpgraderUpgrade(t *testing.T) {
 		{
 			name: "waiting leader count equals to 0",
 			changeFn: func(tc *v1alpha1.TidbCluster) {
+				metrics.DeleteClusterStoreMetrics(tc.Namespace, tc.Name, v1alpha1.TiKVMemberType.String(), "2")
 				tc.Status.PD.Phase = v1alpha1.NormalPhase
 				tc.Status.TiKV.Phase = v1alpha1.UpgradePhase
 				tc.Status.TiKV.Synced = true
@@ -535,6 +613,8 @@ func TestTiKVUpgraderUpgrade(t *testing.T) {
 			},
 			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet, pods map[string]*corev1.Pod) {
 				g.Expect(*newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(int32(2)))
+				// the duration of the running eviction is exported
+				g.Expect(metrics.ClusterEvictLeaderDuration.DeleteLabelValues(tc.Namespace, tc.Name, v1alpha1.TiKVMemberType.String(), "2")).To(BeTrue())
 			},
 		},
 		{
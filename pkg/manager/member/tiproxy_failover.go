@@ -0,0 +1,74 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// NewTiProxyFailover returns a tiproxy Failover
+func NewTiProxyFailover(deps *controller.Dependencies) Failover {
+	return newCommonStatelessFailover(deps, &tiproxyMemberAccess{})
+}
+
+// tiproxyMemberAccess is a folder of access functions for TiProxy member and implements StatelessMemberAccess
+type tiproxyMemberAccess struct{}
+
+var _ StatelessMemberAccess = (*tiproxyMemberAccess)(nil)
+
+func (ma *tiproxyMemberAccess) GetFailoverPeriod(cliConfig *controller.CLIConfig) time.Duration {
+	return cliConfig.TiProxyFailoverPeriod
+}
+
+func (ma *tiproxyMemberAccess) GetMemberType() v1alpha1.MemberType {
+	return v1alpha1.TiProxyMemberType
+}
+
+func (ma *tiproxyMemberAccess) GetMaxFailoverCount(tc *v1alpha1.TidbCluster) *int32 {
+	return tc.Spec.TiProxy.MaxFailoverCount
+}
+
+func (ma *tiproxyMemberAccess) GetMembers(tc *v1alpha1.TidbCluster) map[string]statelessMember {
+	members := make(map[string]statelessMember, len(tc.Status.TiProxy.Members))
+	for name, member := range tc.Status.TiProxy.Members {
+		members[name] = statelessMember{Health: member.Health, LastTransitionTime: member.LastTransitionTime}
+	}
+	return members
+}
+
+func (ma *tiproxyMemberAccess) GetFailureMembers(tc *v1alpha1.TidbCluster) map[string]v1alpha1.StatelessFailureMember {
+	return tc.Status.TiProxy.FailureMembers
+}
+
+func (ma *tiproxyMemberAccess) SetFailureMembers(tc *v1alpha1.TidbCluster, failureMembers map[string]v1alpha1.StatelessFailureMember) {
+	tc.Status.TiProxy.FailureMembers = failureMembers
+}
+
+func (ma *tiproxyMemberAccess) GetStsDesiredOrdinals(tc *v1alpha1.TidbCluster, excludeFailover bool) sets.Int32 {
+	return tc.TiProxyStsDesiredOrdinals(excludeFailover)
+}
+
+func (ma *tiproxyMemberAccess) GetPodName(tc *v1alpha1.TidbCluster, ordinal int32) string {
+	return fmt.Sprintf("%s-%d", controller.TiProxyMemberName(tc.GetName()), ordinal)
+}
+
+// NewFakeTiProxyFailover returns a fake Failover
+func NewFakeTiProxyFailover() Failover {
+	return &fakeStatelessFailover{}
+}
@@ -66,15 +66,17 @@ type tiproxyMemberManager struct {
 	deps      *controller.Dependencies
 	scaler    Scaler
 	upgrader  Upgrader
+	failover  Failover
 	suspender suspender.Suspender
 }
 
 // NewTiProxyMemberManager returns a *tiproxyMemberManager
-func NewTiProxyMemberManager(deps *controller.Dependencies, scaler Scaler, upgrader Upgrader, spder suspender.Suspender) manager.Manager {
+func NewTiProxyMemberManager(deps *controller.Dependencies, scaler Scaler, upgrader Upgrader, failover Failover, spder suspender.Suspender) manager.Manager {
 	m := &tiproxyMemberManager{
 		deps:      deps,
 		scaler:    scaler,
 		upgrader:  upgrader,
+		failover:  failover,
 		suspender: spder,
 	}
 	return m
@@ -129,6 +131,8 @@ func (s *tiproxyMemberManager) handleIfTiProxyScaledToZero(tc *v1alpha1.TidbClus
 		// skip if tiproxy is not scaled to zero
 		return false, nil
 	}
+	// no failover pods are needed if tiproxy is scaled to zero
+	tc.Status.TiProxy.FailureMembers = nil
 
 	sts, err := s.deps.StatefulSetLister.StatefulSets(tc.Namespace).Get(controller.TiProxyMemberName(tc.Name))
 	if err != nil {
@@ -289,6 +293,19 @@ func (m *tiproxyMemberManager) syncStatefulSet(tc *v1alpha1.TidbCluster) error {
 		return err
 	}
 
+	if m.deps.CLIConfig.AutoFailover {
+		if len(tc.Status.TiProxy.FailureMembers) > 0 {
+			m.failover.RemoveUndesiredFailures(tc)
+		}
+		if shouldRecoverStatelessFailure(m.deps, tc, &tiproxyMemberAccess{}) {
+			m.failover.Recover(tc)
+		} else if tc.TiProxyAllPodsStarted() && !tc.TiProxyAllMembersReady() {
+			if err := m.failover.Failover(tc); err != nil {
+				return err
+			}
+		}
+	}
+
 	if !templateEqual(newSts, oldStatefulSet) || tc.Status.TiProxy.Phase == v1alpha1.UpgradePhase {
 		if err := m.upgrader.Upgrade(tc, oldStatefulSet, newSts); err != nil {
 			return err
@@ -310,7 +327,7 @@ func (m *tiproxyMemberManager) syncStatus(tc *v1alpha1.TidbCluster, sts *apps.St
 		tc.Status.TiProxy.Synced = false
 		return err
 	}
-	if tc.Spec.TiProxy.Replicas != *sts.Spec.Replicas {
+	if tc.TiProxyStsDesiredReplicas() != *sts.Spec.Replicas {
 		tc.Status.TiProxy.Phase = v1alpha1.ScalePhase
 	} else if upgrading {
 		tc.Status.TiProxy.Phase = v1alpha1.UpgradePhase
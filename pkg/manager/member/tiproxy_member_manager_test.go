@@ -254,6 +254,7 @@ func newFakeTiProxyMemberManager() (*tiproxyMemberManager, *fakeIndexers) {
 		deps:      fakeDeps,
 		scaler:    NewTiProxyScaler(fakeDeps),
 		upgrader:  NewFakeTiProxyUpgrader(),
+		failover:  NewFakeTiProxyFailover(),
 		suspender: suspender.NewFakeSuspender(),
 	}
 	indexers := &fakeIndexers{
@@ -15,9 +15,14 @@ package member
 
 import (
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	apps "k8s.io/api/apps/v1"
 )
 
+// annoKeyOnDeleteUpgrade marks the StatefulSet whose OnDelete update strategy is set by the upgrader to recreate
+// the pods in its own order, so that it's not taken as the strategy modified manually
+const annoKeyOnDeleteUpgrade = "tidb.pingcap.com/on-delete-upgrade"
+
 // Upgrader implements the logic for upgrading the tidb cluster.
 type Upgrader interface {
 	// Upgrade upgrade the cluster
@@ -28,3 +33,40 @@ type Upgrader interface {
 type DMUpgrader interface {
 	Upgrade(*v1alpha1.DMCluster, *apps.StatefulSet, *apps.StatefulSet) error
 }
+
+// isOnDeleteUpgradeSet returns true if the OnDelete update strategy of the StatefulSet is set by the upgrader
+func isOnDeleteUpgradeSet(set *apps.StatefulSet) bool {
+	if set.Spec.UpdateStrategy.Type != apps.OnDeleteStatefulSetStrategyType {
+		return false
+	}
+	return set.Annotations[annoKeyOnDeleteUpgrade] == "true"
+}
+
+// beginOnDeleteUpgrade sets the OnDelete update strategy, so that only the deleted pods are recreated with the
+// update revision whatever their ordinals are
+func beginOnDeleteUpgrade(set *apps.StatefulSet) {
+	set.Spec.UpdateStrategy = apps.StatefulSetUpdateStrategy{Type: apps.OnDeleteStatefulSetStrategyType}
+	if set.Annotations == nil {
+		set.Annotations = map[string]string{}
+	}
+	set.Annotations[annoKeyOnDeleteUpgrade] = "true"
+}
+
+// endOnDeleteUpgrade restores the RollingUpdate strategy after all the pods are upgraded, so that the current
+// revision of the StatefulSet is updated
+func endOnDeleteUpgrade(set *apps.StatefulSet) {
+	delete(set.Annotations, annoKeyOnDeleteUpgrade)
+	set.Spec.UpdateStrategy.Type = apps.RollingUpdateStatefulSetStrategyType
+	mngerutils.SetUpgradePartition(set, 0)
+}
+
+// upgradePartition returns the partition to begin the upgrade one by one with, it's the partition of the new
+// StatefulSet if the old one is left with the OnDelete strategy by the upgrader
+func upgradePartition(oldSet, newSet *apps.StatefulSet) int32 {
+	for _, set := range []*apps.StatefulSet{oldSet, newSet} {
+		if set.Spec.UpdateStrategy.RollingUpdate != nil && set.Spec.UpdateStrategy.RollingUpdate.Partition != nil {
+			return *set.Spec.UpdateStrategy.RollingUpdate.Partition
+		}
+	}
+	return *oldSet.Spec.Replicas
+}
@@ -440,6 +440,20 @@ func CreateOrUpdateService(serviceLister corelisters.ServiceLister, serviceContr
 }
 
 // addDeferDeletingAnnoToPVC set the label
+// checkSubClusterName returns an error if a TidbCluster has the name of the in-memory cluster of a TiDB group,
+// TiKV pool or TiFlash compute nodes, as the resources of both of them would be named by the name.
+func checkSubClusterName(deps *controller.Dependencies, stc *v1alpha1.TidbCluster) error {
+	ns := stc.GetNamespace()
+	_, err := deps.TiDBClusterLister.TidbClusters(ns).Get(stc.GetName())
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get TidbCluster %s/%s, error: %v", ns, stc.GetName(), err)
+	}
+	return fmt.Errorf("the resources of TidbCluster %s/%s are prefixed with %s, which is the name of another TidbCluster", ns, stc.GetOwnerName(), stc.GetName())
+}
+
 func addDeferDeletingAnnoToPVC(tc *v1alpha1.TidbCluster, pvc *corev1.PersistentVolumeClaim, pvcControl controller.PVCControlInterface, scaleInTime ...string) error {
 	if pvc.Annotations == nil {
 		pvc.Annotations = map[string]string{}
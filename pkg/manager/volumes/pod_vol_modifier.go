@@ -26,12 +26,14 @@ import (
 	errutil "k8s.io/apimachinery/pkg/util/errors"
 	klog "k8s.io/klog/v2"
 
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/features"
 	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation"
 	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation/aws"
 	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation/azure"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
 )
 
 type PodVolumeModifier interface {
@@ -109,6 +111,7 @@ func (p *podVolModifier) Modify(actual []ActualVolume) error {
 				errs = append(errs, err)
 				continue
 			}
+			observeVolumeOperation(vol, "started")
 
 			fallthrough
 		case VolumePhaseModifying:
@@ -133,7 +136,9 @@ func (p *podVolModifier) Modify(actual []ActualVolume) error {
 			}
 			if err := p.modifyPVCAnnoStatus(ctx, vol); err != nil {
 				errs = append(errs, err)
+				continue
 			}
+			observeVolumeOperation(vol, "completed")
 		case VolumePhasePending, VolumePhaseModified, VolumePhaseCannotModify:
 		}
 
@@ -291,6 +296,18 @@ func setLastTransitionTimestamp(pvc *corev1.PersistentVolumeClaim) {
 	pvc.Annotations[annoKeyPVCLastTransitionTimestamp] = metav1.Now().Format(time.RFC3339)
 }
 
+// observeVolumeOperation counts the operations on the volume, it's a modify operation if the storage class is
+// changed, or a resize operation
+func observeVolumeOperation(vol *ActualVolume, result string) {
+	operation := "resize"
+	if scName := vol.Desired.GetStorageClassName(); scName != "" && scName != vol.GetStorageClassName() {
+		operation = "modify"
+	}
+	labels := vol.PVC.Labels
+	metrics.ClusterVolumeOperations.WithLabelValues(vol.PVC.Namespace, labels[label.InstanceLabelKey],
+		labels[label.ComponentLabelKey], operation, result).Inc()
+}
+
 // upgrade revision and snapshot the expected storageclass and size of volume
 func (p *podVolModifier) modifyPVCAnnoSpec(ctx context.Context, vol *ActualVolume, shouldEvict bool) error {
 	pvc := vol.PVC.DeepCopy()
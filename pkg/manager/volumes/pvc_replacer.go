@@ -17,9 +17,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/manager/utils"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	errutil "k8s.io/apimachinery/pkg/util/errors"
@@ -180,6 +182,8 @@ func (p *pvcReplacer) startVolumeReplace(pod *corev1.Pod) error {
 		return fmt.Errorf("add replace volume annotation to pod %s/%s failed: %s", pod.Namespace, pod.Name, err)
 	}
 	klog.Infof("added replace volume annotation to pod %s/%s", pod.Namespace, pod.Name)
+	metrics.ClusterVolumeOperations.WithLabelValues(pod.Namespace, pod.Labels[label.InstanceLabelKey],
+		pod.Labels[label.ComponentLabelKey], "replace", "started").Inc()
 	return nil
 }
 
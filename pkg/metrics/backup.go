@@ -0,0 +1,54 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// LabelType is the label of the type of a backup or restore, e.g. snapshot, log or volume-snapshot
+const LabelType = "type"
+
+var (
+	BackupDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "tidb_operator",
+		Subsystem: "backup",
+		Name:      "duration_seconds",
+		Help:      "Duration of the backups of each TidbCluster",
+		Buckets:   prometheus.ExponentialBuckets(60, 2, 12), // 1m ~ 34h
+	}, []string{LabelNamespace, LabelTC, LabelType, LabelStatus})
+	BackupSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "tidb_operator",
+		Subsystem: "backup",
+		Name:      "size_bytes",
+		Help:      "Size of the last complete backup of each TidbCluster",
+	}, []string{LabelNamespace, LabelTC, LabelType})
+	BackupLastSuccessTimestamp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "tidb_operator",
+		Subsystem: "backup",
+		Name:      "last_success_timestamp_seconds",
+		Help:      "Unix timestamp of the completion of the last complete backup of each TidbCluster",
+	}, []string{LabelNamespace, LabelTC, LabelType})
+	LogBackupCheckpointLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "tidb_operator",
+		Subsystem: "backup",
+		Name:      "log_checkpoint_lag_seconds",
+		Help:      "Lag of the checkpoint of each log backup behind the current time",
+	}, []string{LabelNamespace, LabelName, LabelTC})
+	RestoreDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "tidb_operator",
+		Subsystem: "restore",
+		Name:      "duration_seconds",
+		Help:      "Duration of the restores to each TidbCluster",
+		Buckets:   prometheus.ExponentialBuckets(60, 2, 12), // 1m ~ 34h
+	}, []string{LabelNamespace, LabelTC, LabelType, LabelStatus})
+)
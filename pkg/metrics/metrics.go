@@ -79,5 +79,18 @@ func init() {
 
 		ClusterSpecReplicas,
 		ClusterUpdateErrors,
+		ClusterComponentPhase,
+		ClusterUpgradeDuration,
+		ClusterUpgradePods,
+		ClusterEvictLeaderDuration,
+		ClusterFailoverTotal,
+		ClusterFailureMembers,
+		ClusterVolumeOperations,
+
+		BackupDuration,
+		BackupSize,
+		BackupLastSuccessTimestamp,
+		LogBackupCheckpointLag,
+		RestoreDuration,
 	)
 }
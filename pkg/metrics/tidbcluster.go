@@ -17,6 +17,15 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// Label constants of the TidbCluster metrics.
+const (
+	LabelPhase     = "phase"
+	LabelState     = "state"
+	LabelStore     = "store"
+	LabelOperation = "operation"
+	LabelResult    = "result"
+)
+
 var (
 	ClusterSpecReplicas = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -33,4 +42,81 @@ var (
 			Name:      "update_errors",
 			Help:      "Number of errors generated in each stage when updating TiDB Clusters",
 		}, []string{LabelNamespace, LabelName, LabelComponent})
+
+	ClusterComponentPhase = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "component_phase",
+			Help:      "Phase of each component in TidbCluster, 1 for the current phase and 0 for the others",
+		}, []string{LabelNamespace, LabelName, LabelComponent, LabelPhase})
+
+	ClusterUpgradeDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "upgrade_duration_seconds",
+			Help:      "Duration of the upgrades of each component in TidbCluster",
+			Buckets:   prometheus.ExponentialBuckets(30, 2, 12), // 30s ~ 17h
+		}, []string{LabelNamespace, LabelName, LabelComponent})
+
+	ClusterUpgradePods = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "upgrade_pods",
+			Help:      "Number of the pods of each component in TidbCluster, the state is updated for the pods of the desired revision and total for all pods",
+		}, []string{LabelNamespace, LabelName, LabelComponent, LabelState})
+
+	ClusterEvictLeaderDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "evict_leader_duration_seconds",
+			Help:      "Duration of the running leader eviction of each store before its pod is upgraded, deleted after the eviction ends",
+		}, []string{LabelNamespace, LabelName, LabelComponent, LabelStore})
+
+	ClusterFailoverTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "failover_total",
+			Help:      "Number of the members of each component in TidbCluster marked as failure",
+		}, []string{LabelNamespace, LabelName, LabelComponent})
+
+	ClusterFailureMembers = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "failure_members",
+			Help:      "Number of the current failure members or stores of each component in TidbCluster",
+		}, []string{LabelNamespace, LabelName, LabelComponent})
+
+	ClusterVolumeOperations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "volume_operations_total",
+			Help:      "Number of the resize, modify and replace operations of the volumes of each component in TidbCluster",
+		}, []string{LabelNamespace, LabelName, LabelComponent, LabelOperation, LabelResult})
 )
+
+// DeleteClusterMetrics deletes the metrics of a TidbCluster which has been deleted
+func DeleteClusterMetrics(namespace, name string) {
+	labels := prometheus.Labels{LabelNamespace: namespace, LabelName: name}
+	ClusterSpecReplicas.DeletePartialMatch(labels)
+	ClusterUpdateErrors.DeletePartialMatch(labels)
+	ClusterComponentPhase.DeletePartialMatch(labels)
+	ClusterUpgradeDuration.DeletePartialMatch(labels)
+	ClusterUpgradePods.DeletePartialMatch(labels)
+	ClusterEvictLeaderDuration.DeletePartialMatch(labels)
+	ClusterFailoverTotal.DeletePartialMatch(labels)
+	ClusterFailureMembers.DeletePartialMatch(labels)
+	ClusterVolumeOperations.DeletePartialMatch(labels)
+}
+
+// DeleteClusterStoreMetrics deletes the metrics of a store of a TidbCluster which has been removed or
+// is not evicting leaders any more
+func DeleteClusterStoreMetrics(namespace, name, component, store string) {
+	ClusterEvictLeaderDuration.DeleteLabelValues(namespace, name, component, store)
+}
@@ -15,6 +15,7 @@ package pdapi
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/kvproto/pkg/pdpb"
@@ -38,6 +39,9 @@ const (
 	DeleteMemberActionType                      ActionType = "DeleteMember "
 	SetStoreLabelsActionType                    ActionType = "SetStoreLabels"
 	UpdateReplicationActionType                 ActionType = "UpdateReplicationConfig"
+	UpdateScheduleConfigActionType              ActionType = "UpdateScheduleConfig"
+	GetStoreLimitsActionType                    ActionType = "GetStoreLimits"
+	SetStoreLimitActionType                     ActionType = "SetStoreLimit"
 	BeginEvictLeaderActionType                  ActionType = "BeginEvictLeader"
 	EndEvictLeaderActionType                    ActionType = "EndEvictLeader"
 	GetEvictLeaderSchedulersActionType          ActionType = "GetEvictLeaderSchedulers"
@@ -46,6 +50,10 @@ const (
 	TransferPDLeaderActionType                  ActionType = "TransferPDLeader"
 	GetAutoscalingPlansActionType               ActionType = "GetAutoscalingPlans"
 	GetRecoveringMarkActionType                 ActionType = "GetRecoveringMark"
+	RemoveFailedStoresActionType                ActionType = "RemoveFailedStores"
+	GetUnsafeRecoveryProgressActionType         ActionType = "GetUnsafeRecoveryProgress"
+	GetRegionCountByCheckActionType             ActionType = "GetRegionCountByCheck"
+	GetRegionsStatusActionType                  ActionType = "GetRegionsStatus"
 	GetReadyActionType                          ActionType = "GetReady"
 	PDMSTransferPrimaryActionType               ActionType = "PDMSTransferPrimary"
 )
@@ -63,6 +71,11 @@ type Action struct {
 	Name        string
 	Labels      map[string]string
 	Replication PDReplicationConfig
+	Schedule    PDScheduleConfig
+	LimitType   StoreLimitType
+	Rate        float64
+	IDs         []uint64
+	Timeout     time.Duration
 }
 
 type Reaction func(action *Action) (interface{}, error)
@@ -231,6 +244,35 @@ func (c *FakePDClient) UpdateReplicationConfig(config PDReplicationConfig) error
 	return nil
 }
 
+// UpdateScheduleConfig updates the schedule config
+func (c *FakePDClient) UpdateScheduleConfig(config PDScheduleConfig) error {
+	if reaction, ok := c.reactions[UpdateScheduleConfigActionType]; ok {
+		action := &Action{Schedule: config}
+		_, err := reaction(action)
+		return err
+	}
+	return nil
+}
+
+func (c *FakePDClient) GetStoreLimits() (map[uint64]*StoreLimit, error) {
+	if reaction, ok := c.reactions[GetStoreLimitsActionType]; ok {
+		action := &Action{}
+		result, err := reaction(action)
+		limits, _ := result.(map[uint64]*StoreLimit)
+		return limits, err
+	}
+	return nil, nil
+}
+
+func (c *FakePDClient) SetStoreLimit(storeID uint64, limitType StoreLimitType, rate float64) error {
+	if reaction, ok := c.reactions[SetStoreLimitActionType]; ok {
+		action := &Action{ID: storeID, LimitType: limitType, Rate: rate}
+		_, err := reaction(action)
+		return err
+	}
+	return nil
+}
+
 func (c *FakePDClient) BeginEvictLeader(storeID uint64) error {
 	if reaction, ok := c.reactions[BeginEvictLeaderActionType]; ok {
 		action := &Action{ID: storeID}
@@ -304,6 +346,30 @@ func (c *FakePDClient) GetRecoveringMark() (bool, error) {
 	return true, nil
 }
 
+func (c *FakePDClient) RemoveFailedStores(storeIDs []uint64, timeout time.Duration) error {
+	action := &Action{IDs: storeIDs, Timeout: timeout}
+	_, err := c.fakeAPI(RemoveFailedStoresActionType, action)
+	return err
+}
+
+func (c *FakePDClient) GetUnsafeRecoveryProgress() ([]UnsafeRecoveryStage, error) {
+	action := &Action{}
+	result, err := c.fakeAPI(GetUnsafeRecoveryProgressActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	return result.([]UnsafeRecoveryStage), nil
+}
+
+func (c *FakePDClient) GetRegionCountByCheck(checkType RegionCheckType) (int, error) {
+	action := &Action{Name: string(checkType)}
+	result, err := c.fakeAPI(GetRegionCountByCheckActionType, action)
+	if err != nil {
+		return 0, err
+	}
+	return result.(int), nil
+}
+
 func (c *FakePDClient) GetReady() (bool, error) {
 	action := &Action{}
 	result, err := c.fakeAPI(GetReadyActionType, action)
@@ -349,3 +415,12 @@ func (c *FakePDMSClient) TransferPrimary(newPrimary string) error {
 	_, err := c.fakeAPI(PDMSTransferPrimaryActionType, action)
 	return err
 }
+
+func (c *FakePDClient) GetRegionsStatus() (map[RegionStatusType]int, error) {
+	action := &Action{}
+	result, err := c.fakeAPI(GetRegionsStatusActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	return result.(map[RegionStatusType]int), nil
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/tracing"
 	"k8s.io/client-go/kubernetes"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
@@ -250,14 +251,32 @@ func (pdc *defaultPDControl) GetPDClient(namespace Namespace, tcName string, tls
 			return &pdClient{url: config.clientURL, httpClient: &http.Client{Timeout: DefaultTimeout}}
 		}
 
-		return NewPDClient(config.clientURL, DefaultTimeout, tlsConfig)
+		return withTracing(NewPDClient(config.clientURL, DefaultTimeout, tlsConfig), namespace, tcName)
 	}
 	if _, ok := pdc.pdClients[config.clientKey]; !ok {
-		pdc.pdClients[config.clientKey] = NewPDClient(config.clientURL, DefaultTimeout, nil)
+		pdc.pdClients[config.clientKey] = withTracing(NewPDClient(config.clientURL, DefaultTimeout, nil), namespace, tcName)
 	}
 	return pdc.pdClients[config.clientKey]
 }
 
+// withTracing records the spans of the requests of the client to the PD of the cluster
+func withTracing(client PDClient, namespace Namespace, tcName string) PDClient {
+	if c, ok := client.(*pdClient); ok {
+		c.httpClient.Transport = tracing.NewTransport(c.httpClient.Transport, "pd", pdTracingKey(namespace, tcName))
+	}
+	return client
+}
+
+// withMSTracing records the spans of the requests of the client to the PD microservice of the cluster
+func withMSTracing(client *pdMSClient, namespace Namespace, tcName string) *pdMSClient {
+	client.httpClient.Transport = tracing.NewTransport(client.httpClient.Transport, "pd-"+client.serviceName, pdTracingKey(namespace, tcName))
+	return client
+}
+
+func pdTracingKey(namespace Namespace, tcName string) tracing.Key {
+	return tracing.Key{Kind: v1alpha1.TiDBClusterKind, Namespace: string(namespace), Name: tcName}
+}
+
 func checkServiceName(name string) bool {
 	return name == TSOServiceName || name == SchedulingServiceName
 }
@@ -280,11 +299,11 @@ func (pdc *defaultPDControl) GetPDMSClient(namespace Namespace, tcName, serviceN
 			return &pdMSClient{url: config.clientURL, httpClient: &http.Client{Timeout: DefaultTimeout}}
 		}
 
-		return NewPDMSClient(serviceName, config.clientURL, DefaultTimeout, tlsConfig)
+		return withMSTracing(NewPDMSClient(serviceName, config.clientURL, DefaultTimeout, tlsConfig), namespace, tcName)
 	}
 
 	if _, ok := pdc.pdMSClients[config.clientURL]; !ok {
-		pdc.pdMSClients[config.clientURL] = NewPDMSClient(serviceName, config.clientURL, DefaultTimeout, nil)
+		pdc.pdMSClients[config.clientURL] = withMSTracing(NewPDMSClient(serviceName, config.clientURL, DefaultTimeout, nil), namespace, tcName)
 	}
 	return pdc.pdMSClients[config.clientURL]
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,6 +28,8 @@ import (
 	"github.com/pingcap/kvproto/pkg/pdpb"
 	"github.com/pingcap/tidb-operator/pkg/util/crypto"
 	httputil "github.com/pingcap/tidb-operator/pkg/util/http"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/prom2json"
 	"github.com/tikv/pd/pkg/typeutil"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
@@ -69,6 +72,12 @@ type PDClient interface {
 	SetStoreLabels(storeID uint64, labels map[string]string) (bool, error)
 	// UpdateReplicationConfig updates the replication config
 	UpdateReplicationConfig(config PDReplicationConfig) error
+	// UpdateScheduleConfig updates the schedule config, only the set fields are updated
+	UpdateScheduleConfig(config PDScheduleConfig) error
+	// GetStoreLimits returns the store limits of all stores
+	GetStoreLimits() (map[uint64]*StoreLimit, error)
+	// SetStoreLimit sets the store limit of the given type for a store
+	SetStoreLimit(storeID uint64, limitType StoreLimitType, rate float64) error
 	// DeleteStore deletes a TiKV store from cluster
 	DeleteStore(storeID uint64) error
 	// SetStoreState sets store to specified state.
@@ -94,6 +103,15 @@ type PDClient interface {
 	GetAutoscalingPlans(strategy Strategy) ([]Plan, error)
 	// GetRecoveringMark return the pd recovering mark
 	GetRecoveringMark() (bool, error)
+	// RemoveFailedStores starts the online unsafe recovery to remove the permanently failed stores
+	RemoveFailedStores(storeIDs []uint64, timeout time.Duration) error
+	// GetUnsafeRecoveryProgress returns the stages of the latest online unsafe recovery
+	GetUnsafeRecoveryProgress() ([]UnsafeRecoveryStage, error)
+	// GetRegionCountByCheck returns the count of regions which are unhealthy in the given way, e.g. miss peers
+	GetRegionCountByCheck(checkType RegionCheckType) (int, error)
+	// GetRegionsStatus returns the counts of the unhealthy regions in each status from the metrics of PD without
+	// listing the regions. The regions are only checked by the PD leader, so it must be called on the PD leader.
+	GetRegionsStatus() (map[RegionStatusType]int, error)
 
 	// GetReady checks if a specific PD member is ready.
 	// NOTE: in order to call this method, a PDClient for a specific PD member (`GetPDClientForMember`) is required.
@@ -110,6 +128,7 @@ var (
 	membersPrefix          = "pd/api/v1/members"
 	storesPrefix           = "pd/api/v1/stores"
 	storePrefix            = "pd/api/v1/store"
+	storesLimitPrefix      = "pd/api/v1/stores/limit"
 	configPrefix           = "pd/api/v1/config"
 	clusterIDPrefix        = "pd/api/v1/cluster"
 	schedulersPrefix       = "pd/api/v1/schedulers"
@@ -121,6 +140,9 @@ var (
 	evictLeaderSchedulerConfigPrefix = "pd/api/v1/scheduler-config/evict-leader-scheduler/list"
 	autoscalingPrefix                = "autoscaling"
 	recoveringMarkPrefix             = "pd/api/v1/admin/cluster/markers/snapshot-recovering"
+	unsafeRecoveryPrefix             = "pd/api/v1/admin/unsafe/remove-failed-stores"
+	regionsCheckPrefix               = "pd/api/v1/regions/check"
+	metricsPrefix                    = "metrics"
 
 	readyPrefix = "pd/api/v2/ready"
 
@@ -175,8 +197,10 @@ type MetaStore struct {
 type StoreStatus struct {
 	Capacity           typeutil.ByteSize `json:"capacity"`
 	Available          typeutil.ByteSize `json:"available"`
+	UsedSize           typeutil.ByteSize `json:"used_size"`
 	LeaderCount        int               `json:"leader_count"`
 	RegionCount        int               `json:"region_count"`
+	LearnerCount       int               `json:"learner_count,omitempty"`
 	SendingSnapCount   uint32            `json:"sending_snap_count"`
 	ReceivingSnapCount uint32            `json:"receiving_snap_count"`
 	ApplyingSnapCount  uint32            `json:"applying_snap_count"`
@@ -193,6 +217,22 @@ type StoreInfo struct {
 	Status *StoreStatus `json:"status"`
 }
 
+// StoreLimitType is the type of store limit
+type StoreLimitType string
+
+const (
+	// StoreLimitTypeAddPeer limits the speed of adding peers to a store
+	StoreLimitTypeAddPeer StoreLimitType = "add-peer"
+	// StoreLimitTypeRemovePeer limits the speed of removing peers from a store
+	StoreLimitTypeRemovePeer StoreLimitType = "remove-peer"
+)
+
+// StoreLimit is the store limit returned from PD RESTful interface
+type StoreLimit struct {
+	AddPeer    float64 `json:"add-peer"`
+	RemovePeer float64 `json:"remove-peer"`
+}
+
 // StoresInfo is stores info returned from PD RESTful interface
 type StoresInfo struct {
 	Count  int          `json:"count"`
@@ -266,6 +306,48 @@ type Plan struct {
 	Labels       map[string]string `json:"labels"`
 }
 
+// UnsafeRecoveryStage is a stage of the online unsafe recovery returned from PD RESTful interface
+type UnsafeRecoveryStage struct {
+	Info string `json:"info,omitempty"`
+	Time string `json:"time,omitempty"`
+	// Actions is the recovery plan of the stage, the key is the store, e.g. "store 1"
+	Actions map[string][]string `json:"actions,omitempty"`
+	Details []string            `json:"details,omitempty"`
+}
+
+// RegionCheckType is the way in which regions are unhealthy, checked by PD
+type RegionCheckType string
+
+const (
+	// RegionCheckMissPeer checks the regions without enough replicas
+	RegionCheckMissPeer RegionCheckType = "miss-peer"
+	// RegionCheckDownPeer checks the regions with replicas not responding
+	RegionCheckDownPeer RegionCheckType = "down-peer"
+	// RegionCheckPendingPeer checks the regions with replicas whose raft log is lagging behind
+	RegionCheckPendingPeer RegionCheckType = "pending-peer"
+	// RegionCheckLearnerPeer checks the regions with learner replicas
+	RegionCheckLearnerPeer RegionCheckType = "learner-peer"
+)
+
+// RegionStatusType is the way in which regions are unhealthy, it's the `type` label of metric `pd_regions_status`
+type RegionStatusType string
+
+const (
+	// RegionStatusMissPeer is the count of the regions without enough replicas
+	RegionStatusMissPeer RegionStatusType = "miss-peer-region-count"
+	// RegionStatusDownPeer is the count of the regions with replicas not responding
+	RegionStatusDownPeer RegionStatusType = "down-peer-region-count"
+	// RegionStatusPendingPeer is the count of the regions with replicas whose raft log is lagging behind
+	RegionStatusPendingPeer RegionStatusType = "pending-peer-region-count"
+
+	metricNameRegionsStatus = "pd_regions_status"
+)
+
+// RegionsCount is the count of the regions returned from PD RESTful interface, the regions themselves are ignored
+type RegionsCount struct {
+	Count int `json:"count"`
+}
+
 type schedulerInfo struct {
 	Name    string `json:"name"`
 	StoreID uint64 `json:"store_id"`
@@ -570,6 +652,66 @@ func (c *pdClient) UpdateReplicationConfig(config PDReplicationConfig) error {
 	return fmt.Errorf("failed %v to update replication: %v", res.StatusCode, err)
 }
 
+func (c *pdClient) UpdateScheduleConfig(config PDScheduleConfig) error {
+	apiURL := fmt.Sprintf("%s/%s", c.url, configPrefix)
+	data, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Post(apiURL, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer httputil.DeferClose(res.Body)
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+	err = httputil.ReadErrorBody(res.Body)
+	return fmt.Errorf("failed %v to update schedule config: %v", res.StatusCode, err)
+}
+
+func (c *pdClient) GetStoreLimits() (map[uint64]*StoreLimit, error) {
+	apiURL := fmt.Sprintf("%s/%s", c.url, storesLimitPrefix)
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	limits := map[string]*StoreLimit{}
+	if err := json.Unmarshal(body, &limits); err != nil {
+		return nil, err
+	}
+	result := make(map[uint64]*StoreLimit, len(limits))
+	for id, limit := range limits {
+		storeID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid store id %q in store limits: %v", id, err)
+		}
+		result[storeID] = limit
+	}
+	return result, nil
+}
+
+func (c *pdClient) SetStoreLimit(storeID uint64, limitType StoreLimitType, rate float64) error {
+	apiURL := fmt.Sprintf("%s/%s/%d/limit", c.url, storePrefix, storeID)
+	data, err := json.Marshal(map[string]interface{}{
+		"rate": rate,
+		"type": limitType,
+	})
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Post(apiURL, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer httputil.DeferClose(res.Body)
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+	err = httputil.ReadErrorBody(res.Body)
+	return fmt.Errorf("failed %v to set %s limit of store %d: %v", res.StatusCode, limitType, storeID, err)
+}
+
 func (c *pdClient) BeginEvictLeader(storeID uint64) error {
 	leaderEvictInfo := getLeaderEvictSchedulerInfo(storeID)
 	apiURL := fmt.Sprintf("%s/%s", c.url, schedulersPrefix)
@@ -753,6 +895,92 @@ func (c *pdClient) GetRecoveringMark() (bool, error) {
 	return recoveringMark.Mark, nil
 }
 
+func (c *pdClient) RemoveFailedStores(storeIDs []uint64, timeout time.Duration) error {
+	apiURL := fmt.Sprintf("%s/%s", c.url, unsafeRecoveryPrefix)
+	data, err := json.Marshal(map[string]interface{}{
+		"stores":  storeIDs,
+		"timeout": uint64(timeout.Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Post(apiURL, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer httputil.DeferClose(res.Body)
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+	err = httputil.ReadErrorBody(res.Body)
+	return fmt.Errorf("failed %v to remove failed stores %v: %v", res.StatusCode, storeIDs, err)
+}
+
+func (c *pdClient) GetUnsafeRecoveryProgress() ([]UnsafeRecoveryStage, error) {
+	apiURL := fmt.Sprintf("%s/%s/show", c.url, unsafeRecoveryPrefix)
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	var stages []UnsafeRecoveryStage
+	if err := json.Unmarshal(body, &stages); err != nil {
+		return nil, err
+	}
+	return stages, nil
+}
+
+func (c *pdClient) GetRegionCountByCheck(checkType RegionCheckType) (int, error) {
+	apiURL := fmt.Sprintf("%s/%s/%s", c.url, regionsCheckPrefix, checkType)
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return 0, err
+	}
+	regions := &RegionsCount{}
+	if err := json.Unmarshal(body, regions); err != nil {
+		return 0, err
+	}
+	return regions.Count, nil
+}
+
+func (c *pdClient) GetRegionsStatus() (map[RegionStatusType]int, error) {
+	apiURL := fmt.Sprintf("%s/%s", c.url, metricsPrefix)
+	mfChan := make(chan *dto.MetricFamily, 1024)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- prom2json.FetchMetricFamilies(apiURL, mfChan, c.httpClient.Transport)
+	}()
+
+	counts := map[RegionStatusType]int{}
+	var parseErr error
+	// drain the channel even if a value is invalid so that the fetching goroutine can exit
+	for mf := range mfChan {
+		fm := prom2json.NewFamily(mf)
+		if fm.Name != metricNameRegionsStatus {
+			continue
+		}
+		for _, m := range fm.Metrics {
+			if m, ok := m.(prom2json.Metric); ok {
+				value, err := strconv.ParseFloat(m.Value, 64)
+				if err != nil {
+					parseErr = fmt.Errorf("invalid value %q of metric %s from %s", m.Value, metricNameRegionsStatus, apiURL)
+					continue
+				}
+				counts[RegionStatusType(m.Labels["type"])] = int(value)
+			}
+		}
+	}
+	if err := <-errCh; err != nil {
+		return nil, fmt.Errorf("failed to get metrics from %s: %v", apiURL, err)
+	}
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	if len(counts) == 0 {
+		return nil, fmt.Errorf("metric %s not found for %s", metricNameRegionsStatus, apiURL)
+	}
+	return counts, nil
+}
+
 func (c *pdClient) GetPDLeader() (*pdpb.Member, error) {
 	apiURL := fmt.Sprintf("%s/%s", c.url, pdLeaderPrefix)
 	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
@@ -22,6 +22,7 @@ import (
 	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"github.com/pingcap/kvproto/pkg/metapb"
@@ -353,6 +354,163 @@ func TestSetStoreLabels(t *testing.T) {
 	}
 }
 
+func TestGetStoreLimits(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal("GET"), "check method")
+		g.Expect(request.URL.Path).To(Equal(fmt.Sprintf("/%s", storesLimitPrefix)), "check url")
+
+		w.Header().Set("Content-Type", ContentTypeJSON)
+		w.Write([]byte(`{"1":{"add-peer":15,"remove-peer":15},"4":{"add-peer":200,"remove-peer":15}}`))
+	})
+	defer svc.Close()
+
+	pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+	result, err := pdClient.GetStoreLimits()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(map[uint64]*StoreLimit{
+		1: {AddPeer: 15, RemovePeer: 15},
+		4: {AddPeer: 200, RemovePeer: 15},
+	}))
+}
+
+func TestSetStoreLimit(t *testing.T) {
+	g := NewGomegaWithT(t)
+	id := uint64(1)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal("POST"), "check method")
+		g.Expect(request.URL.Path).To(Equal(fmt.Sprintf("/%s/%d/limit", storePrefix, id)), "check url")
+
+		body := map[string]interface{}{}
+		g.Expect(readJSON(request.Body, &body)).To(Succeed())
+		g.Expect(body).To(Equal(map[string]interface{}{"rate": float64(200), "type": "add-peer"}))
+
+		w.WriteHeader(http.StatusOK)
+	})
+	defer svc.Close()
+
+	pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+	g.Expect(pdClient.SetStoreLimit(id, StoreLimitTypeAddPeer, 200)).To(Succeed())
+}
+
+func TestRemoveFailedStores(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal("POST"), "check method")
+		g.Expect(request.URL.Path).To(Equal(fmt.Sprintf("/%s", unsafeRecoveryPrefix)), "check url")
+
+		body := map[string]interface{}{}
+		g.Expect(readJSON(request.Body, &body)).To(Succeed())
+		g.Expect(body).To(Equal(map[string]interface{}{"stores": []interface{}{float64(4), float64(5)}, "timeout": float64(600)}))
+
+		w.WriteHeader(http.StatusOK)
+	})
+	defer svc.Close()
+
+	pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+	g.Expect(pdClient.RemoveFailedStores([]uint64{4, 5}, 10*time.Minute)).To(Succeed())
+}
+
+func TestGetUnsafeRecoveryProgress(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal("GET"), "check method")
+		g.Expect(request.URL.Path).To(Equal(fmt.Sprintf("/%s/show", unsafeRecoveryPrefix)), "check url")
+
+		w.Header().Set("Content-Type", ContentTypeJSON)
+		w.Write([]byte(`[{"info":"Unsafe recovery enters force leader stage","time":"2024-01-02 15:04:05.000","actions":{"store 1":["force leader on regions: 2, 3"]}},{"info":"Unsafe recovery finished","time":"2024-01-02 15:04:10.000","details":["affected table ids: 72"]}]`))
+	})
+	defer svc.Close()
+
+	pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+	result, err := pdClient.GetUnsafeRecoveryProgress()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal([]UnsafeRecoveryStage{
+		{
+			Info:    "Unsafe recovery enters force leader stage",
+			Time:    "2024-01-02 15:04:05.000",
+			Actions: map[string][]string{"store 1": {"force leader on regions: 2, 3"}},
+		},
+		{
+			Info:    "Unsafe recovery finished",
+			Time:    "2024-01-02 15:04:10.000",
+			Details: []string{"affected table ids: 72"},
+		},
+	}))
+}
+
+func TestGetRegionCountByCheck(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal("GET"), "check method")
+		g.Expect(request.URL.Path).To(Equal(fmt.Sprintf("/%s/pending-peer", regionsCheckPrefix)), "check url")
+
+		w.Header().Set("Content-Type", ContentTypeJSON)
+		w.Write([]byte(`{"count":2,"regions":[{"id":2,"start_key":"","end_key":"7480"},{"id":8,"start_key":"7480","end_key":""}]}`))
+	})
+	defer svc.Close()
+
+	pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+	count, err := pdClient.GetRegionCountByCheck(RegionCheckPendingPeer)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(count).To(Equal(2))
+}
+
+func TestGetRegionsStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal("GET"), "check method")
+		g.Expect(request.URL.Path).To(Equal("/"+metricsPrefix), "check url")
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		w.Write([]byte(`# HELP pd_regions_status Status of the regions.
+# TYPE pd_regions_status gauge
+pd_regions_status{type="down-peer-region-count"} 0
+pd_regions_status{type="miss-peer-region-count"} 3
+pd_regions_status{type="pending-peer-region-count"} 2
+# HELP pd_cluster_status Status of the cluster.
+# TYPE pd_cluster_status gauge
+pd_cluster_status{type="store_up_count"} 3
+`))
+	})
+	defer svc.Close()
+
+	pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+	counts, err := pdClient.GetRegionsStatus()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(counts).To(Equal(map[RegionStatusType]int{
+		RegionStatusDownPeer:    0,
+		RegionStatusMissPeer:    3,
+		RegionStatusPendingPeer: 2,
+	}))
+}
+
+func TestUpdateScheduleConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	limit := uint64(64)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal("POST"), "check method")
+		g.Expect(request.URL.Path).To(Equal(fmt.Sprintf("/%s", configPrefix)), "check url")
+
+		body := map[string]interface{}{}
+		g.Expect(readJSON(request.Body, &body)).To(Succeed())
+		g.Expect(body).To(Equal(map[string]interface{}{"region-schedule-limit": float64(64)}))
+
+		w.WriteHeader(http.StatusOK)
+	})
+	defer svc.Close()
+
+	pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+	g.Expect(pdClient.UpdateScheduleConfig(PDScheduleConfig{RegionScheduleLimit: &limit})).To(Succeed())
+}
+
 func TestDeleteMember(t *testing.T) {
 	g := NewGomegaWithT(t)
 	name := "testMember"
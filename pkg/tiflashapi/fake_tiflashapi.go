@@ -14,13 +14,15 @@
 package tiflashapi
 
 import (
+	"context"
 	"fmt"
 )
 
 type ActionType string
 
 const (
-	GetStoreStatusActionType ActionType = "GetStoreStatus"
+	GetStoreStatusActionType    ActionType = "GetStoreStatus"
+	GetMaxAllocatedIDActionType ActionType = "GetMaxAllocatedID"
 )
 
 type NotFoundReaction struct {
@@ -74,3 +76,12 @@ func (c *FakeTiFlashClient) GetStoreStatus() (Status, error) {
 	}
 	return result.(Status), nil
 }
+
+func (c *FakeTiFlashClient) GetMaxAllocatedID(ctx context.Context) (uint64, error) {
+	action := &Action{}
+	result, err := c.fakeAPI(GetMaxAllocatedIDActionType, action)
+	if err != nil {
+		return 0, err
+	}
+	return result.(uint64), nil
+}
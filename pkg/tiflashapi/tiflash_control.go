@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
 	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/tracing"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
 )
@@ -60,13 +61,21 @@ func (tc *defaultTiFlashControl) GetTiFlashPodClient(namespace string, tcName st
 		tlsConfig, err = pdapi.GetTLSConfig(tc.secretLister, pdapi.Namespace(namespace), util.ClusterClientTLSSecretName(tcName))
 		if err != nil {
 			klog.Errorf("Unable to get tls config for TiFlash cluster %q, tiflash client may not work: %v", tcName, err)
-			return NewTiFlashClient(TiFlashPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true)
 		}
-
-		return NewTiFlashClient(TiFlashPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true)
 	}
 
-	return NewTiFlashClient(TiFlashPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+	client := NewTiFlashClient(TiFlashPodClientURL(namespace, tcName, podName, scheme),
+		TiFlashProxyClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+	return withTracing(client, namespace, tcName)
+}
+
+// withTracing records the spans of the requests of the client to the TiFlash of the cluster
+func withTracing(client TiFlashClient, namespace, tcName string) TiFlashClient {
+	if c, ok := client.(*tiflashClient); ok {
+		key := tracing.Key{Kind: v1alpha1.TiDBClusterKind, Namespace: namespace, Name: tcName}
+		c.httpClient.Transport = tracing.NewTransport(c.httpClient.Transport, "tiflash", key)
+	}
+	return client
 }
 
 func tiflashPodClientKey(schema, namespace, clusterName, podName string) string {
@@ -78,6 +87,11 @@ func TiFlashPodClientURL(namespace, clusterName, podName, scheme string) string
 	return fmt.Sprintf("%s://%s.%s-tiflash-peer.%s:%d", scheme, podName, clusterName, namespace, v1alpha1.DefaultTiFlashProxyStatusPort)
 }
 
+// TiFlashProxyClientURL builds the url of the grpc service of the tiflash proxy
+func TiFlashProxyClientURL(namespace, clusterName, podName, scheme string) string {
+	return fmt.Sprintf("%s://%s.%s-tiflash-peer.%s:%d", scheme, podName, clusterName, namespace, v1alpha1.DefaultTiFlashProxyPort)
+}
+
 // FakeTiFlashControl implements a fake version of TiFlashControlInterface.
 type FakeTiFlashControl struct {
 	defaultTiFlashControl
@@ -14,12 +14,14 @@
 package tiflashapi
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"time"
 
+	"github.com/pingcap/tidb-operator/pkg/tikvapi"
 	httputil "github.com/pingcap/tidb-operator/pkg/util/http"
 )
 
@@ -39,17 +41,28 @@ const (
 
 type TiFlashClient interface {
 	GetStoreStatus() (Status, error)
+	// GetMaxAllocatedID returns the max ID allocated by PD which is known by the tiflash, i.e. the max one of
+	// the store ID and the IDs of the regions and peers of the regions in the store
+	GetMaxAllocatedID(ctx context.Context) (uint64, error)
 }
 
 type tiflashClient struct {
 	url        string
 	httpClient *http.Client
+	// proxy is the client of the tiflash proxy, which serves the same debug service as tikv
+	proxy tikvapi.TiKVClient
 }
 
-// NewTiFlashClient returns a new TiFlashClient
-func NewTiFlashClient(url string, timeout time.Duration, tlsConfig *tls.Config, disableKeepalive bool) TiFlashClient {
+// NewTiFlashClient returns a new TiFlashClient, the proxyURL is the url of the grpc service of the tiflash proxy
+func NewTiFlashClient(url, proxyURL string, timeout time.Duration, tlsConfig *tls.Config, disableKeepalive bool) TiFlashClient {
 	return &tiflashClient{
 		url: url,
+		proxy: tikvapi.NewTiKVClient(tikvapi.TiKVClientOpts{
+			GRPCEndpoint:      proxyURL,
+			Timeout:           timeout,
+			TLSConfig:         tlsConfig,
+			DisableKeepAlives: disableKeepalive,
+		}),
 		httpClient: &http.Client{
 			Timeout: timeout,
 			Transport: &http.Transport{
@@ -74,3 +87,8 @@ func (c *tiflashClient) GetStoreStatus() (Status, error) {
 
 	return Status(body), nil
 }
+
+// GetMaxAllocatedID gets the IDs from the debug service of the tiflash proxy
+func (c *tiflashClient) GetMaxAllocatedID(ctx context.Context) (uint64, error) {
+	return c.proxy.GetMaxAllocatedID(ctx)
+}
@@ -23,6 +23,8 @@ type ActionType string
 const (
 	GetLeaderCountActionType      ActionType = "GetLeaderCount"
 	FlushLogBackupTasksActionType ActionType = "FlushLogBackupTasks"
+	SetConfigActionType           ActionType = "SetConfig"
+	GetMaxAllocatedIDActionType   ActionType = "GetMaxAllocatedID"
 )
 
 type NotFoundReaction struct {
@@ -37,6 +39,7 @@ type Action struct {
 	ID     uint64
 	Name   string
 	Labels map[string]string
+	Config map[string]string
 }
 
 type Reaction func(action *Action) (interface{}, error)
@@ -81,3 +84,18 @@ func (c *FakeTiKVClient) FlushLogBackupTasks(ctx context.Context) error {
 	_, err := c.fakeAPI(FlushLogBackupTasksActionType, action)
 	return err
 }
+
+func (c *FakeTiKVClient) SetConfig(config map[string]string) error {
+	action := &Action{Config: config}
+	_, err := c.fakeAPI(SetConfigActionType, action)
+	return err
+}
+
+func (c *FakeTiKVClient) GetMaxAllocatedID(ctx context.Context) (uint64, error) {
+	action := &Action{}
+	result, err := c.fakeAPI(GetMaxAllocatedIDActionType, action)
+	if err != nil {
+		return 0, err
+	}
+	return result.(uint64), nil
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
 	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/tracing"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
 )
@@ -63,13 +64,22 @@ func (tc *defaultTiKVControl) GetTiKVPodClient(namespace string, tcName string,
 		tlsConfig, err = pdapi.GetTLSConfig(tc.secretLister, pdapi.Namespace(namespace), util.ClusterClientTLSSecretName(tcName))
 		if err != nil {
 			klog.Errorf("Unable to get tls config for TiKV cluster %q, tikv client may not work: %v", tcName, err)
-			return NewTiKVClient(configOfSchema("https"))
+			return withTracing(NewTiKVClient(configOfSchema("https")), namespace, tcName)
 		}
 
-		return NewTiKVClient(configOfSchema("https"))
+		return withTracing(NewTiKVClient(configOfSchema("https")), namespace, tcName)
 	}
 
-	return NewTiKVClient(configOfSchema("http"))
+	return withTracing(NewTiKVClient(configOfSchema("http")), namespace, tcName)
+}
+
+// withTracing records the spans of the HTTP requests of the client to the TiKV of the cluster
+func withTracing(client TiKVClient, namespace, tcName string) TiKVClient {
+	if c, ok := client.(*tikvClient); ok {
+		key := tracing.Key{Kind: v1alpha1.TiDBClusterKind, Namespace: namespace, Name: tcName}
+		c.httpClient.Transport = tracing.NewTransport(c.httpClient.Transport, "tikv", key)
+	}
+	return client
 }
 
 func tikvPodClientKey(schema, namespace, clusterName, podName string) string {
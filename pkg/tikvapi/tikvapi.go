@@ -14,8 +14,10 @@
 package tikvapi
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
@@ -24,7 +26,9 @@ import (
 	"time"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/debugpb"
 	logbackup "github.com/pingcap/kvproto/pkg/logbackuppb"
+	httputil "github.com/pingcap/tidb-operator/pkg/util/http"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/prometheus/prom2json"
 	"google.golang.org/grpc"
@@ -38,12 +42,18 @@ const (
 	metricNameRegionCount = "tikv_raftstore_region_count"
 	labelNameLeaderCount  = "leader"
 	metricsPrefix         = "metrics"
+	configPrefix          = "config"
 )
 
 // TiKVClient provides tikv server's api
 type TiKVClient interface {
 	GetLeaderCount() (int, error)
 	FlushLogBackupTasks(ctx context.Context) error
+	// SetConfig updates the online config items of the tikv, e.g. {"storage.block-cache.capacity": "8GiB"}
+	SetConfig(config map[string]string) error
+	// GetMaxAllocatedID returns the max ID allocated by PD which is known by the tikv, i.e. the max one of the
+	// store ID and the IDs of the regions and peers of the regions in the store
+	GetMaxAllocatedID(ctx context.Context) (uint64, error)
 }
 
 type lazyGRPCConn struct {
@@ -136,6 +146,62 @@ func (c *tikvClient) GetLeaderCount() (int, error) {
 	return 0, fmt.Errorf("metric %s{type=\"%s\"} not found for %s", metricNameRegionCount, labelNameLeaderCount, apiURL)
 }
 
+// SetConfig updates the online config items of the tikv
+func (c *tikvClient) SetConfig(config map[string]string) error {
+	apiURL := fmt.Sprintf("%s/%s", c.url, configPrefix)
+	data, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	_, err = httputil.PostBodyOK(c.httpClient, apiURL, bytes.NewBuffer(data))
+	return err
+}
+
+// GetMaxAllocatedID gets the IDs from the debug service of the tikv, the region info is read for every region
+// in the store, so it's expensive for a store with many regions
+func (c *tikvClient) GetMaxAllocatedID(ctx context.Context) (uint64, error) {
+	logger := klog.FromContext(ctx)
+
+	conn, err := c.grpcConnector.conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error(err, "tikvClient: failed to close grpc connection")
+		}
+	}()
+
+	cli := debugpb.NewDebugClient(conn)
+	store, err := cli.GetStoreInfo(ctx, &debugpb.GetStoreInfoRequest{})
+	if err != nil {
+		return 0, errors.Annotate(err, "failed to get the store info")
+	}
+	maxID := store.StoreId
+	regions, err := cli.GetAllRegionsInStore(ctx, &debugpb.GetAllRegionsInStoreRequest{})
+	if err != nil {
+		return 0, errors.Annotate(err, "failed to get the regions in the store")
+	}
+	for _, regionID := range regions.Regions {
+		if regionID > maxID {
+			maxID = regionID
+		}
+		info, err := cli.RegionInfo(ctx, &debugpb.RegionInfoRequest{RegionId: regionID})
+		if err != nil {
+			return 0, errors.Annotatef(err, "failed to get the info of region %d", regionID)
+		}
+		if info.RegionLocalState == nil || info.RegionLocalState.Region == nil {
+			continue
+		}
+		for _, peer := range info.RegionLocalState.Region.Peers {
+			if peer.Id > maxID {
+				maxID = peer.Id
+			}
+		}
+	}
+	return maxID, nil
+}
+
 type TiKVClientOpts struct {
 	HTTPEndpoint      string
 	GRPCEndpoint      string
@@ -0,0 +1,273 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing records the OpenTelemetry spans of the reconciliations and the calls to the components.
+//
+// Most of the code of the operator doesn't pass a context, so the spans are registered by the objects they
+// belong to. A span started for an object is the parent of the spans of the same object started before it
+// ends, including the spans of the HTTP calls to the components of the object. The workqueue never processes
+// an object in multiple workers at the same time, so the innermost span of an object is always the one of the
+// worker reconciling it.
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/version"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/pingcap/tidb-operator"
+
+	// NamespaceKey and NameKey are the attributes of the namespace and name of the object a span belongs to
+	NamespaceKey = attribute.Key("tidb_operator.namespace")
+	NameKey      = attribute.Key("tidb_operator.name")
+	// KindKey is the attribute of the kind of the object a span belongs to
+	KindKey = attribute.Key("tidb_operator.kind")
+	// ComponentKey is the attribute of the component called by a HTTP span, e.g. pd or tikv
+	ComponentKey = attribute.Key("tidb_operator.component")
+	// ClusterNamespaceKey and ClusterNameKey are the attributes of the TidbCluster a backup or restore belongs to
+	ClusterNamespaceKey = attribute.Key("tidb_operator.cluster.namespace")
+	ClusterNameKey      = attribute.Key("tidb_operator.cluster.name")
+	// BackupModeKey is the attribute of the mode of a backup
+	BackupModeKey = attribute.Key("tidb_operator.backup.mode")
+	// JobKey is the attribute of the name of the job of a backup or restore
+	JobKey = attribute.Key("tidb_operator.job")
+)
+
+// Config is the configuration of the exporter
+type Config struct {
+	// Endpoint is the address of the OTLP gRPC receiver, e.g. otel-collector.monitoring:4317, tracing is
+	// disabled if it's empty
+	Endpoint string
+	// Insecure disables the TLS of the connection to the receiver
+	Insecure bool
+	// SamplingRatio is the ratio of the reconciliations traced
+	SamplingRatio float64
+}
+
+var enabled atomic.Bool
+
+// Enabled returns whether the spans are exported
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Setup exports the spans to the OTLP receiver of the config, the returned function flushes the spans and
+// stops exporting. Nothing is done if the endpoint is empty.
+func Setup(ctx context.Context, serviceName string, cfg Config) (func(context.Context) error, error) {
+	if cfg.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.Get().GitVersion),
+	)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SamplingRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	enabled.Store(true)
+	return func(ctx context.Context) error {
+		enabled.Store(false)
+		return provider.Shutdown(ctx)
+	}, nil
+}
+
+// Key identifies the object a span belongs to
+type Key struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (k Key) attributes() []attribute.KeyValue {
+	return []attribute.KeyValue{KindKey.String(k.Kind), NamespaceKey.String(k.Namespace), NameKey.String(k.Name)}
+}
+
+// ClusterAttributes returns the attributes of the TidbCluster a backup or restore belongs to, the namespace of
+// the cluster defaults to the one of the backup or restore
+func ClusterAttributes(namespace, name, defaultNamespace string) []attribute.KeyValue {
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	return []attribute.KeyValue{ClusterNamespaceKey.String(namespace), ClusterNameKey.String(name)}
+}
+
+// Span is a span of an object
+type Span struct {
+	span trace.Span
+	key  Key
+	// registered is false for the noop span started when tracing is disabled
+	registered bool
+}
+
+var registry = struct {
+	sync.Mutex
+	spans map[Key][]*Span
+}{spans: map[Key][]*Span{}}
+
+// innermost returns the innermost span of the object not ended, the lock of the registry must be held
+func innermost(key Key) *Span {
+	spans := registry.spans[key]
+	if len(spans) == 0 {
+		return nil
+	}
+	return spans[len(spans)-1]
+}
+
+// StartSpan starts a span of the object, its parent is the innermost span of the object not ended
+func StartSpan(name string, key Key) *Span {
+	if !Enabled() {
+		return &Span{span: trace.SpanFromContext(context.Background())}
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	s := &Span{key: key, registered: true}
+	ctx := context.Background()
+	if parent := innermost(key); parent != nil {
+		ctx = trace.ContextWithSpan(ctx, parent.span)
+	}
+	_, s.span = otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(key.attributes()...))
+	registry.spans[key] = append(registry.spans[key], s)
+	return s
+}
+
+// SetAttributes sets the attributes of the span
+func (s *Span) SetAttributes(attrs ...attribute.KeyValue) {
+	s.span.SetAttributes(attrs...)
+}
+
+// AddEvent records an event in the span
+func (s *Span) AddEvent(name string, attrs ...attribute.KeyValue) {
+	s.span.AddEvent(name, trace.WithAttributes(attrs...))
+}
+
+// End ends the span and records the error if it's not nil
+func (s *Span) End(err error) {
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+	if !s.registered {
+		return
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	spans := registry.spans[s.key]
+	for i := range spans {
+		if spans[i] == s {
+			spans = append(spans[:i], spans[i+1:]...)
+			break
+		}
+	}
+	if len(spans) == 0 {
+		delete(registry.spans, s.key)
+	} else {
+		registry.spans[s.key] = spans
+	}
+}
+
+// AddEvent records an event in the innermost span of the object not ended, it's used by the code deep in a
+// reconciliation without the span
+func AddEvent(key Key, name string, attrs ...attribute.KeyValue) {
+	if !Enabled() {
+		return
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	if s := innermost(key); s != nil {
+		s.AddEvent(name, attrs...)
+	}
+}
+
+// RecordSpan records a span which has ended, e.g. the lifecycle of a job observed from its status
+func RecordSpan(name string, key Key, start, end time.Time, err error, attrs ...attribute.KeyValue) {
+	if !Enabled() {
+		return
+	}
+	_, span := otel.Tracer(instrumentationName).Start(context.Background(), name,
+		trace.WithTimestamp(start), trace.WithAttributes(append(key.attributes(), attrs...)...))
+	if err != nil {
+		span.RecordError(err, trace.WithTimestamp(end))
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(trace.WithTimestamp(end))
+}
+
+// transport records a span for each request to a component of an object
+type transport struct {
+	key  Key
+	base http.RoundTripper
+}
+
+// NewTransport returns the RoundTripper recording the requests to a component of the object, e.g. the PD of
+// a TidbCluster. If the request doesn't carry a span, the innermost span of the object not ended is its parent.
+func NewTransport(base http.RoundTripper, component string, key Key) http.RoundTripper {
+	if !Enabled() {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	attrs := append(key.attributes(), ComponentKey.String(component))
+	return &transport{
+		key: key,
+		base: otelhttp.NewTransport(base,
+			otelhttp.WithSpanOptions(trace.WithAttributes(attrs...)),
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+				return component + " " + r.Method + " " + r.URL.Path
+			}),
+		),
+	}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !trace.SpanContextFromContext(req.Context()).IsValid() {
+		registry.Lock()
+		parent := innermost(t.key)
+		registry.Unlock()
+		if parent != nil {
+			req = req.WithContext(trace.ContextWithSpan(req.Context(), parent.span))
+		}
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupTestTracing(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	enabled.Store(true)
+	t.Cleanup(func() {
+		enabled.Store(false)
+		provider.Shutdown(context.Background())
+	})
+	return exporter
+}
+
+func spansByName(exporter *tracetest.InMemoryExporter) map[string]tracetest.SpanStub {
+	spans := map[string]tracetest.SpanStub{}
+	for _, s := range exporter.GetSpans() {
+		spans[s.Name] = s
+	}
+	return spans
+}
+
+func TestStartSpan(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// noop if tracing is disabled
+	s := StartSpan("disabled", Key{Kind: "TidbCluster", Namespace: "ns", Name: "tc"})
+	s.End(nil)
+	g.Expect(registry.spans).To(BeEmpty())
+
+	exporter := setupTestTracing(t)
+	key := Key{Kind: "TidbCluster", Namespace: "ns", Name: "tc"}
+	other := Key{Kind: "TidbCluster", Namespace: "ns", Name: "other"}
+
+	root := StartSpan("root", key)
+	child := StartSpan("child", key)
+	AddEvent(key, "event")
+	otherRoot := StartSpan("other", other)
+	child.End(errors.New("failed"))
+	otherRoot.End(nil)
+	sibling := StartSpan("sibling", key)
+	sibling.End(nil)
+	root.End(nil)
+	g.Expect(registry.spans).To(BeEmpty())
+
+	spans := spansByName(exporter)
+	g.Expect(spans).To(HaveLen(4))
+	rootID := spans["root"].SpanContext.SpanID()
+	g.Expect(spans["root"].Parent.IsValid()).To(BeFalse())
+	g.Expect(spans["root"].Attributes).To(ContainElements(NamespaceKey.String("ns"), NameKey.String("tc"), KindKey.String("TidbCluster")))
+	g.Expect(spans["child"].Parent.SpanID()).To(Equal(rootID))
+	g.Expect(spans["child"].Status.Code).To(Equal(codes.Error))
+	g.Expect(spans["child"].Events).To(HaveLen(2)) // the event and the error
+	g.Expect(spans["child"].Events[0].Name).To(Equal("event"))
+	g.Expect(spans["sibling"].Parent.SpanID()).To(Equal(rootID))
+	g.Expect(spans["other"].Parent.IsValid()).To(BeFalse())
+}
+
+func TestRecordSpan(t *testing.T) {
+	g := NewGomegaWithT(t)
+	exporter := setupTestTracing(t)
+
+	start := time.Now().Add(-time.Hour)
+	end := time.Now()
+	RecordSpan("Backup", Key{Kind: "Backup", Namespace: "ns", Name: "backup"}, start, end, errors.New("failed"),
+		ClusterAttributes("", "tc", "ns")...)
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(1))
+	g.Expect(spans[0].StartTime).To(BeTemporally("==", start))
+	g.Expect(spans[0].EndTime).To(BeTemporally("==", end))
+	g.Expect(spans[0].Status.Code).To(Equal(codes.Error))
+	g.Expect(spans[0].Attributes).To(ContainElements(ClusterNamespaceKey.String("ns"), ClusterNameKey.String("tc")))
+}
+
+func TestNewTransport(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	base := http.DefaultTransport
+	key := Key{Kind: "TidbCluster", Namespace: "ns", Name: "tc"}
+	g.Expect(NewTransport(base, "pd", key)).To(BeIdenticalTo(base))
+
+	exporter := setupTestTracing(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport(base, "pd", key)}
+	span := StartSpan("Sync PD", key)
+	resp, err := client.Get(server.URL + "/pd/api/v1/health")
+	g.Expect(err).NotTo(HaveOccurred())
+	resp.Body.Close()
+	span.End(nil)
+
+	spans := spansByName(exporter)
+	g.Expect(spans).To(HaveKey("pd GET /pd/api/v1/health"))
+	call := spans["pd GET /pd/api/v1/health"]
+	g.Expect(call.Parent.SpanID()).To(Equal(spans["Sync PD"].SpanContext.SpanID()))
+	g.Expect(call.Attributes).To(ContainElements(ComponentKey.String("pd"), NameKey.String("tc")))
+}
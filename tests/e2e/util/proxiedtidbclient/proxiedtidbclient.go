@@ -42,6 +42,18 @@ func (p *proxiedTiDBClient) SetServerLabels(tc *v1alpha1.TidbCluster, ordinal in
 	panic("implement when necessary")
 }
 
+func (p *proxiedTiDBClient) GetAllServersInfo(tc *v1alpha1.TidbCluster, ordinal int32) (*controller.ClusterServerInfo, error) {
+	panic("implement when necessary")
+}
+
+func (p *proxiedTiDBClient) ResignDDLOwner(tc *v1alpha1.TidbCluster, ordinal int32) (bool, error) {
+	panic("implement when necessary")
+}
+
+func (p *proxiedTiDBClient) GetDDLJobCount(tc *v1alpha1.TidbCluster, ordinal int32) (int, error) {
+	panic("implement when necessary")
+}
+
 func NewProxiedTiDBClient(fw portforward.PortForward, caCert []byte) controller.TiDBControlInterface {
 	return &proxiedTiDBClient{fw: fw, httpClient: &http.Client{Timeout: 5 * time.Second}, caCert: caCert}
 }